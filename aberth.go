@@ -0,0 +1,306 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"math/bits"
+	"runtime"
+	"sync"
+)
+
+// ErrZeroPoly is returned by Aberth for the zero polynomial, whose roots are
+// not isolated.
+var ErrZeroPoly = errors.New("bigfloat: zero polynomial")
+
+// A PolyRoot is an approximate root of a polynomial, as found by Aberth.
+type PolyRoot struct {
+	// Root is the approximation, rounded to the requested precision.
+	Root *Complex
+	// Radius is the radius of a disc around Root that contains a root of the
+	// polynomial, from the Newton inclusion bound n|p(z)/p'(z)|.
+	Radius *big.Float
+	// Steps is the number of iterations after which the root stopped moving,
+	// or the iteration limit if it never did.
+	Steps int
+	// Converged is true if the last correction of Root was negligible at the
+	// requested precision.
+	Converged bool
+	// Cluster labels the cluster of the root, counting from zero in the order
+	// of first appearance. Roots whose inclusion discs overlap, directly or
+	// through other roots, share a cluster.
+	Cluster int
+	// ClusterSize is the number of roots in the cluster. A cluster of size m
+	// that stays put as the precision grows usually marks a root of
+	// multiplicity m.
+	ClusterSize int
+}
+
+// Aberth returns approximations of the n roots of p, where n is the degree of
+// p, found with the Aberth–Ehrlich iteration
+// 		zᵢ ↦ zᵢ - p(zᵢ) / (p'(zᵢ) - p(zᵢ) Σ 1/(zᵢ - zⱼ))
+// with the sum over j ≠ i. The corrections of one sweep are computed from the
+// previous approximations by workers goroutines, so the result does not
+// depend on the number of workers; if workers is not positive, then
+// GOMAXPROCS is used. Each root is tracked separately and stops moving once
+// its correction is below 2^(-prec) of its modulus, and the iteration ends
+// when every root has converged or after maxIter sweeps. Roots at zero are
+// deflated exactly. If p is zero, then Aberth returns ErrZeroPoly.
+func (p *ComplexPoly) Aberth(prec uint, maxIter, workers int) ([]PolyRoot, error) {
+	q := (&ComplexPoly{p.c}).trim()
+	if len(q.c) == 0 {
+		return nil, ErrZeroPoly
+	}
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	w := prec + guardBits
+	zeros := 0
+	for q.c[zeros].l.Sign() == 0 && q.c[zeros].r.Sign() == 0 {
+		zeros++
+	}
+	q.c = q.c[zeros:]
+	n := q.Degree()
+	z := aberthStart(q, w)
+	steps := make([]int, n)
+	done := make([]bool, n)
+	next := make([]*Complex, n)
+	for k := 0; k < maxIter; k++ {
+		parallelFor(n, workers, func(i int) {
+			next[i] = nil
+			if !done[i] {
+				next[i], done[i] = aberthStep(q, z, i, w, prec)
+				steps[i] = k + 1
+			}
+		})
+		all := true
+		for i, v := range next {
+			if v != nil {
+				z[i] = v
+			}
+			all = all && done[i]
+		}
+		if all {
+			break
+		}
+	}
+	roots := make([]PolyRoot, zeros+n)
+	for i := range roots {
+		roots[i].Root = newComplexPrec(prec)
+		roots[i].Radius = newFloat(prec)
+		roots[i].Converged = true
+	}
+	parallelFor(n, workers, func(i int) {
+		r := &roots[zeros+i]
+		r.Root.round(z[i], prec)
+		r.Radius.Set(newtonRadius(q, z[i], w))
+		r.Steps = steps[i]
+		r.Converged = done[i]
+	})
+	clusterRoots(roots)
+	return roots, nil
+}
+
+// aberthStart returns n initial approximations for the roots of p, where n
+// is the degree of p, spread on a circle whose radius estimates the largest
+// root modulus. The angles are offset so that the points do not sit on a
+// symmetry axis of p.
+func aberthStart(p *ComplexPoly, prec uint) []*Complex {
+	n := p.Degree()
+	if n == 0 {
+		return nil
+	}
+	lead := complexExpo(&p.c[n])
+	r := math.Inf(-1)
+	for k := 0; k < n; k++ {
+		if p.c[k].l.Sign() == 0 && p.c[k].r.Sign() == 0 {
+			continue
+		}
+		r = math.Max(r, float64(complexExpo(&p.c[k])-lead)/float64(n-k))
+	}
+	e := int(math.Floor(r))
+	m := math.Exp2(r - float64(e))
+	z := make([]*Complex, n)
+	for k := range z {
+		s, c := math.Sincos(2*math.Pi*float64(k)/float64(n) + 0.4)
+		z[k] = newComplexPrec(prec)
+		z[k].l.SetMantExp(big.NewFloat(m*c), e)
+		z[k].r.SetMantExp(big.NewFloat(m*s), e)
+	}
+	return z
+}
+
+// aberthStep returns the Aberth–Ehrlich update of z[i] at prec bits, and true
+// if the correction is negligible at target bits.
+func aberthStep(p *ComplexPoly, z []*Complex, i int, prec, target uint) (*Complex, bool) {
+	v, d := p.evalDeriv(z[i], prec)
+	if v.l.Sign() == 0 && v.r.Sign() == 0 {
+		return z[i], true
+	}
+	sum := newComplexPrec(prec)
+	for j, u := range z {
+		diff := newComplexPrec(prec).Sub(z[i], u)
+		if j == i || diff.l.Sign() == 0 && diff.r.Sign() == 0 {
+			continue
+		}
+		sum.Add(sum, newComplexPrec(prec).Inv(diff))
+	}
+	den := newComplexPrec(prec).Sub(d, sum.Mul(sum, v))
+	if den.l.Sign() == 0 && den.r.Sign() == 0 {
+		// Nudge the root off the critical point.
+		nudge := newComplexPrec(prec).Copy(z[i])
+		e := complexExpo(z[i]) - int(target/2)
+		nudge.l.Add(&nudge.l, new(big.Float).SetMantExp(big.NewFloat(1), e))
+		return nudge, false
+	}
+	corr := newComplexPrec(prec).Quo(v, den)
+	next := newComplexPrec(prec).Sub(z[i], corr)
+	return next, complexExpo(corr) < complexExpo(next)-int(target)
+}
+
+// newtonRadius returns the radius n|p(z)/p'(z)| of a disc around z that
+// contains a root of p, where n is the degree of p. The radius is +Inf if
+// p'(z) is zero and p(z) is not.
+func newtonRadius(p *ComplexPoly, z *Complex, prec uint) *big.Float {
+	v, d := p.evalDeriv(z, prec)
+	r := newFloat(prec)
+	if v.l.Sign() == 0 && v.r.Sign() == 0 {
+		return r
+	}
+	if d.l.Sign() == 0 && d.r.Sign() == 0 {
+		return r.SetInf(false)
+	}
+	r.Quo(v.Quad(), d.Quad())
+	r.Sqrt(r)
+	return r.Mul(r, newFloat(prec).SetInt64(int64(p.Degree())))
+}
+
+// clusterRoots groups the roots whose inclusion discs overlap, directly or
+// through other roots, and sets their Cluster and ClusterSize fields.
+func clusterRoots(roots []PolyRoot) {
+	parent := make([]int, len(roots))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	for i := range roots {
+		for j := i + 1; j < len(roots); j++ {
+			if find(i) == find(j) {
+				continue
+			}
+			s := new(big.Float).Add(roots[i].Radius, roots[j].Radius)
+			if s.IsInf() || new(Complex).Sub(roots[i].Root, roots[j].Root).Quad().Cmp(s.Mul(s, s)) <= 0 {
+				parent[find(j)] = find(i)
+			}
+		}
+	}
+	label := make(map[int]int)
+	size := make(map[int]int)
+	for i := range roots {
+		c := find(i)
+		if _, ok := label[c]; !ok {
+			label[c] = len(label)
+		}
+		size[c]++
+	}
+	for i := range roots {
+		c := find(i)
+		roots[i].Cluster = label[c]
+		roots[i].ClusterSize = size[c]
+	}
+}
+
+// parallelFor calls f(i) for i = 0, 1, ..., n-1 from at most workers
+// goroutines, and waits for every call to return.
+func parallelFor(n, workers int, f func(i int)) {
+	if workers > n {
+		workers = n
+	}
+	var wg sync.WaitGroup
+	for g := 0; g < workers; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := g; i < n; i += workers {
+				f(i)
+			}
+		}(g)
+	}
+	wg.Wait()
+}
+
+// Roots returns the n roots of p, where n is the degree of p, repeated
+// according to multiplicity and rounded to prec bits. The roots are found by
+// Aberth, with an iteration limit that grows with the degree and with prec,
+// and each root stops moving once its correction is below 2^(-prec) of its
+// modulus. The members of a cluster of size m that did not converge
+// approximate a root of multiplicity m only to a fraction of the precision,
+// so that root is refined by Newton's method on the (m-1)-th derivative of p,
+// for which it is a simple root, starting from the mean of the cluster. If p
+// is zero, then Roots panics.
+func (p *ComplexPoly) Roots(prec uint) []*Complex {
+	found, err := p.Aberth(prec, 100+p.Degree()+int(prec), 0)
+	if err != nil {
+		panic(err)
+	}
+	w := prec + guardBits
+	sums := make(map[int]*Complex)
+	stalled := make(map[int]bool)
+	for _, r := range found {
+		if sums[r.Cluster] == nil {
+			sums[r.Cluster] = newComplexPrec(w)
+		}
+		sums[r.Cluster].Add(sums[r.Cluster], r.Root)
+		stalled[r.Cluster] = stalled[r.Cluster] || !r.Converged
+	}
+	refined := make(map[int]*Complex)
+	roots := make([]*Complex, len(found))
+	for k, r := range found {
+		roots[k] = r.Root
+		if r.ClusterSize < 2 || !stalled[r.Cluster] {
+			continue
+		}
+		if refined[r.Cluster] == nil {
+			m := newFloat(w).SetInt64(int64(r.ClusterSize))
+			mean := sums[r.Cluster]
+			mean.l.Quo(&mean.l, m)
+			mean.r.Quo(&mean.r, m)
+			q := new(ComplexPoly).Copy(p)
+			for j := 1; j < r.ClusterSize; j++ {
+				q.Derivative(q)
+			}
+			refined[r.Cluster] = newComplexPrec(prec).round(q.newton(mean, w, prec), prec)
+		}
+		roots[k] = new(Complex).Copy(refined[r.Cluster])
+	}
+	return roots
+}
+
+// newton refines the root z of p by Newton's method at prec bits, until the
+// correction is below 2^(-target) of the modulus of z, and returns it. The
+// number of steps is limited, so that a root that Newton's method does not
+// reach is returned as it stands.
+func (p *ComplexPoly) newton(z *Complex, prec, target uint) *Complex {
+	z = newComplexPrec(prec).Copy(z)
+	for k := 0; k < 20+bits.Len(prec); k++ {
+		v, d := p.evalDeriv(z, prec)
+		if v.l.Sign() == 0 && v.r.Sign() == 0 || d.l.Sign() == 0 && d.r.Sign() == 0 {
+			break
+		}
+		corr := newComplexPrec(prec).Quo(v, d)
+		z.Sub(z, corr)
+		if complexExpo(corr) < complexExpo(z)-int(target) {
+			break
+		}
+	}
+	return z
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"testing"
+	"testing/quick"
+)
+
+// polyFromRoots returns the coefficients of the monic polynomial with the
+// given roots.
+func polyFromRoots(roots ...complex128) []*Complex {
+	c := []*Complex{newComplex128(1)}
+	for _, r := range roots {
+		next := make([]*Complex, len(c)+1)
+		next[0] = new(Complex)
+		for k, v := range c {
+			next[k+1] = new(Complex).Copy(v)
+		}
+		for k, v := range c {
+			next[k].Sub(next[k], new(Complex).Mul(v, newComplex128(r)))
+		}
+		c = next
+	}
+	return c
+}
+
+func TestAberthRootsOfUnity(t *testing.T) {
+	c := make([]*Complex, 17)
+	for k := range c {
+		c[k] = new(Complex)
+	}
+	c[0].l.SetInt64(-1)
+	c[16].l.SetInt64(1)
+	roots, err := NewComplexPoly(c...).Aberth(200, 100, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(roots) != 16 {
+		t.Fatalf("got %d roots", len(roots))
+	}
+	for _, u := range RootsOfUnity(16, 200) {
+		found := false
+		for _, r := range roots {
+			d := new(Complex).Sub(r.Root, u)
+			if d.Quad().Sign() == 0 || complexExpo(d) < -190 {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("root %v not found", u)
+		}
+	}
+	for _, r := range roots {
+		if !r.Converged || r.ClusterSize != 1 {
+			t.Errorf("root %v: converged = %v, cluster size = %d", r.Root, r.Converged, r.ClusterSize)
+		}
+		if r.Radius.Sign() != 0 && expo(r.Radius) > -190 {
+			t.Errorf("root %v: radius = %v", r.Root, r.Radius)
+		}
+	}
+}
+
+func TestAberthClusters(t *testing.T) {
+	// x²(x - 1)³(x + 2)
+	c := polyFromRoots(0, 0, 1, 1, 1, -2)
+	roots, err := NewComplexPoly(c...).Aberth(100, 200, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sizes := make(map[int]int)
+	for _, r := range roots {
+		sizes[r.ClusterSize]++
+		if r.ClusterSize == 3 && !closeToComplex128(r.Root, 1, 20) {
+			t.Errorf("root %v in cluster of size 3", r.Root)
+		}
+	}
+	if sizes[1] != 1 || sizes[2] != 2 || sizes[3] != 3 {
+		t.Errorf("cluster sizes = %v", sizes)
+	}
+}
+
+func TestAberthWorkers(t *testing.T) {
+	c := polyFromRoots(2, 1i, -1+0.5i, 3-2i, 0.25)
+	p := NewComplexPoly(c...)
+	serial, err := p.Aberth(150, 100, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parallel, err := p.Aberth(150, 100, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k := range serial {
+		if !serial[k].Root.Equals(parallel[k].Root) || serial[k].Steps != parallel[k].Steps {
+			t.Errorf("root %d: %v ≠ %v", k, serial[k].Root, parallel[k].Root)
+		}
+	}
+}
+
+func TestAberthZero(t *testing.T) {
+	// The second polynomial has zero coefficients that were never trimmed.
+	for _, p := range []*ComplexPoly{NewComplexPoly(new(Complex)), {make([]Complex, 3)}} {
+		if roots, err := p.Aberth(64, 10, 1); err != ErrZeroPoly {
+			t.Errorf("Aberth(%v) = %v, %v, want ErrZeroPoly", p, roots, err)
+		}
+	}
+}
+
+func TestComplexPolyRoots(t *testing.T) {
+	// (x - 1)²(x - i)(x + 2)
+	c := polyFromRoots(1, 1, 1i, -2)
+	roots := NewComplexPoly(c...).Roots(120)
+	for _, want := range []complex128{1, 1, 1i, -2} {
+		found := false
+		for _, r := range roots {
+			if closeToComplex(r, newComplex128(want), 100) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("root %v not found in %v", want, roots)
+		}
+	}
+	ones := 0
+	for _, r := range roots {
+		if closeToComplex(r, newComplex128(1), 100) {
+			ones++
+		}
+	}
+	if ones != 2 {
+		t.Errorf("double root found %d times", ones)
+	}
+}
+
+func TestComplexPolyRootsResidual(t *testing.T) {
+	f := func(a, b, c *Complex) bool {
+		// t.Logf("a = %v, b = %v, c = %v", a, b, c)
+		p := NewComplexPoly(a, b, c, newComplex128(1))
+		for _, r := range p.Roots(150) {
+			v := p.Eval(setPrecComplex(r, 300))
+			if v.l.Sign() != 0 && complexExpo(v) > -130 {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
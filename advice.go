@@ -0,0 +1,95 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math"
+
+// A PlanKind selects the computation described by a PrecisionPlan.
+type PlanKind int
+
+const (
+	// PolyEval is the evaluation of a polynomial of degree N by Horner's
+	// rule at a point x with |x| ≤ Radius. Its error is at most
+	// 		2Nu(|a0| + |a1||x| + ... + |aN||x|^N)
+	// for a unit roundoff u.
+	PolyEval PlanKind = iota
+	// Product is the product of N values of one of the types of this
+	// package, whose Mul rounds each component once, so that every Mul adds
+	// a relative error of at most u to the quadrance norm, and rounding the
+	// factors adds as much again.
+	Product
+	// Sum is the recursive sum of N values, whose error is at most
+	// 		(N-1)u(|x1| + ... + |xN|)
+	Sum
+)
+
+// A PrecisionPlan describes a computation for AdvisePrecision.
+type PrecisionPlan struct {
+	Kind PlanKind
+	// N is the degree of the polynomial, or the number of factors or terms.
+	N int
+	// Radius bounds the magnitude of the point of a PolyEval.
+	Radius float64
+	// Cond is the condition number of the result: the ratio of the bound
+	// on its magnitude that enters the error, such as |a0| + ... +
+	// |aN||x|^N, to its actual magnitude. It measures the bits lost to
+	// cancellation. If Cond is zero, then 1 is used, except for PolyEval,
+	// where the worst case for coefficients and a result of magnitude 1 is
+	// used: 1 + Radius + ... + Radius^N.
+	Cond float64
+}
+
+// adviceMargin is the number of bits added by AdvisePrecision to the error
+// bounds, which are first-order in the unit roundoff.
+const adviceMargin = 4
+
+// AdvisePrecision returns the working precision, in bits, at which the
+// computation described by plan gives a result with a relative error of at
+// most 2^-bits. It adds to bits the logarithm of the growth factor of the
+// standard error bound of the computation, such as 2N for Horner's rule, and
+// of the condition number, and a small margin. The advice is a bound, so it
+// is pessimistic for well-behaved data; it is meant to replace guesses, not
+// escalation to a verified result. If N is less than 1, or if Radius or Cond
+// is negative or not finite, then AdvisePrecision panics.
+func AdvisePrecision(plan PrecisionPlan, bits uint) uint {
+	if plan.N < 1 {
+		panic("plan with no terms")
+	}
+	if !(plan.Radius >= 0) || math.IsInf(plan.Radius, 0) || !(plan.Cond >= 0) || math.IsInf(plan.Cond, 0) {
+		panic("invalid plan parameter")
+	}
+	n := float64(plan.N)
+	// Both growth and cond are kept as base-2 logarithms, so that a large
+	// degree and radius do not overflow.
+	var growth, cond float64
+	switch plan.Kind {
+	case PolyEval:
+		growth = math.Log2(2 * n)
+		if plan.Cond == 0 {
+			cond = logSumPowers(plan.Radius, plan.N)
+		}
+	case Product:
+		growth = math.Log2(2 * n)
+	case Sum:
+		growth = math.Log2(math.Max(n-1, 1))
+	default:
+		panic("unknown plan kind")
+	}
+	if plan.Cond > 0 {
+		cond = math.Max(math.Log2(plan.Cond), 0)
+	}
+	return bits + uint(math.Ceil(growth+cond)) + adviceMargin
+}
+
+// logSumPowers returns the base-2 logarithm of 1 + r + ... + r^n.
+func logSumPowers(r float64, n int) float64 {
+	switch {
+	case r == 1:
+		return math.Log2(float64(n + 1))
+	case r < 1:
+		return math.Log2((1 - math.Pow(r, float64(n+1))) / (1 - r))
+	}
+	// For r > 1 the sum is r^n (1 - r^-(n+1))/(1 - 1/r).
+	return float64(n)*math.Log2(r) + math.Log2((1-math.Pow(r, -float64(n+1)))/(1-1/r))
+}
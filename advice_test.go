@@ -0,0 +1,54 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestAdvisePrecision(t *testing.T) {
+	tests := []struct {
+		plan PrecisionPlan
+		want uint
+	}{
+		// 2N = 16 costs 4 bits.
+		{PrecisionPlan{Kind: Product, N: 8}, 100 + 4 + adviceMargin},
+		// 1 + 2 + ... + 2^10 < 2^11, and 2N = 20 costs 5 bits.
+		{PrecisionPlan{Kind: PolyEval, N: 10, Radius: 2}, 100 + 16 + adviceMargin},
+		// A given condition number replaces the worst case.
+		{PrecisionPlan{Kind: PolyEval, N: 10, Radius: 2, Cond: 1024}, 100 + 15 + adviceMargin},
+		{PrecisionPlan{Kind: Sum, N: 1}, 100 + adviceMargin},
+		{PrecisionPlan{Kind: Sum, N: 1025, Cond: 0.5}, 100 + 10 + adviceMargin},
+	}
+	for _, test := range tests {
+		if got := AdvisePrecision(test.plan, 100); got != test.want {
+			t.Errorf("AdvisePrecision(%+v, 100) = %d, want %d", test.plan, got, test.want)
+		}
+	}
+	// A huge radius does not overflow.
+	if got := AdvisePrecision(PrecisionPlan{Kind: PolyEval, N: 1000, Radius: 1e300}, 53); got < 53+996*1000 {
+		t.Errorf("AdvisePrecision for a huge radius = %d", got)
+	}
+}
+
+func TestAdvisePrecisionPolyEval(t *testing.T) {
+	// p(x) = (x - 1)^10 near its root, expanded, loses most of its bits to
+	// cancellation; at the advised precision the result is still accurate.
+	x := big.NewFloat(1.0009765625) // 1 + 2^-10
+	c := []float64{1, -10, 45, -120, 210, -252, 210, -120, 45, -10, 1}
+	// |p(x)| = 2^-100, and the bound |a0| + ... + |a10||x|^10 is about 2^10.
+	plan := PrecisionPlan{Kind: PolyEval, N: 10, Radius: 1.01, Cond: 0x1p110}
+	prec := AdvisePrecision(plan, 60)
+	p := newFloat(prec)
+	xp := newFloat(prec).Set(x)
+	for k := 10; k >= 0; k-- {
+		p.Mul(p, xp)
+		p.Add(p, big.NewFloat(c[10-k]))
+	}
+	want := new(big.Float).SetMantExp(big.NewFloat(1), -100)
+	if !closeTo(p, want, 60) {
+		t.Errorf("p(x) at %d bits = %v, want %v", prec, p, want)
+	}
+}
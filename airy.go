@@ -0,0 +1,209 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+)
+
+// airyZeta returns ζ = (2/3)x^(3/2) and x^(1/4), both with principal
+// branches.
+func airyZeta(x *Complex) (*Complex, *Complex) {
+	s := new(Complex).Sqrt(x)
+	q := new(Complex).Sqrt(s)
+	zeta := new(Complex).Mul(x, s)
+	zeta.Scal(zeta, newFloat(maxPrec(&x.l, &x.r)).Quo(big.NewFloat(2), big.NewFloat(3)))
+	return zeta, q
+}
+
+// airySeries returns Ai(x) and Bi(x) from the Maclaurin series
+// 		Ai(x) = c1 f(x) - c2 g(x)
+// 		Bi(x) = √3 (c1 f(x) + c2 g(x))
+// where c1 = Ai(0), c2 = -Ai'(0), and
+// 		f(x) = Σ 3^k (1/3)_k x^3k/(3k)!
+// 		g(x) = Σ 3^k (2/3)_k x^(3k+1)/(3k+1)!
+func airySeries(x *Complex, prec uint) (*Complex, *Complex) {
+	w := prec + guardBits + uint(math.Log2E*4/3*math.Pow(complexAbs(x), 1.5))
+	y := newComplexPrec(w).round(x, w)
+	cube := new(Complex).Mul(y, y)
+	cube.Mul(cube, y)
+	f := newComplexPrec(w)
+	f.l.SetInt64(1)
+	g := new(Complex).Copy(y)
+	tf := new(Complex).Copy(f)
+	tg := new(Complex).Copy(g)
+	peak := 0
+	c := newFloat(w)
+	for k := int64(1); ; k++ {
+		tf.Mul(tf, cube)
+		tf.Scal(tf, c.Quo(big.NewFloat(1), c.SetInt64((3*k-1)*3*k)))
+		tg.Mul(tg, cube)
+		tg.Scal(tg, c.Quo(big.NewFloat(1), c.SetInt64(3*k*(3*k+1))))
+		f.Add(f, tf)
+		g.Add(g, tg)
+		e := complexExpo(tf)
+		if eg := complexExpo(tg); eg > e {
+			e = eg
+		}
+		if e > peak {
+			peak = e
+		}
+		if e < peak-int(w) || (tf.l.Sign() == 0 && tf.r.Sign() == 0) {
+			break
+		}
+	}
+	// Γ(1/3) and Γ(2/3)
+	third := newComplexPrec(w)
+	third.l.Quo(big.NewFloat(1), big.NewFloat(3))
+	g13 := gammaComplex(third, w)
+	third.l.Quo(big.NewFloat(2), big.NewFloat(3))
+	g23 := gammaComplex(third, w)
+	// c1 = 3^(-2/3)/Γ(2/3) and c2 = 3^(-1/3)/Γ(1/3)
+	ln3 := bigLog(newFloat(w).SetInt64(3), w)
+	ln3.Quo(ln3, newFloat(w).SetInt64(3))
+	cbrt3 := bigExp(ln3, w)
+	c2 := newFloat(w).Mul(cbrt3, &g13.l)
+	c2.Quo(big.NewFloat(1), c2)
+	c1 := newFloat(w).Mul(cbrt3, cbrt3)
+	c1.Quo(big.NewFloat(1), c1.Mul(c1, &g23.l))
+	f.Scal(f, c1)
+	g.Scal(g, c2)
+	ai := new(Complex).Sub(f, g)
+	bi := new(Complex).Add(f, g)
+	sqrt3 := newFloat(w).SetInt64(3)
+	bi.Scal(bi, sqrt3.Sqrt(sqrt3))
+	return newComplexPrec(prec).round(ai, prec), newComplexPrec(prec).round(bi, prec)
+}
+
+// airyAsymptotic returns Ai(x) from the asymptotic expansion
+// 		Ai(x) = exp(-ζ)/(2√π x^(1/4)) Σ (-1)^k u[k]/ζ^k
+// where ζ = (2/3)x^(3/2), and u[k] = (2k+1)(2k+3)...(6k-1)/(216^k k!). It
+// requires |arg(x)| ≤ 2π/3, and the boolean result is false if the expansion
+// cannot reach the precision.
+func airyAsymptotic(x *Complex, prec uint) (*Complex, bool) {
+	w := prec + guardBits
+	y := newComplexPrec(w).round(x, w)
+	zeta, q := airyZeta(y)
+	inv := new(Complex).Inv(zeta)
+	sum := newComplexPrec(w)
+	sum.l.SetInt64(1)
+	term := new(Complex).Copy(sum)
+	c := newFloat(w)
+	last := 0
+	for k := int64(1); ; k++ {
+		if k > 8*int64(w) {
+			return nil, false
+		}
+		// u[k]/u[k-1] = (6k-5)(6k-3)(6k-1)/((2k-1)216k)
+		c.SetInt64((6*k - 5) * (6*k - 3) * (6*k - 1))
+		c.Quo(c, newFloat(w).SetInt64((2*k-1)*216*k))
+		term.Mul(term, inv)
+		term.Scal(term, c.Neg(c))
+		e := complexExpo(term)
+		if e < -int(w) {
+			break
+		}
+		if k > 1 && e > last {
+			return nil, false
+		}
+		last = e
+		sum.Add(sum, term)
+	}
+	pi := bigPi(w)
+	d := new(Complex).Scal(q, pi.Sqrt(pi))
+	d.Scal(d, big.NewFloat(2))
+	sum.Mul(sum, new(Complex).Exp(zeta.Neg(zeta)))
+	return newComplexPrec(prec).round(new(Complex).Quo(sum, d), prec), true
+}
+
+// omega returns e^(2πik/3) at precision prec.
+func omega(k int, prec uint) *Complex {
+	z := newComplexPrec(prec)
+	s := newFloat(prec).SetInt64(3)
+	s.Sqrt(s)
+	s.SetMantExp(s, -1)
+	switch (k%3 + 3) % 3 {
+	case 0:
+		z.l.SetInt64(1)
+	case 1:
+		z.l.SetFloat64(-0.5)
+		z.r.Set(s)
+	default:
+		z.l.SetFloat64(-0.5)
+		z.r.Neg(s)
+	}
+	return z
+}
+
+// airyAi returns Ai(x) rounded to prec bits.
+func airyAi(x *Complex, prec uint) *Complex {
+	zeta, _ := airyZeta(x)
+	if complexAbs(zeta) > asymptoticThreshold(prec+guardBits) {
+		if math.Abs(complexArg(x)) <= 2*math.Pi/3 {
+			if ai, ok := airyAsymptotic(x, prec); ok {
+				return ai
+			}
+		} else {
+			// Ai(x) = -ω Ai(ωx) - ω² Ai(ω²x)
+			w := prec + guardBits
+			y := newComplexPrec(w).round(x, w)
+			w1, w2 := omega(1, w), omega(2, w)
+			a := airyAi(new(Complex).Mul(w1, y), w)
+			b := airyAi(new(Complex).Mul(w2, y), w)
+			a.Mul(a, w1)
+			b.Mul(b, w2)
+			a.Add(a, b)
+			return newComplexPrec(prec).round(a.Neg(a), prec)
+		}
+	}
+	ai, _ := airySeries(x, prec)
+	return ai
+}
+
+// airyBi returns Bi(x) rounded to prec bits.
+func airyBi(x *Complex, prec uint) *Complex {
+	zeta, _ := airyZeta(x)
+	if complexAbs(zeta) > asymptoticThreshold(prec+guardBits) {
+		// Bi(x) = e^(πi/6) Ai(ωx) + e^(-πi/6) Ai(ω²x)
+		w := prec + guardBits
+		y := newComplexPrec(w).round(x, w)
+		w1, w2 := omega(1, w), omega(2, w)
+		a := airyAi(new(Complex).Mul(w1, y), w)
+		b := airyAi(new(Complex).Mul(w2, y), w)
+		e := newComplexPrec(w)
+		e.l.SetInt64(3)
+		e.l.Sqrt(&e.l)
+		e.l.SetMantExp(&e.l, -1)
+		e.r.SetFloat64(0.5)
+		a.Mul(a, e)
+		b.Mul(b, e.Conj(e))
+		return newComplexPrec(prec).round(a.Add(a, b), prec)
+	}
+	_, bi := airySeries(x, prec)
+	return bi
+}
+
+// AiryAi sets z equal to the Airy function Ai(x), and returns z.
+//
+// Small arguments are summed with the Maclaurin series at a precision raised
+// to absorb cancellation. Once |x|^(3/2) is large compared with the precision,
+// the asymptotic expansion is used in the sector |arg(x)| ≤ 2π/3, and the
+// connection formula
+// 		Ai(x) + ωAi(ωx) + ω²Ai(ω²x) = 0,    ω = e^(2πi/3)
+// covers the rest of the plane. The result is rounded to the precision of x.
+func (z *Complex) AiryAi(x *Complex) *Complex {
+	return z.Copy(airyAi(x, maxPrec(&x.l, &x.r)))
+}
+
+// AiryBi sets z equal to the Airy function Bi(x), and returns z.
+//
+// Small arguments are summed with the Maclaurin series at a precision raised
+// to absorb cancellation. Once |x|^(3/2) is large compared with the precision,
+// Bi is computed from the connection formula
+// 		Bi(x) = e^(πi/6)Ai(ωx) + e^(-πi/6)Ai(ω²x),    ω = e^(2πi/3)
+// The result is rounded to the precision of x.
+func (z *Complex) AiryBi(x *Complex) *Complex {
+	return z.Copy(airyBi(x, maxPrec(&x.l, &x.r)))
+}
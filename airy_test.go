@@ -0,0 +1,48 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestAiryValues(t *testing.T) {
+	cases := []struct {
+		x      float64
+		ai, bi float64
+	}{
+		{0, 0.35502805388781723926, 0.61492662744600073515},
+		{1, 0.13529241631288141552, 1.2074235949528712594},
+		{-1, 0.53556088329235211880, 0.10399738949694461189},
+	}
+	for _, c := range cases {
+		x := NewComplex(big.NewFloat(c.x), new(big.Float))
+		if got := new(Complex).AiryAi(x); !closeToComplex128(got, complex(c.ai, 0), 48) {
+			t.Errorf("AiryAi(%v) = %v, want %v", c.x, got, c.ai)
+		}
+		if got := new(Complex).AiryBi(x); !closeToComplex128(got, complex(c.bi, 0), 48) {
+			t.Errorf("AiryBi(%v) = %v, want %v", c.x, got, c.bi)
+		}
+	}
+}
+
+func TestAirySeriesAsymptoticAgree(t *testing.T) {
+	// At 53 bits these points use the asymptotic expansion, and at 300 bits
+	// they use the Maclaurin series.
+	points := [][2]float64{{15, 1}, {-14, 5}, {2, -15}, {-15, 0}, {-8, 13}}
+	for _, p := range points {
+		lo := NewComplex(big.NewFloat(p[0]), big.NewFloat(p[1]))
+		hi := newComplexPrec(300)
+		hi.l.SetFloat64(p[0])
+		hi.r.SetFloat64(p[1])
+		for k, f := range []func(z, x *Complex) *Complex{(*Complex).AiryAi, (*Complex).AiryBi} {
+			a := f(new(Complex), lo)
+			b := f(new(Complex), hi)
+			if d := new(Complex).Sub(a, b); complexExpo(d) > complexExpo(b)-45 {
+				t.Errorf("function %d at %v: %v and %v", k, lo, a, b)
+			}
+		}
+	}
+}
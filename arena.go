@@ -0,0 +1,142 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math/big"
+
+// arenaChunk is the number of values allocated at once by an Arena.
+const arenaChunk = 64
+
+// An Arena hands out values for a scoped computation. Values are allocated in
+// chunks, and Reset makes all of them available again, together with the
+// mantissa storage they have grown. A loop that resets the arena on every
+// iteration therefore stops allocating once the first iteration has run,
+// which removes most of the garbage produced by computations that create many
+// short-lived temporaries.
+//
+// Values obtained from an Arena must not be used after Reset. An Arena is not
+// safe for concurrent use.
+type Arena struct {
+	prec          uint
+	floats        slab[big.Float]
+	complexs      slab[Complex]
+	perplexs      slab[Perplex]
+	infras        slab[Infra]
+	hamiltons     slab[Hamilton]
+	cockles       slab[Cockle]
+	infraComplexs slab[InfraComplex]
+	supras        slab[Supra]
+}
+
+// NewArena returns a pointer to an empty Arena whose values have precision
+// prec. If prec is zero, then the values take their precision from the
+// operands of the first operation that sets them, as for new(big.Float).
+func NewArena(prec uint) *Arena {
+	return &Arena{prec: prec}
+}
+
+// Prec returns the precision of the values handed out by a.
+func (a *Arena) Prec() uint {
+	return a.prec
+}
+
+// Reset makes every value handed out by a available again. Values obtained
+// before Reset must not be used afterwards.
+func (a *Arena) Reset() {
+	a.floats.n = 0
+	a.complexs.n = 0
+	a.perplexs.n = 0
+	a.infras.n = 0
+	a.hamiltons.n = 0
+	a.cockles.n = 0
+	a.infraComplexs.n = 0
+	a.supras.n = 0
+}
+
+// A slab holds the values of one type for an Arena.
+type slab[T any] struct {
+	chunks [][]T
+	n      int
+}
+
+// next returns a pointer to the next unused value of s, allocating a new chunk
+// if every chunk is in use.
+func (s *slab[T]) next() *T {
+	i, j := s.n/arenaChunk, s.n%arenaChunk
+	if i == len(s.chunks) {
+		s.chunks = append(s.chunks, make([]T, arenaChunk))
+	}
+	s.n++
+	return &s.chunks[i][j]
+}
+
+// zeroFloat sets x equal to zero with precision prec, keeping its mantissa
+// storage, and returns x. If prec is zero, then x takes its precision from the
+// operands of the next operation that sets it, as for new(big.Float).
+func zeroFloat(x *big.Float, prec uint) *big.Float {
+	// SetInt64 gives a value of zero precision 64 bits, so the precision is
+	// set afterwards.
+	return x.SetInt64(0).SetPrec(prec)
+}
+
+// zero sets each of x equal to zero with the precision of a.
+func (a *Arena) zero(x ...*big.Float) {
+	for _, v := range x {
+		zeroFloat(v, a.prec)
+	}
+}
+
+// Float returns a pointer to a zero big.Float value from a.
+func (a *Arena) Float() *big.Float {
+	return zeroFloat(a.floats.next(), a.prec)
+}
+
+// Complex returns a pointer to a zero Complex value from a.
+func (a *Arena) Complex() *Complex {
+	z := a.complexs.next()
+	a.zero(z.Cartesian())
+	return z
+}
+
+// Perplex returns a pointer to a zero Perplex value from a.
+func (a *Arena) Perplex() *Perplex {
+	z := a.perplexs.next()
+	a.zero(z.Cartesian())
+	return z
+}
+
+// Infra returns a pointer to a zero Infra value from a.
+func (a *Arena) Infra() *Infra {
+	z := a.infras.next()
+	a.zero(z.Cartesian())
+	return z
+}
+
+// Hamilton returns a pointer to a zero Hamilton value from a.
+func (a *Arena) Hamilton() *Hamilton {
+	z := a.hamiltons.next()
+	a.zero(z.Cartesian())
+	return z
+}
+
+// Cockle returns a pointer to a zero Cockle value from a.
+func (a *Arena) Cockle() *Cockle {
+	z := a.cockles.next()
+	a.zero(z.Cartesian())
+	return z
+}
+
+// InfraComplex returns a pointer to a zero InfraComplex value from a.
+func (a *Arena) InfraComplex() *InfraComplex {
+	z := a.infraComplexs.next()
+	a.zero(z.Cartesian())
+	return z
+}
+
+// Supra returns a pointer to a zero Supra value from a.
+func (a *Arena) Supra() *Supra {
+	z := a.supras.next()
+	a.zero(z.Cartesian())
+	return z
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestArenaDistinctValues(t *testing.T) {
+	a := NewArena(100)
+	x := a.Hamilton()
+	y := a.Hamilton()
+	if x == y {
+		t.Fatal("Arena returned the same value twice")
+	}
+	x.l.l.SetInt64(3)
+	if y.l.l.Sign() != 0 {
+		t.Errorf("writing to one value changed another: %v", y)
+	}
+	if x.r.r.Prec() != 100 {
+		t.Errorf("precision = %d, want 100", x.r.r.Prec())
+	}
+}
+
+func TestArenaResetReuses(t *testing.T) {
+	a := NewArena(300)
+	one := big.NewFloat(1)
+	run := func() {
+		a.Reset()
+		for i := 0; i < 200; i++ {
+			z := a.Complex()
+			z.l.SetInt64(int64(i))
+			f := a.Float()
+			f.Add(&z.l, one)
+		}
+	}
+	run()
+	if p := a.Float().Prec(); p != 300 {
+		t.Errorf("Float precision = %d, want 300", p)
+	}
+	if allocs := testing.AllocsPerRun(20, run); allocs > 0 {
+		t.Errorf("reset arena allocates %v times per run", allocs)
+	}
+	if z := a.Complex(); z.l.Sign() != 0 || z.r.Sign() != 0 || z.l.Prec() != 300 || z.r.Prec() != 300 {
+		t.Errorf("reused value is %v at %d bits, want zero at 300 bits", z, z.l.Prec())
+	}
+}
+
+func TestArenaZeroPrec(t *testing.T) {
+	a := NewArena(0)
+	x := NewComplex(newFloat(300).SetInt64(3), newFloat(300).SetInt64(1))
+	for k := 0; k < 2; k++ {
+		a.Reset()
+		f := a.Float()
+		z := a.Complex()
+		q := a.Hamilton()
+		if f.Prec() != 0 || z.l.Prec() != 0 || q.r.r.Prec() != 0 {
+			t.Fatalf("precisions %d, %d, %d, want 0", f.Prec(), z.l.Prec(), q.r.r.Prec())
+		}
+		z.Mul(x, x)
+		f.Add(&x.l, &x.r)
+		if z.l.Prec() != 300 || z.r.Prec() != 300 || f.Prec() != 300 {
+			t.Errorf("results at %d, %d, %d bits, want 300", z.l.Prec(), z.r.Prec(), f.Prec())
+		}
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"sync"
+)
+
+// degenerateBits is the base-2 logarithm of the condition number of a divisor
+// from which Audit reports the quotient or the inverse as near-degenerate.
+const degenerateBits = guardBits / 2
+
+// An AuditDivision is a quotient or an inverse whose divisor was close to a
+// zero divisor.
+type AuditDivision struct {
+	// Op is the name of the operation, "Quo" or "Inv".
+	Op string
+	// Seq is the position of the operation among all the operations of the
+	// audit, starting from 0.
+	Seq int
+	// CondBits is the base-2 logarithm of the condition number of the
+	// divisor y: the ratio of the sum of the squares of the components of
+	// y to the magnitude of Quad(y), or of the same polynomials of higher
+	// degree for types such as Jet. It is the number of bits lost to the
+	// cancellation in Quad(y), which a rounding error in y would bring to
+	// the result.
+	CondBits uint
+}
+
+// An AuditReport describes the operations that a computation carried out
+// with a Context.
+type AuditReport struct {
+	// Ops counts the operations by name, such as "Mul" for Context.Mul.
+	Ops map[string]int
+	// PeakPrec is the largest precision at which an exact result was
+	// computed, in bits.
+	PeakPrec uint
+	// Below and Above count the components of the results that were
+	// rounded down and up.
+	Below, Above int
+	// NearDegenerate lists the quotients and inverses whose divisor has a
+	// condition number of at least 2^16, in order.
+	NearDegenerate []AuditDivision
+}
+
+// Exact returns true if no component of a result was rounded.
+func (r *AuditReport) Exact() bool {
+	return r.Below == 0 && r.Above == 0
+}
+
+// auditor collects an AuditReport. It is safe for concurrent use.
+type auditor struct {
+	mu     sync.Mutex
+	seq    int
+	report AuditReport
+}
+
+// record adds to a the operation name carried out at w bits, with the
+// rounded components f and a divisor with a condition number of 2^cond.
+func (a *auditor) record(name string, w uint, f []*big.Float, cond uint) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	r := &a.report
+	r.Ops[name]++
+	if w > r.PeakPrec {
+		r.PeakPrec = w
+	}
+	for _, v := range f {
+		switch v.Acc() {
+		case big.Below:
+			r.Below++
+		case big.Above:
+			r.Above++
+		}
+	}
+	if cond >= degenerateBits {
+		r.NearDegenerate = append(r.NearDegenerate, AuditDivision{name, a.seq, cond})
+	}
+	a.seq++
+}
+
+// Audit calls f with a copy of c that records the operations carried out
+// with it, and returns the report:
+// 		report := Audit(ctx, func(ctx Context) {
+// 			ctx.Mul(z, x, y)
+// 			ctx.Inv(z, z)
+// 		})
+// Only the operations carried out with the context passed to f, or with
+// copies of it, are recorded; an Audit inside f records its operations in its
+// own report only. The context may be used by several goroutines at once.
+func Audit(c Context, f func(ctx Context)) *AuditReport {
+	a := &auditor{report: AuditReport{Ops: make(map[string]int)}}
+	c.audit = a
+	f(c)
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return &a.report
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestAuditCounts(t *testing.T) {
+	x := NewHamilton(big.NewFloat(1), big.NewFloat(2), big.NewFloat(3), big.NewFloat(4))
+	z := new(Hamilton)
+	r := Audit(Context{Prec: 100}, func(ctx Context) {
+		ctx.Mul(z, x, x)
+		ctx.Add(z, z, x)
+		ctx.Mul(z, z, z)
+	})
+	if r.Ops["Mul"] != 2 || r.Ops["Add"] != 1 || len(r.Ops) != 2 {
+		t.Errorf("Ops = %v, want 2 Mul and 1 Add", r.Ops)
+	}
+	if r.PeakPrec < 100 {
+		t.Errorf("PeakPrec = %d, want at least 100", r.PeakPrec)
+	}
+	if !r.Exact() || len(r.NearDegenerate) != 0 {
+		t.Errorf("report = %+v, want an exact computation", r)
+	}
+	// The operations outside f are not recorded.
+	Context{Prec: 100}.Mul(z, x, x)
+	if r.Ops["Mul"] != 2 {
+		t.Errorf("Ops = %v after the audit, want 2 Mul", r.Ops)
+	}
+}
+
+func TestAuditRounding(t *testing.T) {
+	three := NewComplex(big.NewFloat(3), new(big.Float))
+	r := Audit(Context{Prec: 24, Mode: big.ToZero}, func(ctx Context) {
+		ctx.Inv(new(Complex), three)
+	})
+	if r.Exact() || r.Below != 1 || r.Above != 0 {
+		t.Errorf("Below, Above = %d, %d, want 1, 0", r.Below, r.Above)
+	}
+	if len(r.NearDegenerate) != 0 {
+		t.Errorf("NearDegenerate = %v, want none", r.NearDegenerate)
+	}
+}
+
+func TestAuditNearDegenerate(t *testing.T) {
+	// The quadrance of a Perplex with l ≈ r is l² - r², which cancels.
+	eps := new(big.Float).SetMantExp(big.NewFloat(1), -60)
+	y := NewPerplex(big.NewFloat(1), newFloat(64).Add(big.NewFloat(1), eps))
+	x := NewPerplex(big.NewFloat(2), big.NewFloat(1))
+	q := NewHamilton(big.NewFloat(1e-30), big.NewFloat(2e-30), big.NewFloat(0), big.NewFloat(3e-30))
+	report := Audit(Context{Prec: 53}, func(ctx Context) {
+		ctx.Inv(new(Perplex), x)
+		ctx.Quo(new(Perplex), x, y)
+		ctx.Inv(new(Hamilton), q)
+		ctx.Inv(new(Perplex), y)
+	})
+	d := report.NearDegenerate
+	if len(d) != 2 || d[0].Op != "Quo" || d[0].Seq != 1 || d[1].Op != "Inv" || d[1].Seq != 3 {
+		t.Fatalf("NearDegenerate = %+v, want the Quo and the last Inv", d)
+	}
+	for _, v := range d {
+		if v.CondBits < 58 || v.CondBits > 62 {
+			t.Errorf("CondBits = %d, want about 60", v.CondBits)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math/big"
+
+// Derive evaluates f at x+α, where α is the nilpotent unit of Infra, and
+// returns the real part and the infinitesimal part of the result, which are
+// the value f(x) and the derivative f'(x). This is forward-mode automatic
+// differentiation: f must be built from the arithmetic of Infra, such as Add,
+// Mul, Inv, Exp, and Log, and then Mul(α, α) = 0 carries the chain rule
+// through every step, with no truncation error. The argument x+α has the
+// precision of x, and the results are copies of the components of f(x+α).
+func Derive(f func(*Infra) *Infra, x *big.Float) (*big.Float, *big.Float) {
+	one := new(big.Float).SetPrec(x.Prec()).SetInt64(1)
+	y := f(NewInfra(x, one))
+	return new(big.Float).Copy(&y.l), new(big.Float).Copy(&y.r)
+}
+
+// Gradient evaluates f at x+α and y+β, where α and β are the nilpotent units
+// of Supra, and returns the real part, the α part, and the β part of the
+// result, which are the value f(x, y) and the partial derivatives ∂f/∂x and
+// ∂f/∂y, from a single evaluation. As for Derive, f must be built from the
+// arithmetic of Supra, such as Add, Mul, and Inv. Since every product of α
+// and β lands in γ, the first-order parts follow the rules of
+// differentiation even though Mul is noncommutative; the γ part is not a
+// second derivative, and it is discarded. The arguments have the precision
+// of x and y respectively, and the results are copies of the components of
+// f(x+α, y+β).
+func Gradient(f func(x, y *Supra) *Supra, x, y *big.Float) (*big.Float, *big.Float, *big.Float) {
+	u, v := new(Supra), new(Supra)
+	u.l.l.Copy(x)
+	u.l.r.SetPrec(x.Prec()).SetInt64(1)
+	v.l.l.Copy(y)
+	v.r.l.SetPrec(y.Prec()).SetInt64(1)
+	w := f(u, v)
+	return new(big.Float).Copy(&w.l.l), new(big.Float).Copy(&w.l.r), new(big.Float).Copy(&w.r.l)
+}
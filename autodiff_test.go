@@ -0,0 +1,67 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestDerivePolynomial(t *testing.T) {
+	// f(x) = (x³ + 2)/x, f'(x) = 2x - 2/x².
+	f := func(z *Infra) *Infra {
+		p := new(Infra).Mul(z, z)
+		p.Mul(p, z)
+		p.Add(p, NewInfra(big.NewFloat(2), new(big.Float)))
+		return new(Infra).Quo(p, z)
+	}
+	v, d := Derive(f, big.NewFloat(2))
+	if v.Cmp(big.NewFloat(5)) != 0 || d.Cmp(big.NewFloat(3.5)) != 0 {
+		t.Errorf("Derive(f, 2) = %v, %v, want 5, 3.5", v, d)
+	}
+}
+
+func TestDeriveElementary(t *testing.T) {
+	// f(x) = exp(x) log(x), f'(x) = exp(x)(log(x) + 1/x).
+	f := func(z *Infra) *Infra {
+		e := new(Infra).Exp(z)
+		return e.Mul(e, new(Infra).Log(z))
+	}
+	x := newFloat(100).SetFloat64(1.5)
+	v, d := Derive(f, x)
+	want := math.Exp(1.5) * (math.Log(1.5) + 1/1.5)
+	if !closeTo(v, big.NewFloat(math.Exp(1.5)*math.Log(1.5)), 50) || !closeTo(d, big.NewFloat(want), 50) {
+		t.Errorf("Derive(f, 1.5) = %v, %v", v, d)
+	}
+	if v.Prec() != 100 || d.Prec() != 100 {
+		t.Errorf("precision = %d, %d, want 100", v.Prec(), d.Prec())
+	}
+}
+
+func TestGradient(t *testing.T) {
+	// f(x, y) = x²y + y/x, ∂f/∂x = 2xy - y/x², ∂f/∂y = x² + 1/x.
+	f := func(x, y *Supra) *Supra {
+		p := new(Supra).Mul(x, x)
+		p.Mul(p, y)
+		q := new(Supra).Mul(y, new(Supra).Inv(x))
+		return p.Add(p, q)
+	}
+	v, dx, dy := Gradient(f, big.NewFloat(2), big.NewFloat(3))
+	if v.Cmp(big.NewFloat(13.5)) != 0 || dx.Cmp(big.NewFloat(11.25)) != 0 || dy.Cmp(big.NewFloat(4.5)) != 0 {
+		t.Errorf("Gradient(f, 2, 3) = %v, %v, %v, want 13.5, 11.25, 4.5", v, dx, dy)
+	}
+	// The order of the factors does not change the first-order parts.
+	g := func(x, y *Supra) *Supra {
+		return new(Supra).Mul(y, x)
+	}
+	h := func(x, y *Supra) *Supra {
+		return new(Supra).Mul(x, y)
+	}
+	_, gx, gy := Gradient(g, big.NewFloat(2), big.NewFloat(3))
+	_, hx, hy := Gradient(h, big.NewFloat(2), big.NewFloat(3))
+	if gx.Cmp(hx) != 0 || gy.Cmp(hy) != 0 || gx.Cmp(big.NewFloat(3)) != 0 || gy.Cmp(big.NewFloat(2)) != 0 {
+		t.Errorf("Gradient(xy) = %v, %v and %v, %v, want 3, 2", gx, gy, hx, hy)
+	}
+}
@@ -0,0 +1,265 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math/big"
+
+// A Field is the scalar arithmetic behind the generic algebra code of
+// CayleyDickson. Implementations return new values and never modify their
+// arguments, so a scalar type with value semantics, like float64, and a
+// pointer type, like *big.Float, fit the same interface. Float64Field is the
+// fast path for prototyping and BigFloatField is the multi-precision one;
+// with the mpfr build tag, MPFRField binds the MPFR library. Another backend
+// only needs to implement these methods.
+type Field[T any] interface {
+	// FromInt64 returns n as a scalar.
+	FromInt64(n int64) T
+	Add(x, y T) T
+	Sub(x, y T) T
+	Mul(x, y T) T
+	Quo(x, y T) T
+	Neg(x T) T
+	// Sign returns -1, 0, or +1 as x is negative, zero, or positive.
+	Sign(x T) int
+	// Float64 returns the float64 value nearest to x.
+	Float64(x T) float64
+}
+
+// Float64Field is the Field of float64 values, with hardware rounding.
+type Float64Field struct{}
+
+// FromInt64 returns n as a float64 value.
+func (Float64Field) FromInt64(n int64) float64 { return float64(n) }
+
+// Add returns x+y.
+func (Float64Field) Add(x, y float64) float64 { return x + y }
+
+// Sub returns x-y.
+func (Float64Field) Sub(x, y float64) float64 { return x - y }
+
+// Mul returns x*y.
+func (Float64Field) Mul(x, y float64) float64 { return x * y }
+
+// Quo returns x/y.
+func (Float64Field) Quo(x, y float64) float64 { return x / y }
+
+// Neg returns -x.
+func (Float64Field) Neg(x float64) float64 { return -x }
+
+// Sign returns the sign of x.
+func (Float64Field) Sign(x float64) int {
+	switch {
+	case x < 0:
+		return -1
+	case x > 0:
+		return +1
+	}
+	return 0
+}
+
+// Float64 returns x.
+func (Float64Field) Float64(x float64) float64 { return x }
+
+// BigFloatField is the Field of big.Float values, with every result rounded
+// to Prec bits, or to 64 bits if Prec is zero.
+type BigFloatField struct {
+	Prec uint
+}
+
+// prec returns the precision of the results of f.
+func (f BigFloatField) prec() uint {
+	if f.Prec == 0 {
+		return 64
+	}
+	return f.Prec
+}
+
+// FromInt64 returns n as a big.Float value.
+func (f BigFloatField) FromInt64(n int64) *big.Float { return newFloat(f.prec()).SetInt64(n) }
+
+// Add returns x+y.
+func (f BigFloatField) Add(x, y *big.Float) *big.Float { return newFloat(f.prec()).Add(x, y) }
+
+// Sub returns x-y.
+func (f BigFloatField) Sub(x, y *big.Float) *big.Float { return newFloat(f.prec()).Sub(x, y) }
+
+// Mul returns x*y.
+func (f BigFloatField) Mul(x, y *big.Float) *big.Float { return newFloat(f.prec()).Mul(x, y) }
+
+// Quo returns x/y.
+func (f BigFloatField) Quo(x, y *big.Float) *big.Float { return newFloat(f.prec()).Quo(x, y) }
+
+// Neg returns -x.
+func (f BigFloatField) Neg(x *big.Float) *big.Float { return newFloat(f.prec()).Neg(x) }
+
+// Sign returns the sign of x.
+func (BigFloatField) Sign(x *big.Float) int { return x.Sign() }
+
+// Float64 returns the float64 value nearest to x.
+func (BigFloatField) Float64(x *big.Float) float64 {
+	v, _ := x.Float64()
+	return v
+}
+
+// A CayleyDickson is an algebra of dimension 2ⁿ over the scalars of a Field,
+// built by n doublings. Its elements are slices of 2ⁿ scalars, and the same
+// code computes with any backend. Each doubling adjoins a unit whose square is
+// gamma, which is -1, 0, or +1, and multiplies pairs by the rule
+// 		(a, b)(c, d) = (ac + gamma Conj(d)b, da + b Conj(c))
+// The concrete types of this package do not use CayleyDickson, since they
+// round their products once and set their receivers, but they follow the same
+// rule, so it gives their products up to rounding. For example, the gammas
+// (-1) give Complex, (+1) Perplex, (0) Infra, (-1, -1) Hamilton, (-1, +1)
+// Cockle, (-1, 0) InfraComplex, (0, 0) Supra, (-1, -1, -1) Cayley, and
+// (-1, -1, -1, -1) Sedenion, with the components in the order of Cartesian.
+// It serves as a reference for them, and as a way to try an algebra or a
+// backend that has no concrete type.
+type CayleyDickson[T any] struct {
+	field Field[T]
+	gamma []int
+}
+
+// NewCayleyDickson returns the algebra over f with the doubling parameters
+// gamma, from the first doubling of the scalars to the last. If a parameter is
+// not -1, 0, or +1, then NewCayleyDickson panics.
+func NewCayleyDickson[T any](f Field[T], gamma ...int) *CayleyDickson[T] {
+	for _, g := range gamma {
+		if g < -1 || g > 1 {
+			panic("doubling parameter not in {-1, 0, +1}")
+		}
+	}
+	return &CayleyDickson[T]{f, append([]int(nil), gamma...)}
+}
+
+// Dim returns the dimension of a.
+func (a *CayleyDickson[T]) Dim() int {
+	return 1 << uint(len(a.gamma))
+}
+
+// check panics unless every element of x has the dimension of a.
+func (a *CayleyDickson[T]) check(x ...[]T) {
+	for _, v := range x {
+		if len(v) != a.Dim() {
+			panic("dimension mismatch")
+		}
+	}
+}
+
+// Zero returns the zero element of a.
+func (a *CayleyDickson[T]) Zero() []T {
+	z := make([]T, a.Dim())
+	for k := range z {
+		z[k] = a.field.FromInt64(0)
+	}
+	return z
+}
+
+// One returns the unit element of a.
+func (a *CayleyDickson[T]) One() []T {
+	z := a.Zero()
+	z[0] = a.field.FromInt64(1)
+	return z
+}
+
+// Add returns x+y.
+func (a *CayleyDickson[T]) Add(x, y []T) []T {
+	a.check(x, y)
+	return a.add(x, y)
+}
+
+// Sub returns x-y.
+func (a *CayleyDickson[T]) Sub(x, y []T) []T {
+	a.check(x, y)
+	return a.sub(x, y)
+}
+
+// Scal returns x scaled by s.
+func (a *CayleyDickson[T]) Scal(x []T, s T) []T {
+	a.check(x)
+	z := make([]T, len(x))
+	for k := range x {
+		z[k] = a.field.Mul(x[k], s)
+	}
+	return z
+}
+
+// Conj returns the conjugate of x.
+func (a *CayleyDickson[T]) Conj(x []T) []T {
+	a.check(x)
+	return a.conj(x)
+}
+
+// Mul returns the product of x and y.
+func (a *CayleyDickson[T]) Mul(x, y []T) []T {
+	a.check(x, y)
+	return a.mul(x, y, len(a.gamma))
+}
+
+// Quad returns the quadrance of x, the real part of Mul(x, Conj(x)).
+func (a *CayleyDickson[T]) Quad(x []T) T {
+	return a.Mul(x, a.Conj(x))[0]
+}
+
+// Inv returns the inverse Conj(x)/Quad(x) of x. If the quadrance of x is
+// zero, then Inv panics.
+func (a *CayleyDickson[T]) Inv(x []T) []T {
+	q := a.Quad(x)
+	if a.field.Sign(q) == 0 {
+		panic("inverse of zero divisor")
+	}
+	z := a.Conj(x)
+	for k := range z {
+		z[k] = a.field.Quo(z[k], q)
+	}
+	return z
+}
+
+func (a *CayleyDickson[T]) add(x, y []T) []T {
+	z := make([]T, len(x))
+	for k := range x {
+		z[k] = a.field.Add(x[k], y[k])
+	}
+	return z
+}
+
+func (a *CayleyDickson[T]) sub(x, y []T) []T {
+	z := make([]T, len(x))
+	for k := range x {
+		z[k] = a.field.Sub(x[k], y[k])
+	}
+	return z
+}
+
+// conj returns the conjugate of x: the real part is kept and every other
+// component is negated.
+func (a *CayleyDickson[T]) conj(x []T) []T {
+	z := make([]T, len(x))
+	z[0] = x[0]
+	for k := 1; k < len(x); k++ {
+		z[k] = a.field.Neg(x[k])
+	}
+	return z
+}
+
+// mul returns the product of x and y, which have 2ⁿ components, using the
+// first n doubling parameters.
+func (a *CayleyDickson[T]) mul(x, y []T, n int) []T {
+	if n == 0 {
+		return []T{a.field.Mul(x[0], y[0])}
+	}
+	h := len(x) / 2
+	xl, xr := x[:h], x[h:]
+	yl, yr := y[:h], y[h:]
+	l := a.mul(xl, yl, n-1)
+	if g := a.gamma[n-1]; g != 0 {
+		t := a.mul(a.conj(yr), xr, n-1)
+		if g > 0 {
+			l = a.add(l, t)
+		} else {
+			l = a.sub(l, t)
+		}
+	}
+	r := a.add(a.mul(yr, xl, n-1), a.mul(xr, a.conj(yl), n-1))
+	return append(l, r...)
+}
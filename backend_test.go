@@ -0,0 +1,103 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestCayleyDicksonHamilton(t *testing.T) {
+	alg := NewCayleyDickson[*big.Float](BigFloatField{Prec: 53}, -1, -1)
+	f := func(x, y *Hamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a, b, c, d := x.Cartesian()
+		s, u, v, w := y.Cartesian()
+		got := alg.Mul([]*big.Float{a, b, c, d}, []*big.Float{s, u, v, w})
+		p, q, r, h := new(Hamilton).Mul(x, y).Cartesian()
+		for k, want := range []*big.Float{p, q, r, h} {
+			zero := new(big.Float)
+			if !closeToComplex(NewComplex(got[k], zero), NewComplex(want, zero), 48) {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCayleyDicksonBackends(t *testing.T) {
+	tests := []struct {
+		name  string
+		gamma []int
+		mul   func(x, y []*big.Float) []*big.Float
+	}{
+		{"Perplex", []int{+1}, func(x, y []*big.Float) []*big.Float {
+			a, b := new(Perplex).Mul(NewPerplex(x[0], x[1]), NewPerplex(y[0], y[1])).Cartesian()
+			return []*big.Float{a, b}
+		}},
+		{"Cockle", []int{-1, +1}, func(x, y []*big.Float) []*big.Float {
+			a, b, c, d := new(Cockle).Mul(NewCockle(x[0], x[1], x[2], x[3]), NewCockle(y[0], y[1], y[2], y[3])).Cartesian()
+			return []*big.Float{a, b, c, d}
+		}},
+		{"Supra", []int{0, 0}, func(x, y []*big.Float) []*big.Float {
+			a, b, c, d := new(Supra).Mul(NewSupra(x[0], x[1], x[2], x[3]), NewSupra(y[0], y[1], y[2], y[3])).Cartesian()
+			return []*big.Float{a, b, c, d}
+		}},
+		{"Cayley", []int{-1, -1, -1}, func(x, y []*big.Float) []*big.Float {
+			a, b, c, d, e, f, g, h := new(Cayley).Mul(
+				NewCayley(x[0], x[1], x[2], x[3], x[4], x[5], x[6], x[7]),
+				NewCayley(y[0], y[1], y[2], y[3], y[4], y[5], y[6], y[7]),
+			).Cartesian()
+			return []*big.Float{a, b, c, d, e, f, g, h}
+		}},
+	}
+	for _, tt := range tests {
+		fast := NewCayleyDickson[float64](Float64Field{}, tt.gamma...)
+		n := fast.Dim()
+		x, y := make([]float64, n), make([]float64, n)
+		bx, by := make([]*big.Float, n), make([]*big.Float, n)
+		for k := range x {
+			x[k] = float64(k+1) / 8
+			y[k] = float64(3-k) / 4
+			bx[k], by[k] = big.NewFloat(x[k]), big.NewFloat(y[k])
+		}
+		got := fast.Mul(x, y)
+		want := tt.mul(bx, by)
+		for k := range got {
+			w, _ := want[k].Float64()
+			if math.Abs(got[k]-w) > 1e-12 {
+				t.Errorf("%s: Mul(%v, %v)[%d] = %v, want %v", tt.name, x, y, k, got[k], w)
+			}
+		}
+	}
+}
+
+func TestCayleyDicksonSedenionZeroDivisor(t *testing.T) {
+	alg := NewCayleyDickson[float64](Float64Field{}, -1, -1, -1, -1)
+	if alg.Dim() != 16 {
+		t.Fatalf("Dim() = %d, want 16", alg.Dim())
+	}
+	x, y := alg.Zero(), alg.Zero()
+	x[1], x[10] = 1, 1
+	y[7], y[12] = 1, 1
+	for k, v := range alg.Mul(x, y) {
+		if v != 0 {
+			t.Errorf("Mul(e1 + e10, e7 + e12)[%d] = %v, want 0", k, v)
+		}
+	}
+	if q := alg.Quad(x); q != 2 {
+		t.Errorf("Quad(e1 + e10) = %v, want 2", q)
+	}
+	inv := alg.Inv(x)
+	for k, v := range alg.Mul(x, inv) {
+		if want := alg.One()[k]; v != want {
+			t.Errorf("Mul(x, Inv(x))[%d] = %v, want %v", k, v, want)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// A BasisTable is the multiplication table of a basis e[0], ..., e[n-1] in
+// which every product of two basis elements is zero or a signed basis
+// element. If t[u][v] = s(w+1), with s = ±1, then
+// 		Mul(e[u], e[v]) = s e[w]
+// and if t[u][v] = 0, then the product is zero.
+type BasisTable [][]int
+
+// NewBasisTable returns the multiplication table of the basis of the type
+// named typ, in the order of its Cartesian method (for Zorn, a, u, v, b). The
+// names are those of ConformanceTypes. It returns an error if typ is unknown
+// or if a product of two basis elements is not a signed basis element.
+func NewBasisTable(typ string) (BasisTable, error) {
+	t, ok := lookupConformanceType(typ)
+	if !ok {
+		return nil, fmt.Errorf("bigfloat: unknown type %q", typ)
+	}
+	basis := func(k int) []*big.Float {
+		e := make([]*big.Float, t.dim)
+		for i := range e {
+			e[i] = new(big.Float)
+		}
+		e[k].SetInt64(1)
+		return e
+	}
+	table := make(BasisTable, t.dim)
+	for u := range table {
+		table[u] = make([]int, t.dim)
+		for v := range table[u] {
+			p, err := EvalConformance(typ, "Mul", 53, [][]*big.Float{basis(u), basis(v)})
+			if err != nil {
+				return nil, err
+			}
+			for w, c := range p {
+				if c.Sign() == 0 {
+					continue
+				}
+				if table[u][v] != 0 || new(big.Float).Abs(c).Cmp(big.NewFloat(1)) != 0 {
+					return nil, fmt.Errorf("bigfloat: product %d*%d of %s basis is not a signed basis element", u, v, typ)
+				}
+				table[u][v] = c.Sign() * (w + 1)
+			}
+		}
+	}
+	return table, nil
+}
+
+// Opposite returns the multiplication table of the opposite algebra, in which
+// the order of every product is reversed. The opposite of the Hamilton table
+// is the left-handed convention, with Mul(i, j) = -k.
+func (t BasisTable) Opposite() BasisTable {
+	o := make(BasisTable, len(t))
+	for u := range o {
+		o[u] = make([]int, len(t))
+		for v := range o[u] {
+			o[u][v] = t[v][u]
+		}
+	}
+	return o
+}
+
+// A Relabeling converts the components of a value from one basis to another:
+// component k of the result is Sign[k] times component Perm[k] of the input.
+// Perm is a permutation, and each Sign is ±1.
+type Relabeling struct {
+	Perm []int
+	Sign []int
+}
+
+// Apply returns the components x relabeled by r, as new values. Since the
+// components are only moved and negated, the result is exact. If the length
+// of x differs from that of r, then Apply panics.
+func (r Relabeling) Apply(x []*big.Float) []*big.Float {
+	if len(x) != len(r.Perm) {
+		panic("relabeling length differs")
+	}
+	y := make([]*big.Float, len(x))
+	for k, p := range r.Perm {
+		y[k] = new(big.Float).Set(x[p])
+		if r.Sign[k] < 0 {
+			y[k].Neg(y[k])
+		}
+	}
+	return y
+}
+
+// Inverse returns the relabeling that undoes r.
+func (r Relabeling) Inverse() Relabeling {
+	s := Relabeling{make([]int, len(r.Perm)), make([]int, len(r.Perm))}
+	for k, p := range r.Perm {
+		s.Perm[p] = k
+		s.Sign[p] = r.Sign[k]
+	}
+	return s
+}
+
+// Compose returns the relabeling that applies r and then s.
+func (r Relabeling) Compose(s Relabeling) Relabeling {
+	c := Relabeling{make([]int, len(s.Perm)), make([]int, len(s.Perm))}
+	for k, p := range s.Perm {
+		c.Perm[k] = r.Perm[p]
+		c.Sign[k] = s.Sign[k] * r.Sign[p]
+	}
+	return c
+}
+
+// DeriveRelabeling returns the relabeling with the permutation perm whose
+// signs make it an isomorphism from the algebra with the multiplication table
+// from to the algebra with the table to, so that relabeling the components of
+// a product gives the product of the relabeled components. If several choices
+// of signs work, then it returns the one that is positive on the earliest
+// components of the input. It returns an error if perm is not a permutation
+// of the right length, or if no choice of signs works.
+//
+// For example, with the Hamilton table h, DeriveRelabeling(h, h, perm) with
+// perm = {0, 2, 1, 3} swaps i and j, which forces the sign of k to flip, and
+// DeriveRelabeling(h.Opposite(), h, perm) with perm = {0, 1, 2, 3} converts
+// from the left-handed convention by negating k.
+func DeriveRelabeling(from, to BasisTable, perm []int) (Relabeling, error) {
+	n := len(to)
+	if len(from) != n || len(perm) != n {
+		return Relabeling{}, fmt.Errorf("bigfloat: relabeling of %d components between tables of %d and %d", len(perm), len(from), n)
+	}
+	// The input component u becomes the output component tau[u].
+	tau := make([]int, n)
+	for k := range tau {
+		tau[k] = -1
+	}
+	for k, p := range perm {
+		if p < 0 || p >= n || tau[p] >= 0 {
+			return Relabeling{}, fmt.Errorf("bigfloat: %v is not a permutation", perm)
+		}
+		tau[p] = k
+	}
+	sigma := make([]int, n)
+	// consistent returns true if the products of the input components up to
+	// m, whose signs are assigned, are preserved.
+	consistent := func(m int) bool {
+		for u := 0; u <= m; u++ {
+			for v := 0; v <= m; v++ {
+				want, got := from[u][v], to[tau[u]][tau[v]]
+				if want == 0 || got == 0 {
+					if want != got {
+						return false
+					}
+					continue
+				}
+				s, w := 1, want-1
+				if want < 0 {
+					s, w = -1, -want-1
+				}
+				if w > m {
+					continue
+				}
+				if got != sigma[u]*sigma[v]*sigma[w]*s*(tau[w]+1) {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	var assign func(m int) bool
+	assign = func(m int) bool {
+		if m == n {
+			return true
+		}
+		for _, s := range []int{1, -1} {
+			sigma[m] = s
+			if consistent(m) && assign(m+1) {
+				return true
+			}
+		}
+		return false
+	}
+	if !assign(0) {
+		return Relabeling{}, fmt.Errorf("bigfloat: no signs make %v an isomorphism", perm)
+	}
+	r := Relabeling{append([]int(nil), perm...), make([]int, n)}
+	for k, p := range perm {
+		r.Sign[k] = sigma[p]
+	}
+	return r, nil
+}
+
+// ScalarLastHamilton converts the components of a quaternion stored with the
+// scalar last, as (x, y, z, w), to the order of the Cartesian method of
+// Hamilton, (w, x, y, z). Its Inverse converts back.
+var ScalarLastHamilton = Relabeling{
+	Perm: []int{3, 0, 1, 2},
+	Sign: []int{1, 1, 1, 1},
+}
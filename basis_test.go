@@ -0,0 +1,102 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+	"testing/quick"
+)
+
+func TestDeriveRelabelingHamilton(t *testing.T) {
+	h, err := NewBasisTable("Hamilton")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		from BasisTable
+		perm []int
+		want []int
+	}{
+		{h, []int{0, 1, 2, 3}, []int{1, 1, 1, 1}},
+		{h, []int{0, 2, 3, 1}, []int{1, 1, 1, 1}},
+		{h, []int{0, 2, 1, 3}, []int{1, 1, 1, -1}},
+		{h.Opposite(), []int{0, 1, 2, 3}, []int{1, 1, 1, -1}},
+	}
+	for _, test := range tests {
+		r, err := DeriveRelabeling(test.from, h, test.perm)
+		if err != nil {
+			t.Errorf("DeriveRelabeling(%v) returned %v", test.perm, err)
+			continue
+		}
+		if !reflect.DeepEqual(r.Sign, test.want) {
+			t.Errorf("DeriveRelabeling(%v) signs = %v, want %v", test.perm, r.Sign, test.want)
+		}
+	}
+	if _, err := DeriveRelabeling(h, h, []int{1, 0, 2, 3}); err == nil {
+		t.Error("DeriveRelabeling moved the unit without an error")
+	}
+	if _, err := DeriveRelabeling(h, h, []int{0, 1, 1, 3}); err == nil {
+		t.Error("DeriveRelabeling accepted a non-permutation")
+	}
+}
+
+func TestDeriveRelabelingCayleyMul(t *testing.T) {
+	c, err := NewBasisTable("Cayley")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Relabel i, j, k cyclically in both halves of the doubling.
+	r, err := DeriveRelabeling(c, c, []int{0, 2, 3, 1, 4, 6, 7, 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	comps := func(z *Cayley) []*big.Float {
+		a, b, c, d, e, f, g, h := z.Cartesian()
+		return []*big.Float{a, b, c, d, e, f, g, h}
+	}
+	relabel := func(z *Cayley) *Cayley {
+		c := r.Apply(comps(z))
+		return NewCayley(c[0], c[1], c[2], c[3], c[4], c[5], c[6], c[7])
+	}
+	f := func(x, y *Cayley) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := relabel(new(Cayley).Mul(x, y))
+		m := new(Cayley).Mul(relabel(x), relabel(y))
+		return l.Equals(m)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRelabelingInverseCompose(t *testing.T) {
+	x := []*big.Float{big.NewFloat(1), big.NewFloat(2), big.NewFloat(3), big.NewFloat(4)}
+	y := ScalarLastHamilton.Apply(x)
+	if want := []float64{4, 1, 2, 3}; !equalFloats(y, want) {
+		t.Errorf("ScalarLastHamilton.Apply(%v) = %v, want %v", x, y, want)
+	}
+	r := Relabeling{[]int{0, 2, 1, 3}, []int{1, 1, 1, -1}}
+	s := ScalarLastHamilton.Compose(r)
+	if z := s.Apply(x); !equalFloats(z, []float64{4, 2, 1, -3}) {
+		t.Errorf("Compose(...).Apply(%v) = %v, want [4 2 1 -3]", x, z)
+	}
+	if z := s.Inverse().Apply(s.Apply(x)); !equalFloats(z, []float64{1, 2, 3, 4}) {
+		t.Errorf("Inverse(...).Apply(...) = %v, want %v", z, x)
+	}
+}
+
+// equalFloats returns true if x has the values want.
+func equalFloats(x []*big.Float, want []float64) bool {
+	if len(x) != len(want) {
+		return false
+	}
+	for k := range x {
+		if x[k].Cmp(big.NewFloat(want[k])) != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,382 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+)
+
+// asymptoticThreshold returns the smallest modulus for which an asymptotic
+// expansion whose terms behave like k!/(2|z|)^k can reach precision prec.
+func asymptoticThreshold(prec uint) float64 {
+	return 0.35 * float64(prec)
+}
+
+// seriesGuard returns the number of extra bits needed to absorb the
+// cancellation in a power series whose terms grow like exp(|z|).
+func seriesGuard(x *Complex) uint {
+	return uint(math.Log2E*complexAbs(x)) + 16
+}
+
+// besselSeries returns the two sums
+// 		(x/2)^n Σ t[k]
+// 		(x/2)^n Σ t[k](H[k] + H[n+k])
+// where t[k] = (sx²/4)^k / (k!(n+k)!), s = ±1, and H[k] is the k-th harmonic
+// number. The first sum is J[n](x) for s = -1 and I[n](x) for s = +1. The
+// second sum is only computed if harmonic is true.
+func besselSeries(n int, x *Complex, s int, harmonic bool, prec uint) (*Complex, *Complex) {
+	w := prec + seriesGuard(x)
+	y := newComplexPrec(w).round(x, w)
+	q := new(Complex).Mul(y, y)
+	q.Scal(q, big.NewFloat(0.25*float64(s)))
+	t := newComplexPrec(w)
+	t.l.SetInt64(1)
+	hn := newFloat(w)
+	for k := 1; k <= n; k++ {
+		t.l.Quo(&t.l, newFloat(w).SetInt64(int64(k)))
+		hn.Add(hn, newFloat(w).Quo(big.NewFloat(1), newFloat(w).SetInt64(int64(k))))
+	}
+	sum := new(Complex).Copy(t)
+	hsum := new(Complex).Scal(t, hn)
+	hk := newFloat(w)
+	hnk := newFloat(w).Set(hn)
+	c, h := newFloat(w), newFloat(w)
+	term := new(Complex)
+	peak := complexExpo(t)
+	qAbs := complexAbs(q)
+	for k := 1; ; k++ {
+		t.Mul(t, q)
+		t.Scal(t, c.Quo(big.NewFloat(1), c.SetInt64(int64(k*(n+k)))))
+		if t.l.Sign() == 0 && t.r.Sign() == 0 {
+			break
+		}
+		if e := complexExpo(t); e > peak {
+			peak = e
+		}
+		sum.Add(sum, t)
+		if harmonic {
+			hk.Add(hk, c.Quo(big.NewFloat(1), c.SetInt64(int64(k))))
+			hnk.Add(hnk, c.Quo(big.NewFloat(1), c.SetInt64(int64(n+k))))
+			h.Add(hk, hnk)
+			hsum.Add(hsum, term.Scal(t, h))
+		}
+		if float64(k) > qAbs && complexExpo(t)+bitLen(k) < peak-int(w) {
+			break
+		}
+	}
+	half := new(Complex).Scal(y, big.NewFloat(0.5))
+	pow := newComplexPrec(w)
+	pow.l.SetInt64(1)
+	for k := 0; k < n; k++ {
+		pow.Mul(pow, half)
+	}
+	sum.Mul(sum, pow)
+	hsum.Mul(hsum, pow)
+	return sum, hsum
+}
+
+// bitLen returns the number of bits needed to represent k.
+func bitLen(k int) int {
+	return big.NewInt(int64(k)).BitLen()
+}
+
+// hankelSums returns the sums of the Hankel asymptotic expansions
+// 		P = Σ (-1)^k a[2k]/x^2k
+// 		Q = Σ (-1)^k a[2k+1]/x^(2k+1)
+// 		R = Σ a[k]/x^k
+// where a[k] = (4n² - 1²)(4n² - 3²)...(4n² - (2k-1)²)/(k!8^k). The boolean
+// result is false if the terms start to grow before they fall below the
+// precision.
+func hankelSums(n int, x *Complex, prec uint) (*Complex, *Complex, *Complex, bool) {
+	w := prec + guardBits
+	inv := new(Complex).Inv(newComplexPrec(w).round(x, w))
+	p, q, r := newComplexPrec(w), newComplexPrec(w), newComplexPrec(w)
+	p.l.SetInt64(1)
+	r.l.SetInt64(1)
+	term := newComplexPrec(w)
+	term.l.SetInt64(1)
+	mu := newFloat(w).SetInt64(4 * int64(n) * int64(n))
+	c := newFloat(w)
+	last := 0
+	for k := 1; k < 8*int(w); k++ {
+		c.SetInt64(int64(2*k-1) * int64(2*k-1))
+		c.Sub(mu, c)
+		c.Quo(c, newFloat(w).SetInt64(8*int64(k)))
+		term.Mul(term, inv)
+		term.Scal(term, c)
+		if term.l.Sign() == 0 && term.r.Sign() == 0 {
+			return p, q, r, true
+		}
+		e := complexExpo(term)
+		if e < -int(w) {
+			return p, q, r, true
+		}
+		if k > 1 && 2*k-1 > 2*n && e > last {
+			return nil, nil, nil, false
+		}
+		last = e
+		r.Add(r, term)
+		switch k % 4 {
+		case 0:
+			p.Add(p, term)
+		case 1:
+			q.Add(q, term)
+		case 2:
+			p.Sub(p, term)
+		default:
+			q.Sub(q, term)
+		}
+	}
+	return nil, nil, nil, false
+}
+
+// besselAsymptotic returns J[n](x) and Y[n](x) from the Hankel expansions
+// 		J[n](x) = √(2/πx) (P cos(ω) - Q sin(ω))
+// 		Y[n](x) = √(2/πx) (P sin(ω) + Q cos(ω))
+// where ω = x - (n/2 + 1/4)π. It requires Re(x) ≥ 0, and the boolean result
+// is false if the expansions cannot reach the precision.
+func besselAsymptotic(n int, x *Complex, prec uint) (*Complex, *Complex, bool) {
+	w := prec + guardBits
+	p, q, _, ok := hankelSums(n, x, w)
+	if !ok {
+		return nil, nil, false
+	}
+	y := newComplexPrec(w).round(x, w)
+	pi := bigPi(w)
+	omega := new(Complex).Copy(y)
+	shift := newFloat(w).SetFloat64(float64(2*n+1) / 4)
+	omega.l.Sub(&omega.l, shift.Mul(shift, pi))
+	cos := new(Complex).Cos(omega)
+	sin := new(Complex).Sin(omega)
+	f := new(Complex).Scal(y, pi)
+	f.Scal(f, big.NewFloat(0.5))
+	f.Sqrt(new(Complex).Inv(f))
+	j := new(Complex).Sub(new(Complex).Mul(p, cos), new(Complex).Mul(q, sin))
+	yn := new(Complex).Add(new(Complex).Mul(p, sin), new(Complex).Mul(q, cos))
+	return j.Mul(j, f), yn.Mul(yn, f), true
+}
+
+// useAsymptotic returns true if an asymptotic expansion should be tried at x
+// for order n and precision prec.
+func useAsymptotic(n int, x *Complex, prec uint) bool {
+	return complexAbs(x) > asymptoticThreshold(prec+guardBits)+float64(n)
+}
+
+// besselJ returns J[n](x) rounded to prec bits, for n ≥ 0.
+func besselJ(n int, x *Complex, prec uint) *Complex {
+	if useAsymptotic(n, x, prec) {
+		y := x
+		if x.l.Sign() < 0 {
+			y = new(Complex).Neg(x)
+		}
+		if j, _, ok := besselAsymptotic(n, y, prec); ok {
+			if y != x && n%2 == 1 {
+				j.Neg(j)
+			}
+			return newComplexPrec(prec).round(j, prec)
+		}
+	}
+	j, _ := besselSeries(n, x, -1, false, prec)
+	return newComplexPrec(prec).round(j, prec)
+}
+
+// besselY returns Y[n](x) rounded to prec bits, for n ≥ 0.
+func besselY(n int, x *Complex, prec uint) *Complex {
+	if x.l.Sign() == 0 && x.r.Sign() == 0 {
+		panic("Bessel function of the second kind at zero")
+	}
+	if useAsymptotic(n, x, prec) {
+		if x.l.Sign() >= 0 {
+			if _, y, ok := besselAsymptotic(n, x, prec); ok {
+				return newComplexPrec(prec).round(y, prec)
+			}
+		} else {
+			// Y[n](-u) = (-1)^n (Y[n](u) ± 2iJ[n](u)), with the sign of Im(-u).
+			u := new(Complex).Neg(x)
+			if j, y, ok := besselAsymptotic(n, u, prec); ok {
+				j.mulI(j, 1)
+				j.Scal(j, big.NewFloat(2))
+				if x.r.Signbit() {
+					y.Sub(y, j)
+				} else {
+					y.Add(y, j)
+				}
+				if n%2 == 1 {
+					y.Neg(y)
+				}
+				return newComplexPrec(prec).round(y, prec)
+			}
+		}
+	}
+	w := prec + seriesGuard(x)
+	j, h := besselSeries(n, x, -1, true, w)
+	y := newComplexPrec(w).round(x, w)
+	half := new(Complex).Scal(y, big.NewFloat(0.5))
+	// (2/π) J (log(x/2) + γ)
+	l := new(Complex).Log(half)
+	l.l.Add(&l.l, bigEuler(w))
+	l.Mul(l, j)
+	l.Scal(l, big.NewFloat(2))
+	// - (1/π) Σ (n-k-1)!/k! (x/2)^(2k-n), over k < n
+	if n > 0 {
+		f := besselFiniteSum(n, half, 1, w)
+		l.Sub(l, f)
+	}
+	l.Sub(l, h)
+	l.Scal(l, newFloat(w).Quo(big.NewFloat(1), bigPi(w)))
+	return newComplexPrec(prec).round(l, prec)
+}
+
+// besselFiniteSum returns Σ s^k (n-k-1)!/k! h^(2k-n), over 0 ≤ k < n.
+func besselFiniteSum(n int, h *Complex, s int, w uint) *Complex {
+	inv := new(Complex).Inv(h)
+	h2 := new(Complex).Mul(h, h)
+	// k = 0 term: (n-1)! h^(-n)
+	t := newComplexPrec(w)
+	t.l.SetInt64(1)
+	for k := 1; k < n; k++ {
+		t.l.Mul(&t.l, newFloat(w).SetInt64(int64(k)))
+	}
+	for k := 0; k < n; k++ {
+		t.Mul(t, inv)
+	}
+	sum := new(Complex).Copy(t)
+	for k := 1; k < n; k++ {
+		t.Mul(t, h2)
+		t.Scal(t, newFloat(w).Quo(newFloat(w).SetInt64(int64(s)), newFloat(w).SetInt64(int64(k*(n-k)))))
+		sum.Add(sum, t)
+	}
+	return sum
+}
+
+// besselK returns K[n](x) rounded to prec bits, for n ≥ 0.
+func besselK(n int, x *Complex, prec uint) *Complex {
+	if x.l.Sign() == 0 && x.r.Sign() == 0 {
+		panic("modified Bessel function of the second kind at zero")
+	}
+	if useAsymptotic(n, x, prec) {
+		if _, _, r, ok := hankelSums(n, x, prec); ok {
+			// K[n](x) = √(π/2x) exp(-x) R
+			w := prec + guardBits
+			y := newComplexPrec(w).round(x, w)
+			f := new(Complex).Scal(y, big.NewFloat(2))
+			f.Inv(f)
+			f.Scal(f, bigPi(w))
+			f.Sqrt(f)
+			e := new(Complex).Exp(new(Complex).Neg(y))
+			r.Mul(r, f)
+			return newComplexPrec(prec).round(r.Mul(r, e), prec)
+		}
+	}
+	w := prec + seriesGuard(x)
+	i, h := besselSeries(n, x, 1, true, w)
+	y := newComplexPrec(w).round(x, w)
+	half := new(Complex).Scal(y, big.NewFloat(0.5))
+	// (-1)^(n+1) (log(x/2) + γ) I
+	l := new(Complex).Log(half)
+	l.l.Add(&l.l, bigEuler(w))
+	l.Mul(l, i)
+	if n%2 == 0 {
+		l.Neg(l)
+	}
+	// + 1/2 Σ (-1)^k (n-k-1)!/k! (x/2)^(2k-n), over k < n
+	if n > 0 {
+		f := besselFiniteSum(n, half, -1, w)
+		l.Add(l, f.Scal(f, big.NewFloat(0.5)))
+	}
+	// + (-1)^n/2 Σ t[k](H[k] + H[n+k])
+	h.Scal(h, big.NewFloat(0.5))
+	if n%2 == 1 {
+		h.Neg(h)
+	}
+	return newComplexPrec(prec).round(l.Add(l, h), prec)
+}
+
+// mulI sets z equal to y multiplied by i^k, and returns z.
+func (z *Complex) mulI(y *Complex, k int) *Complex {
+	a := new(big.Float).Set(&y.l)
+	b := new(big.Float).Set(&y.r)
+	switch (k%4 + 4) % 4 {
+	case 0:
+		z.l.Set(a)
+		z.r.Set(b)
+	case 1:
+		z.l.Neg(b)
+		z.r.Set(a)
+	case 2:
+		z.l.Neg(a)
+		z.r.Neg(b)
+	default:
+		z.l.Set(b)
+		z.r.Neg(a)
+	}
+	return z
+}
+
+// besselOrder returns |n| and the sign (-1)^n for negative n, which relates
+// the functions of order n and -n.
+func besselOrder(n int) (int, bool) {
+	if n < 0 {
+		return -n, -n%2 == 1
+	}
+	return n, false
+}
+
+// BesselJ sets z equal to the Bessel function of the first kind J[n](x), and
+// returns z.
+//
+// Small arguments are summed with the power series at a precision raised to
+// absorb cancellation; once |x| is large compared with the precision, the
+// Hankel asymptotic expansion is used instead. The result is rounded to the
+// precision of x.
+func (z *Complex) BesselJ(n int, x *Complex) *Complex {
+	m, neg := besselOrder(n)
+	z.Copy(besselJ(m, x, maxPrec(&x.l, &x.r)))
+	if neg {
+		z.Neg(z)
+	}
+	return z
+}
+
+// BesselY sets z equal to the Bessel function of the second kind Y[n](x), and
+// returns z. The branch cut lies along the negative real axis.
+//
+// Small arguments are summed with the power series at a precision raised to
+// absorb cancellation; once |x| is large compared with the precision, the
+// Hankel asymptotic expansion is used instead. The result is rounded to the
+// precision of x. If x is zero, then BesselY panics.
+func (z *Complex) BesselY(n int, x *Complex) *Complex {
+	m, neg := besselOrder(n)
+	z.Copy(besselY(m, x, maxPrec(&x.l, &x.r)))
+	if neg {
+		z.Neg(z)
+	}
+	return z
+}
+
+// BesselI sets z equal to the modified Bessel function of the first kind
+// I[n](x), and returns z. It is computed from the identity
+// 		I[n](x) = i^(-n) J[n](ix)
+// The result is rounded to the precision of x.
+func (z *Complex) BesselI(n int, x *Complex) *Complex {
+	m, _ := besselOrder(n)
+	ix := new(Complex)
+	ix.l.Neg(&x.r)
+	ix.r.Set(&x.l)
+	j := besselJ(m, ix, maxPrec(&x.l, &x.r))
+	return z.mulI(j, -m)
+}
+
+// BesselK sets z equal to the modified Bessel function of the second kind
+// K[n](x), and returns z. The branch cut lies along the negative real axis.
+//
+// Small arguments are summed with the power series at a precision raised to
+// absorb cancellation; once |x| is large compared with the precision, the
+// asymptotic expansion is used instead. The result is rounded to the
+// precision of x. If x is zero, then BesselK panics.
+func (z *Complex) BesselK(n int, x *Complex) *Complex {
+	m, _ := besselOrder(n)
+	return z.Copy(besselK(m, x, maxPrec(&x.l, &x.r)))
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestBesselFloat64(t *testing.T) {
+	for _, x := range []float64{0.25, 1, 3.5, 12, 45} {
+		for n := -2; n <= 3; n++ {
+			y := NewComplex(big.NewFloat(x), new(big.Float))
+			if got := new(Complex).BesselJ(n, y); !closeToComplex128(got, complex(math.Jn(n, x), 0), 40) {
+				t.Errorf("BesselJ(%d, %v) = %v, want %v", n, x, got, math.Jn(n, x))
+			}
+			if got := new(Complex).BesselY(n, y); !closeToComplex128(got, complex(math.Yn(n, x), 0), 40) {
+				t.Errorf("BesselY(%d, %v) = %v, want %v", n, x, got, math.Yn(n, x))
+			}
+		}
+	}
+}
+
+func TestBesselModifiedValues(t *testing.T) {
+	one := NewComplex(big.NewFloat(1), new(big.Float))
+	cases := []struct {
+		name string
+		got  *Complex
+		want float64
+	}{
+		{"I0", new(Complex).BesselI(0, one), 1.2660658777520082},
+		{"I1", new(Complex).BesselI(1, one), 0.5651591039924851},
+		{"K0", new(Complex).BesselK(0, one), 0.42102443824070834},
+		{"K1", new(Complex).BesselK(1, one), 0.6019072301972346},
+	}
+	for _, c := range cases {
+		if !closeToComplex128(c.got, complex(c.want, 0), 48) {
+			t.Errorf("%s(1) = %v, want %v", c.name, c.got, c.want)
+		}
+	}
+}
+
+// wronskianY returns J[n+1]Y[n] - J[n]Y[n+1] - 2/(πx), together with the
+// binary exponent of the products, which bounds the cancellation.
+func wronskianY(n int, x *Complex) (*Complex, int) {
+	prec := maxPrec(&x.l, &x.r)
+	l := new(Complex).Mul(new(Complex).BesselJ(n+1, x), new(Complex).BesselY(n, x))
+	r := new(Complex).Mul(new(Complex).BesselJ(n, x), new(Complex).BesselY(n+1, x))
+	c := new(Complex).Scal(x, bigPi(prec))
+	c.Scal(new(Complex).Inv(c), big.NewFloat(2))
+	e := complexExpo(l)
+	return l.Sub(l, r).Sub(l, c), e
+}
+
+// wronskianK returns I[n]K[n+1] + I[n+1]K[n] - 1/x, together with the binary
+// exponent of the products, which bounds the cancellation.
+func wronskianK(n int, x *Complex) (*Complex, int) {
+	l := new(Complex).Mul(new(Complex).BesselI(n, x), new(Complex).BesselK(n+1, x))
+	r := new(Complex).Mul(new(Complex).BesselI(n+1, x), new(Complex).BesselK(n, x))
+	e := complexExpo(l)
+	if inv := new(Complex).Inv(x); complexExpo(inv) > e {
+		e = complexExpo(inv)
+	}
+	return l.Add(l, r).Sub(l, new(Complex).Inv(x)), e
+}
+
+func TestBesselWronskians(t *testing.T) {
+	points := [][2]float64{{0.5, 0.25}, {-2, 1.5}, {3, -4}, {60, 10}, {-70, 5}, {8, 80}}
+	for _, p := range points {
+		x := newComplexPrec(120)
+		x.l.SetFloat64(p[0])
+		x.r.SetFloat64(p[1])
+		for n := 0; n < 3; n++ {
+			if d, e := wronskianY(n, x); complexExpo(d) > e-100 {
+				t.Errorf("Wronskian of J and Y at n = %d, x = %v: %v", n, x, d)
+			}
+			if d, e := wronskianK(n, x); complexExpo(d) > e-100 {
+				t.Errorf("Wronskian of I and K at n = %d, x = %v: %v", n, x, d)
+			}
+		}
+	}
+}
+
+func TestBesselSeriesAsymptoticAgree(t *testing.T) {
+	// At 53 bits these points use the asymptotic expansions, and at 400 bits
+	// they use the power series.
+	points := [][2]float64{{45, 3}, {-40, 12}, {-42, -7}, {5, -44}}
+	for _, p := range points {
+		lo := NewComplex(big.NewFloat(p[0]), big.NewFloat(p[1]))
+		hi := newComplexPrec(400)
+		hi.l.SetFloat64(p[0])
+		hi.r.SetFloat64(p[1])
+		for n := 0; n < 3; n++ {
+			fs := []func(z *Complex, n int, x *Complex) *Complex{
+				(*Complex).BesselJ, (*Complex).BesselY, (*Complex).BesselI, (*Complex).BesselK,
+			}
+			for k, f := range fs {
+				a := f(new(Complex), n, lo)
+				b := f(new(Complex), n, hi)
+				d := new(Complex).Sub(a, b)
+				if complexExpo(d) > complexExpo(b)-45 {
+					t.Errorf("function %d of order %d at %v: %v and %v", k, n, lo, a, b)
+				}
+			}
+		}
+	}
+}
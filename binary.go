@@ -0,0 +1,518 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/big"
+)
+
+// binaryCodec writes each component in the compact form of appendBinaryFloat.
+var binaryCodec = floatCodec{"UnmarshalBinary", 1, appendBinaryFloat, nextBinaryFloat}
+
+// The forms of a component in the flags byte of appendBinaryFloat.
+const (
+	binaryZero = iota
+	binaryFinite
+	binaryInf
+)
+
+// appendBinaryFloat appends the compact encoding of x to buf: a flags byte,
+// with the form (zero, finite, or infinite) in bits 0 and 1, the sign in bit
+// 2, and the rounding mode in bits 3 to 5; the precision as a uvarint; and,
+// for a finite x, the exponent e of MantExp as a varint and the odd integer
+// mantissa m, with x = ±m·2^(e-bitlen(m)), as the length and the bytes of its
+// big-endian form. The mantissa has MinPrec bits, so trailing zero bits of the
+// precision take no space.
+func appendBinaryFloat(buf []byte, x *big.Float) ([]byte, error) {
+	form := binaryFinite
+	switch {
+	case x.IsInf():
+		form = binaryInf
+	case x.Sign() == 0:
+		form = binaryZero
+	}
+	flags := byte(form) | byte(x.Mode())<<3
+	if x.Signbit() {
+		flags |= 1 << 2
+	}
+	buf = append(buf, flags)
+	buf = binary.AppendUvarint(buf, uint64(x.Prec()))
+	if form != binaryFinite {
+		return buf, nil
+	}
+	e := x.MantExp(nil)
+	abs := new(big.Float).Abs(x)
+	m, _ := abs.SetMantExp(abs, int(x.MinPrec())-e).Int(nil)
+	buf = binary.AppendVarint(buf, int64(e))
+	b := m.Bytes()
+	buf = binary.AppendUvarint(buf, uint64(len(b)))
+	return append(buf, b...), nil
+}
+
+// errBinaryFloat is the error of a component that is not in the form of
+// appendBinaryFloat.
+var errBinaryFloat = errors.New("invalid component")
+
+// nextBinaryFloat decodes the component at the start of data in the form of
+// appendBinaryFloat, and returns it with the rest of data.
+func nextBinaryFloat(data []byte) (*big.Float, []byte, error) {
+	if len(data) == 0 {
+		return nil, nil, errTruncated
+	}
+	flags := data[0]
+	form, neg, mode := int(flags&3), flags&(1<<2) != 0, big.RoundingMode(flags>>3)
+	if form > binaryInf || mode > big.ToPositiveInf {
+		return nil, nil, errBinaryFloat
+	}
+	prec, n := binary.Uvarint(data[1:])
+	if n <= 0 {
+		return nil, nil, errTruncated
+	}
+	if prec > big.MaxPrec {
+		return nil, nil, errBinaryFloat
+	}
+	data = data[1+n:]
+	x := new(big.Float).SetPrec(uint(prec)).SetMode(mode)
+	switch form {
+	case binaryInf:
+		x.SetInf(neg)
+		return x, data, nil
+	case binaryZero:
+		if neg {
+			x.Neg(x)
+		}
+		return x, data, nil
+	}
+	e, n := binary.Varint(data)
+	if n <= 0 {
+		return nil, nil, errTruncated
+	}
+	data = data[n:]
+	size, n := binary.Uvarint(data)
+	if n <= 0 || size > uint64(len(data)-n) {
+		return nil, nil, errTruncated
+	}
+	m := new(big.Int).SetBytes(data[n : n+int(size)])
+	data = data[n+int(size):]
+	if m.Sign() == 0 || uint64(m.BitLen()) > prec || e < big.MinExp || e > big.MaxExp {
+		return nil, nil, errBinaryFloat
+	}
+	x.SetInt(m)
+	x.SetMantExp(x, int(e)-m.BitLen())
+	if neg {
+		x.Neg(x)
+	}
+	return x, data, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. The encoding is a compact,
+// versioned form of the components, each written as its precision, rounding
+// mode, sign, exponent, and the shortest mantissa that holds its value, so it
+// is much smaller and faster than the text forms, and no digits are lost.
+func (z *Complex) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. The components of z
+// take the precisions and rounding modes of the encoded value. If data is
+// malformed, then UnmarshalBinary returns an error and leaves z unchanged.
+func (z *Complex) UnmarshalBinary(data []byte) error {
+	return binaryCodec.decodeFloats("Complex", data, floatSlice(z.Cartesian()))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex.
+func (z *Perplex) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex.
+func (z *Perplex) UnmarshalBinary(data []byte) error {
+	return binaryCodec.decodeFloats("Perplex", data, floatSlice(z.Cartesian()))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex.
+func (z *Infra) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex.
+func (z *Infra) UnmarshalBinary(data []byte) error {
+	return binaryCodec.decodeFloats("Infra", data, floatSlice(z.Cartesian()))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex.
+func (z *Hamilton) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex.
+func (z *Hamilton) UnmarshalBinary(data []byte) error {
+	return binaryCodec.decodeFloats("Hamilton", data, floatSlice(z.Cartesian()))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex.
+func (z *Cockle) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex.
+func (z *Cockle) UnmarshalBinary(data []byte) error {
+	return binaryCodec.decodeFloats("Cockle", data, floatSlice(z.Cartesian()))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex.
+func (z *DualComplex) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex.
+func (z *DualComplex) UnmarshalBinary(data []byte) error {
+	return binaryCodec.decodeFloats("DualComplex", data, floatSlice(z.Cartesian()))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex.
+func (z *InfraComplex) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex.
+func (z *InfraComplex) UnmarshalBinary(data []byte) error {
+	return binaryCodec.decodeFloats("InfraComplex", data, floatSlice(z.Cartesian()))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex.
+func (z *InfraPerplex) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex.
+func (z *InfraPerplex) UnmarshalBinary(data []byte) error {
+	return binaryCodec.decodeFloats("InfraPerplex", data, floatSlice(z.Cartesian()))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex.
+func (z *Supra) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex.
+func (z *Supra) UnmarshalBinary(data []byte) error {
+	return binaryCodec.decodeFloats("Supra", data, floatSlice(z.Cartesian()))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex.
+func (z *Macfarlane) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex.
+func (z *Macfarlane) UnmarshalBinary(data []byte) error {
+	return binaryCodec.decodeFloats("Macfarlane", data, floatSlice(z.Cartesian()))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex.
+func (z *Cayley) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex.
+func (z *Cayley) UnmarshalBinary(data []byte) error {
+	return binaryCodec.decodeFloats("Cayley", data, floatSlice(z.Cartesian()))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex.
+func (z *DualHamilton) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex.
+func (z *DualHamilton) UnmarshalBinary(data []byte) error {
+	return binaryCodec.decodeFloats("DualHamilton", data, floatSlice(z.Cartesian()))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex.
+func (z *InfraCockle) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex.
+func (z *InfraCockle) UnmarshalBinary(data []byte) error {
+	return binaryCodec.decodeFloats("InfraCockle", data, floatSlice(z.Cartesian()))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex.
+func (z *InfraHamilton) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex.
+func (z *InfraHamilton) UnmarshalBinary(data []byte) error {
+	return binaryCodec.decodeFloats("InfraHamilton", data, floatSlice(z.Cartesian()))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex.
+func (z *SupraComplex) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex.
+func (z *SupraComplex) UnmarshalBinary(data []byte) error {
+	return binaryCodec.decodeFloats("SupraComplex", data, floatSlice(z.Cartesian()))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex.
+func (z *Ultra) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex.
+func (z *Ultra) UnmarshalBinary(data []byte) error {
+	return binaryCodec.decodeFloats("Ultra", data, floatSlice(z.Cartesian()))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex.
+func (z *Sedenion) MarshalBinary() ([]byte, error) {
+	c := z.Cartesian()
+	return binaryCodec.encode(nil, c[:])
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex.
+func (z *Sedenion) UnmarshalBinary(data []byte) error {
+	c := z.Cartesian()
+	return binaryCodec.decodeFloats("Sedenion", data, c[:])
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex.
+func (z *SupraCockle) MarshalBinary() ([]byte, error) {
+	c := z.Cartesian()
+	return binaryCodec.encode(nil, c[:])
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex.
+func (z *SupraCockle) UnmarshalBinary(data []byte) error {
+	c := z.Cartesian()
+	return binaryCodec.decodeFloats("SupraCockle", data, c[:])
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex.
+func (z *SupraHamilton) MarshalBinary() ([]byte, error) {
+	c := z.Cartesian()
+	return binaryCodec.encode(nil, c[:])
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex.
+func (z *SupraHamilton) UnmarshalBinary(data []byte) error {
+	c := z.Cartesian()
+	return binaryCodec.decodeFloats("SupraHamilton", data, c[:])
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex.
+func (v *Vec3) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode(nil, floatSlice(v.Cartesian()))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex.
+func (v *Vec3) UnmarshalBinary(data []byte) error {
+	return binaryCodec.decodeFloats("Vec3", data, floatSlice(v.Cartesian()))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex.
+func (z *Jet) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode(nil, z.Cartesian())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex. The order of z becomes that of the
+// encoded value.
+func (z *Jet) UnmarshalBinary(data []byte) error {
+	return z.decode(binaryCodec, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex. Unlike MarshalJSON, the signature is
+// encoded.
+func (z *Clifford) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode([]int{z.p, z.q}, z.Cartesian())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex. The signature of z becomes that of
+// the encoded value.
+func (z *Clifford) UnmarshalBinary(data []byte) error {
+	return z.decode(binaryCodec, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex.
+func (z *Grassmann) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode(nil, z.Cartesian())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex. The number of generators of z
+// becomes that of the encoded value.
+func (z *Grassmann) UnmarshalBinary(data []byte) error {
+	return z.decode(binaryCodec, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex.
+func (z *Biquaternion) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode(nil, z.floats())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex.
+func (z *Biquaternion) UnmarshalBinary(data []byte) error {
+	return binaryCodec.decodeFloats("Biquaternion", data, z.floats())
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex.
+func (z *Zorn) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode(nil, z.components())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex.
+func (z *Zorn) UnmarshalBinary(data []byte) error {
+	return binaryCodec.decodeFloats("Zorn", data, z.components())
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex. The coefficients are encoded in
+// increasing order of degree.
+func (p *ComplexPoly) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode(nil, p.floats())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex. Leading zero coefficients are
+// dropped.
+func (p *ComplexPoly) UnmarshalBinary(data []byte) error {
+	return p.decode(binaryCodec, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex. The dimensions are encoded, followed by
+// the entries in row-major order.
+func (m *HamiltonMatrix) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode([]int{m.rows, m.cols}, m.floats())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex. The dimensions of m become those of
+// the encoded value.
+func (m *HamiltonMatrix) UnmarshalBinary(data []byte) error {
+	return m.decode(binaryCodec, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex. The coordinates x and y of [x : y] are
+// encoded in turn.
+func (z *ProjectiveComplex) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode(nil, z.floats())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex. If both coordinates are zero, then
+// UnmarshalBinary returns an error and leaves z unchanged.
+func (z *ProjectiveComplex) UnmarshalBinary(data []byte) error {
+	return z.decode(binaryCodec, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex. The coordinates x and y of [x : y] are
+// encoded in turn.
+func (z *ProjectiveHamilton) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode(nil, z.floats())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex. If both coordinates are zero, then
+// UnmarshalBinary returns an error and leaves z unchanged.
+func (z *ProjectiveHamilton) UnmarshalBinary(data []byte) error {
+	return z.decode(binaryCodec, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex. The coordinates x and y of [x : y] are
+// encoded in turn.
+func (z *ProjectiveCockle) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode(nil, z.floats())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex. If both coordinates are zero, then
+// UnmarshalBinary returns an error and leaves z unchanged.
+func (z *ProjectiveCockle) UnmarshalBinary(data []byte) error {
+	return z.decode(binaryCodec, data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex. The coefficients a, b, c, and d are
+// encoded in turn.
+func (z *MöbiusComplex) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode(nil, z.floats())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex.
+func (z *MöbiusComplex) UnmarshalBinary(data []byte) error {
+	return binaryCodec.decodeFloats("MöbiusComplex", data, z.floats())
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the conventions of
+// the MarshalBinary method of Complex. The coefficients a, b, c, and d are
+// encoded in turn.
+func (z *MöbiusHamilton) MarshalBinary() ([]byte, error) {
+	return binaryCodec.encode(nil, z.floats())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, with the conventions
+// of the UnmarshalBinary method of Complex.
+func (z *MöbiusHamilton) UnmarshalBinary(data []byte) error {
+	return binaryCodec.decodeFloats("MöbiusHamilton", data, z.floats())
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"bytes"
+	"encoding"
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	f := func(x *Hamilton, y *Cayley, z *SupraHamilton) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		u, v, w := new(Hamilton), new(Cayley), new(SupraHamilton)
+		for _, p := range []struct {
+			in  encoding.BinaryMarshaler
+			out encoding.BinaryUnmarshaler
+		}{{x, u}, {y, v}, {z, w}} {
+			data, err := p.in.MarshalBinary()
+			if err != nil || p.out.UnmarshalBinary(data) != nil {
+				return false
+			}
+		}
+		return u.Equals(x) && v.Equals(y) && w.Equals(z)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBinaryComponents(t *testing.T) {
+	third := new(big.Float).SetPrec(1000).Quo(big.NewFloat(1), big.NewFloat(3))
+	cases := []*big.Float{
+		third,
+		new(big.Float).SetPrec(1000).SetInt64(-3),
+		new(big.Float).SetPrec(7).SetMode(big.ToZero).Neg(new(big.Float)),
+		new(big.Float).SetInf(true),
+		new(big.Float),
+		new(big.Float).SetMantExp(big.NewFloat(1), big.MinExp),
+	}
+	for _, x := range cases {
+		z := NewComplex(x, third)
+		data, err := z.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		y := new(Complex)
+		if err := y.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary(%v): %v", x, err)
+		}
+		if y.l.Cmp(x) != 0 || y.l.Signbit() != x.Signbit() || y.l.Prec() != x.Prec() || y.l.Mode() != x.Mode() {
+			t.Errorf("round trip of %v (prec %d) = %v (prec %d)", x, x.Prec(), &y.l, y.l.Prec())
+		}
+	}
+	// Trailing zero bits of the precision take no space.
+	small, _ := NewComplex(new(big.Float).SetPrec(1000).SetInt64(-3), new(big.Float)).MarshalBinary()
+	gob, _ := NewComplex(new(big.Float).SetPrec(1000).SetInt64(-3), new(big.Float)).GobEncode()
+	if len(small) > 12 || len(small) >= len(gob) {
+		t.Errorf("MarshalBinary takes %d bytes, GobEncode %d", len(small), len(gob))
+	}
+}
+
+func TestBinaryStructured(t *testing.T) {
+	one, two := big.NewFloat(1), big.NewFloat(2)
+	m := NewHamiltonMatrix(2, 3)
+	m.At(1, 2).Copy(NewHamilton(one, two, one, two))
+	c := NewClifford(1, 2)
+	c.c[5].SetInt64(-3)
+	values := []struct {
+		x encoding.BinaryMarshaler
+		y interface {
+			encoding.BinaryMarshaler
+			encoding.BinaryUnmarshaler
+		}
+	}{
+		{m, new(HamiltonMatrix)},
+		{NewComplexPoly(NewComplex(one, two), NewComplex(two, one)), new(ComplexPoly)},
+		{NewJetVariable(two, 3), new(Jet)},
+		{c, new(Clifford)},
+		{NewProjectiveComplex(NewComplex(one, two), new(Complex)), new(ProjectiveComplex)},
+	}
+	for _, v := range values {
+		data, err := v.x.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := v.y.UnmarshalBinary(data); err != nil {
+			t.Fatalf("%T: %v", v.x, err)
+		}
+		if got, _ := v.y.MarshalBinary(); !bytes.Equal(got, data) {
+			t.Errorf("%T round trip = %v, want %v", v.x, v.y, v.x)
+		}
+	}
+}
+
+func TestBinaryErrors(t *testing.T) {
+	data, _ := NewComplex(big.NewFloat(1.5), big.NewFloat(-2)).MarshalBinary()
+	gob, _ := NewComplex(big.NewFloat(1.5), big.NewFloat(-2)).GobEncode()
+	bad := append([]byte{}, data...)
+	bad[4] = 0xff
+	cases := [][]byte{
+		nil,
+		data[:len(data)-1],
+		append(append([]byte{}, data...), 0),
+		bad,
+		gob,
+	}
+	for _, c := range cases {
+		z := NewComplex(big.NewFloat(7), big.NewFloat(8))
+		if err := z.UnmarshalBinary(c); err == nil {
+			t.Errorf("UnmarshalBinary(%v) succeeded", c)
+		}
+		if !z.Equals(NewComplex(big.NewFloat(7), big.NewFloat(8))) {
+			t.Errorf("UnmarshalBinary(%v) changed z to %v", c, z)
+		}
+	}
+}
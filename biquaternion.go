@@ -0,0 +1,298 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strings"
+)
+
+var symbBiquaternion = [4]string{"", "i", "j", "k"}
+
+// A Biquaternion represents a multi-precision floating-point biquaternion, a
+// quaternion w + xi + yj + zk whose four components are Complex values. The
+// imaginary unit of the components commutes with i, j, and k, so the algebra
+// is the complexification of Hamilton, which is isomorphic to the algebra of
+// 2x2 complex matrices.
+type Biquaternion struct {
+	w, x, y, z Complex
+}
+
+// Real returns the real part of z, a pointer to a Complex value.
+func (z *Biquaternion) Real() *Complex {
+	return &z.w
+}
+
+// Cartesian returns the four Complex Cartesian components of z.
+func (z *Biquaternion) Cartesian() (*Complex, *Complex, *Complex, *Complex) {
+	return &z.w, &z.x, &z.y, &z.z
+}
+
+// String returns the string representation of a Biquaternion value.
+//
+// If z corresponds to w + xi + yj + zk, then the string is "(w+xi+yj+zk)",
+// with each component in the format of a Complex value, such as
+// "((1+2i)+(3+4i)i+(5+6i)j+(7+8i)k)".
+func (z *Biquaternion) String() string {
+	v := []*Complex{&z.w, &z.x, &z.y, &z.z}
+	a := make([]string, 9)
+	a[0] = "("
+	a[1] = v[0].String()
+	for i := 1; i < 4; i++ {
+		a[2*i] = "+" + v[i].String()
+		a[2*i+1] = symbBiquaternion[i]
+	}
+	a[8] = ")"
+	return strings.Join(a, "")
+}
+
+// Format implements fmt.Formatter, with the conventions of the Format method
+// of Complex for each component.
+func (z *Biquaternion) Format(s fmt.State, verb rune) {
+	if formatBad(s, verb, z) {
+		return
+	}
+	io.WriteString(s, "(")
+	for i, v := range []*Complex{&z.w, &z.x, &z.y, &z.z} {
+		if i > 0 {
+			io.WriteString(s, "+")
+		}
+		v.Format(s, verb)
+		io.WriteString(s, symbBiquaternion[i])
+	}
+	io.WriteString(s, ")")
+}
+
+// Equals returns true if y and z are equal.
+func (z *Biquaternion) Equals(y *Biquaternion) bool {
+	if !z.w.Equals(&y.w) || !z.x.Equals(&y.x) || !z.y.Equals(&y.y) || !z.z.Equals(&y.z) {
+		return false
+	}
+	return true
+}
+
+// Copy copies y onto z, and returns z.
+func (z *Biquaternion) Copy(y *Biquaternion) *Biquaternion {
+	z.w.Copy(&y.w)
+	z.x.Copy(&y.x)
+	z.y.Copy(&y.y)
+	z.z.Copy(&y.z)
+	return z
+}
+
+// NewBiquaternion returns a pointer to the Biquaternion value w+xi+yj+zk.
+func NewBiquaternion(w, x, y, z *Complex) *Biquaternion {
+	b := new(Biquaternion)
+	b.w.Copy(w)
+	b.x.Copy(x)
+	b.y.Copy(y)
+	b.z.Copy(z)
+	return b
+}
+
+// Scal sets z equal to y scaled by a, and returns z.
+func (z *Biquaternion) Scal(y *Biquaternion, a *big.Float) *Biquaternion {
+	z.w.Scal(&y.w, a)
+	z.x.Scal(&y.x, a)
+	z.y.Scal(&y.y, a)
+	z.z.Scal(&y.z, a)
+	return z
+}
+
+// ComplexScal sets z equal to y scaled by the complex number a, and returns z.
+func (z *Biquaternion) ComplexScal(y *Biquaternion, a *Complex) *Biquaternion {
+	z.w.Mul(&y.w, a)
+	z.x.Mul(&y.x, a)
+	z.y.Mul(&y.y, a)
+	z.z.Mul(&y.z, a)
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *Biquaternion) Neg(y *Biquaternion) *Biquaternion {
+	z.w.Neg(&y.w)
+	z.x.Neg(&y.x)
+	z.y.Neg(&y.y)
+	z.z.Neg(&y.z)
+	return z
+}
+
+// Conj sets z equal to the quaternion conjugate of y, and returns z. If
+// y = w+xi+yj+zk, then the conjugate is w-xi-yj-zk, with the components left
+// unchanged. It reverses products.
+func (z *Biquaternion) Conj(y *Biquaternion) *Biquaternion {
+	z.w.Copy(&y.w)
+	z.x.Neg(&y.x)
+	z.y.Neg(&y.y)
+	z.z.Neg(&y.z)
+	return z
+}
+
+// ComplexConj sets z equal to the complex conjugate of y, and returns z. Each
+// component is replaced by its Complex conjugate, and i, j, and k are left
+// unchanged. It preserves the order of products.
+func (z *Biquaternion) ComplexConj(y *Biquaternion) *Biquaternion {
+	z.w.Conj(&y.w)
+	z.x.Conj(&y.x)
+	z.y.Conj(&y.y)
+	z.z.Conj(&y.z)
+	return z
+}
+
+// HermConj sets z equal to the Hermitian conjugate of y, the composition of
+// Conj and ComplexConj, and returns z. It reverses products, and it
+// corresponds to the conjugate transpose of the 2x2 complex matrix of y.
+func (z *Biquaternion) HermConj(y *Biquaternion) *Biquaternion {
+	z.ComplexConj(y)
+	return z.Conj(z)
+}
+
+// Add sets z equal to x+y, and returns z.
+func (z *Biquaternion) Add(x, y *Biquaternion) *Biquaternion {
+	z.w.Add(&x.w, &y.w)
+	z.x.Add(&x.x, &y.x)
+	z.y.Add(&x.y, &y.y)
+	z.z.Add(&x.z, &y.z)
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z.
+func (z *Biquaternion) Sub(x, y *Biquaternion) *Biquaternion {
+	z.w.Sub(&x.w, &y.w)
+	z.x.Sub(&x.x, &y.x)
+	z.y.Sub(&x.y, &y.y)
+	z.z.Sub(&x.z, &y.z)
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z.
+//
+// The multiplication rules are those of Hamilton:
+// 		Mul(i, i) = Mul(j, j) = Mul(k, k) = -1
+// 		Mul(i, j) = -Mul(j, i) = k
+// 		Mul(j, k) = -Mul(k, j) = i
+// 		Mul(k, i) = -Mul(i, k) = j
+// with Complex components, which commute with i, j, and k. This binary
+// operation is noncommutative but associative. It has zero divisors; see
+// IsZeroDiv.
+// Unless the components of x or y differ widely in scale, the product is
+// computed exactly and then rounded once, so the result is exact whenever it
+// fits the precision of z; otherwise it is computed with rounded arithmetic.
+func (z *Biquaternion) Mul(x, y *Biquaternion) *Biquaternion {
+	prec := maxPrec(
+		&x.w.l, &x.w.r, &x.x.l, &x.x.r, &x.y.l, &x.y.r, &x.z.l, &x.z.r,
+		&y.w.l, &y.w.r, &y.x.l, &y.x.r, &y.y.l, &y.y.r, &y.z.l, &y.z.r,
+	)
+	mul, add, sub := (*Complex).mulExact, (*Complex).addExact, (*Complex).subExact
+	if !fitsExact(prec,
+		[]*big.Float{&z.w.l, &z.w.r, &z.x.l, &z.x.r, &z.y.l, &z.y.r, &z.z.l, &z.z.r},
+		[]*big.Float{&x.w.l, &x.w.r, &x.x.l, &x.x.r, &x.y.l, &x.y.r, &x.z.l, &x.z.r},
+		[]*big.Float{&y.w.l, &y.w.r, &y.x.l, &y.x.r, &y.y.l, &y.y.r, &y.z.l, &y.z.r},
+	) {
+		mul, add, sub = (*Complex).Mul, (*Complex).Add, (*Complex).Sub
+	}
+	// exact records whether the partial products and sums are exact.
+	exact := true
+	p := func(u, v *Complex) *Complex {
+		r := mul(new(Complex), u, v)
+		exact = exact && r.Exact()
+		return r
+	}
+	// sum returns the sum of the products, with the signs s.
+	sum := func(s [4]int, t [4]*Complex) *Complex {
+		r := new(Complex)
+		for k, v := range t {
+			if s[k] < 0 {
+				sub(r, r, v)
+			} else {
+				add(r, r, v)
+			}
+			exact = exact && r.Exact()
+		}
+		return r
+	}
+	w := sum([4]int{1, -1, -1, -1}, [4]*Complex{p(&x.w, &y.w), p(&x.x, &y.x), p(&x.y, &y.y), p(&x.z, &y.z)})
+	i := sum([4]int{1, 1, 1, -1}, [4]*Complex{p(&x.w, &y.x), p(&x.x, &y.w), p(&x.y, &y.z), p(&x.z, &y.y)})
+	j := sum([4]int{1, -1, 1, 1}, [4]*Complex{p(&x.w, &y.y), p(&x.x, &y.z), p(&x.y, &y.w), p(&x.z, &y.x)})
+	k := sum([4]int{1, 1, -1, 1}, [4]*Complex{p(&x.w, &y.z), p(&x.x, &y.y), p(&x.y, &y.x), p(&x.z, &y.w)})
+	z.w.roundComplex(w, prec)
+	z.x.roundComplex(i, prec)
+	z.y.roundComplex(j, prec)
+	z.z.roundComplex(k, prec)
+	if !exact {
+		markRounded(&z.w.l, &z.w.r, &z.x.l, &z.x.r, &z.y.l, &z.y.r, &z.z.l, &z.z.r)
+	}
+	return z
+}
+
+// Commutator sets z equal to the commutator of x and y:
+// 		Mul(x, y) - Mul(y, x)
+// Then it returns z.
+func (z *Biquaternion) Commutator(x, y *Biquaternion) *Biquaternion {
+	return z.Sub(
+		new(Biquaternion).Mul(x, y),
+		new(Biquaternion).Mul(y, x),
+	)
+}
+
+// Quad returns the quadrance of z, a pointer to a Complex value. If
+// z = w+xi+yj+zk, then the quadrance is
+// 		Mul(w, w) + Mul(x, x) + Mul(y, y) + Mul(z, z)
+// which equals Mul(z, Conj(z)) and the determinant of the 2x2 complex matrix
+// of z. It is multiplicative, but it is complex-valued and it vanishes for
+// non-zero values such as 1 + (0+1i)i. The components are prescaled by a
+// power of two, so the result overflows or underflows only if the quadrance
+// is out of the exponent range of big.Float.
+func (z *Biquaternion) Quad() *Complex {
+	quad, e := z.scaledQuad()
+	rescaleQuad(&quad.l, e)
+	rescaleQuad(&quad.r, e)
+	return quad
+}
+
+// scaledQuad returns the quadrance of z prescaled by 2^(-e), and e.
+func (z *Biquaternion) scaledQuad() (*Complex, int) {
+	y, e := prescaled(
+		&z.w.l, &z.w.r, &z.x.l, &z.x.r, &z.y.l, &z.y.r, &z.z.l, &z.z.r,
+	)
+	quad := new(Complex)
+	for k := 0; k < 8; k += 2 {
+		v := NewComplex(y[k], y[k+1])
+		quad.Add(quad, new(Complex).Mul(v, v))
+	}
+	return quad, e
+}
+
+// IsZeroDiv returns true if z is a zero divisor. This is equivalent to the
+// quadrance of z being zero.
+func (z *Biquaternion) IsZeroDiv() bool {
+	zero := new(Complex)
+	quad, _ := z.scaledQuad()
+	return quad.Equals(zero)
+}
+
+// Inv sets z equal to the inverse of y, and returns z. The inverse is
+// Conj(y)/Quad(y). If y is a zero divisor, then Inv panics.
+func (z *Biquaternion) Inv(y *Biquaternion) *Biquaternion {
+	if y.IsZeroDiv() {
+		panic("inverse of zero divisor")
+	}
+	inv := new(Complex).Inv(y.Quad())
+	z.Conj(y)
+	return z.ComplexScal(z, inv)
+}
+
+// Generate returns a random Biquaternion value for quick.Check testing.
+func (z *Biquaternion) Generate(rand *rand.Rand, size int) reflect.Value {
+	randomBiquaternion := new(Biquaternion)
+	for _, v := range []*Complex{&randomBiquaternion.w, &randomBiquaternion.x, &randomBiquaternion.y, &randomBiquaternion.z} {
+		v.l.SetFloat64(rand.Float64())
+		v.r.SetFloat64(rand.Float64())
+	}
+	return reflect.ValueOf(randomBiquaternion)
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+// closeToBiquaternion returns true if the components of x and y agree to about
+// bits bits, relative to the larger of their modulus and 1.
+func closeToBiquaternion(x, y *Biquaternion, bits int) bool {
+	return closeToComplex(&x.w, &y.w, bits) && closeToComplex(&x.x, &y.x, bits) &&
+		closeToComplex(&x.y, &y.y, bits) && closeToComplex(&x.z, &y.z, bits)
+}
+
+// biquaternionBasis returns the basis element of index k, with 0 for 1, scaled
+// by the complex number c.
+func biquaternionBasis(k int, c *Complex) *Biquaternion {
+	z := new(Biquaternion)
+	w, x, y, v := z.Cartesian()
+	[]*Complex{w, x, y, v}[k].Copy(c)
+	return z
+}
+
+func TestBiquaternionBasis(t *testing.T) {
+	one := NewComplex(big.NewFloat(1), big.NewFloat(0))
+	h := NewComplex(big.NewFloat(0), big.NewFloat(1))
+	// {u, v, w, sign} for Mul(u, v) = sign w.
+	rules := [][4]int{
+		{1, 1, 0, -1}, {2, 2, 0, -1}, {3, 3, 0, -1},
+		{1, 2, 3, 1}, {2, 1, 3, -1},
+		{2, 3, 1, 1}, {3, 2, 1, -1},
+		{3, 1, 2, 1}, {1, 3, 2, -1},
+	}
+	for _, r := range rules {
+		want := biquaternionBasis(r[2], new(Complex).Scal(h, big.NewFloat(float64(r[3]))))
+		// The complex unit commutes with i, j, and k.
+		got := new(Biquaternion).Mul(biquaternionBasis(r[0], h), biquaternionBasis(r[1], one))
+		if !got.Equals(want) {
+			t.Errorf("Mul(%s, %s) = %v, want %v", symbBiquaternion[r[0]], symbBiquaternion[r[1]], got, want)
+		}
+	}
+}
+
+func TestBiquaternionMulAssociative(t *testing.T) {
+	f := func(x, y, z *Biquaternion) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		l, r := new(Biquaternion), new(Biquaternion)
+		l.Mul(l.Mul(x, y), z)
+		r.Mul(x, r.Mul(y, z))
+		return closeToBiquaternion(l, r, 50)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBiquaternionConjugations(t *testing.T) {
+	f := func(x, y *Biquaternion) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		xy := new(Biquaternion).Mul(x, y)
+		// Conj and HermConj reverse products; ComplexConj preserves them.
+		l := new(Biquaternion).Conj(xy)
+		r := new(Biquaternion).Mul(new(Biquaternion).Conj(y), new(Biquaternion).Conj(x))
+		if !closeToBiquaternion(l, r, 50) {
+			return false
+		}
+		l.HermConj(xy)
+		r.Mul(new(Biquaternion).HermConj(y), new(Biquaternion).HermConj(x))
+		if !closeToBiquaternion(l, r, 50) {
+			return false
+		}
+		l.ComplexConj(xy)
+		r.Mul(new(Biquaternion).ComplexConj(x), new(Biquaternion).ComplexConj(y))
+		return closeToBiquaternion(l, r, 50)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBiquaternionQuadMultiplicative(t *testing.T) {
+	f := func(x, y *Biquaternion) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(Biquaternion).Mul(x, y).Quad()
+		r := new(Complex).Mul(x.Quad(), y.Quad())
+		return closeToComplex(l, r, 45)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBiquaternionInv(t *testing.T) {
+	f := func(x *Biquaternion) bool {
+		// t.Logf("x = %v", x)
+		// Keep the quadrance away from zero.
+		x.w.l.Add(&x.w.l, big.NewFloat(2))
+		l := new(Biquaternion).Mul(x, new(Biquaternion).Inv(x))
+		one := biquaternionBasis(0, NewComplex(big.NewFloat(1), big.NewFloat(0)))
+		return closeToBiquaternion(l, one, 45)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBiquaternionIsZeroDiv(t *testing.T) {
+	x := NewBiquaternion(
+		NewComplex(big.NewFloat(1), big.NewFloat(0)),
+		NewComplex(big.NewFloat(0), big.NewFloat(1)),
+		new(Complex),
+		new(Complex),
+	)
+	if !x.IsZeroDiv() {
+		t.Errorf("IsZeroDiv(%v) = false, want true", x)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Inv(%v) did not panic", x)
+		}
+	}()
+	new(Biquaternion).Inv(x)
+}
+
+// A Lorentz transformation of Minkowski space is X -> q X HermConj(q) for a
+// biquaternion q of unit quadrance, acting on the Hermitian biquaternions
+// X = t + h(xi + yj + zk), with h the complex unit of the components. It
+// preserves the Minkowski quadrance Quad(X) = t² - x² - y² - z².
+func TestBiquaternionLorentz(t *testing.T) {
+	f := func(q *Biquaternion, x *Hamilton) bool {
+		// t.Logf("q = %v, x = %v", q, x)
+		q.w.l.Add(&q.w.l, big.NewFloat(2))
+		// Normalize q with a square root of the inverse quadrance.
+		s := new(Complex).Inv(q.Quad())
+		q.ComplexScal(q, s.Sqrt(s))
+		a, b, c, d := x.Cartesian()
+		v := NewBiquaternion(
+			NewComplex(a, new(big.Float)),
+			NewComplex(new(big.Float), b),
+			NewComplex(new(big.Float), c),
+			NewComplex(new(big.Float), d),
+		)
+		l := new(Biquaternion).Mul(q, v)
+		l.Mul(l, new(Biquaternion).HermConj(q))
+		// The image is Hermitian again.
+		if !closeToBiquaternion(l, new(Biquaternion).HermConj(l), 45) {
+			return false
+		}
+		return closeToComplex(l.Quad(), v.Quad(), 40)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBiquaternionCommutatorAlias(t *testing.T) {
+	f := func(x, y *Biquaternion) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		return commutatorAliased(x, y)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"container/list"
+	"math/big"
+	"sync"
+)
+
+// DefaultCacheLimit is the initial limit, in bytes, on the memory held by the
+// cache of constants and nodes.
+const DefaultCacheLimit = 64 << 20
+
+// constStratum is the granularity, in bits, of the precisions at which π and
+// log(2) are cached. A request for prec bits is served from the value at the
+// smallest multiple of constStratum that is at least prec + guardBits, so
+// nearby precisions share one entry.
+const constStratum = 64
+
+// A cacheKind identifies what a cache entry holds.
+type cacheKind int
+
+const (
+	cachePi cacheKind = iota
+	cacheLn2
+	cacheRootsOfUnity
+	cacheTanhSinh
+	cacheGaussLegendre
+)
+
+// A cacheKey identifies a cache entry by what it holds, an integer parameter
+// such as the number of roots or nodes, and the precision.
+type cacheKey struct {
+	kind cacheKind
+	n    int
+	prec uint
+}
+
+// A cacheEntry is an element of the cache, with its approximate size in
+// bytes.
+type cacheEntry struct {
+	key   cacheKey
+	value interface{}
+	size  int64
+}
+
+// A constantCache holds computed constants, roots of unity, and quadrature
+// nodes, with the most recently used entry at the front of lru.
+type constantCache struct {
+	sync.Mutex
+	entries     map[cacheKey]*list.Element
+	lru         list.List
+	size, limit int64
+}
+
+// constCache is the cache used by the package.
+var constCache = constantCache{limit: DefaultCacheLimit}
+
+// cached returns the value held by the cache for key. If there is none, then
+// it calls compute for the value and its size in bytes, and stores the value
+// if it fits the limit, evicting the least recently used entries as needed.
+// The lock is not held during compute, so compute may use the cache itself.
+// Cached values are shared, and must not be modified.
+func cached(key cacheKey, compute func() (interface{}, int64)) interface{} {
+	c := &constCache
+	c.Lock()
+	if e, ok := c.entries[key]; ok {
+		c.lru.MoveToFront(e)
+		c.Unlock()
+		return e.Value.(*cacheEntry).value
+	}
+	c.Unlock()
+	value, size := compute()
+	c.Lock()
+	defer c.Unlock()
+	if e, ok := c.entries[key]; ok {
+		// Another goroutine stored the value first.
+		c.lru.MoveToFront(e)
+		return e.Value.(*cacheEntry).value
+	}
+	if c.limit >= 0 && size > c.limit {
+		return value
+	}
+	if c.entries == nil {
+		c.entries = make(map[cacheKey]*list.Element)
+	}
+	c.entries[key] = c.lru.PushFront(&cacheEntry{key, value, size})
+	c.size += size
+	c.evict()
+	return value
+}
+
+// evict removes the least recently used entries of the cache until its size
+// is within the limit. The lock must be held.
+func (c *constantCache) evict() {
+	for c.limit >= 0 && c.size > c.limit {
+		e := c.lru.Back()
+		entry := e.Value.(*cacheEntry)
+		c.lru.Remove(e)
+		delete(c.entries, entry.key)
+		c.size -= entry.size
+	}
+}
+
+// floatSize returns the approximate size in bytes of a big.Float value with
+// prec bits.
+func floatSize(prec uint) int64 {
+	return 64 + int64(prec+7)/8
+}
+
+// SetCacheLimit sets the limit, in bytes, on the memory held by the cache of
+// π, log(2), roots of unity, and quadrature nodes, evicting the least
+// recently used entries if the cache is larger, and returns the previous
+// limit. A limit of zero disables the cache, and a negative limit removes the
+// bound. The sizes are estimates from the precision of the values.
+func SetCacheLimit(limit int64) int64 {
+	c := &constCache
+	c.Lock()
+	defer c.Unlock()
+	old := c.limit
+	c.limit = limit
+	c.evict()
+	return old
+}
+
+// CacheSize returns the estimated memory, in bytes, held by the cache.
+func CacheSize() int64 {
+	c := &constCache
+	c.Lock()
+	defer c.Unlock()
+	return c.size
+}
+
+// ClearCache removes every entry of the cache.
+func ClearCache() {
+	c := &constCache
+	c.Lock()
+	defer c.Unlock()
+	c.entries = nil
+	c.lru.Init()
+	c.size = 0
+}
+
+// PrewarmCache computes and caches π and log(2) at prec bits, and the
+// tanh-sinh and Gauss–Legendre nodes that IntegrateTanhSinh and
+// IntegrateGaussLegendre use first at prec bits, up to the given tanh-sinh
+// level and number of Gauss–Legendre nodes. It is meant to move the cost of
+// these tables out of a latency-sensitive path.
+func PrewarmCache(prec uint, level, nodes int) {
+	bigPi(prec)
+	bigLn2(prec)
+	w := prec + guardBits
+	for k := 0; k <= level && k <= maxTanhSinhLevel; k++ {
+		tanhSinhLevel(k, w)
+	}
+	for n := 8; n <= nodes && n <= maxGaussLegendre; n *= 2 {
+		gaussLegendreRule(n, w)
+	}
+}
+
+// constPrec returns the precision at which π and log(2) are cached for a
+// request of prec bits.
+func constPrec(prec uint) uint {
+	return (prec + guardBits + constStratum - 1) / constStratum * constStratum
+}
+
+// bigPi returns π rounded to prec bits.
+func bigPi(prec uint) *big.Float {
+	s := constPrec(prec)
+	pi := cached(cacheKey{cachePi, 0, s}, func() (interface{}, int64) {
+		return computePi(s), floatSize(s)
+	})
+	return newFloat(prec).Set(pi.(*big.Float))
+}
+
+// bigLn2 returns log(2) rounded to prec bits.
+func bigLn2(prec uint) *big.Float {
+	s := constPrec(prec)
+	ln2 := cached(cacheKey{cacheLn2, 0, s}, func() (interface{}, int64) {
+		return computeLn2(s), floatSize(s)
+	})
+	return newFloat(prec).Set(ln2.(*big.Float))
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestCachedConstants(t *testing.T) {
+	for _, prec := range []uint{53, 64, 100, 500} {
+		if got, want := bigPi(prec), computePi(prec); !closeTo(got, want, int(prec)-1) {
+			t.Errorf("bigPi(%d) = %v, want %v", prec, got, want)
+		}
+		if got, want := bigLn2(prec), computeLn2(prec); !closeTo(got, want, int(prec)-1) {
+			t.Errorf("bigLn2(%d) = %v, want %v", prec, got, want)
+		}
+		if got := bigPi(prec); got.Prec() != prec {
+			t.Errorf("bigPi(%d) has precision %d", prec, got.Prec())
+		}
+	}
+	// The values returned are copies, so changing them leaves the cache
+	// intact.
+	pi := bigPi(100)
+	pi.Neg(pi)
+	if bigPi(100).Sign() < 0 {
+		t.Error("bigPi returned the cached value")
+	}
+	roots := RootsOfUnity(8, 100)
+	roots[0].l.SetInt64(2)
+	if RootsOfUnity(8, 100)[0].l.Cmp(newFloat(100).SetInt64(1)) != 0 {
+		t.Error("RootsOfUnity returned the cached values")
+	}
+}
+
+func TestCacheLimit(t *testing.T) {
+	defer SetCacheLimit(SetCacheLimit(DefaultCacheLimit))
+	ClearCache()
+	PrewarmCache(200, 2, 16)
+	size := CacheSize()
+	if size <= 0 {
+		t.Fatalf("CacheSize() = %d after PrewarmCache", size)
+	}
+	if old := SetCacheLimit(size / 2); old != DefaultCacheLimit {
+		t.Errorf("SetCacheLimit returned %d, want %d", old, DefaultCacheLimit)
+	}
+	if got := CacheSize(); got > size/2 {
+		t.Errorf("CacheSize() = %d, above the limit %d", got, size/2)
+	}
+	SetCacheLimit(0)
+	if got := CacheSize(); got != 0 {
+		t.Errorf("CacheSize() = %d with the cache disabled", got)
+	}
+	bigPi(300)
+	RootsOfUnity(16, 300)
+	if got := CacheSize(); got != 0 {
+		t.Errorf("CacheSize() = %d with the cache disabled", got)
+	}
+	SetCacheLimit(-1)
+	bigPi(300)
+	if got := CacheSize(); got == 0 {
+		t.Error("CacheSize() = 0 without a limit")
+	}
+	ClearCache()
+	if got := CacheSize(); got != 0 {
+		t.Errorf("CacheSize() = %d after ClearCache", got)
+	}
+}
+
+func TestCacheConcurrent(t *testing.T) {
+	ClearCache()
+	want := computePi(150)
+	var wg sync.WaitGroup
+	for k := 0; k < 8; k++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got := bigPi(150); !closeTo(got, want, 149) {
+				t.Errorf("bigPi(150) = %v, want %v", got, want)
+			}
+			RootsOfUnity(32, 150)
+		}()
+	}
+	wg.Wait()
+}
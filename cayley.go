@@ -0,0 +1,385 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+	"math/rand"
+	"reflect"
+	"strings"
+)
+
+var symbCayley = [8]string{"", "i", "j", "k", "l", "m", "n", "p"}
+
+// A Cayley represents a multi-precision floating-point Cayley octonion.
+type Cayley struct {
+	l, r Hamilton
+}
+
+// Real returns the real part of z.
+func (z *Cayley) Real() *big.Float {
+	return (&z.l).Real()
+}
+
+// Cartesian returns the eight multi-precision floating-point Cartesian
+// components of z.
+func (z *Cayley) Cartesian() (*big.Float, *big.Float, *big.Float, *big.Float, *big.Float, *big.Float, *big.Float, *big.Float) {
+	return &z.l.l.l, &z.l.l.r, &z.l.r.l, &z.l.r.r, &z.r.l.l, &z.r.l.r, &z.r.r.l, &z.r.r.r
+}
+
+// String returns the string representation of a Cayley value.
+//
+// If z corresponds to a + bi + cj + dk + el + fm + gn + hp, then the string is
+// "(a+bi+cj+dk+el+fm+gn+hp)", similar to complex128 values.
+func (z *Cayley) String() string {
+	v := make([]*big.Float, 8)
+	v[0], v[1], v[2], v[3] = z.l.Cartesian()
+	v[4], v[5], v[6], v[7] = z.r.Cartesian()
+	a := make([]string, 17)
+	a[0] = "("
+	a[1] = fmt.Sprintf("%v", v[0])
+	i := 1
+	for j := 2; j < 16; j = j + 2 {
+		if v[i].Sign() < 0 {
+			a[j] = fmt.Sprintf("%v", v[i])
+		} else {
+			a[j] = fmt.Sprintf("+%v", v[i])
+		}
+		a[j+1] = symbCayley[i]
+		i++
+	}
+	a[16] = ")"
+	return strings.Join(a, "")
+}
+
+// Format implements fmt.Formatter, with the conventions of the Format method
+// of Complex.
+func (z *Cayley) Format(s fmt.State, verb rune) {
+	formatTerms(s, verb, z, symbCayley[:], floatSlice(z.Cartesian()))
+}
+
+// Equals returns true if y and z are equal.
+func (z *Cayley) Equals(y *Cayley) bool {
+	if !z.l.Equals(&y.l) || !z.r.Equals(&y.r) {
+		return false
+	}
+	return true
+}
+
+// Copy copies y onto z, and returns z.
+func (z *Cayley) Copy(y *Cayley) *Cayley {
+	z.l.Copy(&y.l)
+	z.r.Copy(&y.r)
+	return z
+}
+
+// NewCayley returns a pointer to the Cayley value
+// a+bi+cj+dk+el+fm+gn+hp.
+func NewCayley(a, b, c, d, e, f, g, h *big.Float) *Cayley {
+	z := new(Cayley)
+	z.l.l.l.Copy(a)
+	z.l.l.r.Copy(b)
+	z.l.r.l.Copy(c)
+	z.l.r.r.Copy(d)
+	z.r.l.l.Copy(e)
+	z.r.l.r.Copy(f)
+	z.r.r.l.Copy(g)
+	z.r.r.r.Copy(h)
+	return z
+}
+
+// setPrec sets z equal to y rounded to prec bits, and returns z.
+func (z *Cayley) setPrec(y *Cayley, prec uint) *Cayley {
+	a, b, c, d, e, f, g, h := y.Cartesian()
+	s, t, u, v, w, m, n, p := z.Cartesian()
+	s.SetPrec(prec).Set(a)
+	t.SetPrec(prec).Set(b)
+	u.SetPrec(prec).Set(c)
+	v.SetPrec(prec).Set(d)
+	w.SetPrec(prec).Set(e)
+	m.SetPrec(prec).Set(f)
+	n.SetPrec(prec).Set(g)
+	p.SetPrec(prec).Set(h)
+	return z
+}
+
+// Scal sets z equal to y scaled by a, and returns z.
+func (z *Cayley) Scal(y *Cayley, a *big.Float) *Cayley {
+	z.l.Scal(&y.l, a)
+	z.r.Scal(&y.r, a)
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *Cayley) Neg(y *Cayley) *Cayley {
+	z.l.Neg(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Conj sets z equal to the conjugate of y, and returns z.
+func (z *Cayley) Conj(y *Cayley) *Cayley {
+	z.l.Conj(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Add sets z equal to x+y, and returns z.
+func (z *Cayley) Add(x, y *Cayley) *Cayley {
+	z.l.Add(&x.l, &y.l)
+	z.r.Add(&x.r, &y.r)
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z.
+func (z *Cayley) Sub(x, y *Cayley) *Cayley {
+	z.l.Sub(&x.l, &y.l)
+	z.r.Sub(&x.r, &y.r)
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z.
+//
+// The multiplication rules are:
+// 		Mul(i, i) = Mul(j, j) = Mul(k, k) = Mul(l, l) = -1
+// 		Mul(m, m) = Mul(n, n) = Mul(p, p) = -1
+// 		Mul(i, j) = -Mul(j, i) = k
+// 		Mul(j, k) = -Mul(k, j) = i
+// 		Mul(k, i) = -Mul(i, k) = j
+// 		Mul(i, l) = -Mul(l, i) = m
+// 		Mul(j, l) = -Mul(l, j) = n
+// 		Mul(k, l) = -Mul(l, k) = p
+// This binary operation is noncommutative and nonassociative, but it is
+// alternative.
+// Unless the components of x or y differ widely in scale, the product is
+// computed exactly and then rounded once, so the result is exact whenever it
+// fits the precision of z; otherwise it is computed with rounded arithmetic.
+func (z *Cayley) Mul(x, y *Cayley) *Cayley {
+	a, b, c, d, e, f, g, h := x.Cartesian()
+	s, t, u, v, w, m, n, p := y.Cartesian()
+	prec := maxPrec(a, b, c, d, e, f, g, h, s, t, u, v, w, m, n, p)
+	z0, z1, z2, z3, z4, z5, z6, z7 := z.Cartesian()
+	if fitsExact(prec,
+		[]*big.Float{z0, z1, z2, z3, z4, z5, z6, z7},
+		[]*big.Float{a, b, c, d, e, f, g, h},
+		[]*big.Float{s, t, u, v, w, m, n, p},
+	) {
+		return z.roundCayley(new(Cayley).mulExact(x, y), prec)
+	}
+	mulDoubling(-1, &z.l, &z.r, &x.l, &x.r, &y.l, &y.r)
+	return z
+}
+
+// Commutator sets z equal to the commutator of x and y:
+// 		Mul(x, y) - Mul(y, x)
+// Then it returns z.
+func (z *Cayley) Commutator(x, y *Cayley) *Cayley {
+	return z.Sub(
+		new(Cayley).Mul(x, y),
+		new(Cayley).Mul(y, x),
+	)
+}
+
+// Associator sets z equal to the associator of w, x, and y:
+// 		Mul(Mul(w, x), y) - Mul(w, Mul(x, y))
+// Then it returns z.
+func (z *Cayley) Associator(w, x, y *Cayley) *Cayley {
+	t := new(Cayley).Mul(w, x)
+	t.Mul(t, y)
+	u := new(Cayley).Mul(x, y)
+	u.Mul(w, u)
+	return z.Sub(t, u)
+}
+
+// Quad returns the quadrance of z. If z = a+bi+cj+dk+el+fm+gn+hp, then the
+// quadrance is
+// 		Mul(a, a) + Mul(b, b) + ... + Mul(h, h)
+// This is always non-negative.
+func (z *Cayley) Quad() *big.Float {
+	return new(big.Float).Add(
+		z.l.Quad(),
+		z.r.Quad(),
+	)
+}
+
+// Abs returns the absolute value of z, which is the square root of the
+// quadrance. It is a pointer to a big.Float value rounded to the precision of
+// z.
+func (z *Cayley) Abs() *big.Float {
+	a, b, c, d, e, f, g, h := z.Cartesian()
+	return bigHypot(maxPrec(a, b, c, d, e, f, g, h), a, b, c, d, e, f, g, h)
+}
+
+// Inv sets z equal to the inverse of y, and returns z. If y is zero, then Inv
+// panics.
+func (z *Cayley) Inv(y *Cayley) *Cayley {
+	if zero := new(Cayley); y.Equals(zero) {
+		panic("inverse of zero")
+	}
+	quad := y.Quad()
+	z.Conj(y)
+	return z.quoQuad(z, quad)
+}
+
+// quoQuad sets z equal to y with every component divided by quad, and returns
+// z.
+func (z *Cayley) quoQuad(y *Cayley, quad *big.Float) *Cayley {
+	a, b, c, d, e, f, g, h := y.Cartesian()
+	s, t, u, v, w, m, n, p := z.Cartesian()
+	s.Quo(a, quad)
+	t.Quo(b, quad)
+	u.Quo(c, quad)
+	v.Quo(d, quad)
+	w.Quo(e, quad)
+	m.Quo(f, quad)
+	n.Quo(g, quad)
+	p.Quo(h, quad)
+	return z
+}
+
+// Pow sets z equal to y raised to the integer power n, and returns z. Since
+// Mul is power-associative, the power is well defined, and it is computed by
+// repeated squaring, with O(log|n|) multiplications at the precision of y. If
+// n is negative, then the inverse of y is raised to -n, so Pow panics if y is
+// zero.
+func (z *Cayley) Pow(y *Cayley, n int) *Cayley {
+	x := new(Cayley).Copy(y)
+	if n < 0 {
+		x.Inv(x)
+		n = -n
+	}
+	a, b, c, d, e, f, g, h := y.Cartesian()
+	prec := maxPrec(a, b, c, d, e, f, g, h)
+	q := new(Cayley)
+	for _, v := range []*big.Float{&q.l.l.l, &q.l.l.r, &q.l.r.l, &q.l.r.r, &q.r.l.l, &q.r.l.r, &q.r.r.l, &q.r.r.r} {
+		v.SetPrec(prec)
+	}
+	q.l.l.l.SetInt64(1)
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			q.Mul(q, x)
+		}
+		if n > 1 {
+			x.Mul(x, x)
+		}
+	}
+	return z.Copy(q)
+}
+
+// PowInt sets z equal to y raised to the integer power n, and returns z. If
+// y = a+v with v imaginary, then Mul(v, v) = -Quad(v), so the powers of y lie
+// in the commutative and associative subalgebra spanned by 1 and v, and
+// 		y^n = α + βv
+// for real α and β. PowInt computes α and β from a and Quad(v) alone and then
+// scales v, so unlike Pow, whose repeated squaring rounds every Cayley
+// product, the result does not depend on how the product is parenthesized,
+// and its imaginary part stays parallel to v. The result is rounded to the precision of y. If
+// n is negative and y is zero, then PowInt panics.
+func (z *Cayley) PowInt(y *Cayley, n int) *Cayley {
+	a, b, c, d, e, f, g, h := y.Cartesian()
+	prec := maxPrec(a, b, c, d, e, f, g, h)
+	v := []*big.Float{b, c, d, e, f, g, h}
+	alpha, beta := powQuadratic(a, v, n, prec)
+	s, t, u, p, w, m, k, q := z.Cartesian()
+	for i, x := range []*big.Float{t, u, p, w, m, k, q} {
+		x.SetPrec(prec).Mul(beta, v[i])
+	}
+	s.SetPrec(prec).Set(alpha)
+	return z
+}
+
+// powQuadratic returns α and β with (a+v)^n = α + βv, where v is the
+// imaginary part with the components v, so that Mul(v, v) = -Quad(v). They
+// are computed by repeated squaring of pairs, with guard bits beyond prec for
+// the error that accumulates over the O(log|n|) steps. If n is negative and
+// a+v is zero, then powQuadratic panics.
+func powQuadratic(a *big.Float, v []*big.Float, n int, prec uint) (*big.Float, *big.Float) {
+	m := n
+	if m < 0 {
+		m = -m
+	}
+	w := prec + guardBits + 2*uint(bits.Len(uint(m)))
+	q := new(big.Float)
+	for _, x := range v {
+		q = exactAdd(q, exactMul(x, x))
+	}
+	q = newFloat(w).Set(q)
+	// The base is a+v, or its inverse (a-v)/(a²+Quad(v)).
+	ba, bb := newFloat(w).Set(a), newFloat(w).SetInt64(1)
+	if n < 0 {
+		quad := newFloat(w).Add(newFloat(w).Mul(a, a), q)
+		if quad.Sign() == 0 {
+			panic("inverse of zero")
+		}
+		ba.Quo(ba, quad)
+		bb.Quo(bb, quad).Neg(bb)
+	}
+	// (α, β)(γ, δ) = (αγ - βδq, αδ + βγ)
+	mul := func(alpha, beta, gamma, delta *big.Float) (*big.Float, *big.Float) {
+		r := newFloat(w).Mul(alpha, gamma)
+		r.Sub(r, newFloat(w).Mul(newFloat(w).Mul(beta, delta), q))
+		i := newFloat(w).Mul(alpha, delta)
+		i.Add(i, newFloat(w).Mul(beta, gamma))
+		return r, i
+	}
+	alpha, beta := newFloat(w).SetInt64(1), newFloat(w)
+	for ; m > 0; m >>= 1 {
+		if m&1 == 1 {
+			alpha, beta = mul(alpha, beta, ba, bb)
+		}
+		if m > 1 {
+			ba, bb = mul(ba, bb, ba, bb)
+		}
+	}
+	return alpha, beta
+}
+
+// QuoL sets z equal to the left quotient of x and y:
+// 		Mul(Inv(y), x)
+// Then it returns z. Since Mul is alternative, this is the solution of
+// Mul(y, z) = x. If y is zero, then QuoL panics.
+func (z *Cayley) QuoL(x, y *Cayley) *Cayley {
+	if zero := new(Cayley); y.Equals(zero) {
+		panic("left denominator is zero")
+	}
+	quad := y.Quad()
+	t := new(Cayley).Conj(y)
+	t.Mul(t, x)
+	return z.quoQuad(t, quad)
+}
+
+// QuoR sets z equal to the right quotient of x and y:
+// 		Mul(x, Inv(y))
+// Then it returns z. Since Mul is alternative, this is the solution of
+// Mul(z, y) = x. If y is zero, then QuoR panics.
+func (z *Cayley) QuoR(x, y *Cayley) *Cayley {
+	if zero := new(Cayley); y.Equals(zero) {
+		panic("right denominator is zero")
+	}
+	quad := y.Quad()
+	t := new(Cayley).Conj(y)
+	t.Mul(x, t)
+	return z.quoQuad(t, quad)
+}
+
+// Generate returns a random Cayley value for quick.Check testing.
+func (z *Cayley) Generate(rand *rand.Rand, size int) reflect.Value {
+	randomCayley := &Cayley{
+		*NewHamilton(
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+		),
+		*NewHamilton(
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+		),
+	}
+	return reflect.ValueOf(randomCayley)
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+// cayleyBasis returns the basis element of index k, with 0 for 1.
+func cayleyBasis(k int) *Cayley {
+	z := new(Cayley)
+	a, b, c, d, e, f, g, h := z.Cartesian()
+	[]*big.Float{a, b, c, d, e, f, g, h}[k].SetInt64(1)
+	return z
+}
+
+// closeToCayley returns true if the components of x and y agree to about
+// bits bits, relative to the larger of their modulus and 1.
+func closeToCayley(x, y *Cayley, bits int) bool {
+	return closeToComplex(&x.l.l, &y.l.l, bits) && closeToComplex(&x.l.r, &y.l.r, bits) &&
+		closeToComplex(&x.r.l, &y.r.l, bits) && closeToComplex(&x.r.r, &y.r.r, bits)
+}
+
+func TestCayleyBasis(t *testing.T) {
+	// i j = k, j k = i, k i = j, i l = m, j l = n, k l = p
+	rules := [][3]int{{1, 2, 3}, {2, 3, 1}, {3, 1, 2}, {1, 4, 5}, {2, 4, 6}, {3, 4, 7}}
+	for _, r := range rules {
+		x, y, want := cayleyBasis(r[0]), cayleyBasis(r[1]), cayleyBasis(r[2])
+		if got := new(Cayley).Mul(x, y); !got.Equals(want) {
+			t.Errorf("Mul(%s, %s) = %v", symbCayley[r[0]], symbCayley[r[1]], got)
+		}
+		if got := new(Cayley).Mul(y, x); !got.Equals(new(Cayley).Neg(want)) {
+			t.Errorf("Mul(%s, %s) = %v", symbCayley[r[1]], symbCayley[r[0]], got)
+		}
+	}
+	minusOne := new(Cayley).Neg(cayleyBasis(0))
+	for k := 1; k < 8; k++ {
+		if got := new(Cayley).Mul(cayleyBasis(k), cayleyBasis(k)); !got.Equals(minusOne) {
+			t.Errorf("Mul(%s, %s) = %v", symbCayley[k], symbCayley[k], got)
+		}
+	}
+}
+
+func TestCayleyAddCommutative(t *testing.T) {
+	f := func(x, y *Cayley) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(Cayley).Add(x, y)
+		r := new(Cayley).Add(y, x)
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCayleyMulNonCommutative(t *testing.T) {
+	f := func(x, y *Cayley) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(Cayley).Commutator(x, y)
+		zero := new(Cayley)
+		return !l.Equals(zero)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCayleyMulNonAssociative(t *testing.T) {
+	f := func(x, y, z *Cayley) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		l := new(Cayley).Associator(x, y, z)
+		return !closeToCayley(l, new(Cayley), 20)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCayleyMulAlternative(t *testing.T) {
+	f := func(x, y *Cayley) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(Cayley).Associator(x, x, y)
+		r := new(Cayley).Associator(x, y, y)
+		zero := new(Cayley)
+		return closeToCayley(l, zero, 45) && closeToCayley(r, zero, 45)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCayleyConjInvolutive(t *testing.T) {
+	f := func(x *Cayley) bool {
+		// t.Logf("x = %v", x)
+		l := new(Cayley).Conj(new(Cayley).Conj(x))
+		return l.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCayleyMulConjAntiDistributive(t *testing.T) {
+	f := func(x, y *Cayley) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(Cayley).Conj(new(Cayley).Mul(x, y))
+		r := new(Cayley).Mul(new(Cayley).Conj(y), new(Cayley).Conj(x))
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCayleyQuadPositive(t *testing.T) {
+	f := func(x *Cayley) bool {
+		// t.Logf("x = %v", x)
+		return x.Quad().Sign() > 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCayleyComposition(t *testing.T) {
+	f := func(x, y *Cayley) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(Cayley).Mul(x, y).Quad()
+		r := new(big.Float).Mul(x.Quad(), y.Quad())
+		return closeTo(l, r, 45)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCayleyQuo(t *testing.T) {
+	f := func(x, y *Cayley) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(Cayley).Mul(y, new(Cayley).QuoL(x, y))
+		r := new(Cayley).Mul(new(Cayley).QuoR(x, y), y)
+		return closeToCayley(l, x, 45) && closeToCayley(r, x, 45)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCayleyPowRepeatedMul(t *testing.T) {
+	f := func(x *Cayley) bool {
+		// t.Logf("x = %v", x)
+		r := new(Cayley).Copy(x)
+		for k := 1; k < 5; k++ {
+			r.Mul(r, x)
+		}
+		return closeToCayley(new(Cayley).Pow(x, 5), r, 45) &&
+			closeToCayley(new(Cayley).Mul(new(Cayley).Pow(x, -2), new(Cayley).Pow(x, 2)), cayleyBasis(0), 45)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCayleyPowInt(t *testing.T) {
+	f := func(x *Cayley) bool {
+		// t.Logf("x = %v", x)
+		l, r := new(Cayley).Copy(x), new(Cayley).Copy(x)
+		for k := 1; k < 5; k++ {
+			l.Mul(l, x)
+			r.Mul(x, r)
+		}
+		p := new(Cayley).PowInt(x, 5)
+		inv := new(Cayley).Mul(new(Cayley).PowInt(x, -3), new(Cayley).PowInt(x, 3))
+		// The result does not depend on a parenthesization, and z may be y.
+		return closeToCayley(p, l, 45) && closeToCayley(p, r, 45) &&
+			closeToCayley(inv, cayleyBasis(0), 45) &&
+			new(Cayley).Copy(x).PowInt(x, 5).Equals(p) &&
+			new(Cayley).PowInt(x, 0).Equals(cayleyBasis(0))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// commutatorAliased returns true if Commutator(x, y) is unchanged when the
+// result is written over a copy of x or of y passed in its place.
+func commutatorAliased[T any, P interface {
+	*T
+	Copy(y *T) *T
+	Equals(y *T) bool
+	Commutator(x, y *T) *T
+}](x, y P) bool {
+	want := P(new(T)).Commutator(x, y)
+	zx := P(new(T))
+	zx.Copy(x)
+	zy := P(new(T))
+	zy.Copy(y)
+	zx.Commutator((*T)(zx), y)
+	zy.Commutator(x, (*T)(zy))
+	return zx.Equals(want) && zy.Equals(want)
+}
+
+func TestCayleyCommutatorAlias(t *testing.T) {
+	f := func(x, y *Cayley) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		return commutatorAliased(x, y)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,368 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"sort"
+)
+
+// maxChebDegree is the largest degree that NewChebFun tries.
+const maxChebDegree = 1024
+
+// A ChebFun represents a real function on an interval [a, b] by the
+// coefficients of its Chebyshev expansion
+// 		c[0] T[0](t) + c[1] T[1](t) + ... + c[n] T[n](t)
+// in the variable t = (2x - a - b)/(b - a), truncated where the coefficients
+// fall below the precision of the ChebFun relative to the largest one.
+type ChebFun struct {
+	a, b big.Float
+	c    []big.Float
+	prec uint
+}
+
+// NewChebFun returns a ChebFun for f on the interval [a, b] with prec bits of
+// relative accuracy, and true if the expansion converged. The function is
+// sampled at the Chebyshev points
+// 		x[j] = (a + b)/2 + (b - a)/2 cos(πj/n)
+// for n = 16, 32, ..., maxChebDegree, reusing the samples of the previous
+// degree, until the last eighth of the coefficients is negligible; if that
+// never happens, the expansion of degree maxChebDegree is returned with false.
+// The function f receives its argument with some guard bits beyond prec and
+// should compute at the precision of its argument. If a is not less than b,
+// then NewChebFun panics.
+func NewChebFun(f func(x *big.Float) *big.Float, a, b *big.Float, prec uint) (*ChebFun, bool) {
+	if a.Cmp(b) >= 0 {
+		panic("empty chebfun interval")
+	}
+	w := prec + guardBits
+	h := &ChebFun{prec: prec}
+	h.a.SetPrec(w).Set(a)
+	h.b.SetPrec(w).Set(b)
+	mid, rad := h.midRad(w)
+	var values []*big.Float
+	for n := 16; ; n *= 2 {
+		cos := chebCos(n, w)
+		next := make([]*big.Float, n+1)
+		for j := range next {
+			if j%2 == 0 && values != nil {
+				next[j] = values[j/2]
+				continue
+			}
+			x := newFloat(w).Mul(rad, cos[j])
+			next[j] = newFloat(w).Set(f(x.Add(x, mid)))
+		}
+		values = next
+		h.c = chebCoefficients(values, cos, w)
+		tail := n - n/8
+		if h.negligible(tail) || n >= maxChebDegree {
+			converged := h.negligible(tail)
+			return h.trim(), converged
+		}
+	}
+}
+
+// chebCos returns cos(πm/n) for m = 0, 1, ..., 2n-1, rounded to prec bits.
+func chebCos(n int, prec uint) []*big.Float {
+	cos := make([]*big.Float, 2*n)
+	u := RootsOfUnity(2*n, prec)
+	for m := range cos {
+		cos[m] = &u[m].l
+	}
+	return cos
+}
+
+// chebCoefficients returns the Chebyshev coefficients of the polynomial of
+// degree n that interpolates values at the points cos(πj/n), computed at
+// prec bits by the discrete cosine transform
+// 		c[k] = (2/n) Σ'' values[j] cos(πjk/n)
+// where the first and last terms of the sum are halved, and so are c[0] and
+// c[n].
+func chebCoefficients(values, cos []*big.Float, prec uint) []big.Float {
+	n := len(values) - 1
+	c := make([]big.Float, n+1)
+	t := newFloat(prec)
+	for k := range c {
+		s := c[k].SetPrec(prec)
+		for j, v := range values {
+			t.Mul(v, cos[j*k%(2*n)])
+			if j == 0 || j == n {
+				t.SetMantExp(t, -1)
+			}
+			s.Add(s, t)
+		}
+		s.Quo(s, newFloat(prec).SetInt64(int64(n)))
+		if k > 0 && k < n {
+			s.SetMantExp(s, 1)
+		}
+	}
+	return c
+}
+
+// midRad returns the midpoint and the half-width of the interval of h at
+// prec bits.
+func (h *ChebFun) midRad(prec uint) (*big.Float, *big.Float) {
+	mid := newFloat(prec).Add(&h.a, &h.b)
+	rad := newFloat(prec).Sub(&h.b, &h.a)
+	return mid.SetMantExp(mid, -1), rad.SetMantExp(rad, -1)
+}
+
+// scale returns the binary exponent of the largest coefficient of h, and
+// false if every coefficient is zero.
+func (h *ChebFun) scale() (int, bool) {
+	e, ok := 0, false
+	for k := range h.c {
+		if h.c[k].Sign() != 0 && (!ok || expo(&h.c[k]) > e) {
+			e, ok = expo(&h.c[k]), true
+		}
+	}
+	return e, ok
+}
+
+// negligible returns true if the coefficients of h from index k on are below
+// the precision of h relative to the largest coefficient.
+func (h *ChebFun) negligible(k int) bool {
+	e, ok := h.scale()
+	if !ok {
+		return true
+	}
+	for ; k < len(h.c); k++ {
+		if h.c[k].Sign() != 0 && expo(&h.c[k]) > e-int(h.prec) {
+			return false
+		}
+	}
+	return true
+}
+
+// trim removes the trailing negligible coefficients of h, keeping at least
+// one, and returns h.
+func (h *ChebFun) trim() *ChebFun {
+	n := len(h.c)
+	for n > 1 && h.negligible(n-1) {
+		n--
+	}
+	h.c = h.c[:n]
+	return h
+}
+
+// Interval returns the endpoints of the interval of h.
+func (h *ChebFun) Interval() (*big.Float, *big.Float) {
+	return new(big.Float).Copy(&h.a), new(big.Float).Copy(&h.b)
+}
+
+// Degree returns the degree of the Chebyshev expansion of h.
+func (h *ChebFun) Degree() int {
+	return len(h.c) - 1
+}
+
+// Coeff returns a copy of the coefficient of T[k] in h.
+func (h *ChebFun) Coeff(k int) *big.Float {
+	if k < 0 || k >= len(h.c) {
+		return new(big.Float)
+	}
+	return new(big.Float).Copy(&h.c[k])
+}
+
+// Eval returns the value of h at x, a pointer to a big.Float value, computed
+// by Clenshaw's recurrence
+// 		b[k] = c[k] + 2t b[k+1] - b[k+2]
+// and rounded to the precision of h. The point x need not lie in the interval
+// of h, but the expansion is only accurate there.
+func (h *ChebFun) Eval(x *big.Float) *big.Float {
+	w := h.prec + guardBits
+	mid, rad := h.midRad(w)
+	t := newFloat(w).Sub(x, mid)
+	t.Quo(t, rad)
+	t2 := newFloat(w).SetMantExp(t, 1)
+	b1, b2, temp := newFloat(w), newFloat(w), newFloat(w)
+	for k := len(h.c) - 1; k >= 1; k-- {
+		temp.Mul(t2, b1)
+		temp.Sub(temp, b2)
+		temp.Add(temp, &h.c[k])
+		b1, b2, temp = temp, b1, b2
+	}
+	temp.Mul(t, b1)
+	temp.Sub(temp, b2)
+	temp.Add(temp, &h.c[0])
+	return newFloat(h.prec).Set(temp)
+}
+
+// sameInterval panics if f and g are not on the same interval, and returns
+// the larger of their precisions.
+func sameInterval(f, g *ChebFun) uint {
+	if f.a.Cmp(&g.a) != 0 || f.b.Cmp(&g.b) != 0 {
+		panic("chebfun intervals differ")
+	}
+	if f.prec > g.prec {
+		return f.prec
+	}
+	return g.prec
+}
+
+// setArith sets h to the ChebFun on the interval of f with coefficients c and
+// precision prec, and returns h.
+func (h *ChebFun) setArith(f *ChebFun, c []big.Float, prec uint) *ChebFun {
+	w := prec + guardBits
+	h.a.SetPrec(w).Set(&f.a)
+	h.b.SetPrec(w).Set(&f.b)
+	h.c = c
+	h.prec = prec
+	return h.trim()
+}
+
+// Add sets h equal to the sum of f and g, and returns h. If f and g are on
+// different intervals, then Add panics.
+func (h *ChebFun) Add(f, g *ChebFun) *ChebFun {
+	prec := sameInterval(f, g)
+	if len(f.c) < len(g.c) {
+		f, g = g, f
+	}
+	c := make([]big.Float, len(f.c))
+	for k := range c {
+		c[k].SetPrec(prec + guardBits).Set(&f.c[k])
+		if k < len(g.c) {
+			c[k].Add(&c[k], &g.c[k])
+		}
+	}
+	return h.setArith(f, c, prec)
+}
+
+// Sub sets h equal to the difference of f and g, and returns h. If f and g
+// are on different intervals, then Sub panics.
+func (h *ChebFun) Sub(f, g *ChebFun) *ChebFun {
+	prec := sameInterval(f, g)
+	n := len(f.c)
+	if len(g.c) > n {
+		n = len(g.c)
+	}
+	c := make([]big.Float, n)
+	for k := range c {
+		c[k].SetPrec(prec + guardBits)
+		if k < len(f.c) {
+			c[k].Set(&f.c[k])
+		}
+		if k < len(g.c) {
+			c[k].Sub(&c[k], &g.c[k])
+		}
+	}
+	return h.setArith(f, c, prec)
+}
+
+// Mul sets h equal to the product of f and g, and returns h. The product is
+// computed from the rule
+// 		T[j] T[k] = (T[j+k] + T[|j-k|])/2
+// If f and g are on different intervals, then Mul panics.
+func (h *ChebFun) Mul(f, g *ChebFun) *ChebFun {
+	prec := sameInterval(f, g)
+	w := prec + guardBits
+	c := make([]big.Float, len(f.c)+len(g.c)-1)
+	for k := range c {
+		c[k].SetPrec(w)
+	}
+	t := newFloat(w)
+	for j := range f.c {
+		for k := range g.c {
+			t.Mul(&f.c[j], &g.c[k])
+			t.SetMantExp(t, -1)
+			c[j+k].Add(&c[j+k], t)
+			d := j - k
+			if d < 0 {
+				d = -d
+			}
+			c[d].Add(&c[d], t)
+		}
+	}
+	return h.setArith(f, c, prec)
+}
+
+// Integral returns the integral of h over its interval, a pointer to a
+// big.Float value, from
+// 		∫ T[k](t) dt = 2/(1 - k²)
+// over [-1, 1] for even k, and zero for odd k.
+func (h *ChebFun) Integral() *big.Float {
+	w := h.prec + guardBits
+	s, t := newFloat(w), newFloat(w)
+	for k := 0; k < len(h.c); k += 2 {
+		t.SetInt64(int64(1 - k*k))
+		t.Quo(&h.c[k], t)
+		s.Add(s, t)
+	}
+	_, rad := h.midRad(w)
+	s.Mul(s, rad)
+	return newFloat(h.prec).SetMantExp(s, 1)
+}
+
+// Roots returns the real roots of h in its interval, in increasing order and
+// repeated according to multiplicity, rounded to the precision of h. The
+// expansion is converted exactly to the monomial basis in t, whose roots are
+// found by Aberth with enough extra precision to absorb the growth of the
+// conversion, and a root is kept if its inclusion disc meets the real
+// segment [-1, 1]. If h has degree zero, then Roots returns no roots.
+func (h *ChebFun) Roots() []*big.Float {
+	n := h.Degree()
+	if n < 1 {
+		return nil
+	}
+	// T[k] in the monomial basis, from T[k+1] = 2t T[k] - T[k-1].
+	prev := []*big.Int{big.NewInt(1)}
+	cur := []*big.Int{big.NewInt(0), big.NewInt(1)}
+	m := make([]*big.Float, n+1)
+	for j := range m {
+		m[j] = new(big.Float)
+	}
+	addTerm := func(c *big.Float, t []*big.Int) {
+		for j, v := range t {
+			if v.Sign() != 0 {
+				m[j] = exactAdd(m[j], exactMul(c, new(big.Float).SetInt(v)))
+			}
+		}
+	}
+	addTerm(&h.c[0], prev)
+	for k := 1; k <= n; k++ {
+		addTerm(&h.c[k], cur)
+		next := make([]*big.Int, len(cur)+1)
+		next[0] = new(big.Int).Neg(prev[0])
+		for j := 1; j < len(next); j++ {
+			next[j] = new(big.Int).Lsh(cur[j-1], 1)
+			if j < len(prev) {
+				next[j].Sub(next[j], prev[j])
+			}
+		}
+		prev, cur = cur, next
+	}
+	c := make([]*Complex, n+1)
+	for j := range c {
+		c[j] = NewComplex(m[j], new(big.Float))
+	}
+	w := h.prec + 2*uint(n) + guardBits
+	found, err := NewComplexPoly(c...).Aberth(w, 100+n+int(w), 0)
+	if err != nil {
+		panic(err)
+	}
+	mid, rad := h.midRad(w)
+	one := newFloat(w).SetInt64(1)
+	var roots []*big.Float
+	for _, r := range found {
+		re, im := r.Root.Cartesian()
+		if new(big.Float).Abs(im).Cmp(r.Radius) > 0 {
+			continue
+		}
+		lim := newFloat(w).Add(one, r.Radius)
+		if new(big.Float).Abs(re).Cmp(lim) > 0 {
+			continue
+		}
+		t := newFloat(w).Set(re)
+		if t.Cmp(one) > 0 {
+			t.Set(one)
+		} else if t.Cmp(new(big.Float).Neg(one)) < 0 {
+			t.Neg(one)
+		}
+		t.Mul(t, rad)
+		roots = append(roots, newFloat(h.prec).Set(t.Add(t, mid)))
+	}
+	sort.Slice(roots, func(i, j int) bool {
+		return roots[i].Cmp(roots[j]) < 0
+	})
+	return roots
+}
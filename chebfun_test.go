@@ -0,0 +1,88 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+)
+
+func expChebFun(t *testing.T, prec uint) *ChebFun {
+	f, ok := NewChebFun(func(x *big.Float) *big.Float {
+		return bigExp(x, x.Prec())
+	}, big.NewFloat(-1), big.NewFloat(2), prec)
+	if !ok {
+		t.Fatal("exp did not converge")
+	}
+	return f
+}
+
+func TestChebFunEval(t *testing.T) {
+	f := expChebFun(t, 120)
+	for _, x := range []float64{-1, -0.3, 0, 0.7, 1.5, 2} {
+		want := bigExp(big.NewFloat(x), 120)
+		if got := f.Eval(big.NewFloat(x)); !closeTo(got, want, 110) {
+			t.Errorf("Eval(%v) = %v, want %v", x, got, want)
+		}
+	}
+}
+
+func TestChebFunArithmetic(t *testing.T) {
+	f := expChebFun(t, 100)
+	sq := new(ChebFun).Mul(f, f)
+	sum := new(ChebFun).Add(f, f)
+	zero := new(ChebFun).Sub(f, f)
+	if zero.Degree() != 0 || zero.Coeff(0).Sign() != 0 {
+		t.Errorf("f - f = %v", zero.c)
+	}
+	for _, x := range []float64{-0.9, 0.1, 1.9} {
+		want := bigExp(big.NewFloat(2*x), 100)
+		if got := sq.Eval(big.NewFloat(x)); !closeTo(got, want, 90) {
+			t.Errorf("f²(%v) = %v, want %v", x, got, want)
+		}
+		want.SetMantExp(bigExp(big.NewFloat(x), 100), 1)
+		if got := sum.Eval(big.NewFloat(x)); !closeTo(got, want, 90) {
+			t.Errorf("2f(%v) = %v, want %v", x, got, want)
+		}
+	}
+}
+
+func TestChebFunIntegral(t *testing.T) {
+	f := expChebFun(t, 120)
+	want := new(big.Float).Sub(bigExp(big.NewFloat(2), 120), bigExp(big.NewFloat(-1), 120))
+	if got := f.Integral(); !closeTo(got, want, 110) {
+		t.Errorf("Integral() = %v, want %v", got, want)
+	}
+}
+
+func TestChebFunRoots(t *testing.T) {
+	f, ok := NewChebFun(func(x *big.Float) *big.Float {
+		s, _ := bigSinCos(x, x.Prec())
+		return s
+	}, big.NewFloat(1), big.NewFloat(7), 100)
+	if !ok {
+		t.Fatal("sin did not converge")
+	}
+	roots := f.Roots()
+	pi := bigPi(100)
+	want := []*big.Float{pi, new(big.Float).SetMantExp(pi, 1)}
+	if len(roots) != len(want) {
+		t.Fatalf("Roots() = %v, want %v", roots, want)
+	}
+	for k := range want {
+		if !closeTo(roots[k], want[k], 90) {
+			t.Errorf("Roots()[%d] = %v, want %v", k, roots[k], want[k])
+		}
+	}
+}
+
+func TestChebFunNotConverged(t *testing.T) {
+	// |x| has only algebraically decaying coefficients.
+	_, ok := NewChebFun(func(x *big.Float) *big.Float {
+		return new(big.Float).Abs(x)
+	}, big.NewFloat(-1), big.NewFloat(1), 64)
+	if ok {
+		t.Error("|x| converged")
+	}
+}
@@ -0,0 +1,338 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+	"math/rand"
+	"reflect"
+	"strings"
+)
+
+// A Clifford represents a multi-precision floating-point element of the
+// Clifford algebra Cl(p, q), generated by p units e1, ..., ep whose squares
+// are +1 and q units ep+1, ..., ep+q whose squares are -1, all of which
+// anticommute. It has 2^(p+q) components, one for each blade: the component
+// of index m is the coefficient of the product, in increasing order, of the
+// units whose bits are set in m. So index 0 is the real part, index 1 is e1,
+// index 2 is e2, and index 3 is e1e2.
+//
+// With this order, Cl(0, 1), Cl(1, 0), and Cl(0, 2) are Complex, Perplex, and
+// Hamilton with the components in the order of Cartesian, and Cl(1, 1) is
+// Cockle up to a relabeling; see CliffordBasisTable and DeriveRelabeling.
+type Clifford struct {
+	p, q int
+	c    []big.Float
+}
+
+// NewClifford returns a pointer to the zero Clifford value of the signature
+// (p, q). If p or q is negative, then NewClifford panics.
+func NewClifford(p, q int) *Clifford {
+	if p < 0 || q < 0 {
+		panic("negative signature")
+	}
+	return &Clifford{p, q, make([]big.Float, 1<<uint(p+q))}
+}
+
+// Signature returns the signature (p, q) of z.
+func (z *Clifford) Signature() (int, int) {
+	return z.p, z.q
+}
+
+// Cartesian returns the 2^(p+q) multi-precision floating-point components of
+// z, in the order of the blades.
+func (z *Clifford) Cartesian() []*big.Float {
+	c := make([]*big.Float, len(z.c))
+	for k := range c {
+		c[k] = &z.c[k]
+	}
+	return c
+}
+
+// Real returns the real part of z.
+func (z *Clifford) Real() *big.Float {
+	return &z.c[0]
+}
+
+// bladeName returns the name of the blade of index m, such as "e1e3".
+func bladeName(m int) string {
+	var s []string
+	for k := 0; m != 0; k++ {
+		if m&1 != 0 {
+			s = append(s, fmt.Sprintf("e%d", k+1))
+		}
+		m >>= 1
+	}
+	return strings.Join(s, "")
+}
+
+// String returns the string representation of a Clifford value.
+//
+// If z has components a, b, c, d, ..., then the string is
+// "(a+be1+ce2+de1e2+...)", similar to complex128 values.
+func (z *Clifford) String() string {
+	a := make([]string, 0, 2*len(z.c)+1)
+	a = append(a, "(", fmt.Sprintf("%v", &z.c[0]))
+	for k := 1; k < len(z.c); k++ {
+		if z.c[k].Sign() < 0 {
+			a = append(a, fmt.Sprintf("%v", &z.c[k]))
+		} else {
+			a = append(a, fmt.Sprintf("+%v", &z.c[k]))
+		}
+		a = append(a, bladeName(k))
+	}
+	a = append(a, ")")
+	return strings.Join(a, "")
+}
+
+// Format implements fmt.Formatter, with the conventions of the Format method
+// of Complex.
+func (z *Clifford) Format(s fmt.State, verb rune) {
+	units := make([]string, len(z.c))
+	for k := range units {
+		units[k] = bladeName(k)
+	}
+	formatTerms(s, verb, z, units, z.Cartesian())
+}
+
+// Equals returns true if y and z have the same signature and are equal.
+func (z *Clifford) Equals(y *Clifford) bool {
+	if z.p != y.p || z.q != y.q {
+		return false
+	}
+	for k := range z.c {
+		if z.c[k].Cmp(&y.c[k]) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// reset gives z the signature (p, q), keeping its components if it already
+// has that signature, and returns z.
+func (z *Clifford) reset(p, q int) *Clifford {
+	if z.p != p || z.q != q || len(z.c) != 1<<uint(p+q) {
+		z.p, z.q = p, q
+		z.c = make([]big.Float, 1<<uint(p+q))
+	}
+	return z
+}
+
+// check panics unless every element of y has the signature of z.
+func (z *Clifford) check(y ...*Clifford) {
+	for _, v := range y {
+		if v.p != z.p || v.q != z.q {
+			panic("signature mismatch")
+		}
+	}
+}
+
+// Copy copies y onto z, including its signature, and returns z.
+func (z *Clifford) Copy(y *Clifford) *Clifford {
+	if z == y {
+		return z
+	}
+	z.reset(y.p, y.q)
+	for k := range z.c {
+		z.c[k].Copy(&y.c[k])
+	}
+	return z
+}
+
+// Scal sets z equal to y scaled by a, and returns z.
+func (z *Clifford) Scal(y *Clifford, a *big.Float) *Clifford {
+	z.reset(y.p, y.q)
+	for k := range z.c {
+		z.c[k].Mul(&y.c[k], a)
+	}
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *Clifford) Neg(y *Clifford) *Clifford {
+	z.reset(y.p, y.q)
+	for k := range z.c {
+		z.c[k].Neg(&y.c[k])
+	}
+	return z
+}
+
+// Add sets z equal to x+y, and returns z. If x and y have different
+// signatures, then Add panics.
+func (z *Clifford) Add(x, y *Clifford) *Clifford {
+	x.check(y)
+	z.reset(x.p, x.q)
+	for k := range z.c {
+		z.c[k].Add(&x.c[k], &y.c[k])
+	}
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z. If x and y have different
+// signatures, then Sub panics.
+func (z *Clifford) Sub(x, y *Clifford) *Clifford {
+	x.check(y)
+	z.reset(x.p, x.q)
+	for k := range z.c {
+		z.c[k].Sub(&x.c[k], &y.c[k])
+	}
+	return z
+}
+
+// reorderSwaps returns the number of transpositions of units that bring the
+// product of the blades of indices m and n into increasing order.
+func reorderSwaps(m, n int) int {
+	swaps := 0
+	for a := m >> 1; a != 0; a >>= 1 {
+		swaps += bits.OnesCount(uint(a & n))
+	}
+	return swaps
+}
+
+// bladeSign returns the sign of the product of the blades of indices m and n,
+// which is the blade of index m^n: the sign of the reordering of the units
+// into increasing order, times the squares of the units shared by m and n.
+func (z *Clifford) bladeSign(m, n int) int {
+	swaps := reorderSwaps(m, n)
+	// The units of index p and above square to -1.
+	swaps += bits.OnesCount(uint((m & n) >> uint(z.p)))
+	if swaps%2 != 0 {
+		return -1
+	}
+	return 1
+}
+
+// Mul sets z equal to the geometric product of x and y, and returns z. If x
+// and y have different signatures, then Mul panics.
+//
+// The multiplication rules are:
+// 		Mul(ek, ek) = +1	for k <= p
+// 		Mul(ek, ek) = -1	for k > p
+// 		Mul(ej, ek) = -Mul(ek, ej)	for j != k
+// This binary operation is associative, and noncommutative if p+q > 1.
+// Unless the components of x or y differ widely in scale, the product is
+// computed exactly and then rounded once, so the result is exact whenever it
+// fits the precision of z; otherwise it is computed with rounded arithmetic.
+func (z *Clifford) Mul(x, y *Clifford) *Clifford {
+	x.check(y)
+	prec := maxPrec(append(x.Cartesian(), y.Cartesian()...)...)
+	o := productOps(prec, z.Cartesian(), x.Cartesian(), y.Cartesian())
+	sum := make([]*big.Float, len(x.c))
+	for k := range sum {
+		sum[k] = new(big.Float)
+	}
+	for m := range x.c {
+		if x.c[m].Sign() == 0 {
+			continue
+		}
+		for n := range y.c {
+			t := o.mul(&x.c[m], &y.c[n])
+			if x.bladeSign(m, n) < 0 {
+				sum[m^n] = o.sub(sum[m^n], t)
+			} else {
+				sum[m^n] = o.add(sum[m^n], t)
+			}
+		}
+	}
+	z.reset(x.p, x.q)
+	for k := range z.c {
+		roundFloat(&z.c[k], sum[k], prec)
+	}
+	o.settle(z.Cartesian()...)
+	return z
+}
+
+// Commutator sets z equal to the commutator of x and y:
+// 		Mul(x, y) - Mul(y, x)
+// Then it returns z.
+func (z *Clifford) Commutator(x, y *Clifford) *Clifford {
+	return z.Sub(
+		new(Clifford).Mul(x, y),
+		new(Clifford).Mul(y, x),
+	)
+}
+
+// gradeSign sets z equal to y with the blades of each grade k multiplied by
+// sign(k), and returns z.
+func (z *Clifford) gradeSign(y *Clifford, sign func(k int) int) *Clifford {
+	z.reset(y.p, y.q)
+	for m := range z.c {
+		if sign(bits.OnesCount(uint(m))) < 0 {
+			z.c[m].Neg(&y.c[m])
+		} else {
+			z.c[m].Copy(&y.c[m])
+		}
+	}
+	return z
+}
+
+// Reverse sets z equal to the reversion of y, which reverses the order of the
+// units in every blade, and returns z. A blade of grade k changes sign when
+// k(k-1)/2 is odd. It reverses products.
+func (z *Clifford) Reverse(y *Clifford) *Clifford {
+	return z.gradeSign(y, func(k int) int {
+		return 1 - 2*(k*(k-1)/2%2)
+	})
+}
+
+// Involute sets z equal to the grade involution of y, which negates every
+// unit, and returns z. A blade of grade k changes sign when k is odd. It
+// preserves products.
+func (z *Clifford) Involute(y *Clifford) *Clifford {
+	return z.gradeSign(y, func(k int) int {
+		return 1 - 2*(k%2)
+	})
+}
+
+// Conj sets z equal to the Clifford conjugate of y, the composition of
+// Reverse and Involute, and returns z. A blade of grade k changes sign when
+// k(k+1)/2 is odd. It reverses products, and for Cl(0, 1) and Cl(0, 2) it is
+// the conjugate of Complex and Hamilton.
+func (z *Clifford) Conj(y *Clifford) *Clifford {
+	return z.gradeSign(y, func(k int) int {
+		return 1 - 2*(k*(k+1)/2%2)
+	})
+}
+
+// Grade sets z equal to the part of y of grade k, the sum of the blades of k
+// units, and returns z. If k is negative or larger than p+q, then the result
+// is zero.
+func (z *Clifford) Grade(y *Clifford, k int) *Clifford {
+	z.reset(y.p, y.q)
+	for m := range z.c {
+		if bits.OnesCount(uint(m)) == k {
+			z.c[m].Copy(&y.c[m])
+		} else {
+			z.c[m].SetPrec(y.c[m].Prec()).SetInt64(0)
+		}
+	}
+	return z
+}
+
+// CliffordBasisTable returns the multiplication table of the blades of
+// Cl(p, q), in the order of Cartesian. If p or q is negative, then
+// CliffordBasisTable panics.
+func CliffordBasisTable(p, q int) BasisTable {
+	z := NewClifford(p, q)
+	t := make(BasisTable, len(z.c))
+	for m := range t {
+		t[m] = make([]int, len(z.c))
+		for n := range t[m] {
+			t[m][n] = z.bladeSign(m, n) * ((m ^ n) + 1)
+		}
+	}
+	return t
+}
+
+// Generate returns a random Clifford value of the signature (3, 1), the
+// algebra of spacetime, for quick.Check testing.
+func (z *Clifford) Generate(rand *rand.Rand, size int) reflect.Value {
+	randomClifford := NewClifford(3, 1)
+	for k := range randomClifford.c {
+		randomClifford.c[k].SetFloat64(rand.Float64())
+	}
+	return reflect.ValueOf(randomClifford)
+}
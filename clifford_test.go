@@ -0,0 +1,167 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+// newCliffordFrom returns the Clifford value of the signature (p, q) with the
+// components c.
+func newCliffordFrom(p, q int, c ...*big.Float) *Clifford {
+	z := NewClifford(p, q)
+	for k, v := range z.Cartesian() {
+		v.Copy(c[k])
+	}
+	return z
+}
+
+func TestCliffordHamilton(t *testing.T) {
+	f := func(x, y *Hamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a, b, c, d := x.Cartesian()
+		u := newCliffordFrom(0, 2, a, b, c, d)
+		a, b, c, d = y.Cartesian()
+		v := newCliffordFrom(0, 2, a, b, c, d)
+		a, b, c, d = new(Hamilton).Mul(x, y).Cartesian()
+		if !new(Clifford).Mul(u, v).Equals(newCliffordFrom(0, 2, a, b, c, d)) {
+			return false
+		}
+		a, b, c, d = new(Hamilton).Conj(x).Cartesian()
+		return new(Clifford).Conj(u).Equals(newCliffordFrom(0, 2, a, b, c, d))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCliffordComplexPerplex(t *testing.T) {
+	f := func(x, y *Complex, s, w *Perplex) bool {
+		// t.Logf("x = %v, y = %v, s = %v, w = %v", x, y, s, w)
+		a, b := new(Complex).Mul(x, y).Cartesian()
+		l := new(Clifford).Mul(newCliffordFrom(0, 1, &x.l, &x.r), newCliffordFrom(0, 1, &y.l, &y.r))
+		if !l.Equals(newCliffordFrom(0, 1, a, b)) {
+			return false
+		}
+		a, b = new(Perplex).Mul(s, w).Cartesian()
+		l = new(Clifford).Mul(newCliffordFrom(1, 0, &s.l, &s.r), newCliffordFrom(1, 0, &w.l, &w.r))
+		return l.Equals(newCliffordFrom(1, 0, a, b))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCliffordCockle(t *testing.T) {
+	cockle, err := NewBasisTable("Cockle")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The units i and j of Cockle are e2 and e1 of Cl(1, 1).
+	r, err := DeriveRelabeling(CliffordBasisTable(1, 1), cockle, []int{0, 2, 1, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := func(x, y *Cockle) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a, b, c, d := x.Cartesian()
+		u := newCliffordFrom(1, 1, r.Inverse().Apply([]*big.Float{a, b, c, d})...)
+		a, b, c, d = y.Cartesian()
+		v := newCliffordFrom(1, 1, r.Inverse().Apply([]*big.Float{a, b, c, d})...)
+		l := r.Apply(new(Clifford).Mul(u, v).Cartesian())
+		a, b, c, d = new(Cockle).Mul(x, y).Cartesian()
+		return NewCockle(l[0], l[1], l[2], l[3]).Equals(NewCockle(a, b, c, d))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCliffordMulAssociative(t *testing.T) {
+	f := func(x, y, z *Clifford) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		l, r := new(Clifford), new(Clifford)
+		l.Mul(l.Mul(x, y), z)
+		r.Mul(x, r.Mul(y, z))
+		d := new(Clifford).Sub(l, r)
+		for _, c := range d.Cartesian() {
+			if !closeTo(new(big.Float).Add(c, big.NewFloat(16)), big.NewFloat(16), 48) {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCliffordInvolutions(t *testing.T) {
+	f := func(x, y *Clifford) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		xy := new(Clifford).Mul(x, y)
+		// Reverse and Conj reverse products; Involute preserves them.
+		l := new(Clifford).Reverse(xy)
+		r := new(Clifford).Mul(new(Clifford).Reverse(y), new(Clifford).Reverse(x))
+		if !l.Equals(r) {
+			return false
+		}
+		l.Conj(xy)
+		r.Mul(new(Clifford).Conj(y), new(Clifford).Conj(x))
+		if !l.Equals(r) {
+			return false
+		}
+		l.Involute(xy)
+		r.Mul(new(Clifford).Involute(x), new(Clifford).Involute(y))
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCliffordGrade(t *testing.T) {
+	f := func(x *Clifford) bool {
+		// t.Logf("x = %v", x)
+		p, q := x.Signature()
+		sum := NewClifford(p, q)
+		for k := 0; k <= p+q; k++ {
+			g := new(Clifford).Grade(x, k)
+			// The grade involution is +1 on even grades and -1 on odd ones.
+			i := new(Clifford).Involute(g)
+			if k%2 == 1 {
+				i.Neg(i)
+			}
+			if !i.Equals(g) {
+				return false
+			}
+			sum.Add(sum, g)
+		}
+		return sum.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCliffordSignatureMismatch(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Mul of Cl(0, 2) and Cl(2, 0) did not panic")
+		}
+	}()
+	new(Clifford).Mul(NewClifford(0, 2), NewClifford(2, 0))
+}
+
+func TestCliffordCommutatorAlias(t *testing.T) {
+	f := func(x, y *Clifford) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		return commutatorAliased(x, y)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -54,6 +54,12 @@ func (z *Cockle) String() string {
 	return strings.Join(a, "")
 }
 
+// Format implements fmt.Formatter, with the conventions of the Format method
+// of Complex.
+func (z *Cockle) Format(s fmt.State, verb rune) {
+	formatTerms(s, verb, z, symbCockle[:], floatSlice(z.Cartesian()))
+}
+
 // Equals returns true if y and z are equal.
 func (z *Cockle) Equals(y *Cockle) bool {
 	if !z.l.Equals(&y.l) || !z.r.Equals(&y.r) {
@@ -123,20 +129,22 @@ func (z *Cockle) Sub(x, y *Cockle) *Cockle {
 // 		Mul(u, t) = -Mul(t, u) = i
 // 		Mul(u, i) = -Mul(i, u) = t
 // This binary operation is noncommutative but associative.
+// Unless the components of x or y differ widely in scale, the product is
+// computed exactly and then rounded once, so the result is exact whenever it
+// fits the precision of z; otherwise it is computed with rounded arithmetic.
 func (z *Cockle) Mul(x, y *Cockle) *Cockle {
-	a := new(Complex).Copy(&x.l)
-	b := new(Complex).Copy(&x.r)
-	c := new(Complex).Copy(&y.l)
-	d := new(Complex).Copy(&y.r)
-	temp := new(Complex)
-	z.l.Add(
-		z.l.Mul(a, c),
-		temp.Mul(temp.Conj(d), b),
-	)
-	z.r.Add(
-		z.r.Mul(d, a),
-		temp.Mul(b, temp.Conj(c)),
+	prec := maxPrec(
+		&x.l.l, &x.l.r, &x.r.l, &x.r.r,
+		&y.l.l, &y.l.r, &y.r.l, &y.r.r,
 	)
+	if fitsExact(prec,
+		[]*big.Float{&z.l.l, &z.l.r, &z.r.l, &z.r.r},
+		[]*big.Float{&x.l.l, &x.l.r, &x.r.l, &x.r.r},
+		[]*big.Float{&y.l.l, &y.l.r, &y.r.l, &y.r.r},
+	) {
+		return z.roundCockle(new(Cockle).mulExact(x, y), prec)
+	}
+	mulDoubling(1, &z.l, &z.r, &x.l, &x.r, &y.l, &y.r)
 	return z
 }
 
@@ -145,24 +153,29 @@ func (z *Cockle) Mul(x, y *Cockle) *Cockle {
 // Then it returns z.
 func (z *Cockle) Commutator(x, y *Cockle) *Cockle {
 	return z.Sub(
-		z.Mul(x, y),
+		new(Cockle).Mul(x, y),
 		new(Cockle).Mul(y, x),
 	)
 }
 
 // Quad returns the quadrance of z. If z = a+bi+ct+du, then the quadrance is
 // 		Mul(a, a) + Mul(b, b) - Mul(c, c) - Mul(d, d)
-// This can be positive, negative, or zero.
+// This can be positive, negative, or zero. The components are prescaled by a
+// power of two, so the result overflows or underflows only if the quadrance
+// is out of the exponent range of big.Float, even when the squares of the
+// components are not.
 func (z *Cockle) Quad() *big.Float {
-	return new(big.Float).Sub(
-		z.l.Quad(),
-		z.r.Quad(),
-	)
+	y, e := prescaled(&z.l.l, &z.l.r, &z.r.l, &z.r.r)
+	return rescaleQuad(new(big.Float).Sub(
+		NewComplex(y[0], y[1]).Quad(),
+		NewComplex(y[2], y[3]).Quad(),
+	), e)
 }
 
 // IsZeroDiv returns true if z is a zero divisor.
 func (z *Cockle) IsZeroDiv() bool {
-	return z.l.Quad().Cmp(z.r.Quad()) == 0
+	y, _ := prescaled(&z.l.l, &z.l.r, &z.r.l, &z.r.r)
+	return NewComplex(y[0], y[1]).Quad().Cmp(NewComplex(y[2], y[3]).Quad()) == 0
 }
 
 // Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
@@ -216,60 +229,144 @@ func (z *Cockle) QuoR(x, y *Cockle) *Cockle {
 
 // CrossRatioL sets z equal to the left cross-ratio of v, w, x, and y:
 // 		Inv(w - x) * (v - x) * Inv(v - y) * (w - y)
-// Then it returns z.
+// Then it returns z. If a denominator has no inverse, then CrossRatioL panics
+// and leaves z unchanged.
 func (z *Cockle) CrossRatioL(v, w, x, y *Cockle) *Cockle {
-	temp := new(Cockle)
-	z.Sub(w, x)
-	z.Inv(z)
-	temp.Sub(v, x)
-	z.Mul(z, temp)
-	temp.Sub(v, y)
-	temp.Inv(temp)
-	z.Mul(z, temp)
-	temp.Sub(w, y)
-	return z.Mul(z, temp)
+	if _, err := z.CrossRatioLChecked(v, w, x, y, ReportDegeneracy); err != nil {
+		panic(err.Error())
+	}
+	return z
+}
+
+// CrossRatioLChecked is like CrossRatioL, but it returns a *DegenerateError
+// instead of panicking when a denominator has no inverse, and leaves z
+// unchanged. The denominators vanish when w = x or v = y. If policy is
+// ProjectiveInfinity, then vanishing denominators with non-zero numerators set
+// z equal to the point at infinity instead.
+func (z *Cockle) CrossRatioLChecked(v, w, x, y *Cockle, policy DegeneracyPolicy) (*Cockle, error) {
+	zero := new(Cockle)
+	vx := new(Cockle).Sub(v, x)
+	wy := new(Cockle).Sub(w, y)
+	wx := new(Cockle).Sub(w, x)
+	vy := new(Cockle).Sub(v, y)
+	inf, err := checkQuotient(
+		"CrossRatioL",
+		vx.Equals(zero) || wy.Equals(zero),
+		wx.Equals(zero) || vy.Equals(zero),
+		wx.IsZeroDiv() || vy.IsZeroDiv(),
+		policy,
+	)
+	switch {
+	case err != nil:
+		return z, err
+	case inf:
+		setInf(&z.l.l, &z.l.r, &z.r.l, &z.r.r)
+		return z, nil
+	}
+	p := new(Cockle).Mul(wx.Inv(wx), vx)
+	p.Mul(p, vy.Inv(vy))
+	return z.Mul(p, wy), nil
 }
 
 // CrossRatioR sets z equal to the right cross-ratio of v, w, x, and y:
 // 		(v - x) * Inv(w - x) * (w - y) * Inv(v - y)
-// Then it returns z.
+// Then it returns z. If a denominator has no inverse, then CrossRatioR panics
+// and leaves z unchanged.
 func (z *Cockle) CrossRatioR(v, w, x, y *Cockle) *Cockle {
-	temp := new(Cockle)
-	z.Sub(v, x)
-	temp.Sub(w, x)
-	temp.Inv(temp)
-	z.Mul(z, temp)
-	temp.Sub(w, y)
-	z.Mul(z, temp)
-	temp.Sub(v, y)
-	temp.Inv(temp)
-	return z.Mul(z, temp)
+	if _, err := z.CrossRatioRChecked(v, w, x, y, ReportDegeneracy); err != nil {
+		panic(err.Error())
+	}
+	return z
+}
+
+// CrossRatioRChecked is like CrossRatioR, but it returns a *DegenerateError
+// instead of panicking when a denominator has no inverse, and leaves z
+// unchanged. The denominators vanish when w = x or v = y. If policy is
+// ProjectiveInfinity, then vanishing denominators with non-zero numerators set
+// z equal to the point at infinity instead.
+func (z *Cockle) CrossRatioRChecked(v, w, x, y *Cockle, policy DegeneracyPolicy) (*Cockle, error) {
+	zero := new(Cockle)
+	vx := new(Cockle).Sub(v, x)
+	wy := new(Cockle).Sub(w, y)
+	wx := new(Cockle).Sub(w, x)
+	vy := new(Cockle).Sub(v, y)
+	inf, err := checkQuotient(
+		"CrossRatioR",
+		vx.Equals(zero) || wy.Equals(zero),
+		wx.Equals(zero) || vy.Equals(zero),
+		wx.IsZeroDiv() || vy.IsZeroDiv(),
+		policy,
+	)
+	switch {
+	case err != nil:
+		return z, err
+	case inf:
+		setInf(&z.l.l, &z.l.r, &z.r.l, &z.r.r)
+		return z, nil
+	}
+	p := new(Cockle).Mul(vx, wx.Inv(wx))
+	p.Mul(p, wy)
+	return z.Mul(p, vy.Inv(vy)), nil
 }
 
 // MöbiusL sets z equal to the left Möbius (fractional linear) transform of y:
 // 		Inv(y*c + d) * (y*a + b)
-// Then it returns z.
+// Then it returns z. If the denominator has no inverse, then MöbiusL panics
+// and leaves z unchanged.
 func (z *Cockle) MöbiusL(y, a, b, c, d *Cockle) *Cockle {
-	z.Mul(y, a)
-	z.Add(z, b)
-	temp := new(Cockle)
-	temp.Mul(y, c)
-	temp.Add(temp, d)
-	temp.Inv(temp)
-	return z.Mul(temp, z)
+	if _, err := z.MöbiusLChecked(y, a, b, c, d, ReportDegeneracy); err != nil {
+		panic(err.Error())
+	}
+	return z
+}
+
+// MöbiusLChecked is like MöbiusL, but it returns a *DegenerateError instead of
+// panicking when the denominator has no inverse, and leaves z unchanged. If
+// policy is ProjectiveInfinity, then a zero denominator with a non-zero
+// numerator sets z equal to the point at infinity instead.
+func (z *Cockle) MöbiusLChecked(y, a, b, c, d *Cockle, policy DegeneracyPolicy) (*Cockle, error) {
+	zero := new(Cockle)
+	num := new(Cockle).Add(new(Cockle).Mul(y, a), b)
+	den := new(Cockle).Add(new(Cockle).Mul(y, c), d)
+	inf, err := checkQuotient("MöbiusL", num.Equals(zero), den.Equals(zero), den.IsZeroDiv(), policy)
+	switch {
+	case err != nil:
+		return z, err
+	case inf:
+		setInf(&z.l.l, &z.l.r, &z.r.l, &z.r.r)
+		return z, nil
+	}
+	return z.Mul(new(Cockle).Inv(den), num), nil
 }
 
 // MöbiusR sets z equal to the right Möbius (fractional linear) transform of y:
 // 		(a*y + b) * Inv(c*y + d)
-// Then it returns z.
+// Then it returns z. If the denominator has no inverse, then MöbiusR panics
+// and leaves z unchanged.
 func (z *Cockle) MöbiusR(y, a, b, c, d *Cockle) *Cockle {
-	z.Mul(a, y)
-	z.Add(z, b)
-	temp := new(Cockle)
-	temp.Mul(c, y)
-	temp.Add(temp, d)
-	temp.Inv(temp)
-	return z.Mul(z, temp)
+	if _, err := z.MöbiusRChecked(y, a, b, c, d, ReportDegeneracy); err != nil {
+		panic(err.Error())
+	}
+	return z
+}
+
+// MöbiusRChecked is like MöbiusR, but it returns a *DegenerateError instead of
+// panicking when the denominator has no inverse, and leaves z unchanged. If
+// policy is ProjectiveInfinity, then a zero denominator with a non-zero
+// numerator sets z equal to the point at infinity instead.
+func (z *Cockle) MöbiusRChecked(y, a, b, c, d *Cockle, policy DegeneracyPolicy) (*Cockle, error) {
+	zero := new(Cockle)
+	num := new(Cockle).Add(new(Cockle).Mul(a, y), b)
+	den := new(Cockle).Add(new(Cockle).Mul(c, y), d)
+	inf, err := checkQuotient("MöbiusR", num.Equals(zero), den.Equals(zero), den.IsZeroDiv(), policy)
+	switch {
+	case err != nil:
+		return z, err
+	case inf:
+		setInf(&z.l.l, &z.l.r, &z.r.l, &z.r.r)
+		return z, nil
+	}
+	return z.Mul(num, new(Cockle).Inv(den)), nil
 }
 
 // IsNilpotent returns true if z raised to the n-th power vanishes.
@@ -289,6 +386,144 @@ func (z *Cockle) IsNilpotent(n int) bool {
 	return false
 }
 
+// A CockleClass identifies the kind of polar form of a Cockle value a+v,
+// where v = bi+ct+du is the vector part, from the sign of
+// 		q = b² - c² - d²
+// and the size of a relative to √|q|.
+type CockleClass int
+
+// The seven kinds of Cockle values.
+const (
+	// NullCockle values have zero quadrance; these are the zero divisors
+	// and zero.
+	NullCockle CockleClass = iota
+	// Elliptic values satisfy q > 0, or v = 0 and a ≠ 0.
+	Elliptic
+	// HyperbolicRight values satisfy q < 0 and a > √-q.
+	HyperbolicRight
+	// HyperbolicLeft values satisfy q < 0 and a < -√-q.
+	HyperbolicLeft
+	// SpaceLike values satisfy q < 0 and |a| < √-q.
+	SpaceLike
+	// ParabolicRight values satisfy q = 0, v ≠ 0, and a > 0.
+	ParabolicRight
+	// ParabolicLeft values satisfy q = 0, v ≠ 0, and a < 0.
+	ParabolicLeft
+)
+
+// Polar returns the polar decomposition of z = a+v: the modulus ρ =
+// √|Quad(z)|, an angle, a unit axis n in the coefficients of i, t, and u, and
+// the class of z. Depending on the class, z is equal to
+// 		+ρ(cos(θ) + n sin(θ))       Elliptic, n² = -1
+// 		+ρ(cosh(φ) + n sinh(φ))     HyperbolicRight, n² = +1
+// 		-ρ(cosh(φ) + n sinh(φ))     HyperbolicLeft, n² = +1
+// 		+ρ(sinh(φ) + n cosh(φ))     SpaceLike, n² = +1
+// 		+ρ(1 + φn)                  ParabolicRight, n² = 0
+// 		-ρ(1 + φn)                  ParabolicLeft, n² = 0
+// The elliptic angle θ lies in the interval [0, π], and a real value has the
+// axis i. The parabolic axis is scaled so that its coefficient of i is one.
+// If z is a NullCockle, then ρ, the angle, and the axis are all zero. The
+// results are rounded to the precision of z.
+func (z *Cockle) Polar() (*big.Float, *big.Float, *Vec3, CockleClass) {
+	a, b, c, d := z.Cartesian()
+	prec := maxPrec(a, b, c, d)
+	w := prec + guardBits
+	q := cockleVectorQuad(b, c, d)
+	quad := exactAdd(exactMul(a, a), q)
+	rho := newFloat(prec)
+	angle := newFloat(prec)
+	axis := new(Vec3)
+	axis.x.SetPrec(prec)
+	axis.y.SetPrec(prec)
+	axis.z.SetPrec(prec)
+	if quad.Sign() == 0 {
+		return rho, angle, axis, NullCockle
+	}
+	rho.Sqrt(quad.Abs(quad))
+	// scale sets the axis to v/s.
+	scale := func(s *big.Float) {
+		axis.x.Quo(b, s)
+		axis.y.Quo(c, s)
+		axis.z.Quo(d, s)
+	}
+	var class CockleClass
+	switch {
+	case q.Sign() > 0:
+		theta := newFloat(w).Sqrt(q)
+		scale(theta)
+		angle.Set(bigAtan2(theta, a, w))
+		class = Elliptic
+	case q.Sign() < 0:
+		theta := newFloat(w).Sqrt(q.Neg(q))
+		scale(theta)
+		switch new(big.Float).Abs(a).Cmp(theta) {
+		case 1:
+			angle.Set(bigAtanhQuo(theta, a, w))
+			class = HyperbolicRight
+			if a.Sign() < 0 {
+				class = HyperbolicLeft
+			}
+		default:
+			angle.Set(bigAtanhQuo(a, theta, w))
+			class = SpaceLike
+		}
+	case b.Sign() != 0:
+		abs := new(big.Float).Abs(b)
+		scale(abs)
+		angle.Quo(abs, a)
+		class = ParabolicRight
+		if a.Sign() < 0 {
+			class = ParabolicLeft
+		}
+	default:
+		axis.x.SetInt64(1)
+		if a.Sign() < 0 {
+			angle.Set(bigPi(prec))
+		}
+		class = Elliptic
+	}
+	return rho, angle, axis, class
+}
+
+// ToMatrix returns the 2x2 real matrix of z under the isomorphism between the
+// Cockle quaternions and M₂(ℝ). If z = a+bi+ct+du, then the matrix is
+// 		[a + c    d - b]
+// 		[b + d    a - c]
+// so that Mul corresponds to the matrix product, Conj to the adjugate, and
+// Quad to the determinant. The entries are rounded to the precision of z.
+func (z *Cockle) ToMatrix() [2][2]*big.Float {
+	a, b, c, d := z.Cartesian()
+	prec := maxPrec(a, b, c, d)
+	return [2][2]*big.Float{
+		{newFloat(prec).Add(a, c), newFloat(prec).Sub(d, b)},
+		{newFloat(prec).Add(b, d), newFloat(prec).Sub(a, c)},
+	}
+}
+
+// FromMatrix sets z equal to the Cockle value of the 2x2 real matrix m, the
+// inverse of ToMatrix, and returns z. The components are rounded to the
+// largest precision of the entries of m.
+func (z *Cockle) FromMatrix(m [2][2]*big.Float) *Cockle {
+	prec := maxPrec(m[0][0], m[0][1], m[1][0], m[1][1])
+	// half returns (x ± y)/2 rounded to prec bits.
+	half := func(x *big.Float, sign int, y *big.Float) *big.Float {
+		t := exactAdd(x, y)
+		if sign < 0 {
+			t = exactSub(x, y)
+		}
+		return newFloat(prec).SetMantExp(t, -1)
+	}
+	a := half(m[0][0], 1, m[1][1])
+	c := half(m[0][0], -1, m[1][1])
+	b := half(m[1][0], -1, m[0][1])
+	d := half(m[1][0], 1, m[0][1])
+	z.l.l.SetPrec(prec).Set(a)
+	z.l.r.SetPrec(prec).Set(b)
+	z.r.l.SetPrec(prec).Set(c)
+	z.r.r.SetPrec(prec).Set(d)
+	return z
+}
+
 // Generate returns a random Cockle value for quick.Check testing.
 func (z *Cockle) Generate(rand *rand.Rand, size int) reflect.Value {
 	randomCockle := &Cockle{
@@ -331,3 +331,151 @@ func XTestCockleComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Polar form
+
+// fromCocklePolar rebuilds a Cockle value from its polar decomposition.
+func fromCocklePolar(rho, angle *big.Float, n *Vec3, class CockleClass, prec uint) *Cockle {
+	var s, v *big.Float
+	sign := 1
+	switch class {
+	case Elliptic:
+		v, s = bigSinCos(angle, prec)
+	case HyperbolicRight, HyperbolicLeft:
+		v, s = bigSinhCosh(angle, prec)
+	case SpaceLike:
+		s, v = bigSinhCosh(angle, prec)
+	case ParabolicRight, ParabolicLeft:
+		s, v = newFloat(prec).SetInt64(1), newFloat(prec).Set(angle)
+	default:
+		return new(Cockle)
+	}
+	if class == HyperbolicLeft || class == ParabolicLeft {
+		sign = -1
+	}
+	r := newFloat(prec).Mul(rho, big.NewFloat(float64(sign)))
+	nx, ny, nz := n.Cartesian()
+	p := func(x, y *big.Float) *big.Float {
+		return newFloat(prec).Mul(newFloat(prec).Mul(r, x), y)
+	}
+	return NewCockle(newFloat(prec).Mul(r, s), p(v, nx), p(v, ny), p(v, nz))
+}
+
+func TestCocklePolarRoundTrip(t *testing.T) {
+	f := func(x *Cockle) bool {
+		// t.Logf("x = %v", x)
+		ok := true
+		for _, shift := range []float64{-2, 0, 2} {
+			y := setPrecCockle(x, 200)
+			y.l.l.Add(&y.l.l, big.NewFloat(shift))
+			rho, angle, n, class := y.Polar()
+			z := fromCocklePolar(rho, angle, n, class, 200)
+			d := new(Complex).Sub(&z.l, &y.l).Quad()
+			d.Add(d, new(Complex).Sub(&z.r, &y.r).Quad())
+			ok = ok && class != NullCockle && (d.Sign() == 0 || expo(d) < -360)
+		}
+		return ok
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCocklePolarClass(t *testing.T) {
+	cases := []struct {
+		a, b, c, d float64
+		class      CockleClass
+	}{
+		{1, 2, 1, 1, Elliptic},
+		{-3, 0, 0, 0, Elliptic},
+		{3, 0, 1, 2, HyperbolicRight},
+		{-3, 0, 1, 2, HyperbolicLeft},
+		{1, 0, 1, 2, SpaceLike},
+		{2, 5, 3, 4, ParabolicRight},
+		{-2, 5, 3, 4, ParabolicLeft},
+		{5, 0, 3, 4, NullCockle},
+	}
+	for _, c := range cases {
+		z := NewCockle(big.NewFloat(c.a), big.NewFloat(c.b), big.NewFloat(c.c), big.NewFloat(c.d))
+		if _, _, _, class := z.Polar(); class != c.class {
+			t.Errorf("class of %v = %v, want %v", z, class, c.class)
+		}
+	}
+	rho, angle, _, _ := NewCockle(big.NewFloat(-3), new(big.Float), new(big.Float), new(big.Float)).Polar()
+	if r, _ := rho.Float64(); r != 3 || !closeTo(angle, bigPi(53), 50) {
+		t.Errorf("Polar(-3) = %v, %v", rho, angle)
+	}
+}
+
+// Matrix representation
+
+// mulMatrix2 returns the product of the 2x2 matrices m and n.
+func mulMatrix2(m, n [2][2]*big.Float) [2][2]*big.Float {
+	var p [2][2]*big.Float
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			p[i][j] = new(big.Float).Add(
+				new(big.Float).Mul(m[i][0], n[0][j]),
+				new(big.Float).Mul(m[i][1], n[1][j]),
+			)
+		}
+	}
+	return p
+}
+
+func TestCockleMatrixMul(t *testing.T) {
+	f := func(x, y *Cockle) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		x, y = setPrecCockle(x, 128), setPrecCockle(y, 128)
+		l := new(Cockle).Mul(x, y).ToMatrix()
+		r := mulMatrix2(x.ToMatrix(), y.ToMatrix())
+		for i := 0; i < 2; i++ {
+			for j := 0; j < 2; j++ {
+				if l[i][j].Cmp(r[i][j]) != 0 {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCockleMatrixDeterminant(t *testing.T) {
+	f := func(x *Cockle) bool {
+		// t.Logf("x = %v", x)
+		x = setPrecCockle(x, 128)
+		m := x.ToMatrix()
+		det := new(big.Float).Sub(
+			new(big.Float).Mul(m[0][0], m[1][1]),
+			new(big.Float).Mul(m[0][1], m[1][0]),
+		)
+		return det.Cmp(x.Quad()) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCockleMatrixRoundTrip(t *testing.T) {
+	f := func(x *Cockle) bool {
+		// t.Logf("x = %v", x)
+		x = setPrecCockle(x, 128)
+		return new(Cockle).FromMatrix(x.ToMatrix()).Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCockleCommutatorAlias(t *testing.T) {
+	f := func(x, y *Cockle) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		return commutatorAliased(x, y)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,315 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
+)
+
+// A floatCodec is a binary encoding of the values of this package, in the
+// layout shared by GobEncode and MarshalBinary: a version byte, the number of
+// dimensions and each dimension as uvarints, and the number of components as
+// a uvarint followed by each component in the form of the codec.
+type floatCodec struct {
+	// method is the name of the decoding method, for errors.
+	method  string
+	version byte
+	// append appends the encoding of x to buf.
+	append func(buf []byte, x *big.Float) ([]byte, error)
+	// next decodes the component at the start of data, and returns it with
+	// the rest of data.
+	next func(data []byte) (*big.Float, []byte, error)
+}
+
+// errTruncated is the error of a component cut short by the end of the data.
+var errTruncated = errors.New("truncated")
+
+// fail returns the error of the decoding method of c for the type typ.
+func (c floatCodec) fail(typ, msg string, err error) error {
+	if err != nil {
+		return fmt.Errorf("bigfloat: %s.%s: %s: %w", typ, c.method, msg, err)
+	}
+	return fmt.Errorf("bigfloat: %s.%s: %s", typ, c.method, msg)
+}
+
+// encode returns the encoding of a value with the dimensions dims and the
+// components x.
+func (c floatCodec) encode(dims []int, x []*big.Float) ([]byte, error) {
+	buf := []byte{c.version}
+	buf = binary.AppendUvarint(buf, uint64(len(dims)))
+	for _, d := range dims {
+		buf = binary.AppendUvarint(buf, uint64(d))
+	}
+	buf = binary.AppendUvarint(buf, uint64(len(x)))
+	for _, v := range x {
+		var err error
+		if buf, err = c.append(buf, v); err != nil {
+			return nil, err
+		}
+	}
+	return buf, nil
+}
+
+// decode returns the ndims dimensions and the components decoded from data,
+// the encoding of a value of the type typ. Every dimension is at most the
+// number of components.
+func (c floatCodec) decode(typ string, data []byte, ndims int) ([]int, []*big.Float, error) {
+	if len(data) == 0 || data[0] != c.version {
+		return nil, nil, c.fail(typ, "unknown encoding version", nil)
+	}
+	data = data[1:]
+	next := func() (int, bool) {
+		u, n := binary.Uvarint(data)
+		if n <= 0 || u > uint64(len(data)) {
+			return 0, false
+		}
+		data = data[n:]
+		return int(u), true
+	}
+	if n, ok := next(); !ok || n != ndims {
+		return nil, nil, c.fail(typ, "wrong number of dimensions", nil)
+	}
+	dims := make([]int, ndims)
+	for k := range dims {
+		d, ok := next()
+		if !ok {
+			return nil, nil, c.fail(typ, "malformed dimension", nil)
+		}
+		dims[k] = d
+	}
+	n, ok := next()
+	if !ok {
+		return nil, nil, c.fail(typ, "malformed number of components", nil)
+	}
+	x := make([]*big.Float, n)
+	for k := range x {
+		var err error
+		if x[k], data, err = c.next(data); err != nil {
+			return nil, nil, c.fail(typ, fmt.Sprintf("malformed component %d", k), err)
+		}
+	}
+	if len(data) != 0 {
+		return nil, nil, c.fail(typ, "trailing data", nil)
+	}
+	for _, d := range dims {
+		if d > n {
+			return nil, nil, c.fail(typ, "dimension out of range", nil)
+		}
+	}
+	return dims, x, nil
+}
+
+// decodeFloats sets the components x equal to those decoded from data, the
+// encoding of a value of the type typ without dimensions. If data is
+// malformed or has a different number of components, then decodeFloats
+// returns an error and leaves x unchanged.
+func (c floatCodec) decodeFloats(typ string, data []byte, x []*big.Float) error {
+	_, v, err := c.decode(typ, data, 0)
+	if err != nil {
+		return err
+	}
+	if len(v) != len(x) {
+		return c.fail(typ, fmt.Sprintf("want %d components, got %d", len(x), len(v)), nil)
+	}
+	for k := range x {
+		x[k].Copy(v[k])
+	}
+	return nil
+}
+
+// decode sets z equal to the value decoded from data with c, and returns nil,
+// or returns an error and leaves z unchanged.
+func (z *Jet) decode(c floatCodec, data []byte) error {
+	_, x, err := c.decode("Jet", data, 0)
+	if err != nil {
+		return err
+	}
+	if len(x) == 0 {
+		return c.fail("Jet", "no components", nil)
+	}
+	z.reset(len(x) - 1)
+	for k := range x {
+		z.c[k].Copy(x[k])
+	}
+	return nil
+}
+
+// decode sets z equal to the value decoded from data with c, and returns nil,
+// or returns an error and leaves z unchanged.
+func (z *Clifford) decode(c floatCodec, data []byte) error {
+	dims, x, err := c.decode("Clifford", data, 2)
+	if err != nil {
+		return err
+	}
+	p, q := dims[0], dims[1]
+	if p+q >= bits.UintSize-1 || len(x) != 1<<uint(p+q) {
+		return c.fail("Clifford", "number of components does not match the signature", nil)
+	}
+	z.reset(p, q)
+	for k := range x {
+		z.c[k].Copy(x[k])
+	}
+	return nil
+}
+
+// decode sets z equal to the value decoded from data with c, and returns nil,
+// or returns an error and leaves z unchanged.
+func (z *Grassmann) decode(c floatCodec, data []byte) error {
+	_, x, err := c.decode("Grassmann", data, 0)
+	if err != nil {
+		return err
+	}
+	if len(x) == 0 || len(x)&(len(x)-1) != 0 {
+		return c.fail("Grassmann", "want a power of two components", nil)
+	}
+	z.reset(bits.TrailingZeros(uint(len(x))))
+	for k := range x {
+		z.c[k].Copy(x[k])
+	}
+	return nil
+}
+
+// floats returns the real and imaginary parts of the components of z, in the
+// order of Cartesian.
+func (z *Biquaternion) floats() []*big.Float {
+	return []*big.Float{&z.w.l, &z.w.r, &z.x.l, &z.x.r, &z.y.l, &z.y.r, &z.z.l, &z.z.r}
+}
+
+// floats returns the real and imaginary parts of the coefficients of p, in
+// increasing order of degree.
+func (p *ComplexPoly) floats() []*big.Float {
+	x := make([]*big.Float, 0, 2*len(p.c))
+	for k := range p.c {
+		x = append(x, &p.c[k].l, &p.c[k].r)
+	}
+	return x
+}
+
+// decode sets p equal to the value decoded from data with c, and returns nil,
+// or returns an error and leaves p unchanged.
+func (p *ComplexPoly) decode(c floatCodec, data []byte) error {
+	_, x, err := c.decode("ComplexPoly", data, 0)
+	if err != nil {
+		return err
+	}
+	if len(x)%2 != 0 {
+		return c.fail("ComplexPoly", "odd number of components", nil)
+	}
+	coef := make([]Complex, len(x)/2)
+	for k := range coef {
+		coef[k].l.Copy(x[2*k])
+		coef[k].r.Copy(x[2*k+1])
+	}
+	p.c = coef
+	p.trim()
+	return nil
+}
+
+// floats returns the components of the entries of m, in row-major order.
+func (m *HamiltonMatrix) floats() []*big.Float {
+	x := make([]*big.Float, 0, 4*len(m.e))
+	for k := range m.e {
+		x = append(x, floatSlice(m.e[k].Cartesian())...)
+	}
+	return x
+}
+
+// decode sets m equal to the value decoded from data with c, and returns nil,
+// or returns an error and leaves m unchanged.
+func (m *HamiltonMatrix) decode(c floatCodec, data []byte) error {
+	dims, x, err := c.decode("HamiltonMatrix", data, 2)
+	if err != nil {
+		return err
+	}
+	if 4*dims[0]*dims[1] != len(x) {
+		return c.fail("HamiltonMatrix", "number of components does not match the dimensions", nil)
+	}
+	n := NewHamiltonMatrix(dims[0], dims[1])
+	for k := range n.e {
+		for j, v := range floatSlice(n.e[k].Cartesian()) {
+			v.Copy(x[4*k+j])
+		}
+	}
+	*m = *n
+	return nil
+}
+
+// floats returns the components of the coordinates x and y of z, in turn.
+func (z *ProjectiveComplex) floats() []*big.Float {
+	return append(floatSlice(z.x.Cartesian()), floatSlice(z.y.Cartesian())...)
+}
+
+// decode sets z equal to the value decoded from data with c, and returns nil,
+// or returns an error and leaves z unchanged.
+func (z *ProjectiveComplex) decode(c floatCodec, data []byte) error {
+	y := new(ProjectiveComplex)
+	if err := c.decodeFloats("ProjectiveComplex", data, y.floats()); err != nil {
+		return err
+	}
+	if zero := new(Complex); y.x.Equals(zero) && y.y.Equals(zero) {
+		return c.fail("ProjectiveComplex", "both coordinates are zero", nil)
+	}
+	z.Copy(y)
+	return nil
+}
+
+// floats returns the components of the coordinates x and y of z, in turn.
+func (z *ProjectiveHamilton) floats() []*big.Float {
+	return append(floatSlice(z.x.Cartesian()), floatSlice(z.y.Cartesian())...)
+}
+
+// decode sets z equal to the value decoded from data with c, and returns nil,
+// or returns an error and leaves z unchanged.
+func (z *ProjectiveHamilton) decode(c floatCodec, data []byte) error {
+	y := new(ProjectiveHamilton)
+	if err := c.decodeFloats("ProjectiveHamilton", data, y.floats()); err != nil {
+		return err
+	}
+	if zero := new(Hamilton); y.x.Equals(zero) && y.y.Equals(zero) {
+		return c.fail("ProjectiveHamilton", "both coordinates are zero", nil)
+	}
+	z.Copy(y)
+	return nil
+}
+
+// floats returns the components of the coordinates x and y of z, in turn.
+func (z *ProjectiveCockle) floats() []*big.Float {
+	return append(floatSlice(z.x.Cartesian()), floatSlice(z.y.Cartesian())...)
+}
+
+// decode sets z equal to the value decoded from data with c, and returns nil,
+// or returns an error and leaves z unchanged.
+func (z *ProjectiveCockle) decode(c floatCodec, data []byte) error {
+	y := new(ProjectiveCockle)
+	if err := c.decodeFloats("ProjectiveCockle", data, y.floats()); err != nil {
+		return err
+	}
+	if zero := new(Cockle); y.x.Equals(zero) && y.y.Equals(zero) {
+		return c.fail("ProjectiveCockle", "both coordinates are zero", nil)
+	}
+	z.Copy(y)
+	return nil
+}
+
+// floats returns the components of the coefficients a, b, c, and d of z.
+func (z *MöbiusComplex) floats() []*big.Float {
+	var x []*big.Float
+	for _, v := range []*Complex{&z.a, &z.b, &z.c, &z.d} {
+		x = append(x, floatSlice(v.Cartesian())...)
+	}
+	return x
+}
+
+// floats returns the components of the coefficients a, b, c, and d of z.
+func (z *MöbiusHamilton) floats() []*big.Float {
+	var x []*big.Float
+	for _, v := range []*Hamilton{&z.a, &z.b, &z.c, &z.d} {
+		x = append(x, floatSlice(v.Cartesian())...)
+	}
+	return x
+}
@@ -44,6 +44,15 @@ func (z *Complex) String() string {
 	return strings.Join(a, "")
 }
 
+// Format implements fmt.Formatter. Each component is written with the verb
+// and the flags, width, and precision, in the format of String, so that %.3f
+// writes "(1.000+2.000i)" for 1 + 2i. The verbs are those of big.Float: 'v',
+// 'b', 'e', 'E', 'f', 'F', 'g', 'G', 'p', and 'x', and 's' is the same as
+// 'v'. Components after the first always have a sign.
+func (z *Complex) Format(s fmt.State, verb rune) {
+	formatTerms(s, verb, z, []string{"", "i"}, floatSlice(z.Cartesian()))
+}
+
 // Equals returns true if y and z are equal.
 func (z *Complex) Equals(y *Complex) bool {
 	if z.l.Cmp(&y.l) != 0 || z.r.Cmp(&y.r) != 0 {
@@ -107,30 +116,46 @@ func (z *Complex) Sub(x, y *Complex) *Complex {
 // The multiplication rule is:
 // 		Mul(i, i) = -1
 // This binary operation is commutative and associative.
+// Unless the components of x or y differ widely in scale, the product is
+// computed exactly and then rounded once, so the result is exact whenever it
+// fits the precision of z; otherwise it is computed with rounded arithmetic.
 func (z *Complex) Mul(x, y *Complex) *Complex {
+	prec := maxPrec(&x.l, &x.r, &y.l, &y.r)
+	if fitsExact(prec,
+		[]*big.Float{&z.l, &z.r},
+		[]*big.Float{&x.l, &x.r},
+		[]*big.Float{&y.l, &y.r},
+	) {
+		return z.roundComplex(new(Complex).mulExact(x, y), prec)
+	}
 	a := new(big.Float).Copy(&x.l)
 	b := new(big.Float).Copy(&x.r)
 	c := new(big.Float).Copy(&y.l)
 	d := new(big.Float).Copy(&y.r)
 	temp := new(big.Float)
-	z.l.Sub(
-		z.l.Mul(a, c),
-		temp.Mul(d, b),
-	)
-	z.r.Add(
-		z.r.Mul(d, a),
-		temp.Mul(b, c),
-	)
+	z.l.Mul(a, c)
+	exact := isExact(&z.l, temp.Mul(d, b))
+	z.l.Sub(&z.l, temp)
+	z.r.Mul(d, a)
+	temp.Mul(b, c)
+	exact = exact && isExact(&z.r, temp)
+	z.r.Add(&z.r, temp)
+	if !exact {
+		markRounded(&z.l, &z.r)
+	}
 	return z
 }
 
 // Quad returns the quadrance of z, a pointer to a big.Float value.
+// The components are prescaled by a power of two, so the result overflows or
+// underflows only if the quadrance is out of the exponent range of big.Float.
 func (z *Complex) Quad() *big.Float {
+	y, e := prescaled(&z.l, &z.r)
 	quad := new(big.Float)
-	return quad.Add(
-		quad.Mul(&z.l, &z.l),
-		new(big.Float).Mul(&z.r, &z.r),
-	)
+	return rescaleQuad(quad.Add(
+		quad.Mul(y[0], y[0]),
+		new(big.Float).Mul(y[1], y[1]),
+	), e)
 }
 
 // Inv sets z equal to the inverse of y, and returns z.
@@ -162,35 +187,75 @@ func (z *Complex) Quo(x, y *Complex) *Complex {
 
 // CrossRatio sets z equal to the cross ratio
 // 		Inv(w - x) * (v - x) * Inv(v - y) * (w - y)
-// Then it returns z.
+// Then it returns z. If a denominator has no inverse, then CrossRatio panics
+// and leaves z unchanged.
 func (z *Complex) CrossRatio(v, w, x, y *Complex) *Complex {
-	temp := new(Complex)
-	z.Sub(w, x)
-	z.Inv(z)
-	temp.Sub(v, x)
-	z.Mul(z, temp)
-	temp.Sub(v, y)
-	temp.Inv(temp)
-	z.Mul(z, temp)
-	temp.Sub(w, y)
-	z.Mul(z, temp)
+	if _, err := z.CrossRatioChecked(v, w, x, y, ReportDegeneracy); err != nil {
+		panic(err.Error())
+	}
 	return z
 }
 
+// CrossRatioChecked is like CrossRatio, but it returns a *DegenerateError
+// instead of panicking when a denominator has no inverse, and leaves z
+// unchanged. The denominators vanish when w = x or v = y. If policy is
+// ProjectiveInfinity, then vanishing denominators with non-zero numerators set
+// z equal to the point at infinity instead.
+func (z *Complex) CrossRatioChecked(v, w, x, y *Complex, policy DegeneracyPolicy) (*Complex, error) {
+	zero := new(Complex)
+	vx := new(Complex).Sub(v, x)
+	wy := new(Complex).Sub(w, y)
+	wx := new(Complex).Sub(w, x)
+	vy := new(Complex).Sub(v, y)
+	inf, err := checkQuotient(
+		"CrossRatio",
+		vx.Equals(zero) || wy.Equals(zero),
+		wx.Equals(zero) || vy.Equals(zero),
+		false,
+		policy,
+	)
+	switch {
+	case err != nil:
+		return z, err
+	case inf:
+		setInf(&z.l, &z.r)
+		return z, nil
+	}
+	p := new(Complex).Mul(wx.Inv(wx), vx)
+	p.Mul(p, vy.Inv(vy))
+	return z.Mul(p, wy), nil
+}
+
 // Möbius sets z equal to the Möbius (fractional linear) transform
 // 		(a*y + b) * Inv(c*y + d)
-// Then it returns z.
+// Then it returns z. If the denominator has no inverse, then Möbius panics
+// and leaves z unchanged.
 func (z *Complex) Möbius(y, a, b, c, d *Complex) *Complex {
-	z.Mul(a, y)
-	z.Add(z, b)
-	temp := new(Complex)
-	temp.Mul(c, y)
-	temp.Add(temp, d)
-	temp.Inv(temp)
-	z.Mul(z, temp)
+	if _, err := z.MöbiusChecked(y, a, b, c, d, ReportDegeneracy); err != nil {
+		panic(err.Error())
+	}
 	return z
 }
 
+// MöbiusChecked is like Möbius, but it returns a *DegenerateError instead of
+// panicking when the denominator has no inverse, and leaves z unchanged. If
+// policy is ProjectiveInfinity, then a zero denominator with a non-zero
+// numerator sets z equal to the point at infinity instead.
+func (z *Complex) MöbiusChecked(y, a, b, c, d *Complex, policy DegeneracyPolicy) (*Complex, error) {
+	zero := new(Complex)
+	num := new(Complex).Add(new(Complex).Mul(a, y), b)
+	den := new(Complex).Add(new(Complex).Mul(c, y), d)
+	inf, err := checkQuotient("Möbius", num.Equals(zero), den.Equals(zero), false, policy)
+	switch {
+	case err != nil:
+		return z, err
+	case inf:
+		setInf(&z.l, &z.r)
+		return z, nil
+	}
+	return z.Mul(num, new(Complex).Inv(den)), nil
+}
+
 // Generate returns a random Complex value for quick.Check testing.
 func (z *Complex) Generate(rand *rand.Rand, size int) reflect.Value {
 	randomComplex := &Complex{
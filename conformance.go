@@ -0,0 +1,482 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// A ConformanceVector is one input/output pair of the conformance suite: the
+// operation Op of the type named Type, applied to Args, whose components are
+// dyadic rationals of Prec bits, gives Want. The components are in the order
+// of the Cartesian method of the type (for Zorn, a, u, v, b).
+type ConformanceVector struct {
+	Type string
+	Op   string
+	Prec uint
+	Args [][]*big.Float
+	Want []*big.Float
+	// Tol bounds the difference between each component of a conforming
+	// result and of Want. It is zero for Add, Sub, Mul, Neg, and Conj, whose
+	// results are correctly rounded to Prec bits, and is the error this
+	// package allows itself for Quad and Inv, which round several times.
+	Tol *big.Float
+}
+
+// ConformanceOps lists the operations of the conformance suite. Add, Sub, and
+// Mul take two arguments; the others take one. Quad has a single component.
+var ConformanceOps = []string{"Add", "Sub", "Mul", "Neg", "Conj", "Quad", "Inv"}
+
+// ConformancePrecs lists the precisions of the conformance suite: those of
+// binary32, binary64, and binary128, and one beyond.
+var ConformancePrecs = []uint{24, 53, 113, 200}
+
+// conformer is the arithmetic surface that the conformance suite exercises.
+type conformer[T any] interface {
+	*T
+	Add(x, y *T) *T
+	Sub(x, y *T) *T
+	Mul(x, y *T) *T
+	Neg(y *T) *T
+	Conj(y *T) *T
+	Inv(y *T) *T
+	Quad() *big.Float
+}
+
+// A conformanceType evaluates the operations of one type on components.
+type conformanceType struct {
+	name string
+	dim  int
+	eval func(op string, prec uint, args [][]*big.Float) []*big.Float
+}
+
+// newConformanceType returns the conformanceType of T, whose components in
+// Cartesian order are given by comps.
+func newConformanceType[T any, P conformer[T]](name string, dim int, comps func(*T) []*big.Float) conformanceType {
+	load := func(c []*big.Float, prec uint) P {
+		z := new(T)
+		for k, v := range comps(z) {
+			v.SetPrec(prec).Set(c[k])
+		}
+		return z
+	}
+	eval := func(op string, prec uint, args [][]*big.Float) []*big.Float {
+		x := load(args[0], prec)
+		var y P
+		if len(args) > 1 {
+			y = load(args[1], prec)
+		}
+		z := P(new(T))
+		switch op {
+		case "Add":
+			z.Add(x, y)
+		case "Sub":
+			z.Sub(x, y)
+		case "Mul":
+			z.Mul(x, y)
+		case "Neg":
+			z.Neg(x)
+		case "Conj":
+			z.Conj(x)
+		case "Quad":
+			return []*big.Float{x.Quad()}
+		case "Inv":
+			z.Inv(x)
+		}
+		return comps(z)
+	}
+	return conformanceType{name, dim, eval}
+}
+
+// conformanceTypes lists the types of the conformance suite, in order.
+var conformanceTypes = []conformanceType{
+	newConformanceType[Complex]("Complex", 2, func(z *Complex) []*big.Float {
+		a, b := z.Cartesian()
+		return []*big.Float{a, b}
+	}),
+	newConformanceType[Perplex]("Perplex", 2, func(z *Perplex) []*big.Float {
+		a, b := z.Cartesian()
+		return []*big.Float{a, b}
+	}),
+	newConformanceType[Infra]("Infra", 2, func(z *Infra) []*big.Float {
+		a, b := z.Cartesian()
+		return []*big.Float{a, b}
+	}),
+	newConformanceType[Hamilton]("Hamilton", 4, func(z *Hamilton) []*big.Float {
+		a, b, c, d := z.Cartesian()
+		return []*big.Float{a, b, c, d}
+	}),
+	newConformanceType[Cockle]("Cockle", 4, func(z *Cockle) []*big.Float {
+		a, b, c, d := z.Cartesian()
+		return []*big.Float{a, b, c, d}
+	}),
+	newConformanceType[Supra]("Supra", 4, func(z *Supra) []*big.Float {
+		a, b, c, d := z.Cartesian()
+		return []*big.Float{a, b, c, d}
+	}),
+	newConformanceType[InfraComplex]("InfraComplex", 4, func(z *InfraComplex) []*big.Float {
+		a, b, c, d := z.Cartesian()
+		return []*big.Float{a, b, c, d}
+	}),
+	newConformanceType[InfraPerplex]("InfraPerplex", 4, func(z *InfraPerplex) []*big.Float {
+		a, b, c, d := z.Cartesian()
+		return []*big.Float{a, b, c, d}
+	}),
+	newConformanceType[Macfarlane]("Macfarlane", 4, func(z *Macfarlane) []*big.Float {
+		a, b, c, d := z.Cartesian()
+		return []*big.Float{a, b, c, d}
+	}),
+	newConformanceType[DualComplex]("DualComplex", 4, func(z *DualComplex) []*big.Float {
+		a, b, c, d := z.Cartesian()
+		return []*big.Float{a, b, c, d}
+	}),
+	newConformanceType[Cayley]("Cayley", 8, func(z *Cayley) []*big.Float {
+		a, b, c, d, e, f, g, h := z.Cartesian()
+		return []*big.Float{a, b, c, d, e, f, g, h}
+	}),
+	newConformanceType[Zorn]("Zorn", 8, func(z *Zorn) []*big.Float {
+		return z.components()
+	}),
+	newConformanceType[SupraComplex]("SupraComplex", 8, func(z *SupraComplex) []*big.Float {
+		a, b, c, d, e, f, g, h := z.Cartesian()
+		return []*big.Float{a, b, c, d, e, f, g, h}
+	}),
+	newConformanceType[InfraHamilton]("InfraHamilton", 8, func(z *InfraHamilton) []*big.Float {
+		a, b, c, d, e, f, g, h := z.Cartesian()
+		return []*big.Float{a, b, c, d, e, f, g, h}
+	}),
+	newConformanceType[InfraCockle]("InfraCockle", 8, func(z *InfraCockle) []*big.Float {
+		a, b, c, d, e, f, g, h := z.Cartesian()
+		return []*big.Float{a, b, c, d, e, f, g, h}
+	}),
+	newConformanceType[Ultra]("Ultra", 8, func(z *Ultra) []*big.Float {
+		a, b, c, d, e, f, g, h := z.Cartesian()
+		return []*big.Float{a, b, c, d, e, f, g, h}
+	}),
+	newConformanceType[DualHamilton]("DualHamilton", 8, func(z *DualHamilton) []*big.Float {
+		a, b, c, d, e, f, g, h := z.Cartesian()
+		return []*big.Float{a, b, c, d, e, f, g, h}
+	}),
+	newConformanceType[Sedenion]("Sedenion", 16, func(z *Sedenion) []*big.Float {
+		c := z.Cartesian()
+		return c[:]
+	}),
+	newConformanceType[SupraHamilton]("SupraHamilton", 16, func(z *SupraHamilton) []*big.Float {
+		c := z.Cartesian()
+		return c[:]
+	}),
+	newConformanceType[SupraCockle]("SupraCockle", 16, func(z *SupraCockle) []*big.Float {
+		c := z.Cartesian()
+		return c[:]
+	}),
+}
+
+// ConformanceTypes returns the names of the types of the conformance suite.
+func ConformanceTypes() []string {
+	names := make([]string, len(conformanceTypes))
+	for k, t := range conformanceTypes {
+		names[k] = t.name
+	}
+	return names
+}
+
+// lookupConformanceType returns the conformanceType named name, or false.
+func lookupConformanceType(name string) (conformanceType, bool) {
+	for _, t := range conformanceTypes {
+		if t.name == name {
+			return t, true
+		}
+	}
+	return conformanceType{}, false
+}
+
+// conformanceArity returns the number of arguments of op, or 0 if op is not
+// an operation of the conformance suite.
+func conformanceArity(op string) int {
+	switch op {
+	case "Add", "Sub", "Mul":
+		return 2
+	case "Neg", "Conj", "Quad", "Inv":
+		return 1
+	}
+	return 0
+}
+
+// EvalConformance evaluates the operation op of the type named typ on args
+// with this package, and returns the components of the result. It is the
+// reference implementation of the conformance suite. The components of args
+// are rounded to prec bits, which is also the precision of the result.
+func EvalConformance(typ, op string, prec uint, args [][]*big.Float) (result []*big.Float, err error) {
+	t, ok := lookupConformanceType(typ)
+	if !ok {
+		return nil, fmt.Errorf("bigfloat: unknown conformance type %q", typ)
+	}
+	n := conformanceArity(op)
+	if n == 0 {
+		return nil, fmt.Errorf("bigfloat: unknown conformance operation %q", op)
+	}
+	if len(args) != n {
+		return nil, fmt.Errorf("bigfloat: %s.%s takes %d arguments, not %d", typ, op, n, len(args))
+	}
+	for _, a := range args {
+		if len(a) != t.dim {
+			return nil, fmt.Errorf("bigfloat: %s has %d components, not %d", typ, t.dim, len(a))
+		}
+	}
+	if prec == 0 || prec > big.MaxPrec {
+		return nil, fmt.Errorf("bigfloat: bad conformance precision %d", prec)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			result, err = nil, fmt.Errorf("bigfloat: %s.%s: %v", typ, op, r)
+		}
+	}()
+	return t.eval(op, prec, args), nil
+}
+
+// splitMix is the SplitMix64 generator, which draws the arguments of the
+// conformance suite independently of math/rand.
+type splitMix uint64
+
+// next returns the next 64 random bits.
+func (s *splitMix) next() uint64 {
+	*s += 0x9e3779b97f4a7c15
+	z := uint64(*s)
+	z = (z ^ z>>30) * 0xbf58476d1ce4e5b9
+	z = (z ^ z>>27) * 0x94d049bb133111eb
+	return z ^ z>>31
+}
+
+// float returns a random dyadic rational of prec bits, of either sign, with
+// magnitude in [1/16, 8).
+func (s *splitMix) float(prec uint) *big.Float {
+	m := new(big.Int)
+	for i := uint(0); i < prec; i += 64 {
+		m.Lsh(m, 64)
+		m.Or(m, new(big.Int).SetUint64(s.next()))
+	}
+	m.Rsh(m, uint(m.BitLen())-prec)
+	m.SetBit(m, int(prec)-1, 1)
+	bits := s.next()
+	x := newFloat(prec).SetInt(m)
+	x.SetMantExp(x, int(bits%7)-3-int(prec))
+	if bits&(1<<32) != 0 {
+		x.Neg(x)
+	}
+	return x
+}
+
+// conformanceTol returns the allowance of a vector of t for op with arguments
+// args: zero for the correctly rounded operations, and otherwise a generous
+// bound on the rounding errors of Quad and of Inv as Conj(y)/Quad(y). With s
+// the sum of the squares of the components of y, m the largest of their
+// magnitudes, n their number, and q = Quad(y), these are
+// 		Quad: n s 2^(1-prec)
+// 		Inv: n m s 2^(2-prec) / q²
+func conformanceTol(t conformanceType, op string, prec uint, args [][]*big.Float) *big.Float {
+	tol := newFloat(64)
+	if op != "Quad" && op != "Inv" {
+		return tol
+	}
+	s, m := newFloat(64), newFloat(64)
+	for _, v := range args[0] {
+		s.Add(s, newFloat(64).Mul(v, v))
+		if a := new(big.Float).Abs(v); a.Cmp(m) > 0 {
+			m.Set(a)
+		}
+	}
+	tol.Mul(newFloat(64).SetInt64(int64(t.dim)), s)
+	if op == "Quad" {
+		return tol.SetMantExp(tol, 1-int(prec))
+	}
+	q := t.eval("Quad", prec, args)[0]
+	tol.Mul(tol, m)
+	tol.Quo(tol, newFloat(64).Mul(q, q))
+	return tol.SetMantExp(tol, 2-int(prec))
+}
+
+// ConformanceVectors returns the conformance suite: two vectors for every
+// operation of ConformanceOps, every type of ConformanceTypes, and every
+// precision of ConformancePrecs, with arguments drawn from a fixed
+// pseudorandom sequence and results computed by EvalConformance. The suite is
+// the same on every call and every platform.
+func ConformanceVectors() []ConformanceVector {
+	var vs []ConformanceVector
+	s := splitMix(0)
+	for _, t := range conformanceTypes {
+		for _, op := range ConformanceOps {
+			for _, prec := range ConformancePrecs {
+				for i := 0; i < 2; i++ {
+					args := make([][]*big.Float, conformanceArity(op))
+					for k := range args {
+						args[k] = make([]*big.Float, t.dim)
+						for j := range args[k] {
+							args[k][j] = s.float(prec)
+						}
+					}
+					want, err := EvalConformance(t.name, op, prec, args)
+					if err != nil {
+						panic(err)
+					}
+					vs = append(vs, ConformanceVector{t.name, op, prec, args, want, conformanceTol(t, op, prec, args)})
+				}
+			}
+		}
+	}
+	return vs
+}
+
+// A ConformanceImpl evaluates the operation op of the type named typ on args,
+// whose components have prec bits, and returns the components of the result,
+// as EvalConformance does.
+type ConformanceImpl func(typ, op string, prec uint, args [][]*big.Float) ([]*big.Float, error)
+
+// A ConformanceFailure is a vector of the conformance suite that an
+// implementation does not reproduce, with its result or error.
+type ConformanceFailure struct {
+	Vector ConformanceVector
+	Got    []*big.Float
+	Err    error
+}
+
+// CheckConformance evaluates every vector of vs with impl, and returns the
+// vectors for which impl returns an error, the wrong number of components, or
+// a component that differs from the wanted one by more than the tolerance of
+// the vector.
+func CheckConformance(vs []ConformanceVector, impl ConformanceImpl) []ConformanceFailure {
+	var fails []ConformanceFailure
+	for _, v := range vs {
+		got, err := impl(v.Type, v.Op, v.Prec, v.Args)
+		if err == nil && !conforms(got, v.Want, v.Tol) {
+			err = fmt.Errorf("bigfloat: %s.%s at %d bits: wrong result", v.Type, v.Op, v.Prec)
+		}
+		if err != nil {
+			fails = append(fails, ConformanceFailure{v, got, err})
+		}
+	}
+	return fails
+}
+
+// conforms returns true if got and want have the same length, and their
+// components differ by at most tol.
+func conforms(got, want []*big.Float, tol *big.Float) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for k, w := range want {
+		if got[k] == nil || got[k].IsInf() != w.IsInf() {
+			return false
+		}
+		if w.IsInf() {
+			if got[k].Cmp(w) != 0 {
+				return false
+			}
+			continue
+		}
+		d := exactSub(got[k], w)
+		if d.Abs(d).Cmp(tol) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// WriteConformance writes the vectors vs to w in a line-oriented text format
+// that ports to other languages can read. Each line holds the type, the
+// operation, the precision, and the tolerance, then each argument after a ":"
+// and the result after a "=", as space-separated components in the 'p' format
+// of big.Float, such as -0x.8p+1 for -1:
+// 		Complex Mul 24 0 : 0x.cp+1 0x.8p+0 : 0x.8p+1 -0x.8p+1 = 0x.8p+2 -0x.8p+1
+func WriteConformance(w io.Writer, vs []ConformanceVector) error {
+	bw := bufio.NewWriter(w)
+	for _, v := range vs {
+		fmt.Fprintf(bw, "%s %s %d %s", v.Type, v.Op, v.Prec, v.Tol.Text('p', 0))
+		for _, a := range v.Args {
+			bw.WriteString(" :")
+			writeConformanceFloats(bw, a)
+		}
+		bw.WriteString(" =")
+		writeConformanceFloats(bw, v.Want)
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
+
+// writeConformanceFloats writes each of x to w, preceded by a space.
+func writeConformanceFloats(w *bufio.Writer, x []*big.Float) {
+	for _, v := range x {
+		w.WriteByte(' ')
+		w.WriteString(v.Text('p', 0))
+	}
+}
+
+// ReadConformance reads vectors written by WriteConformance from r. Blank
+// lines and lines starting with "#" are ignored.
+func ReadConformance(r io.Reader) ([]ConformanceVector, error) {
+	var vs []ConformanceVector
+	sc := bufio.NewScanner(r)
+	sc.Buffer(nil, 1<<20)
+	for line := 1; sc.Scan(); line++ {
+		text := strings.TrimSpace(sc.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		v, err := parseConformance(text)
+		if err != nil {
+			return nil, fmt.Errorf("bigfloat: conformance line %d: %v", line, err)
+		}
+		vs = append(vs, v)
+	}
+	return vs, sc.Err()
+}
+
+// parseConformance parses one line written by WriteConformance.
+func parseConformance(text string) (ConformanceVector, error) {
+	var v ConformanceVector
+	eq := strings.Split(text, " = ")
+	if len(eq) != 2 {
+		return v, fmt.Errorf("want one %q", "=")
+	}
+	parts := strings.Split(eq[0], " : ")
+	head := strings.Fields(parts[0])
+	if len(head) != 4 {
+		return v, fmt.Errorf("want type, operation, precision, and tolerance")
+	}
+	prec, err := strconv.ParseUint(head[2], 10, 32)
+	if err != nil || prec == 0 || prec > big.MaxPrec {
+		return v, fmt.Errorf("bad precision %q", head[2])
+	}
+	v.Type, v.Op, v.Prec = head[0], head[1], uint(prec)
+	if v.Tol, _, err = big.ParseFloat(head[3], 0, 64, big.ToNearestEven); err != nil {
+		return v, err
+	}
+	for _, p := range parts[1:] {
+		a, err := parseConformanceFloats(p, v.Prec)
+		if err != nil {
+			return v, err
+		}
+		v.Args = append(v.Args, a)
+	}
+	v.Want, err = parseConformanceFloats(eq[1], v.Prec)
+	return v, err
+}
+
+// parseConformanceFloats parses the space-separated components in text at
+// prec bits.
+func parseConformanceFloats(text string, prec uint) ([]*big.Float, error) {
+	var x []*big.Float
+	for _, f := range strings.Fields(text) {
+		v, _, err := big.ParseFloat(f, 0, prec, big.ToNearestEven)
+		if err != nil {
+			return nil, err
+		}
+		x = append(x, v)
+	}
+	return x, nil
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+func TestConformanceVectors(t *testing.T) {
+	vs := ConformanceVectors()
+	if n := len(ConformanceTypes()) * len(ConformanceOps) * len(ConformancePrecs) * 2; len(vs) != n {
+		t.Fatalf("len(ConformanceVectors()) = %d, want %d", len(vs), n)
+	}
+	if fails := CheckConformance(vs, EvalConformance); len(fails) != 0 {
+		t.Errorf("%d failures, first %v", len(fails), fails[0].Err)
+	}
+	var buf bytes.Buffer
+	if err := WriteConformance(&buf, vs); err != nil {
+		t.Fatal(err)
+	}
+	// The suite is published data, so any change to it must be deliberate.
+	sum := fmt.Sprintf("%x", sha256.Sum256(buf.Bytes()))
+	if want := "7b9a2c5fb49cd56382e22a6e6569a948916846e5f418779af6152582ee790453"; sum != want {
+		t.Errorf("digest of ConformanceVectors() = %s, want %s", sum, want)
+	}
+	read, err := ReadConformance(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fails := CheckConformance(read, EvalConformance); len(read) != len(vs) || len(fails) != 0 {
+		t.Errorf("ReadConformance: %d vectors, %d failures", len(read), len(fails))
+	}
+	for k, v := range read {
+		if !conforms(v.Want, vs[k].Want, new(big.Float)) || v.Tol.Cmp(vs[k].Tol) != 0 {
+			t.Fatalf("ReadConformance: vector %d = %+v, want %+v", k, v, vs[k])
+		}
+	}
+}
+
+func TestCheckConformance(t *testing.T) {
+	vs := ConformanceVectors()
+	// Rounding the arguments to float64 fails the vectors at 113 and 200 bits,
+	// except for Neg and Conj, and for Quad and Inv, whose tolerance is
+	// measured at the precision of the vector, it fails those too.
+	float64Impl := func(typ, op string, prec uint, args [][]*big.Float) ([]*big.Float, error) {
+		if prec > 53 {
+			prec = 53
+		}
+		return EvalConformance(typ, op, prec, args)
+	}
+	fails := CheckConformance(vs, float64Impl)
+	if len(fails) == 0 {
+		t.Fatal("CheckConformance(float64) reported no failures")
+	}
+	for _, f := range fails {
+		if f.Vector.Prec <= 53 || f.Err == nil {
+			t.Errorf("unexpected failure %v", f.Err)
+		}
+	}
+	unknown := func(typ, op string, prec uint, args [][]*big.Float) ([]*big.Float, error) {
+		return EvalConformance("Unknown", op, prec, args)
+	}
+	if fails := CheckConformance(vs[:3], unknown); len(fails) != 3 {
+		t.Errorf("CheckConformance(unknown) = %d failures, want 3", len(fails))
+	}
+}
+
+func TestEvalConformanceInvZeroDivisor(t *testing.T) {
+	one, zero := big.NewFloat(1), new(big.Float)
+	_, err := EvalConformance("Perplex", "Inv", 53, [][]*big.Float{{one, one}})
+	if err == nil {
+		t.Error("Inv(1+s) did not fail")
+	}
+	got, err := EvalConformance("Complex", "Mul", 53, [][]*big.Float{{zero, one}, {zero, one}})
+	if err != nil || got[0].Cmp(big.NewFloat(-1)) != 0 || got[1].Sign() != 0 {
+		t.Errorf("Mul(i, i) = %v, %v", got, err)
+	}
+}
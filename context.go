@@ -0,0 +1,435 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math/big"
+
+// A Context bundles the precision and the rounding mode of a computation. Its
+// methods take values of any type of this package that has the operation,
+// such as *Hamilton or *Jet, and round every component of the result the same
+// way, whatever the precisions of z and of the operands:
+// 		ctx := Context{Prec: 200, Mode: big.ToZero}
+// 		ctx.Mul(z, x, y)
+// Each component of the result is the exact result rounded once to Prec bits
+// with Mode, so it depends only on the values of the operands and on the
+// context, which makes long computations reproducible. If Prec is zero, then
+// 64 bits are used, as for BigFloatField. The zero Mode is
+// big.ToNearestEven. Audit reports the operations carried out with a
+// context.
+type Context struct {
+	Prec uint
+	Mode big.RoundingMode
+
+	audit *auditor
+}
+
+// A Value is a pointer to a value of one of the types of this package, such
+// as *Complex or *HamiltonMatrix.
+type Value interface {
+	Flatten() []*big.Float
+}
+
+// prec returns the precision of c.
+func (c Context) prec() uint {
+	if c.Prec == 0 {
+		return defaultPrec
+	}
+	return c.Prec
+}
+
+// contextual is the surface that a Context needs of a type: a way to copy its
+// values and to reach their components.
+type contextual[T any] interface {
+	*T
+	Copy(y *T) *T
+	Flatten() []*big.Float
+	Unflatten(x []*big.Float) *T
+}
+
+// contextRound sets every component of r to its value rounded once to the
+// precision of c with its mode, and returns r.
+func contextRound[T any, P contextual[T]](c Context, r P) P {
+	f := r.Flatten()
+	for _, v := range f {
+		v.SetMode(c.Mode).SetPrec(c.prec())
+	}
+	return P(r.Unflatten(f))
+}
+
+// contextWiden returns a copy of x with every component at w bits, which is
+// exact for w at least the precisions of the components.
+func contextWiden[T any, P contextual[T]](w uint, x P) P {
+	f := x.Flatten()
+	for _, v := range f {
+		v.SetPrec(w)
+	}
+	return P(P(new(T)).Copy((*T)(x))).Unflatten(f)
+}
+
+// contextExactPrec returns a precision at which the ring operations of the
+// types of this package are exact on the operands x: twice the span of their
+// components, from the leading bit of the largest to the trailing bit of the
+// smallest, for the products, and a word more for the carries of the sums.
+func contextExactPrec[T any, P contextual[T]](x ...P) uint {
+	var hi, lsb int
+	var prec uint
+	found := false
+	for _, p := range x {
+		for _, v := range p.Flatten() {
+			if q := v.Prec(); q > prec {
+				prec = q
+			}
+			if v.Sign() == 0 || v.IsInf() {
+				continue
+			}
+			e := expo(v)
+			l := e - int(v.MinPrec())
+			if !found {
+				hi, lsb, found = e, l, true
+				continue
+			}
+			if e > hi {
+				hi = e
+			}
+			if l < lsb {
+				lsb = l
+			}
+		}
+	}
+	w := 2*uint(hi-lsb) + 64
+	if prec > w {
+		w = prec
+	}
+	return w
+}
+
+// apply sets z equal to the result of the operation op on the operands x
+// rounded by c. If z is not of a type of this package, then apply panics.
+func (c Context) apply(op string, z Value, x ...Value) {
+	switch z := z.(type) {
+	case *Complex:
+		contextApply(c, op, z, x)
+	case *Perplex:
+		contextApply(c, op, z, x)
+	case *Infra:
+		contextApply(c, op, z, x)
+	case *Hamilton:
+		contextApply(c, op, z, x)
+	case *Cockle:
+		contextApply(c, op, z, x)
+	case *DualComplex:
+		contextApply(c, op, z, x)
+	case *InfraComplex:
+		contextApply(c, op, z, x)
+	case *InfraPerplex:
+		contextApply(c, op, z, x)
+	case *Supra:
+		contextApply(c, op, z, x)
+	case *Macfarlane:
+		contextApply(c, op, z, x)
+	case *Cayley:
+		contextApply(c, op, z, x)
+	case *DualHamilton:
+		contextApply(c, op, z, x)
+	case *InfraCockle:
+		contextApply(c, op, z, x)
+	case *InfraHamilton:
+		contextApply(c, op, z, x)
+	case *SupraComplex:
+		contextApply(c, op, z, x)
+	case *Ultra:
+		contextApply(c, op, z, x)
+	case *Sedenion:
+		contextApply(c, op, z, x)
+	case *SupraCockle:
+		contextApply(c, op, z, x)
+	case *SupraHamilton:
+		contextApply(c, op, z, x)
+	case *Vec3:
+		contextApply(c, op, z, x)
+	case *Jet:
+		contextApply(c, op, z, x)
+	case *Clifford:
+		contextApply(c, op, z, x)
+	case *Grassmann:
+		contextApply(c, op, z, x)
+	case *Biquaternion:
+		contextApply(c, op, z, x)
+	case *Zorn:
+		contextApply(c, op, z, x)
+	case *ComplexPoly:
+		contextApply(c, op, z, x)
+	case *HamiltonMatrix:
+		contextApply(c, op, z, x)
+	case *ProjectiveComplex:
+		contextApply(c, op, z, x)
+	case *ProjectiveHamilton:
+		contextApply(c, op, z, x)
+	case *ProjectiveCockle:
+		contextApply(c, op, z, x)
+	case *MöbiusComplex:
+		contextApply(c, op, z, x)
+	case *MöbiusHamilton:
+		contextApply(c, op, z, x)
+	default:
+		panic("unsupported type")
+	}
+}
+
+// contextApply sets z equal to the result of the operation op on the operands
+// x rounded by c. If an operand is not of the type of z, or the type has no
+// such operation, then contextApply panics.
+func contextApply[T any, P contextual[T]](c Context, op string, z P, x []Value) {
+	y := make([]P, len(x))
+	for k, v := range x {
+		p, ok := v.(P)
+		if !ok {
+			panic("mismatched operand types")
+		}
+		y[k] = p
+	}
+	var r P
+	var w, cond uint
+	switch op {
+	case "Quo", "Inv":
+		r, w, cond = contextQuo(c, op, y)
+	default:
+		r, w = contextExact(op, y)
+		contextRound(c, r)
+	}
+	if c.audit != nil {
+		c.audit.record(op, w, r.Flatten(), cond)
+	}
+	z.Copy((*T)(r))
+}
+
+// contextExact returns the result of the ring operation op on the operands x,
+// carried out exactly, and the precision used. The products of two
+// ComplexPoly values with fftThreshold or more coefficients are the one
+// exception, since the fast Fourier transform is not exact.
+func contextExact[T any, P contextual[T]](op string, x []P) (P, uint) {
+	w := contextExactPrec(x...)
+	a := make([]*T, len(x))
+	for k, p := range x {
+		a[k] = (*T)(contextWiden(w, p))
+	}
+	r := P(new(T))
+	ok := true
+	switch op {
+	case "Set":
+		r.Copy(a[0])
+	case "Add":
+		var m interface{ Add(x, y *T) *T }
+		if m, ok = any(r).(interface{ Add(x, y *T) *T }); ok {
+			m.Add(a[0], a[1])
+		}
+	case "Sub":
+		var m interface{ Sub(x, y *T) *T }
+		if m, ok = any(r).(interface{ Sub(x, y *T) *T }); ok {
+			m.Sub(a[0], a[1])
+		}
+	case "Mul":
+		var m interface{ Mul(x, y *T) *T }
+		if m, ok = any(r).(interface{ Mul(x, y *T) *T }); ok {
+			m.Mul(a[0], a[1])
+		}
+	case "Neg":
+		var m interface{ Neg(y *T) *T }
+		if m, ok = any(r).(interface{ Neg(y *T) *T }); ok {
+			m.Neg(a[0])
+		}
+	case "Conj":
+		var m interface{ Conj(y *T) *T }
+		if m, ok = any(r).(interface{ Conj(y *T) *T }); ok {
+			m.Conj(a[0])
+		}
+	}
+	if !ok {
+		panic(op + " not defined for the type")
+	}
+	return r, w
+}
+
+// contextQuo returns the quotient x[0]/x[1], for Quo, or the inverse of x[0],
+// for Inv, rounded by c, with the precision used and the base-2 logarithm of
+// the condition number of the divisor. The inverse of the divisor is written
+// exactly as a numerator over a real denominator, so that each component is
+// a single correctly rounded division.
+func contextQuo[T any, P contextual[T]](c Context, op string, x []P) (P, uint, uint) {
+	y := x[len(x)-1]
+	r := P(new(T))
+	quo, qok := any(r).(interface{ Quo(x, y *T) *T })
+	inv, iok := any(r).(interface{ Inv(y *T) *T })
+	if !iok || op == "Quo" && !qok {
+		panic(op + " not defined for the type")
+	}
+	w := contextExactPrec(y)
+	num, den, gross := contextInvParts(contextWiden(w, y))
+	if den.Sign() == 0 {
+		// The method of the type panics with its own message.
+		if op == "Quo" {
+			quo.Quo((*T)(x[0]), (*T)(y))
+		} else {
+			inv.Inv((*T)(y))
+		}
+		panic("inverse of zero divisor")
+	}
+	if op == "Quo" {
+		num, w = contextExact("Mul", []P{x[0], num})
+	}
+	f := num.Flatten()
+	for k, v := range f {
+		f[k] = newFloat(c.prec()).SetMode(c.Mode).Quo(v, den)
+	}
+	var cond uint
+	if e := expo(gross) - expo(new(big.Float).Abs(den)); e > 0 {
+		cond = uint(e)
+	}
+	return P(num.Unflatten(f)), w, cond
+}
+
+// contextInvParts returns a numerator n and a real denominator d of the
+// inverse n/d of y, computed exactly from y, whose components are at a
+// precision at which its ring operations are exact, and a value g of the
+// degree of d that is the same polynomial in the magnitudes of the
+// components of y with no cancellation. The ratio g/d measures how close y
+// is to a zero divisor.
+func contextInvParts[T any, P contextual[T]](y P) (n P, d, g *big.Float) {
+	sq := sumSquares(y.Flatten())
+	switch y := any(y).(type) {
+	case *Jet:
+		// The inverse has the components c_n/a0^(n+1), with c_0 = 1 and
+		// c_n = -(a1c(n-1) + a2c(n-2)a0 + ... + anc0a0^(n-1)), brought to
+		// the common denominator a0^(k+1).
+		k := y.Order()
+		a := y.Cartesian()
+		pow := make([]*big.Float, k+2)
+		pow[0] = big.NewFloat(1)
+		for j := 1; j <= k+1; j++ {
+			pow[j] = exactMul(pow[j-1], a[0])
+		}
+		cn := make([]*big.Float, k+1)
+		cn[0] = big.NewFloat(1)
+		for m := 1; m <= k; m++ {
+			sum := new(big.Float)
+			for j := 1; j <= m; j++ {
+				sum = exactSub(sum, exactMul(exactMul(a[j], cn[m-j]), pow[j-1]))
+			}
+			cn[m] = sum
+		}
+		inv := NewJet(k)
+		for m := range cn {
+			inv.c[m].Set(exactMul(cn[m], pow[k-m]))
+		}
+		max := new(big.Float)
+		for _, v := range a {
+			if abs := new(big.Float).Abs(v); abs.Cmp(max) > 0 {
+				max = abs
+			}
+		}
+		g := big.NewFloat(1)
+		for j := 0; j <= k; j++ {
+			g = exactMul(g, max)
+		}
+		return any(inv).(P), pow[k+1], g
+	case *DualHamilton:
+		// If y = p + εq, then the inverse is
+		// 		(Conj(p)|p|^2 - εConj(p)qConj(p))/|p|^4
+		p := new(Hamilton).Conj(&y.l)
+		pp := &new(Hamilton).mulExact(p, &y.l).l.l
+		inv := new(DualHamilton)
+		inv.l.mulExact(p, NewHamilton(pp, new(big.Float), new(big.Float), new(big.Float)))
+		inv.r.mulExact(p, &y.r)
+		inv.r.mulExact(&inv.r, p)
+		inv.r.Neg(&inv.r)
+		return any(inv).(P), exactMul(pp, pp), exactMul(sq, sq)
+	case *Biquaternion:
+		// If q is the quadrance of y, then the inverse is
+		// Conj(y)Conj(q)/|q|^2.
+		q := new(Complex)
+		for _, v := range []*Complex{&y.w, &y.x, &y.y, &y.z} {
+			q.addExact(q, new(Complex).mulExact(v, v))
+		}
+		qc := new(Complex).Conj(q)
+		inv := new(Biquaternion).Conj(y)
+		for _, v := range []*Complex{&inv.w, &inv.x, &inv.y, &inv.z} {
+			v.mulExact(v, qc)
+		}
+		qq := exactAdd(exactMul(&q.l, &q.l), exactMul(&q.r, &q.r))
+		return any(inv).(P), qq, exactMul(sq, sq)
+	}
+	m, ok := any(y).(interface {
+		Conj(y *T) *T
+		Quad() *big.Float
+	})
+	if !ok {
+		panic("inverse not defined for the type")
+	}
+	// The inverse of the other types is Conj(y)/Quad(y).
+	return P(m.Conj((*T)(y))), m.Quad(), sq
+}
+
+// sumSquares returns the sum of the squares of x, computed exactly.
+func sumSquares(x []*big.Float) *big.Float {
+	sum := new(big.Float)
+	for _, v := range x {
+		sum = exactAdd(sum, exactMul(v, v))
+	}
+	return sum
+}
+
+// Set sets z equal to y rounded by c. It is the way to bring a value into a
+// context, and works for every type of this package.
+func (c Context) Set(z, y Value) {
+	c.apply("Set", z, y)
+}
+
+// Add sets z equal to x+y rounded by c. The sum is computed exactly and each
+// component is rounded once to the precision of c with its mode. The working
+// precision, and with it the time and memory taken, grows with the spread of
+// the exponents of the components of x and y. If the operands are not of the
+// type of z, or the type has no Add method, then Add panics.
+func (c Context) Add(z, x, y Value) {
+	c.apply("Add", z, x, y)
+}
+
+// Sub sets z equal to x-y rounded by c, with the conventions of Add.
+func (c Context) Sub(z, x, y Value) {
+	c.apply("Sub", z, x, y)
+}
+
+// Mul sets z equal to the product of x and y rounded by c, with the
+// conventions of Add. The product of two ComplexPoly values with many
+// coefficients, which is computed with the fast Fourier transform, is
+// rounded more than once.
+func (c Context) Mul(z, x, y Value) {
+	c.apply("Mul", z, x, y)
+}
+
+// Neg sets z equal to -y rounded by c, with the conventions of Add.
+func (c Context) Neg(z, y Value) {
+	c.apply("Neg", z, y)
+}
+
+// Conj sets z equal to the conjugate of y rounded by c, with the conventions
+// of Add.
+func (c Context) Conj(z, y Value) {
+	c.apply("Conj", z, y)
+}
+
+// Quo sets z equal to the quotient of x and y rounded by c, with the
+// conventions of Add. The inverse of y is written exactly as a numerator over
+// a real denominator, such as Conj(y) over Quad(y), so each component of the
+// result is the exact quotient rounded once, for every mode. Of the types of
+// this package, only Complex, Perplex, Infra, and Jet have a Quo method.
+func (c Context) Quo(z, x, y Value) {
+	c.apply("Quo", z, x, y)
+}
+
+// Inv sets z equal to the inverse of y rounded by c, with the conventions of
+// Quo. The panics of the Inv method of the type, such as for a zero divisor,
+// are passed on.
+func (c Context) Inv(z, y Value) {
+	c.apply("Inv", z, y)
+}
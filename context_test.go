@@ -0,0 +1,184 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestContextPrecision(t *testing.T) {
+	f := func(x, y *Hamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		ctx := Context{Prec: 100, Mode: big.ToZero}
+		z := new(Hamilton)
+		ctx.Mul(z, x, y)
+		for _, v := range z.Flatten() {
+			if v.Prec() != 100 || v.Mode() != big.ToZero {
+				return false
+			}
+		}
+		// The 53-bit products are exact at 100 bits.
+		want := new(Hamilton).setPrec(x, 200)
+		want.Mul(want, new(Hamilton).setPrec(y, 200))
+		return closeToHamilton(z, want, 95)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestContextIndependentOfReceiver(t *testing.T) {
+	third := new(big.Float).SetPrec(300).Quo(big.NewFloat(1), big.NewFloat(3))
+	x := NewComplex(third, big.NewFloat(1))
+	y := NewComplex(big.NewFloat(3), third)
+	ctx := Context{Prec: 80}
+	a := NewComplex(new(big.Float).SetPrec(10), new(big.Float).SetPrec(1000))
+	b := new(Complex)
+	ctx.Mul(a, x, y)
+	ctx.Mul(b, x, y)
+	if !a.Equals(b) || a.l.Prec() != 80 || a.r.Prec() != 80 {
+		t.Errorf("Mul = %v and %v, want equal values at 80 bits", a, b)
+	}
+	// z may alias an operand.
+	ctx.Mul(x, x, y)
+	if !x.Equals(b) {
+		t.Errorf("aliased Mul = %v, want %v", x, b)
+	}
+}
+
+func TestContextModes(t *testing.T) {
+	third := new(big.Float).SetPrec(200).Quo(big.NewFloat(1), big.NewFloat(3))
+	y := NewJetVariable(third, 2)
+	down, up := new(Jet), new(Jet)
+	Context{Prec: 24, Mode: big.ToNegativeInf}.Set(down, y)
+	Context{Prec: 24, Mode: big.ToPositiveInf}.Set(up, y)
+	if down.Order() != 2 || down.c[0].Cmp(third) >= 0 || up.c[0].Cmp(third) <= 0 {
+		t.Errorf("Set = %v and %v, want bounds of %v", down, up, third)
+	}
+	z := new(Complex)
+	Context{}.Quo(z, NewComplex(big.NewFloat(1), new(big.Float)), NewComplex(big.NewFloat(3), new(big.Float)))
+	if z.l.Prec() != 64 {
+		t.Errorf("default precision %d, want 64", z.l.Prec())
+	}
+}
+
+func TestContextSingleRounding(t *testing.T) {
+	// The product 1 - 2⁻¹²⁰ rounds to 1 at any precision below 120 bits, so
+	// a product rounded to the nearest before it is rounded toward zero
+	// gives 1.
+	eps := new(big.Float).SetMantExp(big.NewFloat(1), -60)
+	one := big.NewFloat(1)
+	x := NewComplex(newFloat(64).Add(one, eps), new(big.Float))
+	y := NewComplex(newFloat(64).Sub(one, eps), new(big.Float))
+	z := new(Complex)
+	Context{Prec: 24, Mode: big.ToZero}.Mul(z, x, y)
+	want := big.NewFloat(1 - 0x1p-24)
+	if z.l.Cmp(want) != 0 {
+		t.Errorf("ToZero product = %v, want %v", &z.l, want)
+	}
+	Context{Prec: 24, Mode: big.AwayFromZero}.Mul(z, x, y)
+	if z.l.Cmp(big.NewFloat(1)) != 0 {
+		t.Errorf("AwayFromZero product = %v, want 1", &z.l)
+	}
+	// The quotient 1/3 is bounded by the directed modes.
+	third := new(big.Float).SetPrec(200).Quo(big.NewFloat(1), big.NewFloat(3))
+	three := NewComplex(big.NewFloat(3), new(big.Float))
+	down, up := new(Complex), new(Complex)
+	Context{Prec: 24, Mode: big.ToNegativeInf}.Inv(down, three)
+	Context{Prec: 24, Mode: big.ToPositiveInf}.Inv(up, three)
+	if down.l.Cmp(third) >= 0 || up.l.Cmp(third) <= 0 || new(big.Float).Sub(&up.l, &down.l).Cmp(big.NewFloat(0x1p-25)) != 0 {
+		t.Errorf("Inv = %v and %v, want adjacent bounds of %v", down, up, third)
+	}
+}
+
+// checkDirected checks that the components of down and up are the components
+// of want rounded to 24 bits toward -Inf and +Inf.
+func checkDirected(t *testing.T, name string, down, up, want Value) {
+	d, u, w := down.Flatten(), up.Flatten(), want.Flatten()
+	for k := range w {
+		lo := newFloat(24).SetMode(big.ToNegativeInf).Set(w[k])
+		hi := newFloat(24).SetMode(big.ToPositiveInf).Set(w[k])
+		if d[k].Cmp(lo) != 0 || u[k].Cmp(hi) != 0 {
+			t.Errorf("%s component %d = %v and %v, want %v and %v", name, k, d[k], u[k], lo, hi)
+		}
+	}
+}
+
+func TestContextDirectedQuo(t *testing.T) {
+	down := Context{Prec: 24, Mode: big.ToNegativeInf}
+	up := Context{Prec: 24, Mode: big.ToPositiveInf}
+	f := func(v ...float64) []*big.Float {
+		x := make([]*big.Float, len(v))
+		for k := range v {
+			x[k] = big.NewFloat(v[k])
+		}
+		return x
+	}
+	// The references are computed by the types at 2000 bits, far beyond the
+	// bits that decide the rounding of these inverses.
+	x := new(Complex).Unflatten(f(1, 2))
+	y := new(Complex).Unflatten(f(3, 5))
+	zd, zu := new(Complex), new(Complex)
+	down.Quo(zd, x, y)
+	up.Quo(zu, x, y)
+	want := new(Complex).Quo(contextWiden(2000, x), contextWiden(2000, y))
+	checkDirected(t, "Complex Quo", zd, zu, want)
+	j := NewJetVariable(big.NewFloat(3), 3)
+	jd, ju := new(Jet), new(Jet)
+	down.Inv(jd, j)
+	up.Inv(ju, j)
+	checkDirected(t, "Jet Inv", jd, ju, new(Jet).Inv(contextWiden(2000, j)))
+	dh := new(DualHamilton).Unflatten(f(1, 2, 3, 4, 1, 1, 1, 1))
+	dd, du := new(DualHamilton), new(DualHamilton)
+	down.Inv(dd, dh)
+	up.Inv(du, dh)
+	checkDirected(t, "DualHamilton Inv", dd, du, new(DualHamilton).Inv(contextWiden(2000, dh)))
+	b := new(Biquaternion).Unflatten(f(1, 1, 2, 0, 0, 1, 1, 3))
+	bd, bu := new(Biquaternion), new(Biquaternion)
+	down.Inv(bd, b)
+	up.Inv(bu, b)
+	checkDirected(t, "Biquaternion Inv", bd, bu, new(Biquaternion).Inv(contextWiden(2000, b)))
+	zo := new(Zorn).Unflatten(f(1, 2, 3, 4, 5, 6, 7, 8))
+	od, ou := new(Zorn), new(Zorn)
+	down.Inv(od, zo)
+	up.Inv(ou, zo)
+	checkDirected(t, "Zorn Inv", od, ou, new(Zorn).Inv(contextWiden(2000, zo)))
+}
+
+func TestContextPanics(t *testing.T) {
+	ctx := Context{Prec: 53}
+	defer func() {
+		if r := recover(); r != "zero inverse" {
+			t.Errorf("recovered %v, want %q", r, "zero inverse")
+		}
+	}()
+	ctx.Inv(new(Complex), new(Complex))
+}
+
+func TestContextShapes(t *testing.T) {
+	one, two := big.NewFloat(1), big.NewFloat(2)
+	ctx := Context{Prec: 30}
+	c := NewClifford(1, 1)
+	c.c[1].SetInt64(3)
+	d := new(Clifford)
+	ctx.Mul(d, c, c)
+	if want := new(Clifford).Mul(c, c); !d.Equals(want) || d.c[0].Prec() != 30 {
+		t.Errorf("Clifford Mul = %v, want %v", d, want)
+	}
+	m := NewHamiltonMatrix(2, 1)
+	m.At(1, 0).Copy(NewHamilton(one, two, one, two))
+	n := new(HamiltonMatrix)
+	ctx.Mul(n, m, NewHamiltonMatrix(1, 3))
+	if n.rows != 2 || n.cols != 3 || n.At(1, 2).l.l.Prec() != 30 {
+		t.Errorf("HamiltonMatrix Mul = %v", n)
+	}
+	p := NewComplexPoly(NewComplex(one, two), NewComplex(two, one))
+	q := new(ComplexPoly)
+	ctx.Mul(q, p, p)
+	if want := new(ComplexPoly).Mul(p, p); q.String() != want.String() {
+		t.Errorf("ComplexPoly Mul = %v, want %v", q, want)
+	}
+}
@@ -0,0 +1,409 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math/big"
+
+// ContinuedFraction returns at most n partial quotients of the simple
+// continued fraction expansion [a0; a1, a2, ...] of x.
+//
+// Only the partial quotients that are determined by the precision of x are
+// returned: the expansion is carried out on the interval of real numbers that
+// round to x, and stops as soon as the endpoints of that interval disagree. If
+// x is a terminating expansion, then the expansion ends with its last partial
+// quotient. If x is infinite, then ContinuedFraction panics.
+func ContinuedFraction(x *big.Float, n int) []*big.Int {
+	if x.IsInf() {
+		panic("continued fraction of infinity")
+	}
+	mid, lo, hi := roundingInterval(x)
+	return expandInterval(mid, lo, hi, n)
+}
+
+// Convergents returns the numerators and denominators of the convergents of
+// the continued fraction with partial quotients a. The k-th convergent is
+// p[k]/q[k], and it satisfies the recurrences
+// 		p[k] = a[k]p[k-1] + p[k-2]
+// 		q[k] = a[k]q[k-1] + q[k-2]
+// with p[-1] = 1, p[-2] = 0, q[-1] = 0, and q[-2] = 1.
+func Convergents(a []*big.Int) ([]*big.Int, []*big.Int) {
+	p := make([]*big.Int, len(a))
+	q := make([]*big.Int, len(a))
+	p1, p2 := big.NewInt(1), big.NewInt(0)
+	q1, q2 := big.NewInt(0), big.NewInt(1)
+	for k, ak := range a {
+		p[k] = new(big.Int).Mul(ak, p1)
+		p[k].Add(p[k], p2)
+		q[k] = new(big.Int).Mul(ak, q1)
+		q[k].Add(q[k], q2)
+		p1, p2 = p[k], p1
+		q1, q2 = q[k], q1
+	}
+	return p, q
+}
+
+// RatioContinuedFraction returns at most n partial quotients of the simple
+// continued fraction expansion of a/b, where z = a+bi. As with
+// ContinuedFraction, only the partial quotients that are determined by the
+// precisions of a and b are returned. If b is zero, then
+// RatioContinuedFraction panics.
+func (z *Complex) RatioContinuedFraction(n int) []*big.Int {
+	if z.r.Sign() == 0 {
+		panic("ratio with zero imaginary part")
+	}
+	if z.l.IsInf() || z.r.IsInf() {
+		panic("continued fraction of infinity")
+	}
+	a, alo, ahi := roundingInterval(&z.l)
+	b, blo, bhi := roundingInterval(&z.r)
+	mid := new(big.Rat).Quo(a, b)
+	lo, hi := new(big.Rat), new(big.Rat)
+	for i, num := range []*big.Rat{alo, ahi} {
+		for j, den := range []*big.Rat{blo, bhi} {
+			if den.Sign() != b.Sign() {
+				// The interval of b contains zero.
+				return nil
+			}
+			r := new(big.Rat).Quo(num, den)
+			if (i == 0 && j == 0) || r.Cmp(lo) < 0 {
+				lo.Set(r)
+			}
+			if (i == 0 && j == 0) || r.Cmp(hi) > 0 {
+				hi.Set(r)
+			}
+		}
+	}
+	return expandInterval(mid, lo, hi, n)
+}
+
+// roundingInterval returns x as an exact rational, together with the endpoints
+// of the interval of real numbers that round to x at the precision of x.
+func roundingInterval(x *big.Float) (*big.Rat, *big.Rat, *big.Rat) {
+	mid, _ := x.Rat(nil)
+	lo, hi := new(big.Rat).Set(mid), new(big.Rat).Set(mid)
+	if x.Sign() == 0 || x.Prec() == 0 {
+		return mid, lo, hi
+	}
+	half := new(big.Float).SetMantExp(big.NewFloat(1), expo(x)-int(x.Prec())-1)
+	ulp, _ := half.Rat(nil)
+	lo.Sub(lo, ulp)
+	hi.Add(hi, ulp)
+	return mid, lo, hi
+}
+
+// expandInterval returns at most n partial quotients of the continued fraction
+// of mid that are shared by every number in the closed interval [lo, hi].
+func expandInterval(mid, lo, hi *big.Rat, n int) []*big.Int {
+	var a []*big.Int
+	mid = new(big.Rat).Set(mid)
+	lo = new(big.Rat).Set(lo)
+	hi = new(big.Rat).Set(hi)
+	for len(a) < n {
+		q := ratFloor(mid)
+		frac := new(big.Rat).Sub(mid, new(big.Rat).SetInt(q))
+		if frac.Sign() == 0 {
+			return append(a, q)
+		}
+		if ratFloor(lo).Cmp(q) != 0 || ratFloor(hi).Cmp(q) != 0 {
+			return a
+		}
+		a = append(a, q)
+		qr := new(big.Rat).SetInt(q)
+		lo.Sub(lo, qr)
+		hi.Sub(hi, qr)
+		if lo.Sign() <= 0 {
+			return a
+		}
+		mid.Inv(frac)
+		lo.Inv(lo)
+		hi.Inv(hi)
+		lo, hi = hi, lo
+	}
+	return a
+}
+
+// ratFloor returns the largest integer not greater than x.
+func ratFloor(x *big.Rat) *big.Int {
+	// Euclidean division rounds down for a positive denominator.
+	return new(big.Int).Div(x.Num(), x.Denom())
+}
+
+// BestRational returns the rational number closest to x with denominator at
+// most maxDen, preferring the smaller denominator in a tie. It walks the
+// Stern–Brocot tree along the exact continued fraction of x: the answer is
+// either the last convergent within the bound or the semiconvergent that
+// follows it. If maxDen is not positive or x is infinite, then BestRational
+// panics.
+func BestRational(x *big.Float, maxDen *big.Int) *big.Rat {
+	if maxDen.Sign() <= 0 {
+		panic("non-positive denominator bound")
+	}
+	if x.IsInf() {
+		panic("rational approximation of infinity")
+	}
+	r, _ := x.Rat(nil)
+	return bestRational(r, maxDen)
+}
+
+// bestRational returns the rational number closest to r with denominator at
+// most maxDen.
+func bestRational(r *big.Rat, maxDen *big.Int) *big.Rat {
+	if r.Denom().Cmp(maxDen) <= 0 {
+		return new(big.Rat).Set(r)
+	}
+	p1, p2 := big.NewInt(1), big.NewInt(0)
+	q1, q2 := big.NewInt(0), big.NewInt(1)
+	x := new(big.Rat).Set(r)
+	for {
+		a := ratFloor(x)
+		q := new(big.Int).Mul(a, q1)
+		q.Add(q, q2)
+		if q.Cmp(maxDen) > 0 {
+			// The semiconvergents (p2 + t p1)/(q2 + t q1) approach r, so the
+			// largest t within the bound gives the best of them.
+			t := new(big.Int).Sub(maxDen, q2)
+			t.Quo(t, q1)
+			sp := new(big.Int).Mul(t, p1)
+			sq := new(big.Int).Mul(t, q1)
+			semi := new(big.Rat).SetFrac(sp.Add(sp, p2), sq.Add(sq, q2))
+			conv := new(big.Rat).SetFrac(p1, q1)
+			ds := new(big.Rat).Sub(semi, r)
+			dc := new(big.Rat).Sub(conv, r)
+			if ds.Abs(ds).Cmp(dc.Abs(dc)) < 0 {
+				return semi
+			}
+			return conv
+		}
+		p := new(big.Int).Mul(a, p1)
+		p.Add(p, p2)
+		p1, p2 = p, p1
+		q1, q2 = q, q1
+		x.Sub(x, new(big.Rat).SetInt(a))
+		// The loop ends before x becomes an integer, since the denominator of
+		// r exceeds the bound.
+		x.Inv(x)
+	}
+}
+
+// SimplestRational returns the rational number with the smallest denominator
+// among those that round to x at the precision of x, choosing the one of
+// smallest absolute value if there are several. It is found by descending the
+// Stern–Brocot tree until a node falls in the rounding interval, which is how
+// a high-precision result that is meant to be a small fraction can be
+// recovered exactly. If x is infinite, then SimplestRational panics.
+func SimplestRational(x *big.Float) *big.Rat {
+	if x.IsInf() {
+		panic("rational approximation of infinity")
+	}
+	_, lo, hi := roundingInterval(x)
+	return simplestRational(lo, hi)
+}
+
+// simplestRational returns the rational number with the smallest denominator
+// in the closed interval [lo, hi], and of smallest absolute value among
+// those.
+func simplestRational(lo, hi *big.Rat) *big.Rat {
+	switch {
+	case lo.Sign() <= 0 && hi.Sign() >= 0:
+		return new(big.Rat)
+	case hi.Sign() < 0:
+		r := simplestRational(new(big.Rat).Neg(hi), new(big.Rat).Neg(lo))
+		return r.Neg(r)
+	}
+	lo = new(big.Rat).Set(lo)
+	hi = new(big.Rat).Set(hi)
+	var a []*big.Int
+	for {
+		f := ratFloor(lo)
+		if lo.IsInt() {
+			a = append(a, f)
+			break
+		}
+		f1 := new(big.Int).Add(f, big.NewInt(1))
+		if new(big.Rat).SetInt(f1).Cmp(hi) <= 0 {
+			a = append(a, f1)
+			break
+		}
+		// Both endpoints lie in (f, f + 1), so they share the partial
+		// quotient f.
+		a = append(a, f)
+		fr := new(big.Rat).SetInt(f)
+		lo.Sub(lo, fr)
+		hi.Sub(hi, fr)
+		lo, hi = hi.Inv(hi), lo.Inv(lo)
+	}
+	p, q := Convergents(a)
+	return new(big.Rat).SetFrac(p[len(p)-1], q[len(q)-1])
+}
+
+// BestRational returns the Gaussian rational closest to z whose real and
+// imaginary parts have denominators at most maxDen, as two rationals. The
+// parts are approximated independently with BestRational, so their common
+// denominator can be as large as maxDen². If maxDen is not positive or a part
+// of z is infinite, then BestRational panics.
+func (z *Complex) BestRational(maxDen *big.Int) (*big.Rat, *big.Rat) {
+	return BestRational(&z.l, maxDen), BestRational(&z.r, maxDen)
+}
+
+// SimplestRational returns the simplest rationals that round to the real and
+// imaginary parts of z, as found by SimplestRational.
+func (z *Complex) SimplestRational() (*big.Rat, *big.Rat) {
+	return SimplestRational(&z.l), SimplestRational(&z.r)
+}
+
+// EngelExpansion returns at most n terms of the Engel expansion of the
+// positive number x,
+// 		x = 1/a1 + 1/(a1 a2) + 1/(a1 a2 a3) + ...
+// with 1 <= a1 <= a2 <= .... The terms are a[k] = ⌈1/x[k]⌉, where x[1] = x
+// and x[k+1] = x[k]a[k] - 1.
+//
+// As with ContinuedFraction, only the terms that are determined by the
+// precision of x are returned. If x is a terminating expansion, then the
+// expansion ends with its last term. If x is not positive or is infinite,
+// then EngelExpansion panics.
+func EngelExpansion(x *big.Float, n int) []*big.Int {
+	if x.Sign() <= 0 {
+		panic("Engel expansion of non-positive value")
+	}
+	if x.IsInf() {
+		panic("Engel expansion of infinity")
+	}
+	mid, lo, hi := roundingInterval(x)
+	return engelInterval(mid, lo, hi, n)
+}
+
+// engelInterval returns at most n terms of the Engel expansion of the positive
+// mid that are shared by every number in the closed interval [lo, hi].
+func engelInterval(mid, lo, hi *big.Rat, n int) []*big.Int {
+	var a []*big.Int
+	mid = new(big.Rat).Set(mid)
+	lo = new(big.Rat).Set(lo)
+	hi = new(big.Rat).Set(hi)
+	for len(a) < n && lo.Sign() > 0 {
+		q := ratCeil(new(big.Rat).Inv(mid))
+		qr := new(big.Rat).SetInt(q)
+		one := big.NewRat(1, 1)
+		if mid.Sub(mid.Mul(mid, qr), one); mid.Sign() == 0 {
+			return append(a, q)
+		}
+		if ratCeil(new(big.Rat).Inv(lo)).Cmp(q) != 0 || ratCeil(new(big.Rat).Inv(hi)).Cmp(q) != 0 {
+			return a
+		}
+		a = append(a, q)
+		lo.Sub(lo.Mul(lo, qr), one)
+		hi.Sub(hi.Mul(hi, qr), one)
+	}
+	return a
+}
+
+// ratCeil returns the smallest integer not less than x.
+func ratCeil(x *big.Rat) *big.Int {
+	q := ratFloor(x)
+	if !x.IsInt() {
+		q.Add(q, big.NewInt(1))
+	}
+	return q
+}
+
+// EngelSum returns the exact value of the Engel expansion with terms a,
+// 		1/a1 + 1/(a1 a2) + ... + 1/(a1 a2 ... an)
+// If a term is not positive, then EngelSum panics.
+func EngelSum(a []*big.Int) *big.Rat {
+	sum := new(big.Rat)
+	prod := big.NewInt(1)
+	for _, ak := range a {
+		if ak.Sign() <= 0 {
+			panic("non-positive Engel term")
+		}
+		prod.Mul(prod, ak)
+		sum.Add(sum, new(big.Rat).SetFrac(big.NewInt(1), prod))
+	}
+	return sum
+}
+
+// splitIntervals returns the components u and v of z in the idempotent basis
+// as exact rationals, each with the endpoints of the interval of values that
+// are consistent with the precisions of the components of z.
+func (z *Perplex) splitIntervals() (u, v [3]*big.Rat) {
+	a, alo, ahi := roundingInterval(&z.l)
+	b, blo, bhi := roundingInterval(&z.r)
+	u = [3]*big.Rat{
+		new(big.Rat).Add(a, b),
+		new(big.Rat).Add(alo, blo),
+		new(big.Rat).Add(ahi, bhi),
+	}
+	v = [3]*big.Rat{
+		new(big.Rat).Sub(a, b),
+		new(big.Rat).Sub(alo, bhi),
+		new(big.Rat).Sub(ahi, blo),
+	}
+	return u, v
+}
+
+// ContinuedFraction returns at most n partial quotients of the simple
+// continued fractions of the components u and v of z in the idempotent basis,
+// as found by Split. Arithmetic in the idempotent basis acts on each branch
+// separately, so the pair of expansions is the split-complex analogue of the
+// continued fraction of a real number. As with ContinuedFraction, only the
+// partial quotients that are determined by the precisions of the components
+// of z are returned. If a component of z is infinite, then ContinuedFraction
+// panics.
+func (z *Perplex) ContinuedFraction(n int) ([]*big.Int, []*big.Int) {
+	if z.l.IsInf() || z.r.IsInf() {
+		panic("continued fraction of infinity")
+	}
+	u, v := z.splitIntervals()
+	return expandInterval(u[0], u[1], u[2], n), expandInterval(v[0], v[1], v[2], n)
+}
+
+// SetContinuedFraction sets z equal to the Perplex value whose components in
+// the idempotent basis are the values of the continued fractions with partial
+// quotients u and v, rounded to prec bits. Then it returns z. If u or v is
+// empty, then SetContinuedFraction panics.
+func (z *Perplex) SetContinuedFraction(u, v []*big.Int, prec uint) *Perplex {
+	if len(u) == 0 || len(v) == 0 {
+		panic("empty continued fraction")
+	}
+	p, q := Convergents(u)
+	x := new(big.Rat).SetFrac(p[len(p)-1], q[len(q)-1])
+	p, q = Convergents(v)
+	y := new(big.Rat).SetFrac(p[len(p)-1], q[len(q)-1])
+	return z.joinRat(x, y, prec)
+}
+
+// EngelExpansion returns at most n terms of the Engel expansions of the
+// components u and v of z in the idempotent basis, as found by Split. As with
+// EngelExpansion, only the terms that are determined by the precisions of the
+// components of z are returned. If u or v is not positive, that is, if z is
+// not in the positive cone |b| < a, or if a component of z is infinite, then
+// EngelExpansion panics.
+func (z *Perplex) EngelExpansion(n int) ([]*big.Int, []*big.Int) {
+	if z.l.IsInf() || z.r.IsInf() {
+		panic("Engel expansion of infinity")
+	}
+	u, v := z.splitIntervals()
+	if u[0].Sign() <= 0 || v[0].Sign() <= 0 {
+		panic("Engel expansion of non-positive value")
+	}
+	return engelInterval(u[0], u[1], u[2], n), engelInterval(v[0], v[1], v[2], n)
+}
+
+// SetEngel sets z equal to the Perplex value whose components in the
+// idempotent basis are the values of the Engel expansions with terms u and v,
+// rounded to prec bits. Then it returns z. If a term is not positive, then
+// SetEngel panics.
+func (z *Perplex) SetEngel(u, v []*big.Int, prec uint) *Perplex {
+	return z.joinRat(EngelSum(u), EngelSum(v), prec)
+}
+
+// joinRat sets z equal to the Perplex value with the rational components u
+// and v in the idempotent basis, rounded to prec bits, and returns z.
+func (z *Perplex) joinRat(u, v *big.Rat, prec uint) *Perplex {
+	half := big.NewRat(1, 2)
+	a := new(big.Rat).Add(u, v)
+	b := new(big.Rat).Sub(u, v)
+	z.l.SetPrec(prec).SetRat(a.Mul(a, half))
+	z.r.SetPrec(prec).SetRat(b.Mul(b, half))
+	return z
+}
@@ -0,0 +1,185 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestContinuedFractionGoldenRatio(t *testing.T) {
+	phi := new(big.Float).SetPrec(200).SetInt64(5)
+	phi.Sqrt(phi)
+	phi.Add(phi, big.NewFloat(1))
+	phi.Quo(phi, big.NewFloat(2))
+	a := ContinuedFraction(phi, 1000)
+	if len(a) < 100 || len(a) > 300 {
+		t.Fatalf("got %d partial quotients", len(a))
+	}
+	for k, ak := range a {
+		if ak.Cmp(big.NewInt(1)) != 0 {
+			t.Fatalf("a[%d] = %v", k, ak)
+		}
+	}
+}
+
+func TestContinuedFractionTerminating(t *testing.T) {
+	a := ContinuedFraction(big.NewFloat(-2.75), 10)
+	want := []int64{-3, 4}
+	if len(a) != len(want) {
+		t.Fatalf("ContinuedFraction(-2.75) = %v", a)
+	}
+	for k := range want {
+		if a[k].Int64() != want[k] {
+			t.Fatalf("ContinuedFraction(-2.75) = %v", a)
+		}
+	}
+}
+
+func TestConvergentsSqrt2(t *testing.T) {
+	x := new(big.Float).SetPrec(100).SetInt64(2)
+	x.Sqrt(x)
+	p, q := Convergents(ContinuedFraction(x, 4))
+	want := [][2]int64{{1, 1}, {3, 2}, {7, 5}, {17, 12}}
+	for k := range want {
+		if p[k].Int64() != want[k][0] || q[k].Int64() != want[k][1] {
+			t.Errorf("convergent %d = %v/%v", k, p[k], q[k])
+		}
+	}
+}
+
+func TestComplexRatioContinuedFraction(t *testing.T) {
+	z := NewComplex(big.NewFloat(3), big.NewFloat(2))
+	a := z.RatioContinuedFraction(10)
+	if len(a) != 2 || a[0].Int64() != 1 || a[1].Int64() != 2 {
+		t.Errorf("RatioContinuedFraction = %v", a)
+	}
+}
+
+func TestBestRationalPi(t *testing.T) {
+	pi := bigPi(200)
+	tests := []struct {
+		maxDen int64
+		want   string
+	}{
+		{1, "3/1"},
+		{7, "22/7"},
+		{100, "311/99"},
+		{113, "355/113"},
+		{10000, "355/113"},
+		{30000, "94053/29938"},
+		{40000, "104348/33215"},
+	}
+	for _, tt := range tests {
+		if got := BestRational(pi, big.NewInt(tt.maxDen)); got.String() != tt.want {
+			t.Errorf("BestRational(π, %d) = %v, want %v", tt.maxDen, got, tt.want)
+		}
+	}
+	if got := BestRational(new(big.Float).Neg(pi), big.NewInt(7)); got.String() != "-22/7" {
+		t.Errorf("BestRational(-π, 7) = %v, want -22/7", got)
+	}
+}
+
+func TestBestRationalExhaustive(t *testing.T) {
+	x := big.NewFloat(0.7071067811865476)
+	r, _ := x.Rat(nil)
+	for maxDen := int64(1); maxDen < 60; maxDen++ {
+		got := BestRational(x, big.NewInt(maxDen))
+		dg := new(big.Rat).Sub(got, r)
+		dg.Abs(dg)
+		for q := int64(1); q <= maxDen; q++ {
+			p := new(big.Rat).Mul(r, big.NewRat(q, 1))
+			for _, n := range []*big.Int{ratFloor(p), new(big.Int).Add(ratFloor(p), big.NewInt(1))} {
+				d := new(big.Rat).Sub(new(big.Rat).SetFrac(n, big.NewInt(q)), r)
+				if d.Abs(d).Cmp(dg) < 0 {
+					t.Fatalf("BestRational(x, %d) = %v, but %v/%d is closer", maxDen, got, n, q)
+				}
+			}
+		}
+	}
+}
+
+func TestSimplestRational(t *testing.T) {
+	third := new(big.Float).SetPrec(100).SetInt64(1)
+	third.Quo(third, big.NewFloat(3))
+	if got := SimplestRational(third); got.String() != "1/3" {
+		t.Errorf("SimplestRational(1/3) = %v", got)
+	}
+	x := new(big.Float).SetPrec(120).SetInt64(-355)
+	x.Quo(x, big.NewFloat(113))
+	if got := SimplestRational(x); got.String() != "-355/113" {
+		t.Errorf("SimplestRational(-355/113) = %v", got)
+	}
+	// An exact value is its own simplest rational.
+	if got := SimplestRational(big.NewFloat(2.75)); got.String() != "11/4" {
+		t.Errorf("SimplestRational(2.75) = %v", got)
+	}
+	z := newComplexPrec(100)
+	z.l.Quo(newFloat(100).SetInt64(2), big.NewFloat(7))
+	z.r.Quo(newFloat(100).SetInt64(-5), big.NewFloat(9))
+	if re, im := z.SimplestRational(); re.String() != "2/7" || im.String() != "-5/9" {
+		t.Errorf("SimplestRational(%v) = %v, %v", z, re, im)
+	}
+	if re, im := z.BestRational(big.NewInt(5)); re.String() != "1/4" || im.String() != "-3/5" {
+		t.Errorf("BestRational(%v, 5) = %v, %v", z, re, im)
+	}
+}
+
+func TestEngelExpansion(t *testing.T) {
+	// e - 1 = 1/1! + 1/2! + ..., with Engel terms 1, 2, 3, ....
+	terms := make([]*big.Int, 80)
+	for k := range terms {
+		terms[k] = big.NewInt(int64(k + 1))
+	}
+	x := new(big.Float).SetPrec(200).SetRat(EngelSum(terms))
+	a := EngelExpansion(x, 1000)
+	if len(a) < 30 || len(a) > 60 {
+		t.Fatalf("got %d terms", len(a))
+	}
+	for k, ak := range a {
+		if ak.Int64() != int64(k+1) {
+			t.Fatalf("a[%d] = %v", k, ak)
+		}
+	}
+	if a := EngelExpansion(big.NewFloat(0.75), 10); len(a) != 2 || a[0].Int64() != 2 || a[1].Int64() != 2 {
+		t.Errorf("EngelExpansion(0.75) = %v, want [2 2]", a)
+	}
+}
+
+func TestPerplexContinuedFraction(t *testing.T) {
+	z := NewPerplex(big.NewFloat(2.75), big.NewFloat(0.25))
+	u, v := z.ContinuedFraction(10)
+	if len(u) != 1 || u[0].Int64() != 3 || len(v) != 2 || v[0].Int64() != 2 || v[1].Int64() != 2 {
+		t.Fatalf("ContinuedFraction = %v, %v, want [3], [2 2]", u, v)
+	}
+	if got := new(Perplex).SetContinuedFraction(u, v, 53); !got.Equals(z) {
+		t.Errorf("SetContinuedFraction = %v, want %v", got, z)
+	}
+	x := new(big.Float).SetPrec(100).Quo(big.NewFloat(1), big.NewFloat(3))
+	y := new(big.Float).SetPrec(100).Sqrt(big.NewFloat(2))
+	z = NewPerplex(y, x)
+	u, v = z.ContinuedFraction(1000)
+	if got := new(Perplex).SetContinuedFraction(u, v, 100); !closeToPerplex(got, z, 90) {
+		t.Errorf("SetContinuedFraction = %v, want %v", got, z)
+	}
+}
+
+func TestPerplexEngelExpansion(t *testing.T) {
+	x := new(big.Float).SetPrec(100).Quo(big.NewFloat(1), big.NewFloat(3))
+	y := new(big.Float).SetPrec(100).Sqrt(big.NewFloat(2))
+	z := NewPerplex(y, x)
+	u, v := z.EngelExpansion(1000)
+	if len(u) == 0 || len(v) == 0 {
+		t.Fatalf("EngelExpansion = %v, %v", u, v)
+	}
+	if got := new(Perplex).SetEngel(u, v, 100); !closeToPerplex(got, z, 80) {
+		t.Errorf("SetEngel = %v, want %v", got, z)
+	}
+	defer func() {
+		if r := recover(); r != "Engel expansion of non-positive value" {
+			t.Errorf("recovered %v", r)
+		}
+	}()
+	NewPerplex(x, y).EngelExpansion(10)
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math/big"
+
+// hamiltonDotSign returns the sign of the four-dimensional dot product of p
+// and q, computed exactly.
+func hamiltonDotSign(p, q *Hamilton) int {
+	a, b, c, d := p.Cartesian()
+	s, t, u, v := q.Cartesian()
+	return exactAdd(
+		exactAdd(exactMul(a, s), exactMul(b, t)),
+		exactAdd(exactMul(c, u), exactMul(d, v)),
+	).Sign()
+}
+
+// UnflipHamilton makes the sequence q of rotations continuous in sign. Since
+// z and -z represent the same rotation, recorded orientations often flip
+// between the two hemispheres, which makes interpolation take the long way
+// around. UnflipHamilton negates, in place, every value whose
+// four-dimensional dot product with its predecessor, after repair, is
+// negative, so that consecutive values lie in the same hemisphere; the first
+// value is kept. It returns the indices of the negated values, in increasing
+// order. The rotations represented by q are unchanged.
+func UnflipHamilton(q []*Hamilton) []int {
+	var flips []int
+	for k := 1; k < len(q); k++ {
+		if hamiltonDotSign(q[k-1], q[k]) < 0 {
+			q[k].Neg(q[k])
+			flips = append(flips, k)
+		}
+	}
+	return flips
+}
+
+// A RotationJump reports a pair of consecutive values of a sequence of
+// rotations that are farther apart than a threshold.
+type RotationJump struct {
+	// Index is the index of the second value of the pair.
+	Index int
+	// Angle is the angle, in [0, π], of the rotation that takes the first
+	// value of the pair to the second.
+	Angle *big.Float
+}
+
+// HamiltonJumps returns the pairs of consecutive values of the sequence q of
+// rotations whose relative rotation has an angle larger than threshold, in
+// radians, in increasing order of index. For consecutive values p and z, the
+// relative rotation Mul(Conj(p), z) = a+v has the angle
+// 		2 atan2(|v|, |a|)
+// which does not depend on the signs of p and z, so HamiltonJumps finds the
+// same jumps before and after UnflipHamilton, and the values need not be unit
+// quaternions. The angles are rounded to the largest precision of each pair.
+// If a value is zero, then HamiltonJumps panics.
+func HamiltonJumps(q []*Hamilton, threshold *big.Float) []RotationJump {
+	var jumps []RotationJump
+	for k := 1; k < len(q); k++ {
+		a, b, c, d := q[k-1].Cartesian()
+		s, t, u, v := q[k].Cartesian()
+		prec := maxPrec(a, b, c, d, s, t, u, v)
+		w := prec + guardBits
+		p, z := new(Hamilton).setPrec(q[k-1], w), new(Hamilton).setPrec(q[k], w)
+		if p.Equals(new(Hamilton)) || z.Equals(new(Hamilton)) {
+			panic("rotation of zero")
+		}
+		r := new(Hamilton).Mul(p.Conj(p), z)
+		a, b, c, d = r.Cartesian()
+		angle := bigAtan2(bigHypot(w, b, c, d), new(big.Float).Abs(a), w)
+		angle.SetMantExp(angle, 1)
+		if angle.Cmp(threshold) > 0 {
+			jumps = append(jumps, RotationJump{k, newFloat(prec).Set(angle)})
+		}
+	}
+	return jumps
+}
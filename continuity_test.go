@@ -0,0 +1,73 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// rotationSeries returns the rotations about the axis (1, 2, 2) by the given
+// angles, at 100 bits.
+func rotationSeries(angles ...float64) []*Hamilton {
+	axis := [3]*big.Float{big.NewFloat(1), big.NewFloat(2), big.NewFloat(2)}
+	q := make([]*Hamilton, len(angles))
+	for k, a := range angles {
+		q[k] = NewHamiltonFromAxisAngle(axis, new(big.Float).SetPrec(100).SetFloat64(a))
+	}
+	return q
+}
+
+func TestUnflipHamilton(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	angles := make([]float64, 50)
+	for k := range angles {
+		// The half-angle crosses π/2, where the real part changes sign.
+		angles[k] = 0.2 * float64(k)
+	}
+	want := rotationSeries(angles...)
+	q := make([]*Hamilton, len(want))
+	var flipped []int
+	for k := range q {
+		q[k] = new(Hamilton).Copy(want[k])
+		if k > 0 && rng.Intn(2) == 0 {
+			q[k].Neg(q[k])
+			flipped = append(flipped, k)
+		}
+	}
+	got := UnflipHamilton(q)
+	if len(got) != len(flipped) {
+		t.Fatalf("UnflipHamilton flipped %v, want %v", got, flipped)
+	}
+	for k := range got {
+		if got[k] != flipped[k] {
+			t.Fatalf("UnflipHamilton flipped %v, want %v", got, flipped)
+		}
+	}
+	for k := range q {
+		if !q[k].Equals(want[k]) {
+			t.Errorf("q[%d] = %v, want %v", k, q[k], want[k])
+		}
+	}
+	if again := UnflipHamilton(q); len(again) != 0 {
+		t.Errorf("UnflipHamilton of a continuous sequence flipped %v", again)
+	}
+}
+
+func TestHamiltonJumps(t *testing.T) {
+	q := rotationSeries(0, 0.1, 0.2, 1.2, 1.3, -1.7)
+	// The sign of a value does not change the jumps.
+	q[4].Neg(q[4])
+	jumps := HamiltonJumps(q, big.NewFloat(0.5))
+	want := []RotationJump{{3, big.NewFloat(1)}, {5, big.NewFloat(3)}}
+	if len(jumps) != len(want) {
+		t.Fatalf("HamiltonJumps = %v, want %v", jumps, want)
+	}
+	for k, j := range jumps {
+		if j.Index != want[k].Index || !closeTo(j.Angle, want[k].Angle, 45) {
+			t.Errorf("jump %d = {%d, %v}, want {%d, %v}", k, j.Index, j.Angle, want[k].Index, want[k].Angle)
+		}
+	}
+}
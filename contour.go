@@ -0,0 +1,160 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math/big"
+
+// A ContourPath is a smooth path in the complex plane, parametrized over the
+// interval [0, 1]. A contour is a slice of paths, each starting where the
+// previous one ends.
+type ContourPath interface {
+	// Point returns the point of the path at t and the derivative of the
+	// path there, rounded to prec bits.
+	Point(t *big.Float, prec uint) (*Complex, *Complex)
+}
+
+// A Segment is the straight path from one point to another.
+type Segment struct {
+	from, to Complex
+}
+
+// NewSegment returns a pointer to the Segment from a to b.
+func NewSegment(a, b *Complex) *Segment {
+	s := new(Segment)
+	s.from.Copy(a)
+	s.to.Copy(b)
+	return s
+}
+
+// Point returns a + t(b - a) and b - a, rounded to prec bits.
+func (s *Segment) Point(t *big.Float, prec uint) (*Complex, *Complex) {
+	dz := newComplexPrec(prec).Sub(&s.to, &s.from)
+	z := newComplexPrec(prec).Scal(dz, t)
+	return z.Add(z, &s.from), dz
+}
+
+// An Arc is the counterclockwise circular path around a center, between two
+// angles in radians. It runs clockwise if the end angle is less than the
+// start angle.
+type Arc struct {
+	center     Complex
+	radius     big.Float
+	start, end big.Float
+	full       bool
+}
+
+// NewArc returns a pointer to the Arc around c of radius r, from angle theta0
+// to angle theta1.
+func NewArc(c *Complex, r, theta0, theta1 *big.Float) *Arc {
+	a := new(Arc)
+	a.center.Copy(c)
+	a.radius.Copy(r)
+	a.start.Copy(theta0)
+	a.end.Copy(theta1)
+	return a
+}
+
+// NewCircle returns a pointer to the Arc that goes once counterclockwise
+// around the circle with center c and radius r, starting at c + r. The angle
+// 2π is computed at the precision at which the Arc is evaluated.
+func NewCircle(c *Complex, r *big.Float) *Arc {
+	a := new(Arc)
+	a.center.Copy(c)
+	a.radius.Copy(r)
+	a.full = true
+	return a
+}
+
+// Point returns c + r exp(iθ) and ir(θ1 - θ0) exp(iθ), where θ = θ0 + t(θ1 -
+// θ0), rounded to prec bits.
+func (a *Arc) Point(t *big.Float, prec uint) (*Complex, *Complex) {
+	w := prec + guardBits
+	span := newFloat(w).Sub(&a.end, &a.start)
+	if a.full {
+		span = bigPi(w)
+		span.SetMantExp(span, 1)
+	}
+	theta := newFloat(w).Mul(t, span)
+	theta.Add(theta, &a.start)
+	s, c := bigSinCos(theta, w)
+	e := newComplexPrec(w)
+	e.l.Mul(&a.radius, c)
+	e.r.Mul(&a.radius, s)
+	z := newComplexPrec(prec).Add(&a.center, e)
+	// i exp(iθ) = -sin θ + i cos θ
+	dz := newComplexPrec(w)
+	dz.l.Neg(&e.r)
+	dz.r.Set(&e.l)
+	return z, newComplexPrec(prec).Scal(dz, span)
+}
+
+// ContourIntegral returns the integral of f along the contour, rounded to
+// prec bits, and true if the quadrature of every path converged. Each path
+// contributes
+// 		∫ f(z(t)) z'(t) dt
+// over [0, 1], computed with IntegrateGaussLegendreComplex, which suits f
+// when it is analytic near the path. The function f receives its argument
+// with some guard bits beyond prec and should compute at the precision of
+// its argument.
+func ContourIntegral(f func(z *Complex) *Complex, contour []ContourPath, prec uint) (*Complex, bool) {
+	w := prec + guardBits
+	sum := newComplexPrec(w)
+	ok := true
+	zero, one := new(big.Float), big.NewFloat(1)
+	for _, p := range contour {
+		s, conv := IntegrateGaussLegendreComplex(func(t *big.Float) *Complex {
+			z, dz := p.Point(t, t.Prec())
+			return dz.Mul(f(z), dz)
+		}, zero, one, w)
+		sum.Add(sum, s)
+		ok = ok && conv
+	}
+	return newComplexPrec(prec).round(sum, prec), ok
+}
+
+// WindingNumber returns the number of times the closed contour winds
+// counterclockwise around z0, from
+// 		1/(2πi) ∮ dz/(z - z0)
+// computed at prec bits and rounded to the nearest integer, and true if the
+// integral is within a quarter of that integer. The quadrature need not reach
+// prec bits for the count to be right, so a point close to the contour is
+// still counted. The point z0 must not lie on the contour.
+func WindingNumber(contour []ContourPath, z0 *Complex, prec uint) (int, bool) {
+	return countTurns(func(z *Complex) *Complex {
+		return new(Complex).Inv(new(Complex).Sub(z, z0))
+	}, contour, prec)
+}
+
+// CountZeros returns the number of zeros minus the number of poles of f
+// inside the closed counterclockwise contour, counted with multiplicity,
+// from the argument principle
+// 		1/(2πi) ∮ f'(z)/f(z) dz
+// where df is the derivative of f. The integral is computed at prec bits and
+// rounded to the nearest integer, and CountZeros returns true if it is within
+// a quarter of that integer, as for WindingNumber. The function f must have
+// no zeros or poles on the contour.
+func CountZeros(f, df func(z *Complex) *Complex, contour []ContourPath, prec uint) (int, bool) {
+	return countTurns(func(z *Complex) *Complex {
+		return new(Complex).Quo(df(z), f(z))
+	}, contour, prec)
+}
+
+// countTurns returns the nearest integer to the contour integral of g divided
+// by 2πi, and true if the integral is within a quarter of that integer.
+func countTurns(g func(z *Complex) *Complex, contour []ContourPath, prec uint) (int, bool) {
+	s, _ := ContourIntegral(g, contour, prec)
+	twoPi := bigPi(prec)
+	twoPi.SetMantExp(twoPi, 1)
+	// s/(2πi) = Im(s)/2π - i Re(s)/2π
+	n := newFloat(prec).Quo(&s.r, twoPi)
+	re := newFloat(prec).Quo(&s.l, twoPi)
+	f, _ := n.Float64()
+	k := int(f + 0.5)
+	if f < 0 {
+		k = -int(-f + 0.5)
+	}
+	d, _ := re.Float64()
+	ok := f-float64(k) < 0.25 && float64(k)-f < 0.25 && d < 0.25 && d > -0.25
+	return k, ok
+}
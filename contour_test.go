@@ -0,0 +1,98 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+)
+
+// square returns the counterclockwise square with corners ±1 ± i.
+func square() []ContourPath {
+	c := []*Complex{
+		newComplex128(-1 - 1i),
+		newComplex128(1 - 1i),
+		newComplex128(1 + 1i),
+		newComplex128(-1 + 1i),
+	}
+	return []ContourPath{
+		NewSegment(c[0], c[1]),
+		NewSegment(c[1], c[2]),
+		NewSegment(c[2], c[3]),
+		NewSegment(c[3], c[0]),
+	}
+}
+
+func TestContourIntegralSegment(t *testing.T) {
+	// ∫ z dz from 0 to 1 + i = (1 + i)²/2 = i
+	f := func(z *Complex) *Complex { return new(Complex).Copy(z) }
+	path := []ContourPath{NewSegment(new(Complex), newComplex128(1+1i))}
+	if got, ok := ContourIntegral(f, path, 100); !ok || !closeToComplex(got, newComplex128(1i), 90) {
+		t.Errorf("ContourIntegral = %v, %v", got, ok)
+	}
+}
+
+func TestContourIntegralCircle(t *testing.T) {
+	// ∮ dz/z = 2πi
+	f := func(z *Complex) *Complex { return new(Complex).Inv(z) }
+	circle := []ContourPath{NewCircle(new(Complex), big.NewFloat(2))}
+	want := NewComplex(new(big.Float), new(big.Float).SetMantExp(bigPi(100), 1))
+	if got, ok := ContourIntegral(f, circle, 100); !ok || !closeToComplex(got, want, 90) {
+		t.Errorf("ContourIntegral = %v, %v, want %v", got, ok, want)
+	}
+	// ∮ z² dz = 0 around the square
+	g := func(z *Complex) *Complex { return new(Complex).Mul(z, z) }
+	if got, ok := ContourIntegral(g, square(), 100); !ok || !closeToComplex(got, new(Complex), 90) {
+		t.Errorf("ContourIntegral = %v, %v, want 0", got, ok)
+	}
+}
+
+func TestContourIntegralArc(t *testing.T) {
+	// ∫ dz/z along the upper half of the unit circle = πi
+	f := func(z *Complex) *Complex { return new(Complex).Inv(z) }
+	arc := []ContourPath{NewArc(new(Complex), big.NewFloat(1), new(big.Float), bigPi(120))}
+	want := NewComplex(new(big.Float), bigPi(100))
+	if got, ok := ContourIntegral(f, arc, 100); !ok || !closeToComplex(got, want, 90) {
+		t.Errorf("ContourIntegral = %v, %v, want %v", got, ok, want)
+	}
+}
+
+func TestWindingNumber(t *testing.T) {
+	cases := []struct {
+		z complex128
+		n int
+	}{
+		{0, 1},
+		{0.5 - 0.9i, 1},
+		{3, 0},
+		{1i + 1.5, 0},
+	}
+	for _, c := range cases {
+		if n, ok := WindingNumber(square(), newComplex128(c.z), 64); !ok || n != c.n {
+			t.Errorf("WindingNumber(%v) = %d, %v, want %d", c.z, n, ok, c.n)
+		}
+	}
+	// Twice around the circle, clockwise.
+	two := []ContourPath{
+		NewArc(new(Complex), big.NewFloat(1), new(big.Float), new(big.Float).Mul(bigPi(100), big.NewFloat(-4))),
+	}
+	if n, ok := WindingNumber(two, newComplex128(0.1i), 64); !ok || n != -2 {
+		t.Errorf("WindingNumber = %d, %v, want -2", n, ok)
+	}
+}
+
+func TestCountZeros(t *testing.T) {
+	// z³ - 1/8 has its zeros on the circle of radius 1/2.
+	p := NewComplexPoly(newComplex128(-0.125), new(Complex), new(Complex), newComplex128(1))
+	dp := new(ComplexPoly).Derivative(p)
+	f := func(z *Complex) *Complex { return p.Eval(z) }
+	df := func(z *Complex) *Complex { return dp.Eval(z) }
+	if n, ok := CountZeros(f, df, square(), 64); !ok || n != 3 {
+		t.Errorf("CountZeros = %d, %v, want 3", n, ok)
+	}
+	small := []ContourPath{NewCircle(newComplex128(0.5), big.NewFloat(0.1))}
+	if n, ok := CountZeros(f, df, small, 64); !ok || n != 1 {
+		t.Errorf("CountZeros = %d, %v, want 1", n, ok)
+	}
+}
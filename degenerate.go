@@ -0,0 +1,87 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math/big"
+
+// A Degeneracy classifies a denominator without an inverse in a Möbius
+// transform or a cross-ratio.
+type Degeneracy int
+
+const (
+	// AtInfinity means that the denominator is zero but the numerator is
+	// not, so the result is the point at infinity. In a cross-ratio this
+	// happens when w = x or v = y.
+	AtInfinity Degeneracy = iota + 1
+	// Indeterminate means that the numerator and the denominator are both
+	// zero. In a cross-ratio this happens when three of the points coincide.
+	Indeterminate
+	// ZeroDivisor means that the denominator is a non-zero zero divisor.
+	ZeroDivisor
+)
+
+// String returns the string version of a Degeneracy value.
+func (k Degeneracy) String() string {
+	switch k {
+	case AtInfinity:
+		return "denominator is zero"
+	case Indeterminate:
+		return "numerator and denominator are zero"
+	case ZeroDivisor:
+		return "denominator is zero divisor"
+	}
+	return "no degeneracy"
+}
+
+// A DegenerateError is returned by the checked Möbius and cross-ratio
+// methods when the denominator has no inverse.
+type DegenerateError struct {
+	Op   string
+	Kind Degeneracy
+}
+
+// Error returns the string version of a DegenerateError value.
+func (e *DegenerateError) Error() string {
+	return "bigfloat: " + e.Op + ": " + e.Kind.String()
+}
+
+// A DegeneracyPolicy selects how the checked Möbius and cross-ratio methods
+// treat a denominator that is zero while the numerator is not.
+type DegeneracyPolicy int
+
+const (
+	// ReportDegeneracy returns a *DegenerateError of kind AtInfinity.
+	ReportDegeneracy DegeneracyPolicy = iota
+	// ProjectiveInfinity sets every component of the result to +Inf, the
+	// point at infinity, and returns no error.
+	ProjectiveInfinity
+)
+
+// checkQuotient classifies a quotient from whether its numerator is zero,
+// whether its denominator is zero, and whether its denominator is a zero
+// divisor. It returns true if the result is the point at infinity, and a
+// non-nil error if the quotient has no value under policy.
+func checkQuotient(op string, numZero, denZero, denZeroDiv bool, policy DegeneracyPolicy) (bool, error) {
+	var k Degeneracy
+	switch {
+	case denZero && numZero:
+		k = Indeterminate
+	case denZero && policy == ProjectiveInfinity:
+		return true, nil
+	case denZero:
+		k = AtInfinity
+	case denZeroDiv:
+		k = ZeroDivisor
+	default:
+		return false, nil
+	}
+	return false, &DegenerateError{Op: op, Kind: k}
+}
+
+// setInf sets every x to +Inf.
+func setInf(x ...*big.Float) {
+	for _, v := range x {
+		v.SetInf(false)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestComplexMöbiusCheckedDegenerate(t *testing.T) {
+	zero := new(big.Float)
+	one := NewComplex(big.NewFloat(1), zero)
+	minusOne := NewComplex(big.NewFloat(-1), zero)
+	orig := NewComplex(big.NewFloat(2), big.NewFloat(3))
+	cases := []struct {
+		b    *Complex
+		kind Degeneracy
+	}{
+		{new(Complex), AtInfinity},
+		{minusOne, Indeterminate},
+	}
+	for _, c := range cases {
+		z := new(Complex).Copy(orig)
+		_, err := z.MöbiusChecked(one, one, c.b, one, minusOne, ReportDegeneracy)
+		var d *DegenerateError
+		if !errors.As(err, &d) || d.Kind != c.kind || !z.Equals(orig) {
+			t.Errorf("MöbiusChecked = %v, %v", z, err)
+		}
+	}
+	z, err := new(Complex).MöbiusChecked(one, one, new(Complex), one, minusOne, ProjectiveInfinity)
+	if err != nil || !z.l.IsInf() || !z.r.IsInf() {
+		t.Errorf("MöbiusChecked = %v, %v", z, err)
+	}
+}
+
+func TestComplexMöbiusPanicLeavesReceiver(t *testing.T) {
+	zero := new(big.Float)
+	one := NewComplex(big.NewFloat(1), zero)
+	orig := NewComplex(big.NewFloat(2), big.NewFloat(3))
+	z := new(Complex).Copy(orig)
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("Möbius did not panic")
+			}
+		}()
+		z.Möbius(one, one, one, one, NewComplex(big.NewFloat(-1), zero))
+	}()
+	if !z.Equals(orig) {
+		t.Errorf("receiver changed to %v", z)
+	}
+}
+
+func TestPerplexMöbiusCheckedZeroDivisor(t *testing.T) {
+	zero := new(big.Float)
+	one := NewPerplex(big.NewFloat(1), zero)
+	s := NewPerplex(zero, big.NewFloat(1))
+	// The denominator 1·1 + s is a non-zero zero divisor.
+	_, err := new(Perplex).MöbiusChecked(one, one, one, one, s, ProjectiveInfinity)
+	var d *DegenerateError
+	if !errors.As(err, &d) || d.Kind != ZeroDivisor || d.Op != "Möbius" {
+		t.Errorf("MöbiusChecked error = %v", err)
+	}
+}
+
+func TestHamiltonCrossRatioCheckedCoincident(t *testing.T) {
+	zero := new(big.Float)
+	v := NewHamilton(big.NewFloat(1), zero, zero, zero)
+	w := NewHamilton(zero, big.NewFloat(1), zero, zero)
+	y := NewHamilton(zero, zero, big.NewFloat(1), zero)
+	if _, err := new(Hamilton).CrossRatioLChecked(v, w, w, y, ReportDegeneracy); err == nil ||
+		err.(*DegenerateError).Kind != AtInfinity {
+		t.Errorf("CrossRatioLChecked(w = x) error = %v", err)
+	}
+	if _, err := new(Hamilton).CrossRatioRChecked(w, w, w, y, ProjectiveInfinity); err == nil ||
+		err.(*DegenerateError).Kind != Indeterminate {
+		t.Errorf("CrossRatioRChecked(v = w = x) error = %v", err)
+	}
+	z, err := new(Hamilton).CrossRatioRChecked(v, w, w, y, ProjectiveInfinity)
+	if err != nil || !z.l.l.IsInf() {
+		t.Errorf("CrossRatioRChecked(w = x) = %v, %v", z, err)
+	}
+	x := NewHamilton(zero, zero, zero, big.NewFloat(1))
+	l := new(Hamilton).CrossRatioL(v, w, x, y)
+	c, err := new(Hamilton).CrossRatioLChecked(v, w, x, y, ReportDegeneracy)
+	if err != nil || !c.Equals(l) {
+		t.Errorf("CrossRatioLChecked = %v, %v, want %v", c, err, l)
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+	"strings"
+)
+
+// pow10 returns 10^e as an exact rational.
+func pow10(e int) *big.Rat {
+	m := int64(e)
+	if m < 0 {
+		m = -m
+	}
+	p := new(big.Int).Exp(big.NewInt(10), big.NewInt(m), nil)
+	if e < 0 {
+		return new(big.Rat).SetFrac(big.NewInt(1), p)
+	}
+	return new(big.Rat).SetInt(p)
+}
+
+// decimalExp returns the decimal exponent e of the positive rational q, with
+// 10^e ≤ q < 10^(e+1).
+func decimalExp(q *big.Rat) int {
+	exp := new(big.Float).SetRat(q).MantExp(nil)
+	e := int(math.Floor(float64(exp-1) * math.Log10(2)))
+	for pow10(e).Cmp(q) > 0 {
+		e--
+	}
+	for pow10(e+1).Cmp(q) <= 0 {
+		e++
+	}
+	return e
+}
+
+// roundDecimal returns the positive rational q rounded to n significant
+// decimal digits, with ties to even, as an integer d of n digits and a
+// decimal exponent e, so that q ≈ d × 10^(e-n+1).
+func roundDecimal(q *big.Rat, n int) (*big.Int, int) {
+	e := decimalExp(q)
+	s := new(big.Rat).Mul(q, pow10(n-1-e))
+	d, r := new(big.Int).QuoRem(s.Num(), s.Denom(), new(big.Int))
+	switch r.Lsh(r, 1).Cmp(s.Denom()) {
+	case 1:
+		d.Add(d, big.NewInt(1))
+	case 0:
+		if d.Bit(0) == 1 {
+			d.Add(d, big.NewInt(1))
+		}
+	}
+	// Rounding up can carry into a new digit.
+	if len(d.String()) > n {
+		d.Quo(d, big.NewInt(10))
+		e++
+	}
+	return d, e
+}
+
+// DecimalDigits returns the first n significant decimal digits of the
+// absolute value of x, correctly rounded to nearest with ties to even, and
+// the decimal exponent e, so that
+// 		|x| ≈ d₁.d₂d₃...dₙ × 10^e
+// Since x is a dyadic rational, the digits are those of its exact value.
+//
+// The radius rad bounds the error of x, as for a value known only to lie in
+// [x - rad, x + rad]; a nil radius means that x is exact. DecimalDigits also
+// returns true if every value in that interval has the same sign as x and
+// rounds to the same digits and exponent, so that the digits are the correct
+// rounding of the unknown value. If it returns false, then the rounding is
+// ambiguous, and x must be computed to higher precision. Zero has n zero
+// digits and exponent 0. If n is not positive or x is infinite, then
+// DecimalDigits panics.
+func DecimalDigits(x *big.Float, n int, rad *big.Float) (string, int, bool) {
+	if n <= 0 {
+		panic("non-positive digit count")
+	}
+	if x.IsInf() {
+		panic("infinite value")
+	}
+	exact := rad == nil || rad.Sign() == 0
+	if x.Sign() == 0 {
+		return strings.Repeat("0", n), 0, exact
+	}
+	q, _ := new(big.Float).Abs(x).Rat(nil)
+	d, e := roundDecimal(q, n)
+	digits := d.String()
+	if exact {
+		return digits, e, true
+	}
+	if rad.IsInf() {
+		return digits, e, false
+	}
+	r, _ := new(big.Float).Abs(rad).Rat(nil)
+	lo := new(big.Rat).Sub(q, r)
+	if lo.Sign() <= 0 {
+		return digits, e, false
+	}
+	hi := new(big.Rat).Add(q, r)
+	// Rounding is monotonic, so the interval rounds to one result exactly
+	// when its endpoints do.
+	dlo, elo := roundDecimal(lo, n)
+	dhi, ehi := roundDecimal(hi, n)
+	ok := elo == e && ehi == e && dlo.Cmp(d) == 0 && dhi.Cmp(d) == 0
+	return digits, e, ok
+}
+
+// DecimalDigitsVerified calls f with increasing precision until the value it
+// returns has n correctly rounded decimal digits, as decided by DecimalDigits
+// with the error radius it returns. The first precision is enough bits for n
+// decimal digits, plus the guard bits, and it doubles each time the rounding
+// is ambiguous. It returns the digits and the decimal exponent of the last
+// value, and true if they are correctly rounded, or false if the precision
+// would exceed limit. The function f should return a value and a bound on its
+// error at the given precision.
+func DecimalDigitsVerified(f func(prec uint) (x, rad *big.Float), n int, limit uint) (string, int, bool) {
+	prec := uint(math.Ceil(float64(n)*math.Log2(10))) + guardBits
+	for {
+		x, rad := f(prec)
+		digits, e, ok := DecimalDigits(x, n, rad)
+		if ok || 2*prec > limit {
+			return digits, e, ok
+		}
+		prec *= 2
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestDecimalDigits(t *testing.T) {
+	tests := []struct {
+		x      float64
+		n      int
+		digits string
+		exp    int
+	}{
+		{1, 3, "100", 0},
+		{0.125, 2, "12", -1},
+		{0.375, 2, "38", -1},
+		{-1234.5, 4, "1234", 3},
+		{9.96, 2, "10", 1},
+		{0.1, 20, "10000000000000000555", -1},
+		{0, 4, "0000", 0},
+	}
+	for _, tt := range tests {
+		digits, exp, ok := DecimalDigits(big.NewFloat(tt.x), tt.n, nil)
+		if digits != tt.digits || exp != tt.exp || !ok {
+			t.Errorf("DecimalDigits(%v, %d) = %q, %d, %v, want %q, %d", tt.x, tt.n, digits, exp, ok, tt.digits, tt.exp)
+		}
+	}
+}
+
+func TestDecimalDigitsAmbiguous(t *testing.T) {
+	// 0.125 ± 2^(-20) straddles the tie between 0.12 and 0.13.
+	x := big.NewFloat(0.125)
+	rad := new(big.Float).SetMantExp(big.NewFloat(1), -20)
+	if _, _, ok := DecimalDigits(x, 2, rad); ok {
+		t.Errorf("DecimalDigits(%v ± %v, 2) is not ambiguous", x, rad)
+	}
+	if digits, _, ok := DecimalDigits(x, 1, rad); digits != "1" || !ok {
+		t.Errorf("DecimalDigits(%v ± %v, 1) = %q, %v", x, rad, digits, ok)
+	}
+	// An interval around 9.995 can round to 9.99 or 10.0.
+	if _, _, ok := DecimalDigits(big.NewFloat(9.995), 3, rad); ok {
+		t.Error("DecimalDigits(9.995 ± 2^(-20), 3) is not ambiguous")
+	}
+	if _, _, ok := DecimalDigits(new(big.Float), 3, rad); ok {
+		t.Error("DecimalDigits(0 ± 2^(-20), 3) is not ambiguous")
+	}
+}
+
+func TestDecimalDigitsVerified(t *testing.T) {
+	want := "31415926535897932384626433832795028841971693993751"
+	f := func(prec uint) (*big.Float, *big.Float) {
+		pi := bigPi(prec)
+		ulp := new(big.Float).SetMantExp(big.NewFloat(1), pi.MantExp(nil)-int(prec))
+		return pi, ulp
+	}
+	digits, exp, ok := DecimalDigitsVerified(f, len(want), 1000)
+	if digits != want || exp != 0 || !ok {
+		t.Errorf("DecimalDigitsVerified(π, %d) = %q, %d, %v", len(want), digits, exp, ok)
+	}
+}
@@ -3,4 +3,17 @@
 
 // Package bigfloat implements multi-precision floating-point arithmetic for
 // many Cayley-Dickson constructs.
+//
+// Concurrency
+//
+// Like big.Float, the types in this package are not safe for concurrent use.
+// Methods write their result into the receiver, and a value may be read by
+// any number of goroutines only as long as no goroutine writes to it. In
+// particular, a value must not be used as the receiver in one goroutine while
+// it is an operand in another. The Sync types, such as SyncComplex and
+// SyncHamilton, wrap a value with a mutex for state that is shared between
+// goroutines; each goroutine should Load a private copy, compute with it, and
+// Store or Update the result. Sync types exist for Complex, Perplex, Infra,
+// Hamilton, Cockle, InfraComplex, and Supra only; values of the other types
+// must be guarded by the caller in the same way.
 package bigfloat
@@ -0,0 +1,179 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+)
+
+// A ConversionLoss reports an algebraic property that a high-precision value,
+// or pair of values, satisfied to within the tolerance of an audit, and that
+// its conversion to float64 no longer satisfies.
+type ConversionLoss struct {
+	// Property is "unit", "composition", or "orthogonality".
+	Property string
+	// Index is the index of the value, or of the first value of a pair.
+	Index int
+	// Before and After are the defects of the property for the original and
+	// the converted values. After is computed in float64 arithmetic, as a
+	// downstream consumer would compute it, and may be +Inf or NaN if the
+	// conversion overflowed.
+	Before, After float64
+}
+
+// broken reports whether a property with defects before and after a
+// conversion held before it but not after it.
+func broken(before, after, tol float64) bool {
+	return before <= tol && !(after <= tol)
+}
+
+// relDefect returns |x - y| / |y|, or |x - y| if y is zero.
+func relDefect(x, y *big.Float) float64 {
+	d := exactSub(x, y)
+	d.Abs(d)
+	if y.Sign() != 0 {
+		d = newFloat(64).Quo(d, new(big.Float).Abs(y))
+	}
+	f, _ := d.Float64()
+	return f
+}
+
+// relDefect64 returns |x - y| / |y|, or |x - y| if y is zero.
+func relDefect64(x, y float64) float64 {
+	if y == 0 {
+		return math.Abs(x - y)
+	}
+	return math.Abs((x - y) / y)
+}
+
+// AuditComplex128 converts the values z to complex128 and reports, in order,
+// the properties broken by the conversion by more than tol:
+// 		unit: |Quad(z[k]) - 1|, for each value that had unit modulus
+// 		composition: the relative defect of Quad(Mul(z[k], z[k+1])) against
+// 		Mul(Quad(z[k]), Quad(z[k+1])), for each consecutive pair
+// Components outside the range of float64 become infinities or zeros, which
+// typically break both properties.
+func AuditComplex128(z []*Complex, tol float64) ([]complex128, []ConversionLoss) {
+	c := make([]complex128, len(z))
+	for k, v := range z {
+		a, _ := v.l.Float64()
+		b, _ := v.r.Float64()
+		c[k] = complex(a, b)
+	}
+	quad64 := func(v complex128) float64 {
+		return real(v)*real(v) + imag(v)*imag(v)
+	}
+	var loss []ConversionLoss
+	one := big.NewFloat(1)
+	for k, v := range z {
+		before := relDefect(v.Quad(), one)
+		after := math.Abs(quad64(c[k]) - 1)
+		if broken(before, after, tol) {
+			loss = append(loss, ConversionLoss{"unit", k, before, after})
+		}
+	}
+	for k := 0; k+1 < len(z); k++ {
+		x, y := z[k], z[k+1]
+		want := new(big.Float).Mul(x.Quad(), y.Quad())
+		before := relDefect(new(Complex).Mul(x, y).Quad(), want)
+		after := relDefect64(quad64(c[k]*c[k+1]), quad64(c[k])*quad64(c[k+1]))
+		if broken(before, after, tol) {
+			loss = append(loss, ConversionLoss{"composition", k, before, after})
+		}
+	}
+	return c, loss
+}
+
+// AuditHamiltonFloat64 converts the values q to arrays of four float64
+// components, in the order of Cartesian, and reports, in order, the properties
+// broken by the conversion by more than tol:
+// 		unit: |Quad(q[k]) - 1|, for each value that had unit modulus
+// 		composition: the relative defect of Quad(Mul(q[k], q[k+1])) against
+// 		Mul(Quad(q[k]), Quad(q[k+1])), for each consecutive pair
+func AuditHamiltonFloat64(q []*Hamilton, tol float64) ([][4]float64, []ConversionLoss) {
+	c := make([][4]float64, len(q))
+	for k, v := range q {
+		a, b, e, f := v.Cartesian()
+		for i, x := range []*big.Float{a, b, e, f} {
+			c[k][i], _ = x.Float64()
+		}
+	}
+	quad64 := func(v [4]float64) float64 {
+		return v[0]*v[0] + v[1]*v[1] + v[2]*v[2] + v[3]*v[3]
+	}
+	mul64 := func(x, y [4]float64) [4]float64 {
+		return [4]float64{
+			x[0]*y[0] - x[1]*y[1] - x[2]*y[2] - x[3]*y[3],
+			x[0]*y[1] + x[1]*y[0] + x[2]*y[3] - x[3]*y[2],
+			x[0]*y[2] - x[1]*y[3] + x[2]*y[0] + x[3]*y[1],
+			x[0]*y[3] + x[1]*y[2] - x[2]*y[1] + x[3]*y[0],
+		}
+	}
+	var loss []ConversionLoss
+	one := big.NewFloat(1)
+	for k, v := range q {
+		before := relDefect(v.Quad(), one)
+		after := math.Abs(quad64(c[k]) - 1)
+		if broken(before, after, tol) {
+			loss = append(loss, ConversionLoss{"unit", k, before, after})
+		}
+	}
+	for k := 0; k+1 < len(q); k++ {
+		x, y := q[k], q[k+1]
+		want := new(big.Float).Mul(x.Quad(), y.Quad())
+		before := relDefect(new(Hamilton).Mul(x, y).Quad(), want)
+		after := relDefect64(quad64(mul64(c[k], c[k+1])), quad64(c[k])*quad64(c[k+1]))
+		if broken(before, after, tol) {
+			loss = append(loss, ConversionLoss{"composition", k, before, after})
+		}
+	}
+	return c, loss
+}
+
+// AuditRotationsFloat64 converts the 3x3 matrices m, such as those returned by
+// ToRotationMatrix, to float64 and reports the matrices whose orthogonality is
+// broken by the conversion by more than tol. The defect of a matrix R is the
+// largest entry of |Rᵀ R - I|.
+func AuditRotationsFloat64(m [][3][3]*big.Float, tol float64) ([][3][3]float64, []ConversionLoss) {
+	c := make([][3][3]float64, len(m))
+	for k := range m {
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				c[k][i][j], _ = m[k][i][j].Float64()
+			}
+		}
+	}
+	var loss []ConversionLoss
+	for k, r := range m {
+		before, after := 0.0, 0.0
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				prec := maxPrec(r[0][i], r[1][i], r[2][i], r[0][j], r[1][j], r[2][j])
+				s := newFloat(prec + guardBits)
+				s64 := 0.0
+				for l := 0; l < 3; l++ {
+					s.Add(s, newFloat(prec+guardBits).Mul(r[l][i], r[l][j]))
+					s64 += c[k][l][i] * c[k][l][j]
+				}
+				want, want64 := new(big.Float), 0.0
+				if i == j {
+					want.SetInt64(1)
+					want64 = 1
+				}
+				if d := relDefect(s, want); d > before {
+					before = d
+				}
+				// Keep a NaN once it appears.
+				if d := math.Abs(s64 - want64); d > after || math.IsNaN(d) {
+					after = d
+				}
+			}
+		}
+		if broken(before, after, tol) {
+			loss = append(loss, ConversionLoss{"orthogonality", k, before, after})
+		}
+	}
+	return c, loss
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestAuditComplex128(t *testing.T) {
+	// (1+i)/√2 has unit modulus, but its components are not float64 values.
+	h := newFloat(200).Sqrt(newFloat(200).SetInt64(2))
+	h.Quo(big.NewFloat(1), h)
+	u := NewComplex(h, h)
+	// Quad overflows float64 for a huge component.
+	huge := NewComplex(newFloat(200).SetFloat64(1e200), newFloat(200))
+	z := []*Complex{u, u, huge}
+	c, loss := AuditComplex128(z, 1e-12)
+	if w := math.Sqrt(0.5); c[0] != complex(w, w) {
+		t.Errorf("c[0] = %v, want (%v+%vi)", c[0], w, w)
+	}
+	if len(loss) != 1 || loss[0].Property != "composition" || loss[0].Index != 1 {
+		t.Fatalf("loss = %+v, want composition at 1", loss)
+	}
+	if a := loss[0].After; !math.IsNaN(a) && !math.IsInf(a, 0) {
+		t.Errorf("loss[0].After = %v, want NaN or Inf", a)
+	}
+	_, loss = AuditComplex128(z[:2], 1e-20)
+	if len(loss) != 2 || loss[0].Property != "unit" || loss[1].Property != "unit" {
+		t.Errorf("loss = %+v, want unit at 0 and 1", loss)
+	}
+	for _, l := range loss {
+		if l.Before > 1e-50 || l.After < 1e-20 || l.After > 1e-15 {
+			t.Errorf("%+v", l)
+		}
+	}
+}
+
+func TestAuditHamiltonFloat64(t *testing.T) {
+	q := NewHamilton(newFloat(200).SetInt64(1), newFloat(200).SetInt64(2),
+		newFloat(200).SetInt64(3), newFloat(200).SetInt64(4))
+	q.Versor(q)
+	r := NewHamilton(newFloat(200).SetFloat64(1e160), newFloat(200),
+		newFloat(200), newFloat(200))
+	c, loss := AuditHamiltonFloat64([]*Hamilton{q, q, r}, 1e-12)
+	if w := 1 / math.Sqrt(30); math.Abs(c[0][3]-4*w) > 1e-15 {
+		t.Errorf("c[0] = %v", c[0])
+	}
+	if len(loss) != 1 || loss[0].Property != "composition" || loss[0].Index != 1 {
+		t.Errorf("loss = %+v, want composition at 1", loss)
+	}
+	_, loss = AuditHamiltonFloat64([]*Hamilton{q}, 1e-20)
+	if len(loss) != 1 || loss[0].Property != "unit" {
+		t.Errorf("loss = %+v, want unit at 0", loss)
+	}
+}
+
+func TestAuditRotationsFloat64(t *testing.T) {
+	q := NewHamilton(newFloat(200).SetInt64(1), newFloat(200).SetInt64(2),
+		newFloat(200).SetInt64(3), newFloat(200).SetInt64(4))
+	m := [][3][3]*big.Float{q.ToRotationMatrix()}
+	// A matrix that was never orthogonal is not reported.
+	var s [3][3]*big.Float
+	for i := range s {
+		for j := range s[i] {
+			s[i][j] = newFloat(200).SetInt64(int64(i + j))
+		}
+	}
+	m = append(m, s)
+	c, loss := AuditRotationsFloat64(m, 1e-12)
+	if len(loss) != 0 {
+		t.Errorf("loss = %+v, want none", loss)
+	}
+	if c[1][2][2] != 4 {
+		t.Errorf("c[1] = %v", c[1])
+	}
+	_, loss = AuditRotationsFloat64(m, 1e-20)
+	if len(loss) != 1 || loss[0].Property != "orthogonality" || loss[0].Index != 0 {
+		t.Errorf("loss = %+v, want orthogonality at 0", loss)
+	}
+}
@@ -0,0 +1,308 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strings"
+)
+
+var symbDualComplex = [4]string{"", "i", "ε", "iε"}
+
+// A DualComplex represents a multi-precision floating-point dual-complex
+// number p + qε, where p and q are complex numbers and ε is a nilpotent unit
+// that anticommutes with i. As an algebra it is InfraComplex, with ε = β and
+// iε = γ, but DualComplex follows the conventions of DualHamilton: its unit
+// values represent rigid motions of the plane, with the points of the plane
+// as complex numbers.
+type DualComplex struct {
+	l, r Complex
+}
+
+// Real returns the real part of z.
+func (z *DualComplex) Real() *big.Float {
+	return (&z.l).Real()
+}
+
+// Cartesian returns the four multi-precision floating-point Cartesian
+// components of z.
+func (z *DualComplex) Cartesian() (*big.Float, *big.Float, *big.Float, *big.Float) {
+	return &z.l.l, &z.l.r, &z.r.l, &z.r.r
+}
+
+// Parts returns the complex part p and the dual part q of z = p + qε.
+func (z *DualComplex) Parts() (*Complex, *Complex) {
+	return &z.l, &z.r
+}
+
+// String returns the string representation of a DualComplex value.
+//
+// If z corresponds to a + bi + cε + diε, then the string is "(a+bi+cε+diε)",
+// similar to complex128 values.
+func (z *DualComplex) String() string {
+	v := make([]*big.Float, 4)
+	v[0], v[1], v[2], v[3] = z.Cartesian()
+	a := make([]string, 9)
+	a[0] = "("
+	a[1] = fmt.Sprintf("%v", v[0])
+	i := 1
+	for j := 2; j < 8; j = j + 2 {
+		if v[i].Sign() < 0 {
+			a[j] = fmt.Sprintf("%v", v[i])
+		} else {
+			a[j] = fmt.Sprintf("+%v", v[i])
+		}
+		a[j+1] = symbDualComplex[i]
+		i++
+	}
+	a[8] = ")"
+	return strings.Join(a, "")
+}
+
+// Format implements fmt.Formatter, with the conventions of the Format method
+// of Complex.
+func (z *DualComplex) Format(s fmt.State, verb rune) {
+	formatTerms(s, verb, z, symbDualComplex[:], floatSlice(z.Cartesian()))
+}
+
+// Equals returns true if y and z are equal.
+func (z *DualComplex) Equals(y *DualComplex) bool {
+	if !z.l.Equals(&y.l) || !z.r.Equals(&y.r) {
+		return false
+	}
+	return true
+}
+
+// Copy copies y onto z, and returns z.
+func (z *DualComplex) Copy(y *DualComplex) *DualComplex {
+	z.l.Copy(&y.l)
+	z.r.Copy(&y.r)
+	return z
+}
+
+// NewDualComplex returns a pointer to the DualComplex value p + qε.
+func NewDualComplex(p, q *Complex) *DualComplex {
+	z := new(DualComplex)
+	z.l.Copy(p)
+	z.r.Copy(q)
+	return z
+}
+
+// Scal sets z equal to y scaled by a, and returns z.
+func (z *DualComplex) Scal(y *DualComplex, a *big.Float) *DualComplex {
+	z.l.Scal(&y.l, a)
+	z.r.Scal(&y.r, a)
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *DualComplex) Neg(y *DualComplex) *DualComplex {
+	z.l.Neg(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Conj sets z equal to the conjugate of y, and returns z. If y = p + qε, then
+// the conjugate is
+// 		Conj(p) - qε
+// which reverses products and is the inverse of y when y is a unit
+// dual-complex number.
+func (z *DualComplex) Conj(y *DualComplex) *DualComplex {
+	z.l.Conj(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// DualConj sets z equal to the dual conjugate of y, and returns z. If
+// y = p + qε, then the dual conjugate is p - qε.
+func (z *DualComplex) DualConj(y *DualComplex) *DualComplex {
+	z.l.Copy(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Add sets z equal to x+y, and returns z.
+func (z *DualComplex) Add(x, y *DualComplex) *DualComplex {
+	z.l.Add(&x.l, &y.l)
+	z.r.Add(&x.r, &y.r)
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z.
+func (z *DualComplex) Sub(x, y *DualComplex) *DualComplex {
+	z.l.Sub(&x.l, &y.l)
+	z.r.Sub(&x.r, &y.r)
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z.
+//
+// With x = p + qε and y = r + sε, the product is
+// 		Mul(p, r) + (Mul(p, s) + Mul(q, Conj(r)))ε
+// since Mul(ε, i) = -Mul(i, ε) and Mul(ε, ε) = 0. This binary operation is
+// noncommutative but associative.
+// Unless the components of x or y differ widely in scale, the product is
+// computed exactly and then rounded once, so the result is exact whenever it
+// fits the precision of z; otherwise it is computed with rounded arithmetic.
+func (z *DualComplex) Mul(x, y *DualComplex) *DualComplex {
+	a, b, c, d := x.Cartesian()
+	s, t, u, v := y.Cartesian()
+	prec := maxPrec(a, b, c, d, s, t, u, v)
+	z0, z1, z2, z3 := z.Cartesian()
+	if fitsExact(prec,
+		[]*big.Float{z0, z1, z2, z3},
+		[]*big.Float{a, b, c, d},
+		[]*big.Float{s, t, u, v},
+	) {
+		l := new(Complex).mulExact(&x.l, &y.l)
+		r := new(Complex).addExact(
+			new(Complex).mulExact(&x.l, &y.r),
+			new(Complex).mulExact(&x.r, new(Complex).Conj(&y.l)),
+		)
+		z.l.roundComplex(l, prec)
+		z.r.roundComplex(r, prec)
+		return z
+	}
+	mulDoubling(0, &z.l, &z.r, &x.l, &x.r, &y.l, &y.r)
+	return z
+}
+
+// Commutator sets z equal to the commutator of x and y:
+// 		Mul(x, y) - Mul(y, x)
+// Then it returns z.
+func (z *DualComplex) Commutator(x, y *DualComplex) *DualComplex {
+	return z.Sub(
+		new(DualComplex).Mul(x, y),
+		new(DualComplex).Mul(y, x),
+	)
+}
+
+// Quad returns the quadrance of z. If z = p + qε, then the quadrance is
+// Quad(p), the real part of Mul(z, Conj(z)), which has no other part. This is
+// always non-negative, and it is 1 for a unit dual-complex number.
+func (z *DualComplex) Quad() *big.Float {
+	return z.l.Quad()
+}
+
+// IsZeroDiv returns true if z is a zero divisor. This is equivalent to the
+// complex part p of z = p + qε being zero.
+func (z *DualComplex) IsZeroDiv() bool {
+	zero := new(Complex)
+	return z.l.Equals(zero)
+}
+
+// Inv sets z equal to the inverse of y, and returns z. The inverse is
+// Conj(y)/Quad(y). If y is a zero divisor, then Inv panics.
+func (z *DualComplex) Inv(y *DualComplex) *DualComplex {
+	if y.IsZeroDiv() {
+		panic("inverse of zero divisor")
+	}
+	quad := y.Quad()
+	z.Conj(y)
+	z.l.l.Quo(&z.l.l, quad)
+	z.l.r.Quo(&z.l.r, quad)
+	z.r.l.Quo(&z.r.l, quad)
+	z.r.r.Quo(&z.r.r, quad)
+	return z
+}
+
+// NewDualComplexFromRigid returns a pointer to the unit dual-complex number of
+// the rigid motion that rotates the plane about the origin by the angle theta,
+// in radians, and then translates it by t:
+// 		r + (1/2)Mul(t, Conj(r))ε
+// where r = cos(theta/2) + i sin(theta/2). The components are rounded to the
+// largest precision of theta and t.
+func NewDualComplexFromRigid(theta *big.Float, t *Complex) *DualComplex {
+	tx, ty := t.Cartesian()
+	prec := maxPrec(theta, tx, ty)
+	w := prec + guardBits
+	half := newFloat(w).SetMantExp(theta, -1)
+	sin, cos := bigSinCos(half, w)
+	z := new(DualComplex)
+	z.l.l.SetPrec(w).Set(cos)
+	z.l.r.SetPrec(w).Set(sin)
+	s := new(Complex).Conj(&z.l)
+	s.Mul(new(Complex).round(t, w), s)
+	z.r.l.SetMantExp(&s.l, -1)
+	z.r.r.SetMantExp(&s.r, -1)
+	return z.setPrec(z, prec)
+}
+
+// Rigid returns the rotation angle theta, in (-π, π], and the translation t
+// of the rigid motion represented by z, which undo NewDualComplexFromRigid
+// for a unit dual-complex number, and a non-zero multiple of one. If
+// z = p + qε, then theta is the argument of Mul(p, p) and
+// 		t = 2Mul(p, q)/Quad(p)
+// The components are rounded to the precision of z. If z is a zero divisor,
+// then Rigid panics.
+func (z *DualComplex) Rigid() (*big.Float, *Complex) {
+	if z.IsZeroDiv() {
+		panic("rigid motion of zero divisor")
+	}
+	a, b, c, d := z.Cartesian()
+	prec := maxPrec(a, b, c, d)
+	x := new(DualComplex).setPrec(z, prec+guardBits)
+	p2 := new(Complex).Mul(&x.l, &x.l)
+	theta := bigAtan2(&p2.r, &p2.l, prec+guardBits)
+	t := new(Complex).Mul(&x.l, &x.r)
+	quad := x.l.Quad()
+	quad.SetMantExp(quad, -1)
+	return newFloat(prec).Set(theta), NewComplex(
+		newFloat(prec).Quo(&t.l, quad),
+		newFloat(prec).Quo(&t.r, quad),
+	)
+}
+
+// Transform returns the point v of the plane moved by the rigid motion
+// represented by z. It is given by the sandwich
+// 		Mul(Mul(z, 1 + vε), DualConj(Conj(z))) = Quad(z)(1 + Transform(v)ε)
+// so that if z = p + qε, then
+// 		Transform(v) = (Mul(Mul(p, p), v) + 2Mul(p, q))/Quad(p)
+// which rotates v by the argument of Mul(p, p) and then translates it. The
+// components are rounded to the largest precision of z and v. If z is a zero
+// divisor, then Transform panics.
+func (z *DualComplex) Transform(v *Complex) *Complex {
+	if z.IsZeroDiv() {
+		panic("rigid motion of zero divisor")
+	}
+	a, b, c, d := z.Cartesian()
+	vx, vy := v.Cartesian()
+	prec := maxPrec(a, b, c, d, vx, vy)
+	p2 := new(Complex).mulExact(&z.l, &z.l)
+	pq := new(Complex).mulExact(&z.l, &z.r)
+	pq.l.SetMantExp(&pq.l, 1)
+	pq.r.SetMantExp(&pq.r, 1)
+	u := new(Complex).addExact(new(Complex).mulExact(p2, v), pq)
+	quad := exactAdd(exactMul(a, a), exactMul(b, b))
+	return NewComplex(
+		newFloat(prec).Quo(&u.l, quad),
+		newFloat(prec).Quo(&u.r, quad),
+	)
+}
+
+// setPrec sets z equal to y with every component rounded to prec bits, and
+// returns z.
+func (z *DualComplex) setPrec(y *DualComplex, prec uint) *DualComplex {
+	z.l.round(&y.l, prec)
+	z.r.round(&y.r, prec)
+	return z
+}
+
+// Generate returns a random DualComplex value for quick.Check testing.
+func (z *DualComplex) Generate(rand *rand.Rand, size int) reflect.Value {
+	randomDualComplex := &DualComplex{
+		*NewComplex(
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+		),
+		*NewComplex(
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+		),
+	}
+	return reflect.ValueOf(randomDualComplex)
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+// closeToDualComplex returns true if the components of x and y agree to about
+// bits bits, relative to the larger of their modulus and 1.
+func closeToDualComplex(x, y *DualComplex, bits int) bool {
+	return closeToComplex(&x.l, &y.l, bits) && closeToComplex(&x.r, &y.r, bits)
+}
+
+// randomPlanarRigid returns the unit dual-complex number of the rigid motion at
+// 200 bits with the angle 2πa and the translation t.
+func randomPlanarRigid(a float64, t *Complex) *DualComplex {
+	theta := newFloat(200).SetFloat64(2 * math.Pi * a)
+	return NewDualComplexFromRigid(theta, new(Complex).round(t, 200))
+}
+
+func TestDualComplexInfraComplex(t *testing.T) {
+	// DualComplex is InfraComplex with ε = β and iε = γ.
+	f := func(x, y *DualComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a, b, c, d := x.Cartesian()
+		u := NewInfraComplex(a, b, c, d)
+		a, b, c, d = y.Cartesian()
+		v := NewInfraComplex(a, b, c, d)
+		s, t, p, q := new(DualComplex).Mul(x, y).Cartesian()
+		a, b, c, d = new(InfraComplex).Mul(u, v).Cartesian()
+		return s.Cmp(a) == 0 && t.Cmp(b) == 0 && p.Cmp(c) == 0 && q.Cmp(d) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDualComplexMulConjAntiDistributive(t *testing.T) {
+	f := func(x, y *DualComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l, r := new(DualComplex), new(DualComplex)
+		l.Conj(l.Mul(x, y))
+		r.Mul(r.Conj(y), new(DualComplex).Conj(x))
+		return closeToDualComplex(l, r, 48)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDualComplexInv(t *testing.T) {
+	f := func(x *DualComplex) bool {
+		// t.Logf("x = %v", x)
+		inv := new(DualComplex).Inv(x)
+		one := NewDualComplex(NewComplex(big.NewFloat(1), new(big.Float)), new(Complex))
+		return closeToDualComplex(new(DualComplex).Mul(x, inv), one, 45) &&
+			closeToDualComplex(new(DualComplex).Mul(inv, x), one, 45)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDualComplexRigid(t *testing.T) {
+	f := func(x, s *Complex) bool {
+		// t.Logf("x = %v, s = %v", x, s)
+		a, _ := s.l.Float64()
+		z := randomPlanarRigid(a-0.5, x)
+		theta, tr := z.Rigid()
+		want := newFloat(200).SetFloat64(2 * math.Pi * (a - 0.5))
+		zero := new(big.Float)
+		return closeToComplex(NewComplex(theta, zero), NewComplex(want, zero), 180) &&
+			closeToComplex(tr, x, 180) && closeTo(z.Quad(), big.NewFloat(1), 180)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDualComplexTransform(t *testing.T) {
+	f := func(x, y, v, s *Complex) bool {
+		// t.Logf("x = %v, y = %v, v = %v, s = %v", x, y, v, s)
+		a, _ := s.l.Float64()
+		b, _ := s.r.Float64()
+		z, w := randomPlanarRigid(a, x), randomPlanarRigid(b, y)
+		v = new(Complex).round(v, 200)
+		// The sandwich moves the point v.
+		p := NewDualComplex(NewComplex(newFloat(200).SetInt64(1), newFloat(200)), v)
+		p.Mul(z, p)
+		p.Mul(p, new(DualComplex).DualConj(new(DualComplex).Conj(z)))
+		one := NewComplex(big.NewFloat(1), new(big.Float))
+		if !closeToComplex(&p.l, one, 180) || !closeToComplex(&p.r, z.Transform(v), 180) {
+			return false
+		}
+		// Rotate by 2πa, then translate by x.
+		sin, cos := math.Sincos(2 * math.Pi * a)
+		vx, _ := v.l.Float64()
+		vy, _ := v.r.Float64()
+		tx, _ := x.l.Float64()
+		ty, _ := x.r.Float64()
+		want := NewComplex(big.NewFloat(cos*vx-sin*vy+tx), big.NewFloat(sin*vx+cos*vy+ty))
+		if !closeToComplex(z.Transform(v), want, 45) {
+			return false
+		}
+		// Mul composes the motions, applying the right one first.
+		zw := new(DualComplex).Mul(z, w)
+		return closeToComplex(zw.Transform(v), z.Transform(w.Transform(v)), 180)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDualComplexCommutatorAlias(t *testing.T) {
+	f := func(x, y *DualComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		return commutatorAliased(x, y)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
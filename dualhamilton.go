@@ -0,0 +1,414 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strings"
+)
+
+var symbDualHamilton = [8]string{"", "i", "j", "k", "ε", "εi", "εj", "εk"}
+
+// A DualHamilton represents a multi-precision floating-point dual quaternion
+// p + εq, where p and q are Hamilton quaternions and ε is a nilpotent unit
+// that commutes with i, j, and k. Unlike InfraHamilton, which doubles Hamilton
+// with the Cayley-Dickson formula, DualHamilton is associative, and its unit
+// values represent rigid motions of three-dimensional space.
+type DualHamilton struct {
+	l, r Hamilton
+}
+
+// Real returns the real part of z.
+func (z *DualHamilton) Real() *big.Float {
+	return (&z.l).Real()
+}
+
+// Cartesian returns the eight multi-precision floating-point Cartesian
+// components of z.
+func (z *DualHamilton) Cartesian() (*big.Float, *big.Float, *big.Float, *big.Float, *big.Float, *big.Float, *big.Float, *big.Float) {
+	a, b, c, d := z.l.Cartesian()
+	e, f, g, h := z.r.Cartesian()
+	return a, b, c, d, e, f, g, h
+}
+
+// Parts returns the quaternion part p and the dual part q of z = p + εq.
+func (z *DualHamilton) Parts() (*Hamilton, *Hamilton) {
+	return &z.l, &z.r
+}
+
+// String returns the string representation of a DualHamilton value.
+//
+// If z corresponds to a + bi + cj + dk + eε + fεi + gεj + hεk, then the string
+// is "(a+bi+cj+dk+eε+fεi+gεj+hεk)", similar to complex128 values.
+func (z *DualHamilton) String() string {
+	v := make([]*big.Float, 8)
+	v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7] = z.Cartesian()
+	a := make([]string, 17)
+	a[0] = "("
+	a[1] = fmt.Sprintf("%v", v[0])
+	i := 1
+	for j := 2; j < 16; j = j + 2 {
+		if v[i].Sign() < 0 {
+			a[j] = fmt.Sprintf("%v", v[i])
+		} else {
+			a[j] = fmt.Sprintf("+%v", v[i])
+		}
+		a[j+1] = symbDualHamilton[i]
+		i++
+	}
+	a[16] = ")"
+	return strings.Join(a, "")
+}
+
+// Format implements fmt.Formatter, with the conventions of the Format method
+// of Complex.
+func (z *DualHamilton) Format(s fmt.State, verb rune) {
+	formatTerms(s, verb, z, symbDualHamilton[:], floatSlice(z.Cartesian()))
+}
+
+// Equals returns true if y and z are equal.
+func (z *DualHamilton) Equals(y *DualHamilton) bool {
+	if !z.l.Equals(&y.l) || !z.r.Equals(&y.r) {
+		return false
+	}
+	return true
+}
+
+// Copy copies y onto z, and returns z.
+func (z *DualHamilton) Copy(y *DualHamilton) *DualHamilton {
+	z.l.Copy(&y.l)
+	z.r.Copy(&y.r)
+	return z
+}
+
+// NewDualHamilton returns a pointer to the DualHamilton value p + εq.
+func NewDualHamilton(p, q *Hamilton) *DualHamilton {
+	z := new(DualHamilton)
+	z.l.Copy(p)
+	z.r.Copy(q)
+	return z
+}
+
+// Scal sets z equal to y scaled by a, and returns z.
+func (z *DualHamilton) Scal(y *DualHamilton, a *big.Float) *DualHamilton {
+	z.l.Scal(&y.l, a)
+	z.r.Scal(&y.r, a)
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *DualHamilton) Neg(y *DualHamilton) *DualHamilton {
+	z.l.Neg(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Conj sets z equal to the quaternion conjugate of y, and returns z. If
+// y = p + εq, then the conjugate is
+// 		Conj(p) + εConj(q)
+// which is the inverse of y when y is a unit dual quaternion.
+func (z *DualHamilton) Conj(y *DualHamilton) *DualHamilton {
+	z.l.Conj(&y.l)
+	z.r.Conj(&y.r)
+	return z
+}
+
+// DualConj sets z equal to the dual conjugate of y, and returns z. If
+// y = p + εq, then the dual conjugate is p - εq.
+func (z *DualHamilton) DualConj(y *DualHamilton) *DualHamilton {
+	z.l.Copy(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Add sets z equal to x+y, and returns z.
+func (z *DualHamilton) Add(x, y *DualHamilton) *DualHamilton {
+	z.l.Add(&x.l, &y.l)
+	z.r.Add(&x.r, &y.r)
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z.
+func (z *DualHamilton) Sub(x, y *DualHamilton) *DualHamilton {
+	z.l.Sub(&x.l, &y.l)
+	z.r.Sub(&x.r, &y.r)
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z.
+//
+// With x = p + εq and y = r + εs, the product is
+// 		Mul(p, r) + ε(Mul(p, s) + Mul(q, r))
+// since ε commutes with i, j, and k and Mul(ε, ε) = 0. This binary operation
+// is noncommutative but associative.
+// Unless the components of x or y differ widely in scale, the product is
+// computed exactly and then rounded once, so the result is exact whenever it
+// fits the precision of z; otherwise it is computed with rounded arithmetic.
+func (z *DualHamilton) Mul(x, y *DualHamilton) *DualHamilton {
+	a, b, c, d, e, f, g, h := x.Cartesian()
+	s, t, u, v, w, m, n, p := y.Cartesian()
+	prec := maxPrec(a, b, c, d, e, f, g, h, s, t, u, v, w, m, n, p)
+	z0, z1, z2, z3, z4, z5, z6, z7 := z.Cartesian()
+	if fitsExact(prec,
+		[]*big.Float{z0, z1, z2, z3, z4, z5, z6, z7},
+		[]*big.Float{a, b, c, d, e, f, g, h},
+		[]*big.Float{s, t, u, v, w, m, n, p},
+	) {
+		l := new(Hamilton).mulExact(&x.l, &y.l)
+		r := new(Hamilton).addExact(
+			new(Hamilton).mulExact(&x.l, &y.r),
+			new(Hamilton).mulExact(&x.r, &y.l),
+		)
+		z.l.roundHamilton(l, prec)
+		z.r.roundHamilton(r, prec)
+		return z
+	}
+	xl := new(Hamilton).Copy(&x.l)
+	xr := new(Hamilton).Copy(&x.r)
+	yl := new(Hamilton).Copy(&y.l)
+	yr := new(Hamilton).Copy(&y.r)
+	temp := new(Hamilton)
+	z.l.Mul(xl, yl)
+	z.r.Mul(xl, yr)
+	temp.Mul(xr, yl)
+	exact := z.r.Exact() && temp.Exact()
+	z.r.Add(&z.r, temp)
+	if !exact {
+		markRounded(z0, z1, z2, z3, z4, z5, z6, z7)
+	}
+	return z
+}
+
+// Commutator sets z equal to the commutator of x and y:
+// 		Mul(x, y) - Mul(y, x)
+// Then it returns z.
+func (z *DualHamilton) Commutator(x, y *DualHamilton) *DualHamilton {
+	return z.Sub(
+		new(DualHamilton).Mul(x, y),
+		new(DualHamilton).Mul(y, x),
+	)
+}
+
+// Quad returns the quadrance of z. If z = p + εq, then the quadrance is
+// Quad(p), the real part of the dual number Mul(z, Conj(z)). This is always
+// non-negative, and it is 1 for a unit dual quaternion.
+func (z *DualHamilton) Quad() *big.Float {
+	return z.l.Quad()
+}
+
+// IsZeroDiv returns true if z is a zero divisor. This is equivalent to the
+// quaternion part p of z = p + εq being zero.
+func (z *DualHamilton) IsZeroDiv() bool {
+	zero := new(Hamilton)
+	return z.l.Equals(zero)
+}
+
+// Inv sets z equal to the inverse of y, and returns z. If y = p + εq, then
+// the inverse is
+// 		Inv(p) - εMul(Mul(Inv(p), q), Inv(p))
+// If y is a zero divisor, then Inv panics.
+func (z *DualHamilton) Inv(y *DualHamilton) *DualHamilton {
+	if y.IsZeroDiv() {
+		panic("inverse of zero divisor")
+	}
+	p := new(Hamilton).Inv(&y.l)
+	q := new(Hamilton).Mul(p, &y.r)
+	q.Mul(q, p)
+	z.l.Copy(p)
+	z.r.Neg(q)
+	return z
+}
+
+// NewDualHamiltonFromRigid returns a pointer to the unit dual quaternion of
+// the rigid motion that rotates by r and then translates by t:
+// 		r + ε(1/2)Mul(t, r)
+// where t is identified with the pure quaternion xi + yj + zk. The rotation r
+// need not be a unit quaternion; it is normalized first. The components are
+// rounded to the largest precision of r and t. If r is zero, then
+// NewDualHamiltonFromRigid panics.
+func NewDualHamiltonFromRigid(r *Hamilton, t *Vec3) *DualHamilton {
+	a, b, c, d := r.Cartesian()
+	tx, ty, tz := t.Cartesian()
+	prec := maxPrec(a, b, c, d, tx, ty, tz)
+	w := prec + guardBits
+	if zero := new(Hamilton); r.Equals(zero) {
+		panic("rigid motion with zero rotation")
+	}
+	z := new(DualHamilton)
+	z.l.setPrec(r, w)
+	z.l.Versor(&z.l)
+	half := func(x *big.Float) *big.Float {
+		return newFloat(w).SetMantExp(x, -1)
+	}
+	z.r.Mul(NewHamilton(newFloat(w), half(tx), half(ty), half(tz)), &z.l)
+	return z.setPrec(z, prec)
+}
+
+// Rigid returns the unit rotation r and the translation t of the rigid motion
+// represented by z, which undo NewDualHamiltonFromRigid for a unit dual
+// quaternion, and a non-zero multiple of one. If z = p + εq, then r = p/|p| and
+// t is the vector part of
+// 		2Mul(q, Conj(p))/Quad(p)
+// The components are rounded to the precision of z. If z is a zero divisor,
+// then Rigid panics.
+func (z *DualHamilton) Rigid() (*Hamilton, *Vec3) {
+	if z.IsZeroDiv() {
+		panic("rigid motion of zero divisor")
+	}
+	a, b, c, d, e, f, g, h := z.Cartesian()
+	prec := maxPrec(a, b, c, d, e, f, g, h)
+	x := new(DualHamilton).setPrec(z, prec+guardBits)
+	t := new(Hamilton).Mul(&x.r, new(Hamilton).Conj(&x.l))
+	quad := x.l.Quad()
+	quad.SetMantExp(quad, -1)
+	_, tx, ty, tz := t.Cartesian()
+	x.l.Versor(&x.l)
+	return new(Hamilton).setPrec(&x.l, prec), NewVec3(
+		newFloat(prec).Quo(tx, quad),
+		newFloat(prec).Quo(ty, quad),
+		newFloat(prec).Quo(tz, quad),
+	)
+}
+
+// Transform returns the point v moved by the rigid motion represented by z:
+// it is rotated by r and then translated by t, where r and t are given by
+// Rigid. The components are rounded to the largest precision of z and v. If z
+// is a zero divisor, then Transform panics.
+func (z *DualHamilton) Transform(v *Vec3) *Vec3 {
+	a, b, c, d, e, f, g, h := z.Cartesian()
+	vx, vy, vz := v.Cartesian()
+	prec := maxPrec(a, b, c, d, e, f, g, h, vx, vy, vz)
+	w := prec + guardBits
+	u := NewVec3(newFloat(w).Set(vx), newFloat(w).Set(vy), newFloat(w).Set(vz))
+	r, t := new(DualHamilton).setPrec(z, w).Rigid()
+	px, py, pz := r.Rotate(u).Cartesian()
+	tx, ty, tz := t.Cartesian()
+	return NewVec3(
+		newFloat(prec).Add(px, tx),
+		newFloat(prec).Add(py, ty),
+		newFloat(prec).Add(pz, tz),
+	)
+}
+
+// ScLERP sets z equal to the screw linear interpolation between the unit dual
+// quaternions x and y at the fraction s, and returns z:
+// 		Mul(x, Pow(Mul(Conj(x), y), s))
+// The power follows the screw motion from x to y, which rotates about and
+// translates along a fixed axis at constant rates, so s = 0 gives x and s = 1
+// gives y (or -y, which represents the same rigid motion). Of the two dual
+// quaternions of the relative motion, the one with a non-negative real part is
+// used, so the rotation takes the shorter way. The result is rounded to the
+// largest precision of x, y, and s.
+func (z *DualHamilton) ScLERP(x, y *DualHamilton, s *big.Float) *DualHamilton {
+	a, b, c, d, e, f, g, h := x.Cartesian()
+	s0, s1, s2, s3, s4, s5, s6, s7 := y.Cartesian()
+	prec := maxPrec(a, b, c, d, e, f, g, h, s0, s1, s2, s3, s4, s5, s6, s7, s)
+	w := prec + guardBits
+	xw := new(DualHamilton).setPrec(x, w)
+	rel := new(DualHamilton).Conj(xw)
+	rel.Mul(rel, new(DualHamilton).setPrec(y, w))
+	if rel.Real().Sign() < 0 {
+		rel.Neg(rel)
+	}
+	rel.powUnit(rel, s, w)
+	rel.Mul(xw, rel)
+	return z.setPrec(rel, prec)
+}
+
+// powUnit sets z equal to the power of the unit dual quaternion y, with a
+// non-negative real part, to the fraction s, computed at prec bits, and
+// returns z.
+//
+// With y = p + εq, p = cos(θ/2) + n sin(θ/2) for a unit axis n, the screw
+// parameters are the half pitch h and the moment m given by
+// 		q = -h sin(θ/2) + ε(m sin(θ/2) + n h cos(θ/2))
+// and then, with φ = sθ/2,
+// 		Pow(y, s) = cos(φ) + n sin(φ) + ε(-sh sin(φ) + m sin(φ) + n sh cos(φ))
+// If p = 1, then y is a pure translation and Pow(y, s) = 1 + εsq.
+func (z *DualHamilton) powUnit(y *DualHamilton, s *big.Float, prec uint) *DualHamilton {
+	w0, v0, v1, v2, w1, u0, u1, u2 := y.Cartesian()
+	sin := bigHypot(prec, v0, v1, v2)
+	if sin.Sign() == 0 {
+		q := new(Hamilton).Scal(&y.r, s)
+		z.l.Copy(NewHamilton(newFloat(prec).SetInt64(1), newFloat(prec), newFloat(prec), newFloat(prec)))
+		z.r.Copy(q)
+		return z
+	}
+	n := []*big.Float{
+		newFloat(prec).Quo(v0, sin),
+		newFloat(prec).Quo(v1, sin),
+		newFloat(prec).Quo(v2, sin),
+	}
+	h := newFloat(prec).Quo(w1, sin)
+	h.Neg(h)
+	m := make([]*big.Float, 3)
+	for k, u := range []*big.Float{u0, u1, u2} {
+		t := newFloat(prec).Mul(n[k], h)
+		t.Mul(t, w0)
+		m[k] = newFloat(prec).Sub(u, t)
+		m[k].Quo(m[k], sin)
+	}
+	phi := bigAtan2(sin, w0, prec)
+	phi.Mul(phi, s)
+	sinPhi, cosPhi := bigSinCos(phi, prec)
+	sh := newFloat(prec).Mul(s, h)
+	// The scalar and vector parts of the dual part.
+	e := newFloat(prec).Mul(sh, sinPhi)
+	e.Neg(e)
+	f := make([]*big.Float, 3)
+	shCos := newFloat(prec).Mul(sh, cosPhi)
+	for k := range f {
+		f[k] = newFloat(prec).Mul(m[k], sinPhi)
+		f[k].Add(f[k], newFloat(prec).Mul(n[k], shCos))
+	}
+	z.l.Copy(NewHamilton(cosPhi,
+		newFloat(prec).Mul(n[0], sinPhi),
+		newFloat(prec).Mul(n[1], sinPhi),
+		newFloat(prec).Mul(n[2], sinPhi),
+	))
+	z.r.Copy(NewHamilton(e, f[0], f[1], f[2]))
+	return z
+}
+
+// setPrec sets z equal to y with every component rounded to prec bits, and
+// returns z.
+func (z *DualHamilton) setPrec(y *DualHamilton, prec uint) *DualHamilton {
+	z.l.setPrec(&y.l, prec)
+	z.r.setPrec(&y.r, prec)
+	return z
+}
+
+// setPrec sets z equal to y with every component rounded to prec bits, and
+// returns z.
+func (z *Hamilton) setPrec(y *Hamilton, prec uint) *Hamilton {
+	a, b, c, d := y.Cartesian()
+	s, t, u, v := z.Cartesian()
+	s.SetPrec(prec).Set(a)
+	t.SetPrec(prec).Set(b)
+	u.SetPrec(prec).Set(c)
+	v.SetPrec(prec).Set(d)
+	return z
+}
+
+// Generate returns a random DualHamilton value for quick.Check testing.
+func (z *DualHamilton) Generate(rand *rand.Rand, size int) reflect.Value {
+	randomDualHamilton := &DualHamilton{
+		*NewHamilton(
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+		),
+		*NewHamilton(
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+		),
+	}
+	return reflect.ValueOf(randomDualHamilton)
+}
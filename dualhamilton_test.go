@@ -0,0 +1,185 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+// closeToDualHamilton returns true if the components of x and y agree to about
+// bits bits, relative to the larger of their modulus and 1.
+func closeToDualHamilton(x, y *DualHamilton, bits int) bool {
+	return closeToComplex(&x.l.l, &y.l.l, bits) && closeToComplex(&x.l.r, &y.l.r, bits) &&
+		closeToComplex(&x.r.l, &y.r.l, bits) && closeToComplex(&x.r.r, &y.r.r, bits)
+}
+
+// closeToVec3 returns true if the components of u and v agree to about bits
+// bits, relative to the larger of their length and 1.
+func closeToVec3(u, v *Vec3, bits int) bool {
+	ux, uy, uz := u.Cartesian()
+	vx, vy, vz := v.Cartesian()
+	zero := new(big.Float)
+	return closeToComplex(NewComplex(ux, uy), NewComplex(vx, vy), bits) &&
+		closeToComplex(NewComplex(uz, zero), NewComplex(vz, zero), bits)
+}
+
+// randomRigid returns the unit dual quaternion of a random rigid motion at
+// 200 bits, with rotation x and translation v.
+func randomRigid(x *Hamilton, v *Vec3) *DualHamilton {
+	vx, vy, vz := v.Cartesian()
+	t := NewVec3(newFloat(200).Set(vx), newFloat(200).Set(vy), newFloat(200).Set(vz))
+	return NewDualHamiltonFromRigid(setPrecHamilton(x, 200), t)
+}
+
+func TestDualHamiltonBasis(t *testing.T) {
+	one, zero := big.NewFloat(1), new(big.Float)
+	eps := NewDualHamilton(new(Hamilton), NewHamilton(one, zero, zero, zero))
+	i := NewDualHamilton(NewHamilton(zero, one, zero, zero), new(Hamilton))
+	epsi := NewDualHamilton(new(Hamilton), NewHamilton(zero, one, zero, zero))
+	if got := new(DualHamilton).Mul(eps, eps); !got.Equals(new(DualHamilton)) {
+		t.Errorf("Mul(ε, ε) = %v", got)
+	}
+	if l, r := new(DualHamilton).Mul(eps, i), new(DualHamilton).Mul(i, eps); !l.Equals(epsi) || !r.Equals(epsi) {
+		t.Errorf("Mul(ε, i) = %v, Mul(i, ε) = %v", l, r)
+	}
+}
+
+func TestDualHamiltonMulAssociative(t *testing.T) {
+	f := func(x, y, z *DualHamilton) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		l, r := new(DualHamilton), new(DualHamilton)
+		l.Mul(l.Mul(x, y), z)
+		r.Mul(x, r.Mul(y, z))
+		return closeToDualHamilton(l, r, 48)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDualHamiltonMulConjAntiDistributive(t *testing.T) {
+	f := func(x, y *DualHamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l, r := new(DualHamilton), new(DualHamilton)
+		l.Conj(l.Mul(x, y))
+		r.Mul(r.Conj(y), new(DualHamilton).Conj(x))
+		return closeToDualHamilton(l, r, 48)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDualHamiltonInv(t *testing.T) {
+	f := func(x *DualHamilton) bool {
+		// t.Logf("x = %v", x)
+		inv := new(DualHamilton).Inv(x)
+		one := NewDualHamilton(NewHamilton(big.NewFloat(1), new(big.Float), new(big.Float), new(big.Float)), new(Hamilton))
+		return closeToDualHamilton(new(DualHamilton).Mul(x, inv), one, 40) &&
+			closeToDualHamilton(new(DualHamilton).Mul(inv, x), one, 40)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDualHamiltonRigid(t *testing.T) {
+	f := func(x *Hamilton, v *Vec3) bool {
+		// t.Logf("x = %v, v = %v", x, v)
+		z := randomRigid(x, v)
+		if !closeTo(z.Quad(), big.NewFloat(1), 190) {
+			return false
+		}
+		// The conjugate is the inverse.
+		one := NewDualHamilton(NewHamilton(big.NewFloat(1), new(big.Float), new(big.Float), new(big.Float)), new(Hamilton))
+		if !closeToDualHamilton(new(DualHamilton).Mul(z, new(DualHamilton).Conj(z)), one, 190) {
+			return false
+		}
+		r, u := z.Rigid()
+		want := setPrecHamilton(x, 200)
+		want.Versor(want)
+		return closeToComplex(&r.l, &want.l, 190) && closeToComplex(&r.r, &want.r, 190) &&
+			closeToVec3(u, v, 190)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDualHamiltonTransform(t *testing.T) {
+	f := func(x, y *Hamilton, u, v, p *Vec3) bool {
+		// t.Logf("x = %v, y = %v, u = %v, v = %v, p = %v", x, y, u, v, p)
+		a, b := randomRigid(x, u), randomRigid(y, v)
+		px, py, pz := p.Cartesian()
+		q := NewVec3(newFloat(200).Set(px), newFloat(200).Set(py), newFloat(200).Set(pz))
+		// Rotate, then translate.
+		r := setPrecHamilton(x, 200).Rotate(q)
+		want := NewVec3(newFloat(200), newFloat(200), newFloat(200))
+		ux, uy, uz := u.Cartesian()
+		rx, ry, rz := r.Cartesian()
+		want.x.Add(rx, ux)
+		want.y.Add(ry, uy)
+		want.z.Add(rz, uz)
+		if !closeToVec3(a.Transform(q), want, 185) {
+			return false
+		}
+		// The product composes the motions.
+		l := new(DualHamilton).Mul(a, b).Transform(q)
+		return closeToVec3(l, a.Transform(b.Transform(q)), 185)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDualHamiltonScLERPEndpoints(t *testing.T) {
+	f := func(x, y *Hamilton, u, v *Vec3) bool {
+		// t.Logf("x = %v, y = %v, u = %v, v = %v", x, y, u, v)
+		a, b := randomRigid(x, u), randomRigid(y, v)
+		l := new(DualHamilton).ScLERP(a, b, new(big.Float))
+		r := new(DualHamilton).ScLERP(a, b, big.NewFloat(1))
+		if r.Real().Sign()*b.Real().Sign() < 0 {
+			r.Neg(r)
+		}
+		return closeToDualHamilton(l, a, 180) && closeToDualHamilton(r, b, 180)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDualHamiltonScLERPScrew(t *testing.T) {
+	// A screw motion: a quarter turn about the z axis with a rise of 4, and
+	// the motion through the origin that carries (1, 0, 0) to (0, 1, 4).
+	zero, one := newFloat(200), newFloat(200).SetInt64(1)
+	z := [3]*big.Float{zero, zero, one}
+	quarter := newFloat(200).Quo(bigPi(200), newFloat(200).SetInt64(2))
+	a := NewDualHamiltonFromRigid(NewHamilton(one, zero, zero, zero), NewVec3(zero, zero, zero))
+	b := NewDualHamiltonFromRigid(NewHamiltonFromAxisAngle(z, quarter), NewVec3(zero, zero, newFloat(200).SetInt64(4)))
+	mid := new(DualHamilton).ScLERP(a, b, big.NewFloat(0.5))
+	got := mid.Transform(NewVec3(one, zero, zero))
+	h := newFloat(200).Sqrt(newFloat(200).SetFloat64(0.5))
+	want := NewVec3(h, h, newFloat(200).SetInt64(2))
+	if !closeToVec3(got, want, 190) {
+		t.Errorf("ScLERP(a, b, 1/2) moves (1, 0, 0) to %v, want %v", got, want)
+	}
+	// A pure translation is interpolated linearly.
+	c := NewDualHamiltonFromRigid(NewHamilton(one, zero, zero, zero), NewVec3(newFloat(200).SetInt64(6), zero, zero))
+	got = new(DualHamilton).ScLERP(a, c, big.NewFloat(0.25)).Transform(NewVec3(zero, zero, zero))
+	if want := NewVec3(newFloat(200).SetFloat64(1.5), zero, zero); !closeToVec3(got, want, 190) {
+		t.Errorf("ScLERP(a, c, 1/4) moves the origin to %v, want %v", got, want)
+	}
+}
+
+func TestDualHamiltonCommutatorAlias(t *testing.T) {
+	f := func(x, y *DualHamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		return commutatorAliased(x, y)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,405 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math/big"
+
+// The elliptic functions in this file use the parameter m = k², where k is the
+// modulus.
+
+// agmSteps runs the arithmetic-geometric mean iteration on a and b at
+// precision prec, and returns the final mean together with the sequence
+// c[0]², c[1]², ... where c[0]² = a² - b² and c[n+1] = (a[n] - b[n])/2. At each
+// step the square root with |a - b| ≤ |a + b| is chosen, which selects the
+// principal value for complex arguments.
+func agmSteps(a, b *Complex, prec uint) (*Complex, []*Complex) {
+	a = newComplexPrec(prec).round(a, prec)
+	b = newComplexPrec(prec).round(b, prec)
+	c2 := []*Complex{new(Complex).Sub(new(Complex).Mul(a, a), new(Complex).Mul(b, b))}
+	half := big.NewFloat(0.5)
+	for i := 0; i < 4*int(prec); i++ {
+		c := new(Complex).Sub(a, b)
+		c.Scal(c, half)
+		if c.l.Sign() == 0 && c.r.Sign() == 0 {
+			return a, c2
+		}
+		c2 = append(c2, new(Complex).Mul(c, c))
+		an := new(Complex).Add(a, b)
+		an.Scal(an, half)
+		bn := new(Complex).Sqrt(new(Complex).Mul(a, b))
+		if d := new(Complex).Sub(an, bn); d.Quad().Cmp(new(Complex).Add(an, bn).Quad()) > 0 {
+			bn.Neg(bn)
+		}
+		a, b = an, bn
+		// The next c is of order c²/a, which is negligible once c is below
+		// half the precision.
+		if complexExpo(c) < complexExpo(a)-int(prec)/2-1 {
+			return a, c2
+		}
+	}
+	return a, c2
+}
+
+// ellipticKE returns the complete elliptic integrals K(m) and E(m) at
+// precision prec.
+func ellipticKE(m *Complex, prec uint) (*Complex, *Complex) {
+	w := prec + guardBits
+	one := newComplexPrec(w)
+	one.l.SetInt64(1)
+	mm := newComplexPrec(w).round(m, w)
+	if mm.Equals(one) {
+		panic("complete elliptic integral at m = 1")
+	}
+	b := new(Complex).Sqrt(new(Complex).Sub(one, mm))
+	a, c2 := agmSteps(one, b, w)
+	// K = π/(2 agm)
+	k := newComplexPrec(w)
+	k.l.Set(bigPi(w))
+	k = new(Complex).Quo(k, new(Complex).Scal(a, big.NewFloat(2)))
+	// E = K (1 - Σ 2^(n-1) c[n]²)
+	sum := newComplexPrec(w)
+	for n, c := range c2 {
+		t := new(Complex).Copy(c)
+		t.l.SetMantExp(&t.l, n-1)
+		t.r.SetMantExp(&t.r, n-1)
+		sum.Add(sum, t)
+	}
+	e := new(Complex).Sub(one, sum)
+	e.Mul(e, k)
+	return newComplexPrec(prec).round(k, prec), newComplexPrec(prec).round(e, prec)
+}
+
+// EllipticK sets z equal to the complete elliptic integral of the first kind
+// K(m), and returns z. It is computed with the arithmetic-geometric mean
+// 		K(m) = π / (2 agm(1, √(1-m)))
+// The result is rounded to the precision of m. If m is one, then EllipticK
+// panics.
+func (z *Complex) EllipticK(m *Complex) *Complex {
+	k, _ := ellipticKE(m, maxPrec(&m.l, &m.r))
+	return z.Copy(k)
+}
+
+// EllipticE sets z equal to the complete elliptic integral of the second kind
+// E(m), and returns z. It is computed with the arithmetic-geometric mean
+// 		E(m) = K(m) (1 - Σ 2^(n-1) c[n]²)
+// The result is rounded to the precision of m. If m is one, then EllipticE
+// panics.
+func (z *Complex) EllipticE(m *Complex) *Complex {
+	_, e := ellipticKE(m, maxPrec(&m.l, &m.r))
+	return z.Copy(e)
+}
+
+// carlsonConverged returns true if the duplication theorem has brought the
+// three arguments close enough to their mean a for a fifth-order expansion to
+// reach precision prec. The deviations are returned in d.
+func carlsonConverged(a *Complex, x []*Complex, d []*Complex, prec uint) bool {
+	done := true
+	for i := range x {
+		d[i] = new(Complex).Sub(a, x[i])
+		d[i].Quo(new(Complex).Copy(d[i]), a)
+		if d[i].l.Sign() != 0 || d[i].r.Sign() != 0 {
+			if complexExpo(d[i]) > -int(prec)/6-1 {
+				done = false
+			}
+		}
+	}
+	return done
+}
+
+// carlsonStep applies one step of the duplication theorem to x, y, and z:
+// each argument is replaced by (t + λ)/4, where
+// 		λ = √x√y + √y√z + √z√x
+// It returns √z.
+func carlsonStep(x, y, z *Complex) *Complex {
+	sx := new(Complex).Sqrt(x)
+	sy := new(Complex).Sqrt(y)
+	sz := new(Complex).Sqrt(z)
+	lambda := new(Complex).Mul(sx, sy)
+	lambda.Add(lambda, new(Complex).Mul(sy, sz))
+	lambda.Add(lambda, new(Complex).Mul(sz, sx))
+	quarter := big.NewFloat(0.25)
+	x.Scal(x.Add(x, lambda), quarter)
+	y.Scal(y.Add(y, lambda), quarter)
+	z.Scal(z.Add(z, lambda), quarter)
+	return sz
+}
+
+// carlsonRF returns the Carlson symmetric integral RF(x, y, z) at precision
+// prec.
+func carlsonRF(x, y, z *Complex, prec uint) *Complex {
+	w := prec + guardBits
+	x = newComplexPrec(w).round(x, w)
+	y = newComplexPrec(w).round(y, w)
+	z = newComplexPrec(w).round(z, w)
+	third := newFloat(w).Quo(big.NewFloat(1), big.NewFloat(3))
+	d := make([]*Complex, 3)
+	a := new(Complex)
+	for i := 0; i < 4*int(w); i++ {
+		a.Add(x, y)
+		a.Add(a, z)
+		a.Scal(a, third)
+		if carlsonConverged(a, []*Complex{x, y, z}, d, w) {
+			break
+		}
+		carlsonStep(x, y, z)
+	}
+	// E2 = XY - Z², E3 = XYZ, with X + Y + Z = 0.
+	e2 := new(Complex).Mul(d[0], d[1])
+	e2.Sub(e2, new(Complex).Mul(d[2], d[2]))
+	e3 := new(Complex).Mul(d[0], d[1])
+	e3.Mul(e3, d[2])
+	// 1 - E2/10 + E3/14 + E2²/24 - 3E2E3/44
+	s := newComplexPrec(w)
+	s.l.SetInt64(1)
+	s.Sub(s, new(Complex).Scal(e2, newFloat(w).Quo(big.NewFloat(1), big.NewFloat(10))))
+	s.Add(s, new(Complex).Scal(e3, newFloat(w).Quo(big.NewFloat(1), big.NewFloat(14))))
+	s.Add(s, new(Complex).Scal(new(Complex).Mul(e2, e2), newFloat(w).Quo(big.NewFloat(1), big.NewFloat(24))))
+	s.Sub(s, new(Complex).Scal(new(Complex).Mul(e2, e3), newFloat(w).Quo(big.NewFloat(3), big.NewFloat(44))))
+	r := new(Complex).Quo(s, new(Complex).Sqrt(a))
+	return newComplexPrec(prec).round(r, prec)
+}
+
+// carlsonRD returns the Carlson symmetric integral RD(x, y, z) at precision
+// prec.
+func carlsonRD(x, y, z *Complex, prec uint) *Complex {
+	w := prec + guardBits
+	x = newComplexPrec(w).round(x, w)
+	y = newComplexPrec(w).round(y, w)
+	z = newComplexPrec(w).round(z, w)
+	fifth := newFloat(w).Quo(big.NewFloat(1), big.NewFloat(5))
+	sum := newComplexPrec(w)
+	fac := newFloat(w).SetInt64(1)
+	d := make([]*Complex, 3)
+	a := new(Complex)
+	for i := 0; i < 4*int(w); i++ {
+		a.Add(x, y)
+		a.Add(a, new(Complex).Scal(z, big.NewFloat(3)))
+		a.Scal(a, fifth)
+		if carlsonConverged(a, []*Complex{x, y, z}, d, w) {
+			break
+		}
+		// After the step, the new z is (z + λ)/4, so 4z is the old z + λ.
+		sz := carlsonStep(x, y, z)
+		t := new(Complex).Scal(z, big.NewFloat(4))
+		t.Mul(t, sz)
+		sum.Add(sum, new(Complex).Scal(new(Complex).Inv(t), fac))
+		fac.SetMantExp(fac, -2)
+	}
+	// With X = 1 - x/A etc., X + Y + 3Z = 0.
+	ea := new(Complex).Mul(d[0], d[1])
+	eb := new(Complex).Mul(d[2], d[2])
+	ec := new(Complex).Sub(ea, eb)
+	ed := new(Complex).Sub(ea, new(Complex).Scal(eb, big.NewFloat(6)))
+	ee := new(Complex).Add(ed, new(Complex).Scal(ec, big.NewFloat(2)))
+	c := func(p, q int64) *big.Float {
+		return newFloat(w).Quo(newFloat(w).SetInt64(p), newFloat(w).SetInt64(q))
+	}
+	// 1 + ED(-3/14 + 9/88 ED - 9/52 Z EE) + Z(EE/6 + Z(-9/22 EC + 3/26 Z EA))
+	inner := new(Complex).Scal(ed, c(9, 88))
+	inner.Sub(inner, new(Complex).Scal(new(Complex).Mul(d[2], ee), c(9, 52)))
+	inner.l.Sub(&inner.l, c(3, 14))
+	s := new(Complex).Mul(ed, inner)
+	inner2 := new(Complex).Scal(new(Complex).Mul(d[2], ea), c(3, 26))
+	inner2.Sub(inner2, new(Complex).Scal(ec, c(9, 22)))
+	inner2.Mul(inner2, d[2])
+	inner2.Add(inner2, new(Complex).Scal(ee, c(1, 6)))
+	s.Add(s, inner2.Mul(inner2, d[2]))
+	s.l.Add(&s.l, big.NewFloat(1))
+	den := new(Complex).Mul(a, new(Complex).Sqrt(a))
+	r := new(Complex).Quo(s, den)
+	r.Scal(r, fac)
+	r.Add(r, sum.Scal(sum, big.NewFloat(3)))
+	return newComplexPrec(prec).round(r, prec)
+}
+
+// CarlsonRF sets z equal to the Carlson symmetric elliptic integral of the
+// first kind
+// 		RF(x, y, w) = 1/2 ∫ dt / √((t+x)(t+y)(t+w)),    0 ≤ t < ∞
+// and returns z. It is computed with the duplication theorem, at the largest
+// precision of x, y, and w.
+func (z *Complex) CarlsonRF(x, y, w *Complex) *Complex {
+	prec := maxPrec(&x.l, &x.r, &y.l, &y.r, &w.l, &w.r)
+	return z.Copy(carlsonRF(x, y, w, prec))
+}
+
+// CarlsonRD sets z equal to the Carlson symmetric elliptic integral of the
+// second kind
+// 		RD(x, y, w) = 3/2 ∫ dt / ((t+w)√((t+x)(t+y)(t+w))),    0 ≤ t < ∞
+// and returns z. It is computed with the duplication theorem, at the largest
+// precision of x, y, and w.
+func (z *Complex) CarlsonRD(x, y, w *Complex) *Complex {
+	prec := maxPrec(&x.l, &x.r, &y.l, &y.r, &w.l, &w.r)
+	return z.Copy(carlsonRD(x, y, w, prec))
+}
+
+// ellipticIncomplete returns the incomplete elliptic integrals F(φ, m) and
+// E(φ, m) at precision prec. The real part of φ is first reduced to
+// [-π/2, π/2] with
+// 		F(φ + kπ, m) = F(φ, m) + 2kK(m)
+// 		E(φ + kπ, m) = E(φ, m) + 2kE(m)
+// and the reduced integrals are computed from
+// 		F(φ, m) = s RF(c², 1 - ms², 1)
+// 		E(φ, m) = F(φ, m) - (m/3) s³ RD(c², 1 - ms², 1)
+// where s = sin(φ) and c = cos(φ).
+func ellipticIncomplete(phi, m *Complex, prec uint) (*Complex, *Complex) {
+	w := prec + guardBits
+	p := newComplexPrec(w).round(phi, w)
+	mm := newComplexPrec(w).round(m, w)
+	pi := bigPi(w)
+	k := nearestTurn(&p.l, pi)
+	p.l.Sub(&p.l, newFloat(w).Mul(pi, newFloat(w).SetInt64(int64(k))))
+	s := new(Complex).Sin(p)
+	c := new(Complex).Cos(p)
+	one := newComplexPrec(w)
+	one.l.SetInt64(1)
+	c2 := new(Complex).Mul(c, c)
+	s2 := new(Complex).Mul(s, s)
+	y := new(Complex).Sub(one, new(Complex).Mul(mm, s2))
+	f := carlsonRF(c2, y, one, w)
+	f.Mul(f, s)
+	e := carlsonRD(c2, y, one, w)
+	e.Mul(e, new(Complex).Mul(s2, s))
+	e.Mul(e, mm)
+	e.Scal(e, newFloat(w).Quo(big.NewFloat(1), big.NewFloat(3)))
+	e.Sub(f, e)
+	if k != 0 {
+		kk, ee := ellipticKE(mm, w)
+		twoK := newFloat(w).SetInt64(int64(2 * k))
+		f.Add(f, kk.Scal(kk, twoK))
+		e.Add(e, ee.Scal(ee, twoK))
+	}
+	return newComplexPrec(prec).round(f, prec), newComplexPrec(prec).round(e, prec)
+}
+
+// EllipticF sets z equal to the incomplete elliptic integral of the first
+// kind
+// 		F(φ, m) = ∫ dθ / √(1 - m sin²(θ)),    0 ≤ θ ≤ φ
+// and returns z. It is computed from the Carlson form RF, at the larger
+// precision of φ and m.
+func (z *Complex) EllipticF(phi, m *Complex) *Complex {
+	f, _ := ellipticIncomplete(phi, m, maxPrec(&phi.l, &phi.r, &m.l, &m.r))
+	return z.Copy(f)
+}
+
+// EllipticEIncomplete sets z equal to the incomplete elliptic integral of the
+// second kind
+// 		E(φ, m) = ∫ √(1 - m sin²(θ)) dθ,    0 ≤ θ ≤ φ
+// and returns z. It is computed from the Carlson forms RF and RD, at the
+// larger precision of φ and m.
+func (z *Complex) EllipticEIncomplete(phi, m *Complex) *Complex {
+	_, e := ellipticIncomplete(phi, m, maxPrec(&phi.l, &phi.r, &m.l, &m.r))
+	return z.Copy(e)
+}
+
+// jacobi returns sn(u|m), cn(u|m), and dn(u|m) at precision prec.
+//
+// The argument is divided by 2^h so that the Maclaurin series, whose
+// coefficients follow from the differential equations
+// 		sn' = cn dn,    cn' = -sn dn,    dn' = -m sn cn
+// converge quickly. The results are then doubled h times with
+// 		sn(2u) = 2 sn cn dn / Δ
+// 		cn(2u) = (cn² - sn² dn²) / Δ
+// 		dn(2u) = (dn² - m sn² cn²) / Δ
+// where Δ = 1 - m sn⁴.
+func jacobi(u, m *Complex, prec uint) (*Complex, *Complex, *Complex) {
+	h := 8
+	for h*h < int(prec) {
+		h++
+	}
+	if e := complexExpo(u); e > 0 {
+		h += e
+	}
+	w := prec + guardBits + uint(h)
+	v := newComplexPrec(w).round(u, w)
+	v.l.SetMantExp(&v.l, -h)
+	v.r.SetMantExp(&v.r, -h)
+	mm := newComplexPrec(w).round(m, w)
+	// Taylor coefficients of sn, cn, and dn.
+	sc := []*Complex{newComplexPrec(w)}
+	cc := []*Complex{newComplexPrec(w)}
+	dc := []*Complex{newComplexPrec(w)}
+	cc[0].l.SetInt64(1)
+	dc[0].l.SetInt64(1)
+	sn, cn, dn := newComplexPrec(w), newComplexPrec(w), newComplexPrec(w)
+	cn.l.SetInt64(1)
+	dn.l.SetInt64(1)
+	pow := newComplexPrec(w)
+	pow.l.SetInt64(1)
+	for k := 0; k < int(w); k++ {
+		s, c, d := newComplexPrec(w), newComplexPrec(w), newComplexPrec(w)
+		for j := 0; j <= k; j++ {
+			s.Add(s, new(Complex).Mul(cc[j], dc[k-j]))
+			c.Sub(c, new(Complex).Mul(sc[j], dc[k-j]))
+			d.Sub(d, new(Complex).Mul(sc[j], cc[k-j]))
+		}
+		d.Mul(d, mm)
+		inv := newFloat(w).Quo(big.NewFloat(1), newFloat(w).SetInt64(int64(k+1)))
+		s.Scal(s, inv)
+		c.Scal(c, inv)
+		d.Scal(d, inv)
+		sc, cc, dc = append(sc, s), append(cc, c), append(dc, d)
+		pow.Mul(pow, v)
+		ts := new(Complex).Mul(s, pow)
+		tc := new(Complex).Mul(c, pow)
+		td := new(Complex).Mul(d, pow)
+		sn.Add(sn, ts)
+		cn.Add(cn, tc)
+		dn.Add(dn, td)
+		if k > 2 && complexExpo(ts) < -int(w)+complexExpo(v) &&
+			complexExpo(tc) < -int(w) && complexExpo(td) < -int(w) {
+			break
+		}
+	}
+	two := big.NewFloat(2)
+	for i := 0; i < h; i++ {
+		s2 := new(Complex).Mul(sn, sn)
+		c2 := new(Complex).Mul(cn, cn)
+		d2 := new(Complex).Mul(dn, dn)
+		delta := newComplexPrec(w)
+		delta.l.SetInt64(1)
+		delta.Sub(delta, new(Complex).Mul(mm, new(Complex).Mul(s2, s2)))
+		if delta.l.Sign() == 0 && delta.r.Sign() == 0 {
+			panic("Jacobi elliptic function at a pole")
+		}
+		ns := new(Complex).Mul(sn, cn)
+		ns.Mul(ns, dn)
+		ns.Scal(ns, two)
+		nc := new(Complex).Sub(c2, new(Complex).Mul(s2, d2))
+		nd := new(Complex).Sub(d2, new(Complex).Mul(mm, new(Complex).Mul(s2, c2)))
+		sn.Quo(ns, delta)
+		cn.Quo(nc, delta)
+		dn.Quo(nd, delta)
+	}
+	return newComplexPrec(prec).round(sn, prec),
+		newComplexPrec(prec).round(cn, prec),
+		newComplexPrec(prec).round(dn, prec)
+}
+
+// JacobiElliptic returns the Jacobi elliptic functions sn(u|m), cn(u|m), and
+// dn(u|m), computed at the larger precision of u and m. If u is a pole of the
+// functions, then JacobiElliptic panics.
+func JacobiElliptic(u, m *Complex) (*Complex, *Complex, *Complex) {
+	return jacobi(u, m, maxPrec(&u.l, &u.r, &m.l, &m.r))
+}
+
+// JacobiSn sets z equal to the Jacobi elliptic function sn(u|m), and returns
+// z.
+func (z *Complex) JacobiSn(u, m *Complex) *Complex {
+	sn, _, _ := JacobiElliptic(u, m)
+	return z.Copy(sn)
+}
+
+// JacobiCn sets z equal to the Jacobi elliptic function cn(u|m), and returns
+// z.
+func (z *Complex) JacobiCn(u, m *Complex) *Complex {
+	_, cn, _ := JacobiElliptic(u, m)
+	return z.Copy(cn)
+}
+
+// JacobiDn sets z equal to the Jacobi elliptic function dn(u|m), and returns
+// z.
+func (z *Complex) JacobiDn(u, m *Complex) *Complex {
+	_, _, dn := JacobiElliptic(u, m)
+	return z.Copy(dn)
+}
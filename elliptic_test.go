@@ -0,0 +1,145 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+	"math/cmplx"
+	"testing"
+)
+
+func complexPrec(a, b float64, prec uint) *Complex {
+	z := newComplexPrec(prec)
+	z.l.SetFloat64(a)
+	z.r.SetFloat64(b)
+	return z
+}
+
+func TestEllipticKEValues(t *testing.T) {
+	cases := []struct {
+		m    float64
+		k, e float64
+	}{
+		{0, math.Pi / 2, math.Pi / 2},
+		{0.5, 1.8540746773013719184, 1.3506438810476755025},
+		{-1, 1.3110287771460599052, 1.9100988945138560089},
+		{0.9, 2.5780921133481733274, 1.1047747327040733261},
+	}
+	for _, c := range cases {
+		m := NewComplex(big.NewFloat(c.m), new(big.Float))
+		if got := new(Complex).EllipticK(m); !closeToComplex128(got, complex(c.k, 0), 50) {
+			t.Errorf("EllipticK(%v) = %v, want %v", c.m, got, c.k)
+		}
+		if got := new(Complex).EllipticE(m); !closeToComplex128(got, complex(c.e, 0), 50) {
+			t.Errorf("EllipticE(%v) = %v, want %v", c.m, got, c.e)
+		}
+	}
+}
+
+func TestEllipticLegendreRelation(t *testing.T) {
+	// E K' + E' K - K K' = π/2, where the primes denote the complementary
+	// parameter 1 - m.
+	for _, m := range [][2]float64{{0.3, 0}, {0.7, 0.2}, {-2, 1}} {
+		x := complexPrec(m[0], m[1], 200)
+		y := new(Complex).Sub(complexPrec(1, 0, 200), x)
+		k := new(Complex).EllipticK(x)
+		e := new(Complex).EllipticE(x)
+		kc := new(Complex).EllipticK(y)
+		ec := new(Complex).EllipticE(y)
+		s := new(Complex).Mul(e, kc)
+		s.Add(s, new(Complex).Mul(ec, k))
+		s.Sub(s, new(Complex).Mul(k, kc))
+		if !closeTo(&s.l, newFloat(200).SetMantExp(bigPi(200), -1), 190) || s.r.Sign() != 0 && expo(&s.r) > -190 {
+			t.Errorf("Legendre relation at m = %v: %v", m, s)
+		}
+	}
+}
+
+func TestEllipticIncompleteComplete(t *testing.T) {
+	// F(π/2, m) = K(m), E(π/2, m) = E(m), and F(φ + π, m) = F(φ, m) + 2K(m).
+	for _, m := range [][2]float64{{0.5, 0}, {0.2, 0.6}, {-3, -1}} {
+		x := complexPrec(m[0], m[1], 150)
+		phi := newComplexPrec(150)
+		phi.l.SetMantExp(bigPi(150), -1)
+		f := new(Complex).EllipticF(phi, x)
+		k := new(Complex).EllipticK(x)
+		if d := new(Complex).Sub(f, k); complexExpo(d) > complexExpo(k)-140 {
+			t.Errorf("F(π/2, %v) = %v, want %v", m, f, k)
+		}
+		e := new(Complex).EllipticEIncomplete(phi, x)
+		ee := new(Complex).EllipticE(x)
+		if d := new(Complex).Sub(e, ee); complexExpo(d) > complexExpo(ee)-140 {
+			t.Errorf("E(π/2, %v) = %v, want %v", m, e, ee)
+		}
+		phi = complexPrec(0.4, 0.3, 150)
+		f = new(Complex).EllipticF(phi, x)
+		phi.l.Add(&phi.l, bigPi(150))
+		g := new(Complex).EllipticF(phi, x)
+		g.Sub(g, f)
+		if d := g.Sub(g, k.Scal(k, big.NewFloat(2))); complexExpo(d) > -138 {
+			t.Errorf("F(φ + π, %v) - F(φ, %v) - 2K = %v", m, m, d)
+		}
+	}
+}
+
+func TestJacobiEllipticIdentities(t *testing.T) {
+	points := [][4]float64{
+		{0.3, 0, 0.5, 0},
+		{1.7, 0.4, 0.3, 0.2},
+		{-2, 1.1, -1, 0.5},
+		{12, -0.5, 0.8, 0},
+		{0.1, 3, 0.25, -0.1},
+	}
+	for _, p := range points {
+		u := complexPrec(p[0], p[1], 200)
+		m := complexPrec(p[2], p[3], 200)
+		sn, cn, dn := JacobiElliptic(u, m)
+		s2 := new(Complex).Mul(sn, sn)
+		a := new(Complex).Add(s2, new(Complex).Mul(cn, cn))
+		b := new(Complex).Add(new(Complex).Mul(dn, dn), new(Complex).Mul(m, s2))
+		for _, x := range []*Complex{a, b} {
+			x.l.Sub(&x.l, big.NewFloat(1))
+			if e := complexExpo(x); e > complexExpo(s2)-185 && e > -185 {
+				t.Errorf("identity at u = %v, m = %v: residual %v", u, m, x)
+			}
+		}
+	}
+}
+
+func TestJacobiEllipticLimits(t *testing.T) {
+	// sn(u|0) = sin(u) and sn(u|1) = tanh(u).
+	for _, p := range [][2]float64{{0.5, 0.2}, {3, -1}, {-7, 0.5}} {
+		u := NewComplex(big.NewFloat(p[0]), big.NewFloat(p[1]))
+		c := complex(p[0], p[1])
+		m := NewComplex(new(big.Float), new(big.Float))
+		if got := new(Complex).JacobiSn(u, m); !closeToComplex128(got, cmplx.Sin(c), 48) {
+			t.Errorf("JacobiSn(%v, 0) = %v, want %v", c, got, cmplx.Sin(c))
+		}
+		if got := new(Complex).JacobiCn(u, m); !closeToComplex128(got, cmplx.Cos(c), 48) {
+			t.Errorf("JacobiCn(%v, 0) = %v, want %v", c, got, cmplx.Cos(c))
+		}
+		m.l.SetInt64(1)
+		if got := new(Complex).JacobiSn(u, m); !closeToComplex128(got, cmplx.Tanh(c), 48) {
+			t.Errorf("JacobiSn(%v, 1) = %v, want %v", c, got, cmplx.Tanh(c))
+		}
+		if got := new(Complex).JacobiDn(u, m); !closeToComplex128(got, 1/cmplx.Cosh(c), 48) {
+			t.Errorf("JacobiDn(%v, 1) = %v, want %v", c, got, 1/cmplx.Cosh(c))
+		}
+	}
+}
+
+func TestJacobiSnInvertsEllipticF(t *testing.T) {
+	// sn(F(φ, m) | m) = sin(φ).
+	for _, p := range [][4]float64{{0.7, 0, 0.4, 0}, {0.3, 0.2, 0.5, 0.5}, {-1.2, -0.4, -2, 0}} {
+		phi := complexPrec(p[0], p[1], 150)
+		m := complexPrec(p[2], p[3], 150)
+		f := new(Complex).EllipticF(phi, m)
+		sn := new(Complex).JacobiSn(f, m)
+		s := new(Complex).Sin(phi)
+		if d := new(Complex).Sub(sn, s); complexExpo(d) > complexExpo(s)-140 {
+			t.Errorf("sn(F(%v, %v)) = %v, want %v", phi, m, sn, s)
+		}
+	}
+}
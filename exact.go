@@ -0,0 +1,694 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math/big"
+
+// exactMul returns the product of x and y without rounding.
+func exactMul(x, y *big.Float) *big.Float {
+	return newFloat(x.MinPrec()+y.MinPrec()+1).Mul(x, y)
+}
+
+// exactAdd returns the sum of x and y without rounding.
+func exactAdd(x, y *big.Float) *big.Float {
+	return newFloat(exactPrec(x, y)).Add(x, y)
+}
+
+// exactSub returns the difference of x and y without rounding.
+func exactSub(x, y *big.Float) *big.Float {
+	return newFloat(exactPrec(x, y)).Sub(x, y)
+}
+
+// exactSpan returns the number of bits from the leading bit of the largest to
+// the trailing bit of the smallest of the finite nonzero x, which is the
+// precision that holds all of them at a common scale.
+func exactSpan(x ...*big.Float) uint {
+	var hi, lsb int
+	found := false
+	for _, v := range x {
+		if v.Sign() == 0 || v.IsInf() {
+			continue
+		}
+		e := expo(v)
+		l := e - int(v.MinPrec())
+		if !found {
+			hi, lsb, found = e, l, true
+			continue
+		}
+		if e > hi {
+			hi = e
+		}
+		if l < lsb {
+			lsb = l
+		}
+	}
+	return uint(hi - lsb)
+}
+
+// fitsExact returns true if the components x and y of the operands of a
+// product each span at most twice the working precision, which is the larger
+// of prec and the precisions of the components z of the result. Only then is
+// the product computed exactly, at a cost bounded by a small multiple of the
+// working precision. Components whose exponents are farther apart would make
+// the exact product grow with their exponent range, so such a product is
+// computed with rounded arithmetic instead.
+func fitsExact(prec uint, z, x, y []*big.Float) bool {
+	for _, v := range z {
+		if p := v.Prec(); p > prec {
+			prec = p
+		}
+	}
+	return exactSpan(x...) <= 2*prec && exactSpan(y...) <= 2*prec
+}
+
+// floatOps holds the product, sum, and difference of big.Float values that a
+// product of composite values is built from. If rounded is not nil, then the
+// operations set it to true when they round.
+type floatOps struct {
+	mul, add, sub func(x, y *big.Float) *big.Float
+	rounded       *bool
+}
+
+// exactOps computes without rounding.
+var exactOps = floatOps{exactMul, exactAdd, exactSub, nil}
+
+// productOps returns exactOps if fitsExact(prec, z, x, y) is true, and
+// otherwise operations that round to prec bits.
+func productOps(prec uint, z, x, y []*big.Float) floatOps {
+	if fitsExact(prec, z, x, y) {
+		return exactOps
+	}
+	rounded := new(bool)
+	check := func(v *big.Float) *big.Float {
+		if v.Acc() != big.Exact {
+			*rounded = true
+		}
+		return v
+	}
+	return floatOps{
+		mul: func(x, y *big.Float) *big.Float {
+			return check(newFloat(prec).Mul(x, y))
+		},
+		add: func(x, y *big.Float) *big.Float {
+			return check(newFloat(prec).Add(x, y))
+		},
+		sub: func(x, y *big.Float) *big.Float {
+			return check(newFloat(prec).Sub(x, y))
+		},
+		rounded: rounded,
+	}
+}
+
+// settle marks the components z of a result computed with o as rounded if an
+// operation of o rounded, since the accuracy of each component only reflects
+// the last operation that set it.
+func (o floatOps) settle(z ...*big.Float) {
+	if o.rounded != nil && *o.rounded {
+		markRounded(z...)
+	}
+}
+
+// markRounded sets the accuracy of each exact x to Below or Above without
+// changing its value, for the components of a result whose intermediate
+// values were rounded. A finite nonzero x is set to itself plus a quarter of
+// its ulp, in the direction that its rounding mode takes back to x; a zero
+// or an infinity is set from a product that underflows or overflows.
+func markRounded(x ...*big.Float) {
+	one := big.NewFloat(1)
+	for _, v := range x {
+		if v.Acc() != big.Exact {
+			continue
+		}
+		var m, n *big.Float
+		switch {
+		case v.IsInf():
+			m = new(big.Float).SetMantExp(one, big.MaxExp-1)
+			n = new(big.Float).Copy(m)
+		case v.Sign() == 0:
+			m = new(big.Float).SetMantExp(one, big.MinExp)
+			n = new(big.Float).Copy(m)
+		default:
+			mode := v.Mode()
+			smaller := mode == big.AwayFromZero ||
+				mode == big.ToPositiveInf && !v.Signbit() ||
+				mode == big.ToNegativeInf && v.Signbit()
+			d := new(big.Float).SetMantExp(one, expo(v)-int(v.Prec())-2)
+			if v.Signbit() != smaller {
+				d.Neg(d)
+			}
+			v.Set(exactAdd(v, d))
+			continue
+		}
+		if v.Signbit() {
+			n.Neg(n)
+		}
+		v.Mul(m, n)
+	}
+}
+
+// doubling is the set of methods used by mulDoubling on the halves of a
+// Cayley-Dickson pair.
+type doubling[T any] interface {
+	*T
+	Copy(y *T) *T
+	Conj(y *T) *T
+	Add(x, y *T) *T
+	Sub(x, y *T) *T
+	Mul(x, y *T) *T
+	Exact() bool
+	Flatten() []*big.Float
+	Unflatten(x []*big.Float) *T
+}
+
+// mulDoubling sets (zl, zr) equal to the product of the Cayley-Dickson pairs
+// (xl, xr) and (yl, yr) with rounded arithmetic. The left half of the product
+// is xl*yl + sq*Conj(yr)*xr, where sq is -1, 0, or +1, and the right half is
+// yr*xl + xr*Conj(yl). If a partial product or sum is rounded, then every
+// component of the product is marked as rounded.
+func mulDoubling[T any, P doubling[T]](sq int, zl, zr, xl, xr, yl, yr P) {
+	a := P(new(T)).Copy(xl)
+	b := P(new(T)).Copy(xr)
+	c := P(new(T)).Copy(yl)
+	d := P(new(T)).Copy(yr)
+	temp := P(new(T))
+	zl.Mul(a, c)
+	exact := zl.Exact()
+	if sq != 0 {
+		temp.Mul(P(new(T)).Conj(d), b)
+		exact = exact && temp.Exact()
+		if sq < 0 {
+			zl.Sub(zl, temp)
+		} else {
+			zl.Add(zl, temp)
+		}
+	}
+	zr.Mul(d, a)
+	temp.Mul(b, P(new(T)).Conj(c))
+	exact = exact && zr.Exact() && temp.Exact()
+	zr.Add(zr, temp)
+	if !exact {
+		for _, v := range []P{zl, zr} {
+			f := v.Flatten()
+			markRounded(f...)
+			v.Unflatten(f)
+		}
+	}
+}
+
+// roundFloat sets z equal to x rounded to the precision of z, and returns z.
+// If z has zero precision, then x is rounded to prec bits instead.
+func roundFloat(z, x *big.Float, prec uint) *big.Float {
+	if z.Prec() == 0 {
+		z.SetPrec(prec)
+	}
+	return z.Set(x)
+}
+
+// isExact returns true if the most recent operation on each of x involved no
+// rounding.
+func isExact(x ...*big.Float) bool {
+	for _, v := range x {
+		if v.Acc() != big.Exact {
+			return false
+		}
+	}
+	return true
+}
+
+// mulExact sets z equal to the product of x and y without rounding, and
+// returns z.
+func (z *Complex) mulExact(x, y *Complex) *Complex {
+	l := exactSub(exactMul(&x.l, &y.l), exactMul(&x.r, &y.r))
+	r := exactAdd(exactMul(&x.r, &y.l), exactMul(&x.l, &y.r))
+	z.l.Copy(l)
+	z.r.Copy(r)
+	return z
+}
+
+// addExact sets z equal to x+y without rounding, and returns z.
+func (z *Complex) addExact(x, y *Complex) *Complex {
+	z.l.Copy(exactAdd(&x.l, &y.l))
+	z.r.Copy(exactAdd(&x.r, &y.r))
+	return z
+}
+
+// subExact sets z equal to x-y without rounding, and returns z.
+func (z *Complex) subExact(x, y *Complex) *Complex {
+	z.l.Copy(exactSub(&x.l, &y.l))
+	z.r.Copy(exactSub(&x.r, &y.r))
+	return z
+}
+
+// roundComplex sets z equal to y rounded to the precision of the components
+// of z, using prec for components with zero precision, and returns z.
+func (z *Complex) roundComplex(y *Complex, prec uint) *Complex {
+	roundFloat(&z.l, &y.l, prec)
+	roundFloat(&z.r, &y.r, prec)
+	return z
+}
+
+// mulExact sets z equal to the product of x and y without rounding, and
+// returns z.
+func (z *Perplex) mulExact(x, y *Perplex) *Perplex {
+	l := exactAdd(exactMul(&x.l, &y.l), exactMul(&y.r, &x.r))
+	r := exactAdd(exactMul(&y.r, &x.l), exactMul(&x.r, &y.l))
+	z.l.Copy(l)
+	z.r.Copy(r)
+	return z
+}
+
+// addExact sets z equal to x+y without rounding, and returns z.
+func (z *Perplex) addExact(x, y *Perplex) *Perplex {
+	z.l.Copy(exactAdd(&x.l, &y.l))
+	z.r.Copy(exactAdd(&x.r, &y.r))
+	return z
+}
+
+// roundPerplex sets z equal to y rounded to the precision of the components
+// of z, using prec for components with zero precision, and returns z.
+func (z *Perplex) roundPerplex(y *Perplex, prec uint) *Perplex {
+	roundFloat(&z.l, &y.l, prec)
+	roundFloat(&z.r, &y.r, prec)
+	return z
+}
+
+// mulExact sets z equal to the product of x and y without rounding, and
+// returns z.
+func (z *Hamilton) mulExact(x, y *Hamilton) *Hamilton {
+	l := new(Complex).subExact(
+		new(Complex).mulExact(&x.l, &y.l),
+		new(Complex).mulExact(new(Complex).Conj(&y.r), &x.r),
+	)
+	r := new(Complex).addExact(
+		new(Complex).mulExact(&y.r, &x.l),
+		new(Complex).mulExact(&x.r, new(Complex).Conj(&y.l)),
+	)
+	z.l.Copy(l)
+	z.r.Copy(r)
+	return z
+}
+
+// addExact sets z equal to x+y without rounding, and returns z.
+func (z *Hamilton) addExact(x, y *Hamilton) *Hamilton {
+	z.l.addExact(&x.l, &y.l)
+	z.r.addExact(&x.r, &y.r)
+	return z
+}
+
+// subExact sets z equal to x-y without rounding, and returns z.
+func (z *Hamilton) subExact(x, y *Hamilton) *Hamilton {
+	z.l.subExact(&x.l, &y.l)
+	z.r.subExact(&x.r, &y.r)
+	return z
+}
+
+// roundHamilton sets z equal to y rounded to the precision of z, and returns
+// z. Components of z with zero precision are rounded to prec bits instead.
+func (z *Hamilton) roundHamilton(y *Hamilton, prec uint) *Hamilton {
+	z.l.roundComplex(&y.l, prec)
+	z.r.roundComplex(&y.r, prec)
+	return z
+}
+
+// mulExact sets z equal to the product of x and y without rounding, and
+// returns z.
+func (z *Cockle) mulExact(x, y *Cockle) *Cockle {
+	l := new(Complex).addExact(
+		new(Complex).mulExact(&x.l, &y.l),
+		new(Complex).mulExact(new(Complex).Conj(&y.r), &x.r),
+	)
+	r := new(Complex).addExact(
+		new(Complex).mulExact(&y.r, &x.l),
+		new(Complex).mulExact(&x.r, new(Complex).Conj(&y.l)),
+	)
+	z.l.Copy(l)
+	z.r.Copy(r)
+	return z
+}
+
+// addExact sets z equal to x+y without rounding, and returns z.
+func (z *Cockle) addExact(x, y *Cockle) *Cockle {
+	z.l.addExact(&x.l, &y.l)
+	z.r.addExact(&x.r, &y.r)
+	return z
+}
+
+// roundCockle sets z equal to y rounded to the precision of z, and returns z.
+// Components of z with zero precision are rounded to prec bits instead.
+func (z *Cockle) roundCockle(y *Cockle, prec uint) *Cockle {
+	z.l.roundComplex(&y.l, prec)
+	z.r.roundComplex(&y.r, prec)
+	return z
+}
+
+// mulExact sets z equal to the product of x and y without rounding, and
+// returns z.
+func (z *InfraComplex) mulExact(x, y *InfraComplex) *InfraComplex {
+	temp := new(Complex)
+	l := new(Complex).mulExact(&x.l, &y.l)
+	r := new(Complex).addExact(
+		new(Complex).mulExact(&y.r, &x.l),
+		temp.mulExact(&x.r, temp.Conj(&y.l)),
+	)
+	z.l.Copy(l)
+	z.r.Copy(r)
+	return z
+}
+
+// addExact sets z equal to x+y without rounding, and returns z.
+func (z *InfraComplex) addExact(x, y *InfraComplex) *InfraComplex {
+	z.l.addExact(&x.l, &y.l)
+	z.r.addExact(&x.r, &y.r)
+	return z
+}
+
+// roundInfraComplex sets z equal to y rounded to the precision of z, and
+// returns z. Components of z with zero precision are rounded to prec bits
+// instead.
+func (z *InfraComplex) roundInfraComplex(y *InfraComplex, prec uint) *InfraComplex {
+	z.l.roundComplex(&y.l, prec)
+	z.r.roundComplex(&y.r, prec)
+	return z
+}
+
+// mulExact sets z equal to the product of x and y without rounding, and
+// returns z.
+func (z *InfraHamilton) mulExact(x, y *InfraHamilton) *InfraHamilton {
+	temp := new(Hamilton)
+	l := new(Hamilton).mulExact(&x.l, &y.l)
+	r := new(Hamilton).addExact(
+		new(Hamilton).mulExact(&y.r, &x.l),
+		temp.mulExact(&x.r, temp.Conj(&y.l)),
+	)
+	z.l.Copy(l)
+	z.r.Copy(r)
+	return z
+}
+
+// addExact sets z equal to x+y without rounding, and returns z.
+func (z *InfraHamilton) addExact(x, y *InfraHamilton) *InfraHamilton {
+	z.l.addExact(&x.l, &y.l)
+	z.r.addExact(&x.r, &y.r)
+	return z
+}
+
+// roundInfraHamilton sets z equal to y rounded to the precision of z, and
+// returns z. Components of z with zero precision are rounded to prec bits
+// instead.
+func (z *InfraHamilton) roundInfraHamilton(y *InfraHamilton, prec uint) *InfraHamilton {
+	z.l.roundHamilton(&y.l, prec)
+	z.r.roundHamilton(&y.r, prec)
+	return z
+}
+
+// mulExact sets z equal to the product of x and y without rounding, and
+// returns z.
+func (z *InfraCockle) mulExact(x, y *InfraCockle) *InfraCockle {
+	temp := new(Cockle)
+	l := new(Cockle).mulExact(&x.l, &y.l)
+	r := new(Cockle).addExact(
+		new(Cockle).mulExact(&y.r, &x.l),
+		temp.mulExact(&x.r, temp.Conj(&y.l)),
+	)
+	z.l.Copy(l)
+	z.r.Copy(r)
+	return z
+}
+
+// addExact sets z equal to x+y without rounding, and returns z.
+func (z *InfraCockle) addExact(x, y *InfraCockle) *InfraCockle {
+	z.l.addExact(&x.l, &y.l)
+	z.r.addExact(&x.r, &y.r)
+	return z
+}
+
+// roundInfraCockle sets z equal to y rounded to the precision of z, and
+// returns z. Components of z with zero precision are rounded to prec bits
+// instead.
+func (z *InfraCockle) roundInfraCockle(y *InfraCockle, prec uint) *InfraCockle {
+	z.l.roundCockle(&y.l, prec)
+	z.r.roundCockle(&y.r, prec)
+	return z
+}
+
+// mulExact sets z equal to the product of x and y without rounding, and
+// returns z.
+func (z *Cayley) mulExact(x, y *Cayley) *Cayley {
+	l := new(Hamilton).subExact(
+		new(Hamilton).mulExact(&x.l, &y.l),
+		new(Hamilton).mulExact(new(Hamilton).Conj(&y.r), &x.r),
+	)
+	r := new(Hamilton).addExact(
+		new(Hamilton).mulExact(&y.r, &x.l),
+		new(Hamilton).mulExact(&x.r, new(Hamilton).Conj(&y.l)),
+	)
+	z.l.Copy(l)
+	z.r.Copy(r)
+	return z
+}
+
+// addExact sets z equal to x+y without rounding, and returns z.
+func (z *Cayley) addExact(x, y *Cayley) *Cayley {
+	z.l.addExact(&x.l, &y.l)
+	z.r.addExact(&x.r, &y.r)
+	return z
+}
+
+// subExact sets z equal to x-y without rounding, and returns z.
+func (z *Cayley) subExact(x, y *Cayley) *Cayley {
+	z.l.subExact(&x.l, &y.l)
+	z.r.subExact(&x.r, &y.r)
+	return z
+}
+
+// roundCayley sets z equal to y rounded to the precision of z, and returns z.
+// Components of z with zero precision are rounded to prec bits instead.
+func (z *Cayley) roundCayley(y *Cayley, prec uint) *Cayley {
+	z.l.roundHamilton(&y.l, prec)
+	z.r.roundHamilton(&y.r, prec)
+	return z
+}
+
+// mulExact sets z equal to the product of x and y without rounding, and
+// returns z.
+func (z *Sedenion) mulExact(x, y *Sedenion) *Sedenion {
+	l := new(Cayley).subExact(
+		new(Cayley).mulExact(&x.l, &y.l),
+		new(Cayley).mulExact(new(Cayley).Conj(&y.r), &x.r),
+	)
+	r := new(Cayley).addExact(
+		new(Cayley).mulExact(&y.r, &x.l),
+		new(Cayley).mulExact(&x.r, new(Cayley).Conj(&y.l)),
+	)
+	z.l.Copy(l)
+	z.r.Copy(r)
+	return z
+}
+
+// mulExact sets z equal to the product of x and y without rounding, and
+// returns z.
+func (z *Infra) mulExact(x, y *Infra) *Infra {
+	r := exactAdd(exactMul(&y.r, &x.l), exactMul(&x.r, &y.l))
+	z.l.Copy(exactMul(&x.l, &y.l))
+	z.r.Copy(r)
+	return z
+}
+
+// addExact sets z equal to x+y without rounding, and returns z.
+func (z *Infra) addExact(x, y *Infra) *Infra {
+	z.l.Copy(exactAdd(&x.l, &y.l))
+	z.r.Copy(exactAdd(&x.r, &y.r))
+	return z
+}
+
+// mulExact sets z equal to the product of x and y without rounding, and
+// returns z.
+func (z *Supra) mulExact(x, y *Supra) *Supra {
+	l := new(Infra).mulExact(&x.l, &y.l)
+	r := new(Infra).addExact(
+		new(Infra).mulExact(&y.r, &x.l),
+		new(Infra).mulExact(&x.r, new(Infra).Conj(&y.l)),
+	)
+	z.l.Copy(l)
+	z.r.Copy(r)
+	return z
+}
+
+// addExact sets z equal to x+y without rounding, and returns z.
+func (z *Supra) addExact(x, y *Supra) *Supra {
+	z.l.addExact(&x.l, &y.l)
+	z.r.addExact(&x.r, &y.r)
+	return z
+}
+
+// roundSupra sets z equal to y rounded to the precision of z, and returns z.
+// Components of z with zero precision are rounded to prec bits instead.
+func (z *Supra) roundSupra(y *Supra, prec uint) *Supra {
+	roundFloat(&z.l.l, &y.l.l, prec)
+	roundFloat(&z.l.r, &y.l.r, prec)
+	roundFloat(&z.r.l, &y.r.l, prec)
+	roundFloat(&z.r.r, &y.r.r, prec)
+	return z
+}
+
+// Exact returns true if the most recent operation that set z involved no
+// rounding. Add, Sub, and Mul round each component only once, so when all
+// components are dyadic rationals whose exact result fits the precision of z,
+// these operations are exact and Exact reports it. A product whose components
+// differ too widely in scale is computed with rounded arithmetic, and is
+// reported as rounded if any of its partial products or sums was.
+func (z *Complex) Exact() bool {
+	return isExact(&z.l, &z.r)
+}
+
+// Exact returns true if the most recent operation that set z involved no
+// rounding.
+func (z *Perplex) Exact() bool {
+	return isExact(&z.l, &z.r)
+}
+
+// Exact returns true if the most recent operation that set z involved no
+// rounding.
+func (z *Infra) Exact() bool {
+	return isExact(&z.l, &z.r)
+}
+
+// Exact returns true if the most recent operation that set z involved no
+// rounding.
+func (z *Hamilton) Exact() bool {
+	return isExact(&z.l.l, &z.l.r, &z.r.l, &z.r.r)
+}
+
+// Exact returns true if the most recent operation that set z involved no
+// rounding.
+func (z *Cockle) Exact() bool {
+	return isExact(&z.l.l, &z.l.r, &z.r.l, &z.r.r)
+}
+
+// Exact returns true if the most recent operation that set z involved no
+// rounding.
+func (z *InfraComplex) Exact() bool {
+	return isExact(&z.l.l, &z.l.r, &z.r.l, &z.r.r)
+}
+
+// Exact returns true if the most recent operation that set z involved no
+// rounding.
+func (z *InfraPerplex) Exact() bool {
+	return isExact(&z.l.l, &z.l.r, &z.r.l, &z.r.r)
+}
+
+// Exact returns true if the most recent operation that set z involved no
+// rounding.
+func (z *SupraComplex) Exact() bool {
+	return isExact(z.Cartesian())
+}
+
+// Exact returns true if the most recent operation that set z involved no
+// rounding.
+func (z *InfraHamilton) Exact() bool {
+	return isExact(z.Cartesian())
+}
+
+// Exact returns true if the most recent operation that set z involved no
+// rounding.
+func (z *SupraHamilton) Exact() bool {
+	c := z.Cartesian()
+	return isExact(c[:]...)
+}
+
+// Exact returns true if the most recent operation that set z involved no
+// rounding.
+func (z *InfraCockle) Exact() bool {
+	return isExact(z.Cartesian())
+}
+
+// Exact returns true if the most recent operation that set z involved no
+// rounding.
+func (z *SupraCockle) Exact() bool {
+	c := z.Cartesian()
+	return isExact(c[:]...)
+}
+
+// Exact returns true if the most recent operation that set z involved no
+// rounding.
+func (z *Supra) Exact() bool {
+	return isExact(&z.l.l, &z.l.r, &z.r.l, &z.r.r)
+}
+
+// Exact returns true if the most recent operation that set z involved no
+// rounding.
+func (z *Ultra) Exact() bool {
+	return isExact(z.Cartesian())
+}
+
+// Exact returns true if the most recent operation that set z involved no
+// rounding.
+func (z *Cayley) Exact() bool {
+	return isExact(z.Cartesian())
+}
+
+// Exact returns true if the most recent operation that set z involved no
+// rounding.
+func (z *Sedenion) Exact() bool {
+	c := z.Cartesian()
+	return isExact(c[:]...)
+}
+
+// dot returns the dot product of s and t.
+func (o floatOps) dot(s, t *Vec3) *big.Float {
+	return o.add(
+		o.add(o.mul(&s.x, &t.x), o.mul(&s.y, &t.y)),
+		o.mul(&s.z, &t.z),
+	)
+}
+
+// cross sets v equal to the cross product of s and t, and returns v.
+func (o floatOps) cross(v, s, t *Vec3) *Vec3 {
+	x := o.sub(o.mul(&s.y, &t.z), o.mul(&s.z, &t.y))
+	y := o.sub(o.mul(&s.z, &t.x), o.mul(&s.x, &t.z))
+	z := o.sub(o.mul(&s.x, &t.y), o.mul(&s.y, &t.x))
+	v.x.Copy(x)
+	v.y.Copy(y)
+	v.z.Copy(z)
+	return v
+}
+
+// comb sets v equal to the linear combination as + bt, and returns v.
+func (o floatOps) comb(v *Vec3, a *big.Float, s *Vec3, b *big.Float, t *Vec3) *Vec3 {
+	x := o.add(o.mul(a, &s.x), o.mul(b, &t.x))
+	y := o.add(o.mul(a, &s.y), o.mul(b, &t.y))
+	z := o.add(o.mul(a, &s.z), o.mul(b, &t.z))
+	v.x.Copy(x)
+	v.y.Copy(y)
+	v.z.Copy(z)
+	return v
+}
+
+// addVec sets v equal to s+t, and returns v.
+func (o floatOps) addVec(v, s, t *Vec3) *Vec3 {
+	v.x.Copy(o.add(&s.x, &t.x))
+	v.y.Copy(o.add(&s.y, &t.y))
+	v.z.Copy(o.add(&s.z, &t.z))
+	return v
+}
+
+// subVec sets v equal to s-t, and returns v.
+func (o floatOps) subVec(v, s, t *Vec3) *Vec3 {
+	v.x.Copy(o.sub(&s.x, &t.x))
+	v.y.Copy(o.sub(&s.y, &t.y))
+	v.z.Copy(o.sub(&s.z, &t.z))
+	return v
+}
+
+// Exact returns true if the most recent operation that set z involved no
+// rounding.
+func (z *Zorn) Exact() bool {
+	return isExact(z.components()...)
+}
+
+// Exact returns true if the most recent operation that set z involved no
+// rounding.
+func (z *Macfarlane) Exact() bool {
+	return isExact(z.components()...)
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestComplexMulExact(t *testing.T) {
+	// The random components are multiples of 2**(-53) in [0, 1), so their
+	// products fit in 128 bits.
+	f := func(x, y *Complex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		z := newComplexPrec(128).Mul(x, y)
+		w := newComplexPrec(512).Mul(x, y)
+		return z.Exact() && z.Equals(w)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonMulExact(t *testing.T) {
+	f := func(x, y *Hamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		z := setPrecHamilton(new(Hamilton), 128).Mul(x, y)
+		w := setPrecHamilton(new(Hamilton), 512).Mul(x, y)
+		return z.Exact() && z.Equals(w)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexMulInexact(t *testing.T) {
+	x := NewComplex(newFloat(4).SetInt64(3), newFloat(4).SetInt64(5))
+	if z := new(Complex).Mul(x, x); !z.Exact() {
+		t.Errorf("Mul(%v, %v) = %v is not exact", x, x, z)
+	}
+	y := NewComplex(newFloat(4).SetInt64(13), newFloat(4).SetInt64(1))
+	if z := new(Complex).Mul(x, y); z.Exact() {
+		t.Errorf("Mul(%v, %v) = %v is exact", x, y, z)
+	}
+}
+
+func TestMulFallbackInexact(t *testing.T) {
+	// The components of x are too far apart for an exact product, and the
+	// square of 1+2**(-63) is rounded to 64 bits, while the sums that follow
+	// it are exact.
+	one := newFloat(64).SetInt64(1)
+	a := newFloat(64).SetMantExp(one, -63)
+	a.Add(a, one)
+	tiny := newFloat(64).SetMantExp(one, -500)
+	zero := newFloat(64)
+	want := newFloat(64).SetMantExp(one, -62)
+	want.Add(want, one)
+
+	x := NewComplex(a, tiny)
+	y := NewComplex(a, zero)
+	if z := new(Complex).Mul(x, y); z.Exact() || z.l.Cmp(want) != 0 {
+		t.Errorf("Mul(%v, %v) = %v, exact: %t", x, y, z, z.Exact())
+	}
+	p := NewHamilton(a, tiny, zero, zero)
+	q := NewHamilton(a, zero, zero, zero)
+	if z := new(Hamilton).Mul(p, q); z.Exact() || z.l.l.Cmp(want) != 0 {
+		t.Errorf("Mul(%v, %v) = %v, exact: %t", p, q, z, z.Exact())
+	}
+	u := NewMacfarlane(a, tiny, zero, zero)
+	v := NewMacfarlane(a, zero, zero, zero)
+	if z := new(Macfarlane).Mul(u, v); z.Exact() || z.a.Cmp(want) != 0 {
+		t.Errorf("Mul(%v, %v) = %v, exact: %t", u, v, z, z.Exact())
+	}
+}
+
+func TestMarkRounded(t *testing.T) {
+	modes := []big.RoundingMode{
+		big.ToNearestEven, big.ToNearestAway, big.ToZero,
+		big.AwayFromZero, big.ToNegativeInf, big.ToPositiveInf,
+	}
+	for _, m := range modes {
+		for _, f := range []float64{1, -1, 0.75, -3.25e10, 1e-300, 0, math.Copysign(0, -1), math.Inf(-1)} {
+			v := newFloat(64).SetMode(m).SetFloat64(f)
+			w := new(big.Float).Copy(v)
+			markRounded(v)
+			if v.Cmp(w) != 0 || v.Signbit() != w.Signbit() || v.Prec() != 64 || v.Acc() == big.Exact {
+				t.Errorf("markRounded(%v) in %v = %v at %d bits, %v", w, m, v, v.Prec(), v.Acc())
+			}
+		}
+	}
+}
+
+func TestHamiltonMulRoundsOnce(t *testing.T) {
+	// The square of 1+2**(-8) needs 17 bits, but its cancellation against -1
+	// leaves 2**(-7)+2**(-16), which fits in 16 bits.
+	one := newFloat(16).SetInt64(1)
+	a := newFloat(16).SetMantExp(one, -8)
+	a.Add(a, one)
+	zero := newFloat(16)
+	x := NewHamilton(a, one, zero, zero)
+	z := new(Hamilton).Mul(x, x)
+	want := newFloat(16).SetMantExp(one, -16)
+	want.Add(want, newFloat(16).SetMantExp(one, -7))
+	if !z.Exact() || z.l.l.Cmp(want) != 0 {
+		t.Errorf("Mul(%v, %v) = %v", x, x, z)
+	}
+}
+
+func TestHamiltonMulConjOperand(t *testing.T) {
+	// The partial product Mul(Conj(j), j) needs few bits, which must not limit
+	// the precision of the conjugate of a+bi in the next partial product.
+	third := new(big.Float).Quo(big.NewFloat(1), big.NewFloat(3))
+	one, zero := big.NewFloat(1), new(big.Float)
+	x := NewHamilton(one, zero, one, zero)
+	y := NewHamilton(third, third, one, zero)
+	z := new(Hamilton).Mul(x, y)
+	// (1+j)(t+ti+j) = (t-1) + ti + (1+t)j - tk
+	want := []*big.Float{
+		new(big.Float).Sub(third, one),
+		third,
+		new(big.Float).Add(one, third),
+		new(big.Float).Neg(third),
+	}
+	a, b, c, d := z.Cartesian()
+	for k, v := range []*big.Float{a, b, c, d} {
+		if v.Cmp(want[k]) != 0 {
+			t.Errorf("Mul(%v, %v) = %v, want %v", x, y, z, want)
+			break
+		}
+	}
+}
+
+func TestSupraAddExact(t *testing.T) {
+	f := func(x, y *Supra) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		z := new(Supra)
+		z.l.l.SetPrec(64)
+		z.l.r.SetPrec(64)
+		z.r.l.SetPrec(64)
+		z.r.r.SetPrec(64)
+		return z.Add(x, y).Exact() && z.Sub(z, y).Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	one := newFloat(8).SetInt64(1)
+	tiny := newFloat(8).SetMantExp(one, -20)
+	x := NewSupra(one, one, one, one)
+	y := NewSupra(tiny, tiny, tiny, tiny)
+	if z := new(Supra).Add(x, y); z.Exact() {
+		t.Errorf("Add(%v, %v) = %v is exact", x, y, z)
+	}
+}
+
+func TestComplexMulWideExponents(t *testing.T) {
+	// The exact product of these components would need about 4e8 bits.
+	one := big.NewFloat(1)
+	hi := newFloat(53).SetMantExp(one, 1e8)
+	lo := newFloat(53).SetMantExp(one, -1e8)
+	x := NewComplex(hi, lo)
+	z := new(Complex).Mul(x, x)
+	want := NewComplex(newFloat(53).SetMantExp(one, 2e8), newFloat(53).SetInt64(2))
+	if !z.Equals(want) || z.l.Prec() != 53 {
+		t.Errorf("Mul(%v, %v) = %v, want %v", x, x, z, want)
+	}
+}
@@ -0,0 +1,406 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math/big"
+
+// Exp sets z equal to the exponential of y, and returns z.
+//
+// If y = a+bi, then the exponential is
+// 		exp(a)cos(b) + exp(a)sin(b)i
+// The result is rounded to the precision of y.
+func (z *Complex) Exp(y *Complex) *Complex {
+	prec := maxPrec(&y.l, &y.r)
+	e := bigExp(&y.l, prec)
+	s, c := bigSinCos(&y.r, prec)
+	z.l.SetPrec(prec)
+	z.r.SetPrec(prec)
+	prod(&z.l, e, c)
+	prod(&z.r, e, s)
+	return z
+}
+
+// Log sets z equal to the principal logarithm of y, and returns z.
+//
+// If y = a+bi, then the logarithm is
+// 		log(√(a² + b²)) + atan2(b, a)i
+// so the imaginary part lies in the interval [-π, π]. The logarithm of zero
+// has a real part equal to -Inf. The result is rounded to the precision of y.
+func (z *Complex) Log(y *Complex) *Complex {
+	prec := maxPrec(&y.l, &y.r)
+	w := prec + guardBits
+	quad := newFloat(w).Mul(&y.l, &y.l)
+	quad.Add(quad, newFloat(w).Mul(&y.r, &y.r))
+	arg := bigAtan2(&y.r, &y.l, prec)
+	mod := bigLog(quad, w)
+	z.l.SetPrec(prec)
+	z.r.SetPrec(prec)
+	if !mod.IsInf() {
+		mod.SetMantExp(mod, -1)
+	}
+	z.l.Set(mod)
+	z.r.Set(arg)
+	return z
+}
+
+// LogExpConsistent sets z equal to a logarithm of y and returns the branch
+// correction k applied to the principal logarithm:
+// 		z = Log(y) + 2πki
+// If ref is nil, then k is zero. Otherwise k is chosen so that the imaginary
+// part of z is as close as possible to the imaginary part of ref, which keeps
+// the logarithms of a sequence of rotations continuous. The boolean result
+// reports whether Exp(z) reproduces y to within a relative error of tol.
+func (z *Complex) LogExpConsistent(y, ref *Complex, tol *big.Float) (int, bool) {
+	prec := maxPrec(&y.l, &y.r)
+	w := prec + guardBits
+	orig := new(Complex).Copy(y)
+	z.Log(y)
+	k := 0
+	if ref != nil && !z.l.IsInf() {
+		twoPi := bigPi(w)
+		twoPi.SetMantExp(twoPi, 1)
+		k = nearestTurn(newFloat(w).Sub(&ref.r, &z.r), twoPi)
+		twoPi.Mul(twoPi, newFloat(w).SetInt64(int64(k)))
+		z.r.Add(&z.r, twoPi)
+	}
+	diff := new(Complex).Exp(z)
+	diff.Sub(diff, orig)
+	bound := newFloat(w).Mul(tol, tol)
+	bound.Mul(bound, orig.Quad())
+	return k, diff.Quad().Cmp(bound) <= 0
+}
+
+// Exp sets z equal to the exponential of y, and returns z.
+//
+// If y = a+v, where v is the vector part of y, then the exponential is
+// 		exp(a)(cos(|v|) + v sin(|v|)/|v|)
+// The result is rounded to the precision of y.
+func (z *Hamilton) Exp(y *Hamilton) *Hamilton {
+	a, b, c, d := y.Cartesian()
+	prec := maxPrec(a, b, c, d)
+	w := prec + guardBits
+	theta := bigHypot(w, b, c, d)
+	e := bigExp(a, w)
+	s, co := bigSinCos(theta, w)
+	f := newFloat(w)
+	if theta.Sign() != 0 {
+		f.Quo(prod(f, e, s), theta)
+	}
+	return z.setScaledVector(prod(co, e, co), f, b, c, d, prec)
+}
+
+// Log sets z equal to the principal logarithm of y, and returns z.
+//
+// If y = a+v, where v is the vector part of y, then the logarithm is
+// 		log(|y|) + v atan2(|v|, a)/|v|
+// so the vector part has length at most π. If v is zero and a is negative,
+// then the vector part of the logarithm is πi. The logarithm of zero has a
+// real part equal to -Inf. The result is rounded to the precision of y.
+func (z *Hamilton) Log(y *Hamilton) *Hamilton {
+	a, b, c, d := y.Cartesian()
+	prec := maxPrec(a, b, c, d)
+	w := prec + guardBits
+	theta := bigHypot(w, b, c, d)
+	mod := bigLog(bigHypot(w, a, b, c, d), w)
+	if theta.Sign() == 0 {
+		zero := new(big.Float)
+		if a.Sign() < 0 {
+			return z.setScaledVector(mod, bigPi(w), big.NewFloat(1), zero, zero, prec)
+		}
+		return z.setScaledVector(mod, zero, zero, zero, zero, prec)
+	}
+	f := bigAtan2(theta, a, w)
+	f.Quo(f, theta)
+	return z.setScaledVector(mod, f, b, c, d, prec)
+}
+
+// LogExpConsistent sets z equal to a logarithm of y and returns the branch
+// correction k applied to the principal logarithm. If u is the unit vector
+// along the vector part of Log(y), and θ is the length of that vector part,
+// then z has the same real part as Log(y) and the vector part
+// 		u(θ + 2πk)
+// If ref is nil, then k is zero. Otherwise k is chosen so that the vector part
+// of z is as close as possible to the vector part of ref, which keeps the
+// logarithms of a sequence of rotations continuous. When the vector part of y
+// vanishes, the axis is taken from ref. The boolean result reports whether
+// Exp(z) reproduces y to within a relative error of tol.
+func (z *Hamilton) LogExpConsistent(y, ref *Hamilton, tol *big.Float) (int, bool) {
+	a, b, c, d := y.Cartesian()
+	prec := maxPrec(a, b, c, d)
+	w := prec + guardBits
+	orig := new(Hamilton).Copy(y)
+	z.Log(y)
+	k := 0
+	if ref != nil && !z.l.l.IsInf() {
+		_, rb, rc, rd := ref.Cartesian()
+		_, zb, zc, zd := z.Cartesian()
+		theta := bigHypot(w, zb, zc, zd)
+		rnorm := bigHypot(w, rb, rc, rd)
+		u := [3]*big.Float{newFloat(w), newFloat(w), newFloat(w)}
+		switch {
+		case orig.l.r.Sign() != 0 || orig.r.l.Sign() != 0 || orig.r.r.Sign() != 0:
+			u[0].Quo(zb, theta)
+			u[1].Quo(zc, theta)
+			u[2].Quo(zd, theta)
+		case rnorm.Sign() != 0:
+			u[0].Quo(rb, rnorm)
+			u[1].Quo(rc, rnorm)
+			u[2].Quo(rd, rnorm)
+		default:
+			u[0].SetInt64(1)
+		}
+		proj := newFloat(w).Mul(u[0], rb)
+		proj.Add(proj, newFloat(w).Mul(u[1], rc))
+		proj.Add(proj, newFloat(w).Mul(u[2], rd))
+		twoPi := bigPi(w)
+		twoPi.SetMantExp(twoPi, 1)
+		k = nearestTurn(proj.Sub(proj, theta), twoPi)
+		twoPi.Mul(twoPi, newFloat(w).SetInt64(int64(k)))
+		z.setScaledVector(
+			newFloat(w).Set(&z.l.l), theta.Add(theta, twoPi),
+			u[0], u[1], u[2], prec,
+		)
+	}
+	diff := new(Hamilton).Exp(z)
+	diff.Sub(diff, orig)
+	bound := newFloat(w).Mul(tol, tol)
+	bound.Mul(bound, orig.Quad())
+	return k, diff.Quad().Cmp(bound) <= 0
+}
+
+// setScaledVector sets z equal to a + f(bi + cj + dk) rounded to prec bits,
+// and returns z.
+func (z *Hamilton) setScaledVector(a, f, b, c, d *big.Float, prec uint) *Hamilton {
+	w := prec + guardBits
+	vb := newFloat(w).Mul(f, b)
+	vc := newFloat(w).Mul(f, c)
+	vd := newFloat(w).Mul(f, d)
+	z.l.l.SetPrec(prec).Set(a)
+	z.l.r.SetPrec(prec).Set(vb)
+	z.r.l.SetPrec(prec).Set(vc)
+	z.r.r.SetPrec(prec).Set(vd)
+	return z
+}
+
+// nearestTurn returns the integer nearest to x/turn.
+func nearestTurn(x, turn *big.Float) int {
+	q := new(big.Float).Quo(x, turn)
+	if q.Sign() < 0 {
+		q.Sub(q, big.NewFloat(0.5))
+	} else {
+		q.Add(q, big.NewFloat(0.5))
+	}
+	k, _ := q.Int64()
+	return int(k)
+}
+
+// Exp sets z equal to the exponential of y, and returns z.
+//
+// If y = a+bs, then the exponential is
+// 		exp(a)cosh(b) + exp(a)sinh(b)s
+// which always lies in the RightQuadrant. The result is rounded to the
+// precision of y.
+func (z *Perplex) Exp(y *Perplex) *Perplex {
+	prec := maxPrec(&y.l, &y.r)
+	e := bigExp(&y.l, prec)
+	sh, ch := bigSinhCosh(&y.r, prec)
+	z.l.SetPrec(prec)
+	z.r.SetPrec(prec)
+	prod(&z.l, e, ch)
+	prod(&z.r, e, sh)
+	return z
+}
+
+// Log sets z equal to the logarithm of y, and returns z.
+//
+// If y = a+bs lies in the RightQuadrant, then the logarithm is
+// 		log(√(a² - b²)) + atanh(b/a)s
+// Values outside the RightQuadrant are not exponentials of perplex numbers,
+// so Log panics for them. The result is rounded to the precision of y.
+func (z *Perplex) Log(y *Perplex) *Perplex {
+	rho, phi, class := y.HyperbolicPolar()
+	if class != RightQuadrant {
+		panic("logarithm outside the right quadrant")
+	}
+	prec := maxPrec(&y.l, &y.r)
+	z.l.SetPrec(prec).Set(bigLog(rho, prec))
+	z.r.SetPrec(prec).Set(phi)
+	return z
+}
+
+// Exp sets z equal to the exponential of y, and returns z.
+//
+// If y = a+bα, then α² = 0 truncates the power series, and the exponential is
+// exactly
+// 		exp(a) + exp(a)bα
+// so the infinitesimal part carries the derivative of exp. The result is
+// rounded to the precision of y.
+func (z *Infra) Exp(y *Infra) *Infra {
+	prec := maxPrec(&y.l, &y.r)
+	e := bigExp(&y.l, prec+guardBits)
+	z.r.SetPrec(prec)
+	prod(&z.r, e, &y.r)
+	z.l.SetPrec(prec).Set(e)
+	return z
+}
+
+// Log sets z equal to the logarithm of y, and returns z.
+//
+// If y = a+bα with a > 0, then the logarithm is exactly
+// 		log(a) + (b/a)α
+// Values with a ≤ 0 are not exponentials of infra numbers, so Log panics for
+// them. The result is rounded to the precision of y.
+func (z *Infra) Log(y *Infra) *Infra {
+	if y.l.Sign() <= 0 {
+		panic("logarithm of infra number with non-positive real part")
+	}
+	prec := maxPrec(&y.l, &y.r)
+	z.r.SetPrec(prec).Quo(&y.r, &y.l)
+	z.l.SetPrec(prec).Set(bigLog(&y.l, prec))
+	return z
+}
+
+// Exp sets z equal to the exponential of y, and returns z.
+//
+// If y = a+v, where v = bi+ct+du is the vector part of y, then v² = -q with
+// 		q = b² - c² - d²
+// and the exponential depends on the sign of q. If q > 0 (elliptic), then
+// 		exp(a)(cos(√q) + v sin(√q)/√q)
+// If q < 0 (hyperbolic), then
+// 		exp(a)(cosh(√-q) + v sinh(√-q)/√-q)
+// If q = 0 (parabolic), then
+// 		exp(a)(1 + v)
+// The result is rounded to the precision of y.
+func (z *Cockle) Exp(y *Cockle) *Cockle {
+	a, b, c, d := y.Cartesian()
+	prec := maxPrec(a, b, c, d)
+	w := prec + guardBits
+	q := cockleVectorQuad(b, c, d)
+	e := bigExp(a, w)
+	f := newFloat(w).Set(e)
+	switch q.Sign() {
+	case 1:
+		theta := newFloat(w).Sqrt(q)
+		s, co := bigSinCos(theta, w)
+		f.Quo(prod(f, e, s), theta)
+		e.Mul(e, co)
+	case -1:
+		theta := newFloat(w).Sqrt(newFloat(w).Neg(q))
+		sh, ch := bigSinhCosh(theta, w)
+		f.Quo(prod(f, e, sh), theta)
+		e.Mul(e, ch)
+	}
+	return z.setScaledVector(e, f, b, c, d, prec)
+}
+
+// Log sets z equal to the logarithm of y, and returns z.
+//
+// If y = a+v, where v = bi+ct+du is the vector part of y, then v² = -q with
+// 		q = b² - c² - d²
+// and the logarithm depends on the sign of q. If q > 0 (elliptic), then
+// 		log(√(a² + q)) + v atan2(√q, a)/√q
+// which is defined for all a. If q < 0 (hyperbolic), then
+// 		log(√(a² + q)) + v atanh(√-q/a)/√-q
+// which requires a > √-q. If q = 0 and v is non-zero (parabolic), then
+// 		log(a) + v/a
+// which requires a > 0. If v is zero and a is negative, then the vector part
+// of the logarithm is πi, and the logarithm of zero has a real part equal to
+// -Inf. Values outside these domains are not exponentials of Cockle numbers,
+// so Log panics for them. The result is rounded to the precision of y.
+func (z *Cockle) Log(y *Cockle) *Cockle {
+	a, b, c, d := y.Cartesian()
+	prec := maxPrec(a, b, c, d)
+	w := prec + guardBits
+	q := cockleVectorQuad(b, c, d)
+	zero := new(big.Float)
+	switch {
+	case q.Sign() > 0:
+		theta := newFloat(w).Sqrt(q)
+		mod := bigLog(exactAdd(exactMul(a, a), q), w)
+		mod.SetMantExp(mod, -1)
+		f := bigAtan2(theta, a, w)
+		return z.setScaledVector(mod, f.Quo(f, theta), b, c, d, prec)
+	case q.Sign() < 0:
+		theta := newFloat(w).Sqrt(newFloat(w).Neg(q))
+		if a.Cmp(theta) <= 0 {
+			panic("logarithm of cockle number outside the domain")
+		}
+		mod := bigLog(exactAdd(exactMul(a, a), q), w)
+		mod.SetMantExp(mod, -1)
+		f := bigAtanhQuo(theta, a, w)
+		return z.setScaledVector(mod, f.Quo(f, theta), b, c, d, prec)
+	case b.Sign() != 0 || c.Sign() != 0 || d.Sign() != 0:
+		if a.Sign() <= 0 {
+			panic("logarithm of cockle number outside the domain")
+		}
+		f := newFloat(w).Quo(big.NewFloat(1), a)
+		return z.setScaledVector(bigLog(a, w), f, b, c, d, prec)
+	case a.Sign() < 0:
+		mod := bigLog(newFloat(w).Neg(a), w)
+		return z.setScaledVector(mod, bigPi(w), big.NewFloat(1), zero, zero, prec)
+	}
+	return z.setScaledVector(bigLog(a, w), zero, zero, zero, zero, prec)
+}
+
+// cockleVectorQuad returns b² - c² - d² without rounding.
+func cockleVectorQuad(b, c, d *big.Float) *big.Float {
+	return exactSub(exactSub(exactMul(b, b), exactMul(c, c)), exactMul(d, d))
+}
+
+// setScaledVector sets z equal to a + f(bi + ct + du) rounded to prec bits,
+// and returns z.
+func (z *Cockle) setScaledVector(a, f, b, c, d *big.Float, prec uint) *Cockle {
+	w := prec + guardBits
+	vb := newFloat(w).Mul(f, b)
+	vc := newFloat(w).Mul(f, c)
+	vd := newFloat(w).Mul(f, d)
+	z.l.l.SetPrec(prec).Set(a)
+	z.l.r.SetPrec(prec).Set(vb)
+	z.r.l.SetPrec(prec).Set(vc)
+	z.r.r.SetPrec(prec).Set(vd)
+	return z
+}
+
+// Exp sets z equal to the exponential of y, and returns z. If y = a0 + a1α +
+// ..., then the components of the exponential b0 + b1α + ... are given by
+// 		b0 = exp(a0)
+// 		nbn = a1b(n-1) + 2a2b(n-2) + ... + nanb0
+// The result is rounded to the precision of y.
+func (z *Jet) Exp(y *Jet) *Jet {
+	prec := maxPrec(y.Cartesian()...)
+	w := prec + guardBits
+	b := make([]*big.Float, len(y.c))
+	b[0] = bigExp(&y.c[0], w)
+	for n := 1; n < len(b); n++ {
+		b[n] = jetSum(y.c, b, n, w)
+	}
+	return z.setJet(b, prec)
+}
+
+// Log sets z equal to the natural logarithm of y, and returns z. If y = a0 +
+// a1α + ..., then the components of the logarithm b0 + b1α + ... are given by
+// 		b0 = log(a0)
+// 		nbn = (nan - (b1a(n-1) + 2b2a(n-2) + ... + (n-1)b(n-1)a1))/a0
+// The result is rounded to the precision of y. If the real part of y is not
+// positive, then Log panics.
+func (z *Jet) Log(y *Jet) *Jet {
+	if y.c[0].Sign() <= 0 {
+		panic("logarithm of non-positive real part")
+	}
+	prec := maxPrec(y.Cartesian()...)
+	w := prec + guardBits
+	b := make([]*big.Float, len(y.c))
+	b[0] = bigLog(&y.c[0], w)
+	for n := 1; n < len(b); n++ {
+		sum := newFloat(w)
+		for j := 1; j < n; j++ {
+			t := newFloat(w).Mul(b[j], &y.c[n-j])
+			sum.Add(sum, t.Mul(t, newFloat(w).SetInt64(int64(j))))
+		}
+		sum.Quo(sum, newFloat(w).SetInt64(int64(n)))
+		b[n] = sum.Sub(&y.c[n], sum)
+		b[n].Quo(b[n], &y.c[0])
+	}
+	return z.setJet(b, prec)
+}
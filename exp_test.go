@@ -0,0 +1,333 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+	"math/cmplx"
+	"testing"
+	"testing/quick"
+)
+
+// setPrecComplex returns a copy of x with both components at precision prec.
+func setPrecComplex(x *Complex, prec uint) *Complex {
+	y := new(Complex)
+	y.l.SetPrec(prec).Set(&x.l)
+	y.r.SetPrec(prec).Set(&x.r)
+	return y
+}
+
+// setPrecHamilton returns a copy of x with all components at precision prec.
+func setPrecHamilton(x *Hamilton, prec uint) *Hamilton {
+	y := new(Hamilton)
+	y.l.Copy(setPrecComplex(&x.l, prec))
+	y.r.Copy(setPrecComplex(&x.r, prec))
+	return y
+}
+
+func TestComplexExpFloat64(t *testing.T) {
+	f := func(x *Complex) bool {
+		a, _ := x.l.Float64()
+		b, _ := x.r.Float64()
+		return closeToComplex128(new(Complex).Exp(x), cmplx.Exp(complex(a, b)), 48)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexLogFloat64(t *testing.T) {
+	f := func(x *Complex) bool {
+		a, _ := x.l.Float64()
+		b, _ := x.r.Float64()
+		// cmplx.Log loses bits to cancellation in log|x| near |x| = 1, so
+		// the reference takes log|x| from |x|^2 - 1 there, which is exact at
+		// 200 bits.
+		q := new(big.Float).SetPrec(200).Mul(&x.l, &x.l)
+		q.Add(q, new(big.Float).SetPrec(200).Mul(&x.r, &x.r))
+		u, _ := new(big.Float).SetPrec(200).Sub(q, big.NewFloat(1)).Float64()
+		v, _ := q.Float64()
+		re := math.Log(v) / 2
+		if math.Abs(u) < 0.5 {
+			re = math.Log1p(u) / 2
+		}
+		want := complex(re, math.Atan2(b, a))
+		return closeToComplex128(new(Complex).Log(x), want, 48)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexExpLogInverse(t *testing.T) {
+	f := func(x *Complex) bool {
+		y := setPrecComplex(x, 200)
+		l := new(Complex).Exp(new(Complex).Log(y))
+		return closeTo(&l.l, &y.l, 180) && closeTo(&l.r, &y.r, 180)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonExpLogInverse(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		y := setPrecHamilton(x, 200)
+		l := new(Hamilton).Exp(new(Hamilton).Log(y))
+		d := l.Sub(l, y).Quad()
+		return d.Sign() == 0 || expo(d) < expo(y.Quad())-360
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonLogExpInverse(t *testing.T) {
+	// The random vector parts are shorter than π, so Log undoes Exp.
+	f := func(x *Hamilton) bool {
+		y := setPrecHamilton(x, 200)
+		l := new(Hamilton).Log(new(Hamilton).Exp(y))
+		d := l.Sub(l, y).Quad()
+		return d.Sign() == 0 || expo(d) < expo(y.Quad())-360
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonExpComplexSubalgebra(t *testing.T) {
+	// On values a+bi, Exp and Log agree with those of Complex.
+	f := func(x *Complex) bool {
+		y := new(Hamilton)
+		y.l.Copy(x)
+		e := new(Hamilton).Exp(y)
+		l := new(Hamilton).Log(y)
+		de := new(Complex).Sub(&e.l, new(Complex).Exp(x))
+		dl := new(Complex).Sub(&l.l, new(Complex).Log(x))
+		return e.r.Quad().Sign() == 0 && l.r.Quad().Sign() == 0 &&
+			(de.Quad().Sign() == 0 || complexExpo(de) < complexExpo(&e.l)-50) &&
+			(dl.Quad().Sign() == 0 || complexExpo(dl) < complexExpo(&l.l)-50)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonExpVectorIsVersor(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		y := setPrecHamilton(x, 200)
+		y.l.l.SetInt64(0)
+		q := new(Hamilton).Exp(y).Quad()
+		return closeTo(q, big.NewFloat(1), 190)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonLogNegativeReal(t *testing.T) {
+	zero := new(big.Float)
+	y := NewHamilton(big.NewFloat(-1), zero, zero, zero)
+	l := new(Hamilton).Log(y)
+	if l.l.l.Sign() != 0 || !closeTo(&l.l.r, bigPi(53), 50) || l.r.Quad().Sign() != 0 {
+		t.Errorf("Log(%v) = %v", y, l)
+	}
+	if l = new(Hamilton).Log(new(Hamilton)); !l.l.l.IsInf() {
+		t.Errorf("Log(0) = %v", l)
+	}
+}
+
+func TestComplexLogExpConsistentWinding(t *testing.T) {
+	y := new(Complex).Exp(NewComplex(big.NewFloat(0.5), big.NewFloat(3)))
+	ref := NewComplex(big.NewFloat(0), big.NewFloat(3+4*math.Pi))
+	z := new(Complex)
+	k, ok := z.LogExpConsistent(y, ref, big.NewFloat(1e-14))
+	if k != 2 || !ok {
+		t.Fatalf("LogExpConsistent = %d, %t", k, ok)
+	}
+	if got, _ := z.r.Float64(); math.Abs(got-(3+4*math.Pi)) > 1e-12 {
+		t.Errorf("imaginary part = %v", got)
+	}
+}
+
+func TestHamiltonLogExpConsistentWinding(t *testing.T) {
+	zero := new(big.Float)
+	y := new(Hamilton).Exp(NewHamilton(zero, big.NewFloat(0.6), zero, big.NewFloat(0.8)))
+	ref := NewHamilton(zero, big.NewFloat(-0.6*(2*math.Pi-1)), zero, big.NewFloat(-0.8*(2*math.Pi-1)))
+	z := new(Hamilton)
+	k, ok := z.LogExpConsistent(y, ref, big.NewFloat(1e-14))
+	if k != -1 || !ok {
+		t.Fatalf("LogExpConsistent = %d, %t", k, ok)
+	}
+	if got, _ := z.l.r.Float64(); math.Abs(got+0.6*(2*math.Pi-1)) > 1e-12 {
+		t.Errorf("i component = %v", got)
+	}
+}
+
+func TestPerplexExpLogInverse(t *testing.T) {
+	f := func(x *Perplex) bool {
+		y := new(Perplex)
+		y.l.SetPrec(200).Set(&x.l)
+		y.r.SetPrec(200).Set(&x.r)
+		l := new(Perplex).Log(new(Perplex).Exp(y))
+		return closeTo(&l.l, &y.l, 180) && closeTo(&l.r, &y.r, 180)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPerplexHyperbolicPolar(t *testing.T) {
+	cases := []struct {
+		a, b  float64
+		class PerplexClass
+	}{
+		{5, 3, RightQuadrant},
+		{-5, 3, LeftQuadrant},
+		{3, 5, UpperQuadrant},
+		{3, -5, LowerQuadrant},
+		{2, -2, LightLike},
+	}
+	for _, c := range cases {
+		z := NewPerplex(big.NewFloat(c.a), big.NewFloat(c.b))
+		rho, phi, class := z.HyperbolicPolar()
+		if class != c.class {
+			t.Errorf("class of %v = %v, want %v", z, class, c.class)
+			continue
+		}
+		if class == LightLike {
+			continue
+		}
+		if r, _ := rho.Float64(); r != 4 {
+			t.Errorf("modulus of %v = %v", z, rho)
+		}
+		p, _ := phi.Float64()
+		if want := math.Atanh(math.Min(math.Abs(c.a), math.Abs(c.b)) / math.Max(math.Abs(c.a), math.Abs(c.b))); math.Abs(math.Abs(p)-want) > 1e-15 {
+			t.Errorf("rapidity of %v = %v, want ±%v", z, p, want)
+		}
+	}
+}
+
+func TestInfraExpLogInverse(t *testing.T) {
+	f := func(x *Infra) bool {
+		y := new(Infra)
+		y.l.SetPrec(200).Set(&x.l)
+		y.r.SetPrec(200).Set(&x.r)
+		l := new(Infra).Log(new(Infra).Exp(y))
+		return closeTo(&l.l, &y.l, 180) && closeTo(&l.r, &y.r, 180)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraExpLogDerivative(t *testing.T) {
+	// The infinitesimal part of f(a+α) is the derivative f'(a).
+	f := func(x *Infra) bool {
+		y := new(Infra)
+		y.l.SetPrec(200).Set(&x.l)
+		y.l.Add(&y.l, big.NewFloat(0.5))
+		y.r.SetPrec(200).SetInt64(1)
+		e := new(Infra).Exp(y)
+		l := new(Infra).Log(y)
+		inv := newFloat(200).Quo(big.NewFloat(1), &y.l)
+		return e.l.Cmp(&e.r) == 0 && closeTo(&l.r, inv, 195)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// setPrecCockle returns a copy of x with all components at precision prec.
+func setPrecCockle(x *Cockle, prec uint) *Cockle {
+	y := new(Cockle)
+	y.l.Copy(setPrecComplex(&x.l, prec))
+	y.r.Copy(setPrecComplex(&x.r, prec))
+	return y
+}
+
+func TestCockleLogExpInverse(t *testing.T) {
+	// The random vector parts have |q| < 1, so the elliptic angle is shorter
+	// than π and Log undoes Exp in every case.
+	f := func(x *Cockle) bool {
+		// t.Logf("x = %v", x)
+		y := setPrecCockle(x, 200)
+		l := new(Cockle).Log(new(Cockle).Exp(y))
+		d := new(Complex).Sub(&l.l, &y.l).Quad()
+		d.Add(d, new(Complex).Sub(&l.r, &y.r).Quad())
+		return d.Sign() == 0 || expo(d) < -360
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCockleExpLogInverse(t *testing.T) {
+	f := func(x *Cockle) bool {
+		// t.Logf("x = %v", x)
+		y := setPrecCockle(x, 200)
+		// Move the real part past the hyperbolic boundary.
+		y.l.l.Add(&y.l.l, big.NewFloat(2))
+		l := new(Cockle).Exp(new(Cockle).Log(y))
+		d := new(Complex).Sub(&l.l, &y.l).Quad()
+		d.Add(d, new(Complex).Sub(&l.r, &y.r).Quad())
+		return d.Sign() == 0 || expo(d) < -360
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCockleExpPerplexSubalgebra(t *testing.T) {
+	// On values a+ct, Exp agrees with that of Perplex.
+	f := func(x *Perplex) bool {
+		zero := new(big.Float)
+		y := NewCockle(&x.l, zero, &x.r, zero)
+		e := new(Cockle).Exp(y)
+		p := new(Perplex).Exp(x)
+		return closeTo(&e.l.l, &p.l, 50) && closeTo(&e.r.l, &p.r, 50) &&
+			e.l.r.Sign() == 0 && e.r.r.Sign() == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCockleExpLogParabolic(t *testing.T) {
+	// With v = i+t, v² = 0 and exp(a+v) = exp(a)(1+v).
+	zero := new(big.Float)
+	one := big.NewFloat(1)
+	y := NewCockle(big.NewFloat(0.5), one, one, zero)
+	e := new(Cockle).Exp(y)
+	ea := math.Exp(0.5)
+	for _, v := range []*big.Float{&e.l.l, &e.l.r, &e.r.l} {
+		if !closeTo(v, big.NewFloat(ea), 50) {
+			t.Errorf("Exp(%v) = %v", y, e)
+		}
+	}
+	l := new(Cockle).Log(e)
+	d := new(Complex).Sub(&l.l, &y.l).Quad()
+	d.Add(d, new(Complex).Sub(&l.r, &y.r).Quad())
+	if d.Sign() != 0 && expo(d) > -100 {
+		t.Errorf("Log(%v) = %v", e, l)
+	}
+}
+
+func TestCockleLogOutsideDomain(t *testing.T) {
+	zero := new(big.Float)
+	for _, y := range []*Cockle{
+		NewCockle(big.NewFloat(0.5), zero, big.NewFloat(1), zero),
+		NewCockle(big.NewFloat(-1), big.NewFloat(1), big.NewFloat(1), zero),
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Log(%v) did not panic", y)
+				}
+			}()
+			new(Cockle).Log(y)
+		}()
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/bits"
+)
+
+// fftThreshold is the number of coefficients from which ComplexPoly.Mul
+// switches from the schoolbook rule to the fast Fourier transform.
+const fftThreshold = 64
+
+// fft replaces a with its discrete Fourier transform
+// 		A[k] = Σ a[j] ω^(jk)
+// where ω = exp(-2πi/n), or with the unnormalized inverse transform, with ω
+// = exp(2πi/n), if inverse is true. The length n of a must be a power of two,
+// roots must hold the n-th roots of unity in the order of RootsOfUnity, and
+// the arithmetic is done at the precision of the elements of a.
+func fft(a []Complex, roots []*Complex, inverse bool) {
+	n := len(a)
+	shift := uint(bits.UintSize - bits.Len(uint(n)) + 1)
+	for i := range a {
+		if j := int(bits.Reverse(uint(i)) >> shift); i < j {
+			a[i], a[j] = a[j], a[i]
+		}
+	}
+	t := new(Complex)
+	u := new(Complex)
+	for size := 2; size <= n; size *= 2 {
+		half, step := size/2, n/size
+		for start := 0; start < n; start += size {
+			for k := 0; k < half; k++ {
+				r := roots[k*step]
+				if !inverse {
+					r = u.Conj(r)
+				}
+				x, y := &a[start+k], &a[start+k+half]
+				t.Mul(r, y)
+				y.Sub(x, t)
+				x.Add(x, t)
+			}
+		}
+	}
+}
+
+// mulFFT returns the coefficients of the product of the polynomials with
+// coefficients x and y, computed as the inverse transform of the product of
+// the transforms. The rounding errors of the transforms are absolute, of
+// order 2^(-w) log n max|x| max|y| for working precision w, so w exceeds the
+// precision of the coefficients by the guard bits, twice the bit length of
+// n, and the binary spread of the magnitudes of the non-zero coefficients of
+// each operand, which keeps the small coefficients of the product accurate.
+// Coefficients that are exactly zero in the product may come out as values
+// of the size of the error. If the spread exceeds the precision, then the
+// transforms would cost more than they save, and mulSchoolbook is used.
+func mulFFT(x, y []Complex) []Complex {
+	prec := uint(0)
+	for _, c := range [][]Complex{x, y} {
+		for k := range c {
+			if q := maxPrec(&c[k].l, &c[k].r); q > prec {
+				prec = q
+			}
+		}
+	}
+	spread := complexSpread(x) + complexSpread(y)
+	if spread > prec {
+		return mulSchoolbook(x, y)
+	}
+	m := len(x) + len(y) - 1
+	n := 1 << uint(bits.Len(uint(m-1)))
+	w := prec + guardBits + 2*uint(bits.Len(uint(n))) + spread
+	a := make([]Complex, n)
+	b := make([]Complex, n)
+	for k := range a {
+		a[k].l.SetPrec(w)
+		a[k].r.SetPrec(w)
+		b[k].l.SetPrec(w)
+		b[k].r.SetPrec(w)
+		if k < len(x) {
+			a[k].round(&x[k], w)
+		}
+		if k < len(y) {
+			b[k].round(&y[k], w)
+		}
+	}
+	roots := RootsOfUnity(n, w)
+	fft(a, roots, false)
+	fft(b, roots, false)
+	for k := range a {
+		a[k].Mul(&a[k], &b[k])
+	}
+	fft(a, roots, true)
+	logN := -bits.Len(uint(n)) + 1
+	c := make([]Complex, m)
+	for k := range c {
+		a[k].l.SetMantExp(&a[k].l, logN)
+		a[k].r.SetMantExp(&a[k].r, logN)
+		c[k].round(&a[k], prec)
+	}
+	return c
+}
+
+// complexSpread returns the difference between the largest and the smallest
+// binary exponents of the non-zero values of c.
+func complexSpread(c []Complex) uint {
+	lo, hi := math.MaxInt32, math.MinInt32
+	for k := range c {
+		if c[k].l.Sign() == 0 && c[k].r.Sign() == 0 {
+			continue
+		}
+		e := complexExpo(&c[k])
+		if e < lo {
+			lo = e
+		}
+		if e > hi {
+			hi = e
+		}
+	}
+	if hi < lo {
+		return 0
+	}
+	return uint(hi - lo)
+}
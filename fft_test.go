@@ -0,0 +1,77 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/bits"
+	"math/rand"
+	"testing"
+)
+
+// randomComplexPoly returns a random ComplexPoly value with n coefficients.
+func randomComplexPoly(r *rand.Rand, n int) *ComplexPoly {
+	c := make([]*Complex, n)
+	for k := range c {
+		c[k] = newComplex128(complex(r.Float64(), r.Float64()))
+	}
+	return NewComplexPoly(c...)
+}
+
+func TestFFTInverse(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 8, 64} {
+		p := randomComplexPoly(r, n)
+		a := make([]Complex, n)
+		for k := range a {
+			a[k].round(&p.c[k], 100)
+		}
+		roots := RootsOfUnity(n, 100)
+		fft(a, roots, false)
+		fft(a, roots, true)
+		for k := range a {
+			a[k].l.SetMantExp(&a[k].l, -bits.TrailingZeros(uint(n)))
+			a[k].r.SetMantExp(&a[k].r, -bits.TrailingZeros(uint(n)))
+			if !closeToComplex(&a[k], &p.c[k], 90) {
+				t.Errorf("n = %d: a[%d] = %v, want %v", n, k, &a[k], &p.c[k])
+			}
+		}
+	}
+}
+
+func TestComplexPolyMulFFT(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	for _, size := range [][2]int{{64, 64}, {100, 70}, {200, 3}} {
+		x := randomComplexPoly(r, size[0])
+		y := randomComplexPoly(r, size[1])
+		got := new(ComplexPoly).Mul(x, y)
+		want := &ComplexPoly{mulSchoolbook(x.c, y.c)}
+		if got.Degree() != want.Degree() {
+			t.Fatalf("degree %d, want %d", got.Degree(), want.Degree())
+		}
+		for k := range want.c {
+			if !closeToComplex(&got.c[k], &want.c[k], 45) {
+				t.Errorf("%v: c[%d] = %v, want %v", size, k, &got.c[k], &want.c[k])
+			}
+		}
+	}
+}
+
+func TestComplexPolyMulFFTSpread(t *testing.T) {
+	// (1 + εx + ... + εx⁶⁴)², with ε = 2^-40, whose small coefficients
+	// would be lost in an absolute error at the precision of the operands.
+	c := make([]*Complex, 65)
+	for k := range c {
+		c[k] = newComplex128(0x1p-40)
+	}
+	c[0] = newComplex128(1)
+	x := NewComplexPoly(c...)
+	got := new(ComplexPoly).Mul(x, x)
+	want := mulSchoolbook(x.c, x.c)
+	for k := range want {
+		d := new(Complex).Sub(&got.c[k], &want[k])
+		if d.Quad().Sign() != 0 && complexExpo(d) > complexExpo(&want[k])-50 {
+			t.Errorf("c[%d] = %v, want %v", k, &got.c[k], &want[k])
+		}
+	}
+}
@@ -0,0 +1,488 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// flatten returns copies of the components x, with their precisions.
+func flatten(x []*big.Float) []*big.Float {
+	v := make([]*big.Float, len(x))
+	for k := range x {
+		v[k] = new(big.Float).Copy(x[k])
+	}
+	return v
+}
+
+// unflatten copies the values v onto the components x, with their
+// precisions. If v and x have different lengths, then unflatten panics.
+func unflatten(x, v []*big.Float) {
+	if len(v) != len(x) {
+		panic("wrong number of components")
+	}
+	for k := range x {
+		x[k].Copy(v[k])
+	}
+}
+
+// Flatten returns copies of the components of z in the order of Cartesian,
+// with their precisions, so generic code can treat z as a vector of
+// coefficients.
+func (z *Complex) Flatten() []*big.Float {
+	return flatten(floatSlice(z.Cartesian()))
+}
+
+// Unflatten sets z equal to the value with the components x, in the order of
+// Flatten, and returns z. The components keep their precisions. If x has the
+// wrong length, then Unflatten panics.
+func (z *Complex) Unflatten(x []*big.Float) *Complex {
+	unflatten(floatSlice(z.Cartesian()), x)
+	return z
+}
+
+// Flatten returns copies of the components of z, with the conventions of the
+// Flatten method of Complex.
+func (z *Perplex) Flatten() []*big.Float {
+	return flatten(floatSlice(z.Cartesian()))
+}
+
+// Unflatten sets z equal to the value with the components x, with the
+// conventions of the Unflatten method of Complex, and returns z.
+func (z *Perplex) Unflatten(x []*big.Float) *Perplex {
+	unflatten(floatSlice(z.Cartesian()), x)
+	return z
+}
+
+// Flatten returns copies of the components of z, with the conventions of the
+// Flatten method of Complex.
+func (z *Infra) Flatten() []*big.Float {
+	return flatten(floatSlice(z.Cartesian()))
+}
+
+// Unflatten sets z equal to the value with the components x, with the
+// conventions of the Unflatten method of Complex, and returns z.
+func (z *Infra) Unflatten(x []*big.Float) *Infra {
+	unflatten(floatSlice(z.Cartesian()), x)
+	return z
+}
+
+// Flatten returns copies of the components of z, with the conventions of the
+// Flatten method of Complex.
+func (z *Hamilton) Flatten() []*big.Float {
+	return flatten(floatSlice(z.Cartesian()))
+}
+
+// Unflatten sets z equal to the value with the components x, with the
+// conventions of the Unflatten method of Complex, and returns z.
+func (z *Hamilton) Unflatten(x []*big.Float) *Hamilton {
+	unflatten(floatSlice(z.Cartesian()), x)
+	return z
+}
+
+// Flatten returns copies of the components of z, with the conventions of the
+// Flatten method of Complex.
+func (z *Cockle) Flatten() []*big.Float {
+	return flatten(floatSlice(z.Cartesian()))
+}
+
+// Unflatten sets z equal to the value with the components x, with the
+// conventions of the Unflatten method of Complex, and returns z.
+func (z *Cockle) Unflatten(x []*big.Float) *Cockle {
+	unflatten(floatSlice(z.Cartesian()), x)
+	return z
+}
+
+// Flatten returns copies of the components of z, with the conventions of the
+// Flatten method of Complex.
+func (z *DualComplex) Flatten() []*big.Float {
+	return flatten(floatSlice(z.Cartesian()))
+}
+
+// Unflatten sets z equal to the value with the components x, with the
+// conventions of the Unflatten method of Complex, and returns z.
+func (z *DualComplex) Unflatten(x []*big.Float) *DualComplex {
+	unflatten(floatSlice(z.Cartesian()), x)
+	return z
+}
+
+// Flatten returns copies of the components of z, with the conventions of the
+// Flatten method of Complex.
+func (z *InfraComplex) Flatten() []*big.Float {
+	return flatten(floatSlice(z.Cartesian()))
+}
+
+// Unflatten sets z equal to the value with the components x, with the
+// conventions of the Unflatten method of Complex, and returns z.
+func (z *InfraComplex) Unflatten(x []*big.Float) *InfraComplex {
+	unflatten(floatSlice(z.Cartesian()), x)
+	return z
+}
+
+// Flatten returns copies of the components of z, with the conventions of the
+// Flatten method of Complex.
+func (z *InfraPerplex) Flatten() []*big.Float {
+	return flatten(floatSlice(z.Cartesian()))
+}
+
+// Unflatten sets z equal to the value with the components x, with the
+// conventions of the Unflatten method of Complex, and returns z.
+func (z *InfraPerplex) Unflatten(x []*big.Float) *InfraPerplex {
+	unflatten(floatSlice(z.Cartesian()), x)
+	return z
+}
+
+// Flatten returns copies of the components of z, with the conventions of the
+// Flatten method of Complex.
+func (z *Supra) Flatten() []*big.Float {
+	return flatten(floatSlice(z.Cartesian()))
+}
+
+// Unflatten sets z equal to the value with the components x, with the
+// conventions of the Unflatten method of Complex, and returns z.
+func (z *Supra) Unflatten(x []*big.Float) *Supra {
+	unflatten(floatSlice(z.Cartesian()), x)
+	return z
+}
+
+// Flatten returns copies of the components of z, with the conventions of the
+// Flatten method of Complex.
+func (z *Macfarlane) Flatten() []*big.Float {
+	return flatten(floatSlice(z.Cartesian()))
+}
+
+// Unflatten sets z equal to the value with the components x, with the
+// conventions of the Unflatten method of Complex, and returns z.
+func (z *Macfarlane) Unflatten(x []*big.Float) *Macfarlane {
+	unflatten(floatSlice(z.Cartesian()), x)
+	return z
+}
+
+// Flatten returns copies of the components of z, with the conventions of the
+// Flatten method of Complex.
+func (z *Cayley) Flatten() []*big.Float {
+	return flatten(floatSlice(z.Cartesian()))
+}
+
+// Unflatten sets z equal to the value with the components x, with the
+// conventions of the Unflatten method of Complex, and returns z.
+func (z *Cayley) Unflatten(x []*big.Float) *Cayley {
+	unflatten(floatSlice(z.Cartesian()), x)
+	return z
+}
+
+// Flatten returns copies of the components of z, with the conventions of the
+// Flatten method of Complex.
+func (z *DualHamilton) Flatten() []*big.Float {
+	return flatten(floatSlice(z.Cartesian()))
+}
+
+// Unflatten sets z equal to the value with the components x, with the
+// conventions of the Unflatten method of Complex, and returns z.
+func (z *DualHamilton) Unflatten(x []*big.Float) *DualHamilton {
+	unflatten(floatSlice(z.Cartesian()), x)
+	return z
+}
+
+// Flatten returns copies of the components of z, with the conventions of the
+// Flatten method of Complex.
+func (z *InfraCockle) Flatten() []*big.Float {
+	return flatten(floatSlice(z.Cartesian()))
+}
+
+// Unflatten sets z equal to the value with the components x, with the
+// conventions of the Unflatten method of Complex, and returns z.
+func (z *InfraCockle) Unflatten(x []*big.Float) *InfraCockle {
+	unflatten(floatSlice(z.Cartesian()), x)
+	return z
+}
+
+// Flatten returns copies of the components of z, with the conventions of the
+// Flatten method of Complex.
+func (z *InfraHamilton) Flatten() []*big.Float {
+	return flatten(floatSlice(z.Cartesian()))
+}
+
+// Unflatten sets z equal to the value with the components x, with the
+// conventions of the Unflatten method of Complex, and returns z.
+func (z *InfraHamilton) Unflatten(x []*big.Float) *InfraHamilton {
+	unflatten(floatSlice(z.Cartesian()), x)
+	return z
+}
+
+// Flatten returns copies of the components of z, with the conventions of the
+// Flatten method of Complex.
+func (z *SupraComplex) Flatten() []*big.Float {
+	return flatten(floatSlice(z.Cartesian()))
+}
+
+// Unflatten sets z equal to the value with the components x, with the
+// conventions of the Unflatten method of Complex, and returns z.
+func (z *SupraComplex) Unflatten(x []*big.Float) *SupraComplex {
+	unflatten(floatSlice(z.Cartesian()), x)
+	return z
+}
+
+// Flatten returns copies of the components of z, with the conventions of the
+// Flatten method of Complex.
+func (z *Ultra) Flatten() []*big.Float {
+	return flatten(floatSlice(z.Cartesian()))
+}
+
+// Unflatten sets z equal to the value with the components x, with the
+// conventions of the Unflatten method of Complex, and returns z.
+func (z *Ultra) Unflatten(x []*big.Float) *Ultra {
+	unflatten(floatSlice(z.Cartesian()), x)
+	return z
+}
+
+// Flatten returns copies of the components of z, with the conventions of the
+// Flatten method of Complex.
+func (z *Sedenion) Flatten() []*big.Float {
+	c := z.Cartesian()
+	return flatten(c[:])
+}
+
+// Unflatten sets z equal to the value with the components x, with the
+// conventions of the Unflatten method of Complex, and returns z.
+func (z *Sedenion) Unflatten(x []*big.Float) *Sedenion {
+	c := z.Cartesian()
+	unflatten(c[:], x)
+	return z
+}
+
+// Flatten returns copies of the components of z, with the conventions of the
+// Flatten method of Complex.
+func (z *SupraCockle) Flatten() []*big.Float {
+	c := z.Cartesian()
+	return flatten(c[:])
+}
+
+// Unflatten sets z equal to the value with the components x, with the
+// conventions of the Unflatten method of Complex, and returns z.
+func (z *SupraCockle) Unflatten(x []*big.Float) *SupraCockle {
+	c := z.Cartesian()
+	unflatten(c[:], x)
+	return z
+}
+
+// Flatten returns copies of the components of z, with the conventions of the
+// Flatten method of Complex.
+func (z *SupraHamilton) Flatten() []*big.Float {
+	c := z.Cartesian()
+	return flatten(c[:])
+}
+
+// Unflatten sets z equal to the value with the components x, with the
+// conventions of the Unflatten method of Complex, and returns z.
+func (z *SupraHamilton) Unflatten(x []*big.Float) *SupraHamilton {
+	c := z.Cartesian()
+	unflatten(c[:], x)
+	return z
+}
+
+// Flatten returns copies of the components of v, with the conventions of the
+// Flatten method of Complex.
+func (v *Vec3) Flatten() []*big.Float {
+	return flatten(floatSlice(v.Cartesian()))
+}
+
+// Unflatten sets v equal to the value with the components x, with the
+// conventions of the Unflatten method of Complex, and returns v.
+func (v *Vec3) Unflatten(x []*big.Float) *Vec3 {
+	unflatten(floatSlice(v.Cartesian()), x)
+	return v
+}
+
+// Flatten returns copies of the components of z, with the conventions of the
+// Flatten method of Complex.
+func (z *Jet) Flatten() []*big.Float {
+	return flatten(z.Cartesian())
+}
+
+// Unflatten sets z equal to the value with the components x, with the
+// conventions of the Unflatten method of Complex, and returns z. The order of z
+// becomes len(x) - 1. If x is empty, then Unflatten panics.
+func (z *Jet) Unflatten(x []*big.Float) *Jet {
+	if len(x) == 0 {
+		panic("wrong number of components")
+	}
+	z.reset(len(x) - 1)
+	unflatten(z.Cartesian(), x)
+	return z
+}
+
+// Flatten returns copies of the components of z, with the conventions of the
+// Flatten method of Complex.
+func (z *Clifford) Flatten() []*big.Float {
+	return flatten(z.Cartesian())
+}
+
+// Unflatten sets z equal to the value with the components x, with the
+// conventions of the Unflatten method of Complex, and returns z. The signature
+// of z is kept, so x must have one component for each of its blades.
+func (z *Clifford) Unflatten(x []*big.Float) *Clifford {
+	unflatten(z.Cartesian(), x)
+	return z
+}
+
+// Flatten returns copies of the components of z, with the conventions of the
+// Flatten method of Complex.
+func (z *Grassmann) Flatten() []*big.Float {
+	return flatten(z.Cartesian())
+}
+
+// Unflatten sets z equal to the value with the components x, with the
+// conventions of the Unflatten method of Complex, and returns z. The number of
+// generators of z becomes n for x with 2^n components. If the length of x is
+// not a power of two, then Unflatten panics.
+func (z *Grassmann) Unflatten(x []*big.Float) *Grassmann {
+	if len(x) == 0 || len(x)&(len(x)-1) != 0 {
+		panic("wrong number of components")
+	}
+	z.reset(bits.TrailingZeros(uint(len(x))))
+	unflatten(z.Cartesian(), x)
+	return z
+}
+
+// Flatten returns copies of the real and imaginary parts of the components of
+// z, in the order of Cartesian, with the conventions of the Flatten method of
+// Complex.
+func (z *Biquaternion) Flatten() []*big.Float {
+	return flatten(z.floats())
+}
+
+// Unflatten sets z equal to the value with the components x, with the
+// conventions of the Unflatten method of Complex, and returns z.
+func (z *Biquaternion) Unflatten(x []*big.Float) *Biquaternion {
+	unflatten(z.floats(), x)
+	return z
+}
+
+// Flatten returns copies of the components of z in the order a, u, v, b, with
+// the conventions of the Flatten method of Complex.
+func (z *Zorn) Flatten() []*big.Float {
+	return flatten(z.components())
+}
+
+// Unflatten sets z equal to the value with the components x, with the
+// conventions of the Unflatten method of Complex, and returns z.
+func (z *Zorn) Unflatten(x []*big.Float) *Zorn {
+	unflatten(z.components(), x)
+	return z
+}
+
+// Flatten returns copies of the real and imaginary parts of the coefficients of
+// p, in increasing order of degree, with the conventions of the Flatten method
+// of Complex.
+func (p *ComplexPoly) Flatten() []*big.Float {
+	return flatten(p.floats())
+}
+
+// Unflatten sets p equal to the polynomial with the coefficients x, with the
+// conventions of the Unflatten method of Complex, and returns p. Leading zero
+// coefficients are dropped. If the length of x is odd, then Unflatten panics.
+func (p *ComplexPoly) Unflatten(x []*big.Float) *ComplexPoly {
+	if len(x)%2 != 0 {
+		panic("wrong number of components")
+	}
+	p.c = make([]Complex, len(x)/2)
+	unflatten(p.floats(), x)
+	return p.trim()
+}
+
+// Flatten returns copies of the components of the entries of m, in row-major
+// order, with the conventions of the Flatten method of Complex.
+func (m *HamiltonMatrix) Flatten() []*big.Float {
+	return flatten(m.floats())
+}
+
+// Unflatten sets m equal to the matrix with the components x, with the
+// conventions of the Unflatten method of Complex, and returns m. The dimensions
+// of m are kept, so x must have four components for each entry.
+func (m *HamiltonMatrix) Unflatten(x []*big.Float) *HamiltonMatrix {
+	unflatten(m.floats(), x)
+	return m
+}
+
+// Flatten returns copies of the components of the coordinates x and y of z, in
+// turn, with the conventions of the Flatten method of Complex.
+func (z *ProjectiveComplex) Flatten() []*big.Float {
+	return flatten(z.floats())
+}
+
+// Unflatten sets z equal to the point with the components x, with the
+// conventions of the Unflatten method of Complex, and returns z. If both
+// coordinates are zero, then Unflatten panics and leaves z unchanged.
+func (z *ProjectiveComplex) Unflatten(x []*big.Float) *ProjectiveComplex {
+	y := new(ProjectiveComplex)
+	unflatten(y.floats(), x)
+	if zero := new(Complex); y.x.Equals(zero) && y.y.Equals(zero) {
+		panic("projective point with zero coordinates")
+	}
+	return z.Copy(y)
+}
+
+// Flatten returns copies of the components of the coordinates x and y of z, in
+// turn, with the conventions of the Flatten method of Complex.
+func (z *ProjectiveHamilton) Flatten() []*big.Float {
+	return flatten(z.floats())
+}
+
+// Unflatten sets z equal to the point with the components x, with the
+// conventions of the Unflatten method of Complex, and returns z. If both
+// coordinates are zero, then Unflatten panics and leaves z unchanged.
+func (z *ProjectiveHamilton) Unflatten(x []*big.Float) *ProjectiveHamilton {
+	y := new(ProjectiveHamilton)
+	unflatten(y.floats(), x)
+	if zero := new(Hamilton); y.x.Equals(zero) && y.y.Equals(zero) {
+		panic("projective point with zero coordinates")
+	}
+	return z.Copy(y)
+}
+
+// Flatten returns copies of the components of the coordinates x and y of z, in
+// turn, with the conventions of the Flatten method of Complex.
+func (z *ProjectiveCockle) Flatten() []*big.Float {
+	return flatten(z.floats())
+}
+
+// Unflatten sets z equal to the point with the components x, with the
+// conventions of the Unflatten method of Complex, and returns z. If both
+// coordinates are zero, then Unflatten panics and leaves z unchanged.
+func (z *ProjectiveCockle) Unflatten(x []*big.Float) *ProjectiveCockle {
+	y := new(ProjectiveCockle)
+	unflatten(y.floats(), x)
+	if zero := new(Cockle); y.x.Equals(zero) && y.y.Equals(zero) {
+		panic("projective point with zero coordinates")
+	}
+	return z.Copy(y)
+}
+
+// Flatten returns copies of the components of the coefficients a, b, c, and d
+// of z, in turn, with the conventions of the Flatten method of Complex.
+func (z *MöbiusComplex) Flatten() []*big.Float {
+	return flatten(z.floats())
+}
+
+// Unflatten sets z equal to the value with the components x, with the
+// conventions of the Unflatten method of Complex, and returns z.
+func (z *MöbiusComplex) Unflatten(x []*big.Float) *MöbiusComplex {
+	unflatten(z.floats(), x)
+	return z
+}
+
+// Flatten returns copies of the components of the coefficients a, b, c, and d
+// of z, in turn, with the conventions of the Flatten method of Complex.
+func (z *MöbiusHamilton) Flatten() []*big.Float {
+	return flatten(z.floats())
+}
+
+// Unflatten sets z equal to the value with the components x, with the
+// conventions of the Unflatten method of Complex, and returns z.
+func (z *MöbiusHamilton) Unflatten(x []*big.Float) *MöbiusHamilton {
+	unflatten(z.floats(), x)
+	return z
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestFlattenRoundTrip(t *testing.T) {
+	f := func(x *Hamilton, y *Cayley, z *Sedenion) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		return new(Hamilton).Unflatten(x.Flatten()).Equals(x) &&
+			new(Cayley).Unflatten(y.Flatten()).Equals(y) &&
+			new(Sedenion).Unflatten(z.Flatten()).Equals(z)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestFlattenPrecision(t *testing.T) {
+	third := new(big.Float).SetPrec(200).Quo(big.NewFloat(1), big.NewFloat(3))
+	z := NewComplex(third, new(big.Float).SetPrec(7).SetInt64(5))
+	x := z.Flatten()
+	if x[0].Prec() != 200 || x[1].Prec() != 7 {
+		t.Errorf("precisions %d and %d, want 200 and 7", x[0].Prec(), x[1].Prec())
+	}
+	// The components are copies.
+	x[0].SetInt64(1)
+	if z.l.Cmp(third) != 0 {
+		t.Errorf("Flatten aliases z: %v", z)
+	}
+	y := new(Complex).Unflatten(z.Flatten())
+	if !y.Equals(z) || y.l.Prec() != 200 || y.r.Prec() != 7 {
+		t.Errorf("Unflatten = %v with precisions %d and %d", y, y.l.Prec(), y.r.Prec())
+	}
+}
+
+func TestFlattenShapes(t *testing.T) {
+	one, two := big.NewFloat(1), big.NewFloat(2)
+	x := []*big.Float{one, two, new(big.Float), two, new(big.Float), new(big.Float), new(big.Float), new(big.Float)}
+	if g := new(Grassmann).Unflatten(x); g.n != 3 {
+		t.Errorf("Grassmann has %d generators, want 3", g.n)
+	}
+	if j := new(Jet).Unflatten(x); j.Order() != 7 {
+		t.Errorf("Jet has order %d, want 7", j.Order())
+	}
+	// Leading zero coefficients are dropped.
+	if p := new(ComplexPoly).Unflatten(x); len(p.c) != 2 {
+		t.Errorf("ComplexPoly has %d coefficients, want 2", len(p.c))
+	}
+	m := NewHamiltonMatrix(1, 2)
+	if m.Unflatten(x); m.At(0, 0).l.r.Cmp(two) != 0 {
+		t.Errorf("HamiltonMatrix = %v", m)
+	}
+	u := NewVec3(one, two, one)
+	z := NewZorn(one, two, u, u)
+	if y := new(Zorn).Unflatten(z.Flatten()); !y.Equals(z) {
+		t.Errorf("Zorn round trip = %v, want %v", y, z)
+	}
+	p := NewProjectiveHamilton(NewHamilton(one, two, one, two), new(Hamilton))
+	if q := new(ProjectiveHamilton).Unflatten(p.Flatten()); q.String() != p.String() {
+		t.Errorf("ProjectiveHamilton round trip = %v, want %v", q, p)
+	}
+}
+
+func TestUnflattenPanics(t *testing.T) {
+	cases := []struct {
+		f    func()
+		want string
+	}{
+		{func() { new(Complex).Unflatten([]*big.Float{big.NewFloat(1)}) }, "wrong number of components"},
+		{func() { new(Grassmann).Unflatten([]*big.Float{new(big.Float), new(big.Float), new(big.Float)}) }, "wrong number of components"},
+		{func() { new(ProjectiveComplex).Unflatten(new(Hamilton).Flatten()) }, "projective point with zero coordinates"},
+	}
+	for _, c := range cases {
+		func() {
+			defer func() {
+				if r := recover(); r != c.want {
+					t.Errorf("recovered %v, want %q", r, c.want)
+				}
+			}()
+			c.f()
+		}()
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// floatSlice returns its arguments as a slice.
+func floatSlice(x ...*big.Float) []*big.Float {
+	return x
+}
+
+// formatVerb returns true if verb is one of the verbs of big.Float, or 's',
+// which is treated as 'v'.
+func formatVerb(verb rune) bool {
+	return strings.ContainsRune("vsbeEfFgGpx", verb)
+}
+
+// formatSpec returns the format string of the verb with the flags, width, and
+// precision of s, and the '+' flag if plus is true.
+func formatSpec(s fmt.State, verb rune, plus bool) string {
+	spec := []byte{'%'}
+	for _, c := range "+- 0" {
+		if s.Flag(int(c)) || (c == '+' && plus) {
+			spec = append(spec, byte(c))
+		}
+	}
+	if w, ok := s.Width(); ok {
+		spec = strconv.AppendInt(spec, int64(w), 10)
+	}
+	if p, ok := s.Precision(); ok {
+		spec = append(spec, '.')
+		spec = strconv.AppendInt(spec, int64(p), 10)
+	}
+	if verb == 's' {
+		verb = 'v'
+	}
+	return string(spec) + string(verb)
+}
+
+// formatFloat writes x to s with the verb and the flags, width, and precision
+// of s, always with a sign if plus is true.
+func formatFloat(s fmt.State, verb rune, x *big.Float, plus bool) {
+	fmt.Fprintf(s, formatSpec(s, verb, plus), x)
+}
+
+// formatBad writes the string of fmt for the verb not supported by z, such as
+// "%!d(*bigfloat.Complex=(1+2i))", to s, and returns true if verb is not
+// supported.
+func formatBad(s fmt.State, verb rune, z fmt.Stringer) bool {
+	if formatVerb(verb) {
+		return false
+	}
+	fmt.Fprintf(s, "%%!%c(%T=%s)", verb, z, z.String())
+	return true
+}
+
+// formatTerms writes z, with the components x and the units, to s in the
+// format of String, "(a+bi+...)", with each component written with the verb
+// and the flags, width, and precision of s.
+func formatTerms(s fmt.State, verb rune, z fmt.Stringer, units []string, x []*big.Float) {
+	if formatBad(s, verb, z) {
+		return
+	}
+	io.WriteString(s, "(")
+	for k, v := range x {
+		formatFloat(s, verb, v, k > 0)
+		io.WriteString(s, units[k])
+	}
+	io.WriteString(s, ")")
+}
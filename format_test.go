@@ -0,0 +1,56 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestFormat(t *testing.T) {
+	one, two := big.NewFloat(1), big.NewFloat(-2.5)
+	u := NewVec3(one, two, big.NewFloat(3))
+	jet := NewJetVariable(big.NewFloat(0.5), 2)
+	cases := []struct {
+		format string
+		value  interface{}
+		want   string
+	}{
+		{"%v", NewComplex(one, two), "(1-2.5i)"},
+		{"%s", NewComplex(one, two), "(1-2.5i)"},
+		{"%.3f", NewComplex(one, two), "(1.000-2.500i)"},
+		{"%7.2f", NewComplex(one, one), "(   1.00  +1.00i)"},
+		{"%.2e", NewPerplex(one, one), "(1.00e+00+1.00e+00s)"},
+		{"%g", NewInfra(two, one), "(-2.5+1α)"},
+		{"%.1f", NewHamilton(one, two, one, two), "(1.0-2.5i+1.0j-2.5k)"},
+		{"%+v", NewHamilton(one, two, one, two), "(+1-2.5i+1j-2.5k)"},
+		{"%.1f", jet, "(0.5+1.0α+0.0α^2)"},
+		{"%.1f", u, "(1.0, -2.5, 3.0)"},
+		{"%.1f", NewZorn(one, two, u, u), "[[1.0, (1.0, -2.5, 3.0)], [(1.0, -2.5, 3.0), -2.5]]"},
+		{"%.1f", NewBiquaternion(NewComplex(one, one), new(Complex), new(Complex), new(Complex)),
+			"((1.0+1.0i)+(0.0+0.0i)i+(0.0+0.0i)j+(0.0+0.0i)k)"},
+		{"%.1f", NewComplexPoly(NewComplex(one, two), NewComplex(one, one)), "(1.0-2.5i) + (1.0+1.0i)x"},
+		{"%v", NewComplexPoly(), "(0+0i)"},
+		{"%d", NewComplex(one, two), "%!d(*bigfloat.Complex=(1-2.5i))"},
+	}
+	for _, c := range cases {
+		if got := fmt.Sprintf(c.format, c.value); got != c.want {
+			t.Errorf("Sprintf(%q, %T) = %q, want %q", c.format, c.value, got, c.want)
+		}
+	}
+}
+
+func TestFormatString(t *testing.T) {
+	f := func(x *Hamilton, y *Cayley, z *SupraHamilton) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		return fmt.Sprintf("%v", x) == x.String() &&
+			fmt.Sprintf("%v", y) == y.String() &&
+			fmt.Sprintf("%v", z) == z.String()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
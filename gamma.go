@@ -0,0 +1,112 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"sync"
+)
+
+// bernoulliNumbers holds the Bernoulli numbers computed so far.
+var bernoulliNumbers struct {
+	sync.Mutex
+	b []*big.Rat
+}
+
+// bernoulli returns the Bernoulli number B[n], with B[1] = -1/2. The numbers
+// are exact rationals, so they are computed once and kept.
+func bernoulli(n int) *big.Rat {
+	bernoulliNumbers.Lock()
+	defer bernoulliNumbers.Unlock()
+	b := bernoulliNumbers.b
+	for m := len(b); m <= n; m++ {
+		// B[m] = -1/(m+1) Σ C(m+1, k) B[k], over k < m
+		sum := new(big.Rat)
+		c := big.NewInt(1)
+		for k := 0; k < m; k++ {
+			if b[k].Sign() != 0 {
+				sum.Add(sum, new(big.Rat).Mul(new(big.Rat).SetInt(c), b[k]))
+			}
+			c.Mul(c, big.NewInt(int64(m+1-k)))
+			c.Quo(c, big.NewInt(int64(k+1)))
+		}
+		if m == 0 {
+			sum.SetInt64(-1)
+		}
+		b = append(b, sum.Quo(sum.Neg(sum), big.NewRat(int64(m+1), 1)))
+	}
+	bernoulliNumbers.b = b
+	return b[n]
+}
+
+// isNonPositiveInteger returns true if z is real and equal to 0, -1, -2, ...
+func isNonPositiveInteger(z *Complex) bool {
+	return z.r.Sign() == 0 && z.l.Sign() <= 0 && z.l.IsInt()
+}
+
+// gammaComplex returns the gamma function of y rounded to prec bits. If y is
+// a pole, then gammaComplex panics.
+//
+// The argument is shifted to the right until the Stirling series reaches the
+// working precision, and the shift is undone with the recurrence
+// 		Γ(y+1) = yΓ(y)
+// Arguments in the left half-plane are handled with the reflection formula
+// 		Γ(y)Γ(1-y) = π/sin(πy)
+func gammaComplex(y *Complex, prec uint) *Complex {
+	if isNonPositiveInteger(y) {
+		panic("gamma function at a pole")
+	}
+	w := prec + guardBits
+	if e := complexExpo(y); e > 0 {
+		w += 2 * uint(e)
+	}
+	x := newComplexPrec(w).round(y, w)
+	if x.l.Cmp(big.NewFloat(0.5)) < 0 {
+		// Γ(y) = π / (sin(πy) Γ(1-y))
+		pi := bigPi(w)
+		s := new(Complex).Scal(x, pi)
+		s.Sin(s)
+		one := newComplexPrec(w)
+		one.l.SetInt64(1)
+		g := gammaComplex(one.Sub(one, x), w)
+		s.Mul(s, g)
+		g.l.Set(pi)
+		g.r.SetInt64(0)
+		return newComplexPrec(prec).round(new(Complex).Quo(g, s), prec)
+	}
+	terms := int(w/8) + 1
+	r := newFloat(w).SetInt64(int64(w/4) + 2)
+	p := newComplexPrec(w)
+	p.l.SetInt64(1)
+	for x.l.Cmp(r) < 0 {
+		p.Mul(p, x)
+		x.l.Add(&x.l, big.NewFloat(1))
+	}
+	// Stirling series for log Γ(x).
+	lx := new(Complex).Log(x)
+	half := newComplexPrec(w).Copy(x)
+	half.l.Sub(&half.l, big.NewFloat(0.5))
+	lg := new(Complex).Mul(half, lx)
+	lg.Sub(lg, x)
+	twoPi := bigPi(w)
+	twoPi.SetMantExp(twoPi, 1)
+	c := bigLog(twoPi, w)
+	lg.l.Add(&lg.l, c.SetMantExp(c, -1))
+	inv := new(Complex).Inv(x)
+	inv2 := new(Complex).Mul(inv, inv)
+	pow := new(Complex).Copy(inv)
+	term := new(Complex)
+	for k := 1; k <= terms; k++ {
+		b := new(big.Float).SetPrec(w).SetRat(bernoulli(2 * k))
+		b.Quo(b, newFloat(w).SetInt64(int64(2*k*(2*k-1))))
+		term.Scal(pow, b)
+		if complexExpo(term) < complexExpo(lg)-int(w) {
+			break
+		}
+		lg.Add(lg, term)
+		pow.Mul(pow, inv2)
+	}
+	g := new(Complex).Exp(lg)
+	return newComplexPrec(prec).round(new(Complex).Quo(g, p), prec)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestBernoulli(t *testing.T) {
+	want := map[int]*big.Rat{
+		0:  big.NewRat(1, 1),
+		1:  big.NewRat(-1, 2),
+		2:  big.NewRat(1, 6),
+		3:  big.NewRat(0, 1),
+		12: big.NewRat(-691, 2730),
+	}
+	for n, b := range want {
+		if got := bernoulli(n); got.Cmp(b) != 0 {
+			t.Errorf("bernoulli(%d) = %v, want %v", n, got, b)
+		}
+	}
+}
+
+func TestGammaComplex(t *testing.T) {
+	cases := []struct {
+		a, b float64
+		want complex128
+	}{
+		{5, 0, 24},
+		{0.5, 0, 1.7724538509055160273},
+		{-0.5, 0, -3.5449077018110320546},
+		{1.0 / 3, 0, 2.6789385347077476337},
+		{1, 1, complex(0.49801566811835604271, -0.15494982830181068512)},
+	}
+	for _, c := range cases {
+		y := NewComplex(big.NewFloat(c.a), big.NewFloat(c.b))
+		if got := gammaComplex(y, 53); !closeToComplex128(got, c.want, 48) {
+			t.Errorf("gammaComplex(%v) = %v, want %v", y, got, c.want)
+		}
+	}
+}
+
+func TestGammaComplexHighPrecision(t *testing.T) {
+	// Γ(1/2)² = π
+	y := newComplexPrec(300)
+	y.l.SetFloat64(0.5)
+	g := gammaComplex(y, 300)
+	g.Mul(g, g)
+	if !closeTo(&g.l, bigPi(300), 290) {
+		t.Errorf("Γ(1/2)² = %v", g)
+	}
+}
@@ -0,0 +1,436 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"encoding/binary"
+	"math/big"
+)
+
+// gobCodec writes each component as the length and the bytes of the GobEncode
+// method of big.Float.
+var gobCodec = floatCodec{"GobDecode", 1, appendGobFloat, nextGobFloat}
+
+// appendGobFloat appends the length and the GobEncode encoding of x to buf.
+func appendGobFloat(buf []byte, x *big.Float) ([]byte, error) {
+	b, err := x.GobEncode()
+	if err != nil {
+		return nil, err
+	}
+	buf = binary.AppendUvarint(buf, uint64(len(b)))
+	return append(buf, b...), nil
+}
+
+// nextGobFloat decodes the component at the start of data in the form of
+// appendGobFloat, and returns it with the rest of data.
+func nextGobFloat(data []byte) (*big.Float, []byte, error) {
+	size, n := binary.Uvarint(data)
+	if n <= 0 || size > uint64(len(data)-n) {
+		return nil, nil, errTruncated
+	}
+	data = data[n:]
+	x := new(big.Float)
+	if err := x.GobDecode(data[:size]); err != nil {
+		return nil, nil, err
+	}
+	return x, data[size:], nil
+}
+
+// GobEncode implements gob.GobEncoder. Each component is encoded with the
+// GobEncode method of big.Float, so its precision, rounding mode, and accuracy
+// survive the round trip, unlike with MarshalJSON.
+func (z *Complex) GobEncode() ([]byte, error) {
+	return gobCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// GobDecode implements gob.GobDecoder. The components of z take the precisions,
+// rounding modes, and accuracies of the encoded value. If data is malformed,
+// then GobDecode returns an error and leaves z unchanged.
+func (z *Complex) GobDecode(data []byte) error {
+	return gobCodec.decodeFloats("Complex", data, floatSlice(z.Cartesian()))
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex.
+func (z *Perplex) GobEncode() ([]byte, error) {
+	return gobCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex.
+func (z *Perplex) GobDecode(data []byte) error {
+	return gobCodec.decodeFloats("Perplex", data, floatSlice(z.Cartesian()))
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex.
+func (z *Infra) GobEncode() ([]byte, error) {
+	return gobCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex.
+func (z *Infra) GobDecode(data []byte) error {
+	return gobCodec.decodeFloats("Infra", data, floatSlice(z.Cartesian()))
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex.
+func (z *Hamilton) GobEncode() ([]byte, error) {
+	return gobCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex.
+func (z *Hamilton) GobDecode(data []byte) error {
+	return gobCodec.decodeFloats("Hamilton", data, floatSlice(z.Cartesian()))
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex.
+func (z *Cockle) GobEncode() ([]byte, error) {
+	return gobCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex.
+func (z *Cockle) GobDecode(data []byte) error {
+	return gobCodec.decodeFloats("Cockle", data, floatSlice(z.Cartesian()))
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex.
+func (z *DualComplex) GobEncode() ([]byte, error) {
+	return gobCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex.
+func (z *DualComplex) GobDecode(data []byte) error {
+	return gobCodec.decodeFloats("DualComplex", data, floatSlice(z.Cartesian()))
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex.
+func (z *InfraComplex) GobEncode() ([]byte, error) {
+	return gobCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex.
+func (z *InfraComplex) GobDecode(data []byte) error {
+	return gobCodec.decodeFloats("InfraComplex", data, floatSlice(z.Cartesian()))
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex.
+func (z *InfraPerplex) GobEncode() ([]byte, error) {
+	return gobCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex.
+func (z *InfraPerplex) GobDecode(data []byte) error {
+	return gobCodec.decodeFloats("InfraPerplex", data, floatSlice(z.Cartesian()))
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex.
+func (z *Supra) GobEncode() ([]byte, error) {
+	return gobCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex.
+func (z *Supra) GobDecode(data []byte) error {
+	return gobCodec.decodeFloats("Supra", data, floatSlice(z.Cartesian()))
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex.
+func (z *Macfarlane) GobEncode() ([]byte, error) {
+	return gobCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex.
+func (z *Macfarlane) GobDecode(data []byte) error {
+	return gobCodec.decodeFloats("Macfarlane", data, floatSlice(z.Cartesian()))
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex.
+func (z *Cayley) GobEncode() ([]byte, error) {
+	return gobCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex.
+func (z *Cayley) GobDecode(data []byte) error {
+	return gobCodec.decodeFloats("Cayley", data, floatSlice(z.Cartesian()))
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex.
+func (z *DualHamilton) GobEncode() ([]byte, error) {
+	return gobCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex.
+func (z *DualHamilton) GobDecode(data []byte) error {
+	return gobCodec.decodeFloats("DualHamilton", data, floatSlice(z.Cartesian()))
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex.
+func (z *InfraCockle) GobEncode() ([]byte, error) {
+	return gobCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex.
+func (z *InfraCockle) GobDecode(data []byte) error {
+	return gobCodec.decodeFloats("InfraCockle", data, floatSlice(z.Cartesian()))
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex.
+func (z *InfraHamilton) GobEncode() ([]byte, error) {
+	return gobCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex.
+func (z *InfraHamilton) GobDecode(data []byte) error {
+	return gobCodec.decodeFloats("InfraHamilton", data, floatSlice(z.Cartesian()))
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex.
+func (z *SupraComplex) GobEncode() ([]byte, error) {
+	return gobCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex.
+func (z *SupraComplex) GobDecode(data []byte) error {
+	return gobCodec.decodeFloats("SupraComplex", data, floatSlice(z.Cartesian()))
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex.
+func (z *Ultra) GobEncode() ([]byte, error) {
+	return gobCodec.encode(nil, floatSlice(z.Cartesian()))
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex.
+func (z *Ultra) GobDecode(data []byte) error {
+	return gobCodec.decodeFloats("Ultra", data, floatSlice(z.Cartesian()))
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex.
+func (z *Sedenion) GobEncode() ([]byte, error) {
+	c := z.Cartesian()
+	return gobCodec.encode(nil, c[:])
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex.
+func (z *Sedenion) GobDecode(data []byte) error {
+	c := z.Cartesian()
+	return gobCodec.decodeFloats("Sedenion", data, c[:])
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex.
+func (z *SupraCockle) GobEncode() ([]byte, error) {
+	c := z.Cartesian()
+	return gobCodec.encode(nil, c[:])
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex.
+func (z *SupraCockle) GobDecode(data []byte) error {
+	c := z.Cartesian()
+	return gobCodec.decodeFloats("SupraCockle", data, c[:])
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex.
+func (z *SupraHamilton) GobEncode() ([]byte, error) {
+	c := z.Cartesian()
+	return gobCodec.encode(nil, c[:])
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex.
+func (z *SupraHamilton) GobDecode(data []byte) error {
+	c := z.Cartesian()
+	return gobCodec.decodeFloats("SupraHamilton", data, c[:])
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex.
+func (v *Vec3) GobEncode() ([]byte, error) {
+	return gobCodec.encode(nil, floatSlice(v.Cartesian()))
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex.
+func (v *Vec3) GobDecode(data []byte) error {
+	return gobCodec.decodeFloats("Vec3", data, floatSlice(v.Cartesian()))
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex.
+func (z *Jet) GobEncode() ([]byte, error) {
+	return gobCodec.encode(nil, z.Cartesian())
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex. The order of z becomes that of the encoded value.
+func (z *Jet) GobDecode(data []byte) error {
+	return z.decode(gobCodec, data)
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex. Unlike MarshalJSON, the signature is encoded.
+func (z *Clifford) GobEncode() ([]byte, error) {
+	return gobCodec.encode([]int{z.p, z.q}, z.Cartesian())
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex. The signature of z becomes that of the encoded value.
+func (z *Clifford) GobDecode(data []byte) error {
+	return z.decode(gobCodec, data)
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex.
+func (z *Grassmann) GobEncode() ([]byte, error) {
+	return gobCodec.encode(nil, z.Cartesian())
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex. The number of generators of z becomes that of the encoded
+// value.
+func (z *Grassmann) GobDecode(data []byte) error {
+	return z.decode(gobCodec, data)
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex.
+func (z *Biquaternion) GobEncode() ([]byte, error) {
+	return gobCodec.encode(nil, z.floats())
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex.
+func (z *Biquaternion) GobDecode(data []byte) error {
+	return gobCodec.decodeFloats("Biquaternion", data, z.floats())
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex.
+func (z *Zorn) GobEncode() ([]byte, error) {
+	return gobCodec.encode(nil, z.components())
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex.
+func (z *Zorn) GobDecode(data []byte) error {
+	return gobCodec.decodeFloats("Zorn", data, z.components())
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex. The coefficients are encoded in increasing order of
+// degree.
+func (p *ComplexPoly) GobEncode() ([]byte, error) {
+	return gobCodec.encode(nil, p.floats())
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex. Leading zero coefficients are dropped.
+func (p *ComplexPoly) GobDecode(data []byte) error {
+	return p.decode(gobCodec, data)
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex. The dimensions are encoded, followed by the entries in
+// row-major order.
+func (m *HamiltonMatrix) GobEncode() ([]byte, error) {
+	return gobCodec.encode([]int{m.rows, m.cols}, m.floats())
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex. The dimensions of m become those of the encoded value.
+func (m *HamiltonMatrix) GobDecode(data []byte) error {
+	return m.decode(gobCodec, data)
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex. The coordinates x and y of [x : y] are encoded in turn.
+func (z *ProjectiveComplex) GobEncode() ([]byte, error) {
+	return gobCodec.encode(nil, z.floats())
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex. If both coordinates are zero, then GobDecode returns an
+// error and leaves z unchanged.
+func (z *ProjectiveComplex) GobDecode(data []byte) error {
+	return z.decode(gobCodec, data)
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex. The coordinates x and y of [x : y] are encoded in turn.
+func (z *ProjectiveHamilton) GobEncode() ([]byte, error) {
+	return gobCodec.encode(nil, z.floats())
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex. If both coordinates are zero, then GobDecode returns an
+// error and leaves z unchanged.
+func (z *ProjectiveHamilton) GobDecode(data []byte) error {
+	return z.decode(gobCodec, data)
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex. The coordinates x and y of [x : y] are encoded in turn.
+func (z *ProjectiveCockle) GobEncode() ([]byte, error) {
+	return gobCodec.encode(nil, z.floats())
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex. If both coordinates are zero, then GobDecode returns an
+// error and leaves z unchanged.
+func (z *ProjectiveCockle) GobDecode(data []byte) error {
+	return z.decode(gobCodec, data)
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex. The coefficients a, b, c, and d are encoded in turn.
+func (z *MöbiusComplex) GobEncode() ([]byte, error) {
+	return gobCodec.encode(nil, z.floats())
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex.
+func (z *MöbiusComplex) GobDecode(data []byte) error {
+	return gobCodec.decodeFloats("MöbiusComplex", data, z.floats())
+}
+
+// GobEncode implements gob.GobEncoder, with the conventions of the GobEncode
+// method of Complex. The coefficients a, b, c, and d are encoded in turn.
+func (z *MöbiusHamilton) GobEncode() ([]byte, error) {
+	return gobCodec.encode(nil, z.floats())
+}
+
+// GobDecode implements gob.GobDecoder, with the conventions of the GobDecode
+// method of Complex.
+func (z *MöbiusHamilton) GobDecode(data []byte) error {
+	return gobCodec.decodeFloats("MöbiusHamilton", data, z.floats())
+}
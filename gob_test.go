@@ -0,0 +1,105 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"bytes"
+	"encoding/gob"
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+// gobRoundTrip encodes x with gob and decodes the result into y.
+func gobRoundTrip(x, y interface{}) error {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(x); err != nil {
+		return err
+	}
+	return gob.NewDecoder(&buf).Decode(y)
+}
+
+func TestGobRoundTrip(t *testing.T) {
+	f := func(x *Hamilton, y *Cayley, z *SupraHamilton) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		u, v, w := new(Hamilton), new(Cayley), new(SupraHamilton)
+		return gobRoundTrip(x, u) == nil && gobRoundTrip(y, v) == nil && gobRoundTrip(z, w) == nil &&
+			u.Equals(x) && v.Equals(y) && w.Equals(z)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGobPrecision(t *testing.T) {
+	// Unlike with JSON, the decoded value takes the precision of the encoded
+	// one, even in a zero value.
+	third := new(big.Float).SetPrec(200).Quo(big.NewFloat(1), big.NewFloat(3))
+	x := NewComplex(third, new(big.Float).SetPrec(7).SetMode(big.ToZero).SetInt64(5))
+	y := new(Complex)
+	if err := gobRoundTrip(x, y); err != nil {
+		t.Fatal(err)
+	}
+	if !y.Equals(x) || y.l.Prec() != 200 || y.r.Prec() != 7 || y.r.Mode() != big.ToZero {
+		t.Errorf("round trip = %v with precisions %d and %d, want %v", y, y.l.Prec(), y.r.Prec(), x)
+	}
+}
+
+func TestGobStructured(t *testing.T) {
+	one, two := big.NewFloat(1), big.NewFloat(2)
+	m := NewHamiltonMatrix(2, 3)
+	m.At(1, 2).Copy(NewHamilton(one, two, one, two))
+	u := NewVec3(one, two, one)
+	g := NewGrassmann(2)
+	g.c[3].SetInt64(5)
+	c := NewClifford(1, 2)
+	c.c[5].SetInt64(-3)
+	h := NewHamilton(one, two, one, two)
+	values := []struct{ x, y interface{} }{
+		{m, new(HamiltonMatrix)},
+		{NewComplexPoly(NewComplex(one, two), NewComplex(two, one)), new(ComplexPoly)},
+		{NewZorn(one, two, u, u), new(Zorn)},
+		{NewBiquaternion(NewComplex(one, two), new(Complex), NewComplex(two, two), new(Complex)), new(Biquaternion)},
+		{NewProjectiveHamilton(h, new(Hamilton)), new(ProjectiveHamilton)},
+		{NewJetVariable(two, 3), new(Jet)},
+		{g, new(Grassmann)},
+		{c, new(Clifford)},
+		{NewMöbiusHamilton(h, h, new(Hamilton), h), new(MöbiusHamilton)},
+	}
+	for _, v := range values {
+		if err := gobRoundTrip(v.x, v.y); err != nil {
+			t.Fatalf("%T: %v", v.x, err)
+		}
+		// The encodings agree if and only if the values and precisions do.
+		got, _ := v.y.(gob.GobEncoder).GobEncode()
+		want, _ := v.x.(gob.GobEncoder).GobEncode()
+		if !bytes.Equal(got, want) {
+			t.Errorf("%T round trip = %v, want %v", v.x, v.y, v.x)
+		}
+	}
+}
+
+func TestGobErrors(t *testing.T) {
+	data, _ := NewHamilton(big.NewFloat(1), big.NewFloat(2), big.NewFloat(3), big.NewFloat(4)).GobEncode()
+	cases := [][]byte{
+		nil,
+		{0},
+		data[:len(data)-1],
+		append(append([]byte{}, data...), 0),
+		data,
+	}
+	for _, c := range cases {
+		z := NewComplex(big.NewFloat(7), big.NewFloat(8))
+		if err := z.GobDecode(c); err == nil {
+			t.Errorf("GobDecode(%v) succeeded", c)
+		}
+		if !z.Equals(NewComplex(big.NewFloat(7), big.NewFloat(8))) {
+			t.Errorf("GobDecode(%v) changed z to %v", c, z)
+		}
+	}
+	zero, _ := new(ProjectiveComplex).GobEncode()
+	if err := new(ProjectiveComplex).GobDecode(zero); err == nil {
+		t.Error("GobDecode of a projective point with zero coordinates succeeded")
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math/big"
+
+// quadratic is the arithmetic surface that Form and GramMatrix need.
+type quadratic[T any] interface {
+	*T
+	Add(x, y *T) *T
+	Sub(x, y *T) *T
+	Quad() *big.Float
+}
+
+// Form returns the symmetric bilinear form of the quadrance at x and y:
+// 		(Quad(x+y) - Quad(x-y))/4
+// so that Form(x, x) = Quad(x). For the Euclidean types, such as Complex,
+// Hamilton, and Cayley, it is the dot product of the components; for types
+// with an indefinite quadrance, such as Perplex and Cockle, it is indefinite
+// too. The sum and the difference are rounded to the precision of x and y, so
+// the result is accurate relative to the product of the magnitudes of x and
+// y, rather than to the result.
+func Form[T any, P quadratic[T]](x, y P) *big.Float {
+	u, v := P(new(T)), P(new(T))
+	u.Add(x, y)
+	v.Sub(x, y)
+	s, d := u.Quad(), v.Quad()
+	s.Sub(s, d)
+	return s.SetMantExp(s, -2)
+}
+
+// GramMatrix returns the matrix of the values of Form on every pair of
+// elements of v, with Quad on the diagonal. It is symmetric.
+func GramMatrix[T any, P quadratic[T]](v []P) [][]*big.Float {
+	g := make([][]*big.Float, len(v))
+	for i := range g {
+		g[i] = make([]*big.Float, len(v))
+	}
+	for i := range v {
+		g[i][i] = v[i].Quad()
+		for j := i + 1; j < len(v); j++ {
+			g[i][j] = Form(v[i], v[j])
+			g[j][i] = new(big.Float).Copy(g[i][j])
+		}
+	}
+	return g
+}
+
+// IsOrthonormal returns true if the elements of v are pairwise orthogonal
+// and normalized to within tol: every off-diagonal entry of GramMatrix(v) is
+// at most tol in absolute value, and every diagonal entry is within tol of 1
+// or -1. The sign is free so that frames of the types with an indefinite
+// quadrance, where some values of a basis have quadrance -1, are accepted.
+func IsOrthonormal[T any, P quadratic[T]](v []P, tol *big.Float) bool {
+	g := GramMatrix(v)
+	one := big.NewFloat(1)
+	for i := range g {
+		for j, e := range g[i] {
+			d := new(big.Float).Abs(e)
+			if i == j {
+				d.Sub(d, one).Abs(d)
+			}
+			if d.Cmp(tol) > 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
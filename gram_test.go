@@ -0,0 +1,56 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestFormHamilton(t *testing.T) {
+	f := func(x, y *Hamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a, b, c, d := x.Cartesian()
+		s, u, v, w := y.Cartesian()
+		dot := new(big.Float).Mul(a, s)
+		dot.Add(dot, new(big.Float).Mul(b, u))
+		dot.Add(dot, new(big.Float).Mul(c, v))
+		dot.Add(dot, new(big.Float).Mul(d, w))
+		four := big.NewFloat(4)
+		return closeTo(new(big.Float).Add(Form(x, y), four), new(big.Float).Add(dot, four), 48) &&
+			closeTo(Form(x, x), x.Quad(), 48)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGramMatrixBasis(t *testing.T) {
+	one, zero := big.NewFloat(1), new(big.Float)
+	h := []*Hamilton{
+		NewHamilton(one, zero, zero, zero),
+		NewHamilton(zero, one, zero, zero),
+		NewHamilton(zero, zero, one, zero),
+		NewHamilton(zero, zero, zero, one),
+	}
+	tol := big.NewFloat(1e-15)
+	if !IsOrthonormal(h, tol) {
+		t.Errorf("GramMatrix(%v) = %v", h, GramMatrix(h))
+	}
+	// The basis of Perplex has the Gram matrix diag(1, -1).
+	p := []*Perplex{NewPerplex(one, zero), NewPerplex(zero, one)}
+	g := GramMatrix(p)
+	if g[0][0].Cmp(one) != 0 || g[1][1].Cmp(big.NewFloat(-1)) != 0 || g[0][1].Sign() != 0 || g[1][0].Sign() != 0 {
+		t.Errorf("GramMatrix(%v) = %v", p, g)
+	}
+	if !IsOrthonormal(p, tol) {
+		t.Errorf("IsOrthonormal(%v) = false", p)
+	}
+	// 1+i and 1-i are orthogonal, but not normalized.
+	c := []*Complex{NewComplex(one, one), NewComplex(one, big.NewFloat(-1))}
+	if g := GramMatrix(c); g[0][1].Sign() != 0 || IsOrthonormal(c, tol) {
+		t.Errorf("GramMatrix(%v) = %v", c, g)
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+	"math/rand"
+	"reflect"
+	"strings"
+)
+
+// A Grassmann represents a multi-precision floating-point element of the
+// exterior algebra over n generators e1, ..., en, whose squares are zero and
+// which anticommute. Its components are ordered by blades as in Clifford: the
+// component of index m is the coefficient of the wedge product, in increasing
+// order, of the generators whose bits are set in m.
+//
+// With this order, the exterior algebra over 2 generators is Supra with the
+// components in the order of Cartesian. The one over 3 generators is not
+// Ultra, which is nonassociative, although both have 8 components.
+type Grassmann struct {
+	n int
+	c []big.Float
+}
+
+// NewGrassmann returns a pointer to the zero Grassmann value over n
+// generators. If n is negative, then NewGrassmann panics.
+func NewGrassmann(n int) *Grassmann {
+	if n < 0 {
+		panic("negative number of generators")
+	}
+	return &Grassmann{n, make([]big.Float, 1<<uint(n))}
+}
+
+// Generators returns the number of generators of z.
+func (z *Grassmann) Generators() int {
+	return z.n
+}
+
+// Cartesian returns the 2^n multi-precision floating-point components of z,
+// in the order of the blades.
+func (z *Grassmann) Cartesian() []*big.Float {
+	c := make([]*big.Float, len(z.c))
+	for k := range c {
+		c[k] = &z.c[k]
+	}
+	return c
+}
+
+// Real returns the real part of z.
+func (z *Grassmann) Real() *big.Float {
+	return &z.c[0]
+}
+
+// String returns the string representation of a Grassmann value.
+//
+// If z has components a, b, c, d, ..., then the string is
+// "(a+be1+ce2+de1e2+...)", similar to complex128 values.
+func (z *Grassmann) String() string {
+	a := make([]string, 0, 2*len(z.c)+1)
+	a = append(a, "(", fmt.Sprintf("%v", &z.c[0]))
+	for k := 1; k < len(z.c); k++ {
+		if z.c[k].Sign() < 0 {
+			a = append(a, fmt.Sprintf("%v", &z.c[k]))
+		} else {
+			a = append(a, fmt.Sprintf("+%v", &z.c[k]))
+		}
+		a = append(a, bladeName(k))
+	}
+	a = append(a, ")")
+	return strings.Join(a, "")
+}
+
+// Format implements fmt.Formatter, with the conventions of the Format method
+// of Complex.
+func (z *Grassmann) Format(s fmt.State, verb rune) {
+	units := make([]string, len(z.c))
+	for k := range units {
+		units[k] = bladeName(k)
+	}
+	formatTerms(s, verb, z, units, z.Cartesian())
+}
+
+// Equals returns true if y and z have the same generators and are equal.
+func (z *Grassmann) Equals(y *Grassmann) bool {
+	if z.n != y.n {
+		return false
+	}
+	for k := range z.c {
+		if z.c[k].Cmp(&y.c[k]) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// reset gives z n generators, keeping its components if it already has n,
+// and returns z.
+func (z *Grassmann) reset(n int) *Grassmann {
+	if z.n != n || len(z.c) != 1<<uint(n) {
+		z.n = n
+		z.c = make([]big.Float, 1<<uint(n))
+	}
+	return z
+}
+
+// check panics unless every element of y has the generators of z.
+func (z *Grassmann) check(y ...*Grassmann) {
+	for _, v := range y {
+		if v.n != z.n {
+			panic("number of generators mismatch")
+		}
+	}
+}
+
+// Copy copies y onto z, including its generators, and returns z.
+func (z *Grassmann) Copy(y *Grassmann) *Grassmann {
+	if z == y {
+		return z
+	}
+	z.reset(y.n)
+	for k := range z.c {
+		z.c[k].Copy(&y.c[k])
+	}
+	return z
+}
+
+// Scal sets z equal to y scaled by a, and returns z.
+func (z *Grassmann) Scal(y *Grassmann, a *big.Float) *Grassmann {
+	z.reset(y.n)
+	for k := range z.c {
+		z.c[k].Mul(&y.c[k], a)
+	}
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *Grassmann) Neg(y *Grassmann) *Grassmann {
+	z.reset(y.n)
+	for k := range z.c {
+		z.c[k].Neg(&y.c[k])
+	}
+	return z
+}
+
+// Add sets z equal to x+y, and returns z. If x and y have different numbers
+// of generators, then Add panics.
+func (z *Grassmann) Add(x, y *Grassmann) *Grassmann {
+	x.check(y)
+	z.reset(x.n)
+	for k := range z.c {
+		z.c[k].Add(&x.c[k], &y.c[k])
+	}
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z. If x and y have different numbers
+// of generators, then Sub panics.
+func (z *Grassmann) Sub(x, y *Grassmann) *Grassmann {
+	x.check(y)
+	z.reset(x.n)
+	for k := range z.c {
+		z.c[k].Sub(&x.c[k], &y.c[k])
+	}
+	return z
+}
+
+// Wedge sets z equal to the wedge product of x and y, and returns z. If x and
+// y have different numbers of generators, then Wedge panics.
+//
+// The multiplication rules are:
+// 		Wedge(ek, ek) = 0
+// 		Wedge(ej, ek) = -Wedge(ek, ej)
+// This binary operation is associative, and it is graded-commutative: blades
+// of grades j and k commute if jk is even and anticommute if it is odd.
+// Unless the components of x or y differ widely in scale, the product is
+// computed exactly and then rounded once, so the result is exact whenever it
+// fits the precision of z; otherwise it is computed with rounded arithmetic.
+func (z *Grassmann) Wedge(x, y *Grassmann) *Grassmann {
+	x.check(y)
+	prec := maxPrec(append(x.Cartesian(), y.Cartesian()...)...)
+	o := productOps(prec, z.Cartesian(), x.Cartesian(), y.Cartesian())
+	sum := make([]*big.Float, len(x.c))
+	for k := range sum {
+		sum[k] = new(big.Float)
+	}
+	for m := range x.c {
+		if x.c[m].Sign() == 0 {
+			continue
+		}
+		for n := range y.c {
+			if m&n != 0 {
+				continue
+			}
+			t := o.mul(&x.c[m], &y.c[n])
+			if reorderSwaps(m, n)%2 != 0 {
+				sum[m|n] = o.sub(sum[m|n], t)
+			} else {
+				sum[m|n] = o.add(sum[m|n], t)
+			}
+		}
+	}
+	z.reset(x.n)
+	for k := range z.c {
+		roundFloat(&z.c[k], sum[k], prec)
+	}
+	o.settle(z.Cartesian()...)
+	return z
+}
+
+// Grade sets z equal to the part of y of grade k, the sum of the blades of k
+// generators, and returns z. If k is negative or larger than n, then the
+// result is zero.
+func (z *Grassmann) Grade(y *Grassmann, k int) *Grassmann {
+	z.reset(y.n)
+	for m := range z.c {
+		if bits.OnesCount(uint(m)) == k {
+			z.c[m].Copy(&y.c[m])
+		} else {
+			z.c[m].SetPrec(y.c[m].Prec()).SetInt64(0)
+		}
+	}
+	return z
+}
+
+// Complement sets z equal to the complement of y, and returns z. The
+// complement of a blade is the blade of the other generators, with the sign
+// for which
+// 		Wedge(e, Complement(e)) = e1e2...en
+// so it sends grade k to grade n-k, like the Hodge star of a Euclidean space
+// with an orthonormal basis. Applying it twice multiplies the blades of grade
+// k by (-1)^(k(n-k)).
+func (z *Grassmann) Complement(y *Grassmann) *Grassmann {
+	full := len(y.c) - 1
+	c := make([]big.Float, len(y.c))
+	for m := range y.c {
+		if reorderSwaps(m, full^m)%2 != 0 {
+			c[full^m].Neg(&y.c[m])
+		} else {
+			c[full^m].Copy(&y.c[m])
+		}
+	}
+	z.n, z.c = y.n, c
+	return z
+}
+
+// Generate returns a random Grassmann value over 3 generators for quick.Check
+// testing.
+func (z *Grassmann) Generate(rand *rand.Rand, size int) reflect.Value {
+	randomGrassmann := NewGrassmann(3)
+	for k := range randomGrassmann.c {
+		randomGrassmann.c[k].SetFloat64(rand.Float64())
+	}
+	return reflect.ValueOf(randomGrassmann)
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+// newGrassmannFrom returns the Grassmann value over n generators with the
+// components c.
+func newGrassmannFrom(n int, c ...*big.Float) *Grassmann {
+	z := NewGrassmann(n)
+	for k, v := range z.Cartesian() {
+		v.Copy(c[k])
+	}
+	return z
+}
+
+func TestGrassmannSupra(t *testing.T) {
+	f := func(x, y *Supra) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a, b, c, d := x.Cartesian()
+		u := newGrassmannFrom(2, a, b, c, d)
+		a, b, c, d = y.Cartesian()
+		v := newGrassmannFrom(2, a, b, c, d)
+		a, b, c, d = new(Supra).Mul(x, y).Cartesian()
+		return new(Grassmann).Wedge(u, v).Equals(newGrassmannFrom(2, a, b, c, d))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGrassmannWedgeAssociative(t *testing.T) {
+	f := func(x, y, z *Grassmann) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		l, r := new(Grassmann), new(Grassmann)
+		l.Wedge(l.Wedge(x, y), z)
+		r.Wedge(x, r.Wedge(y, z))
+		d := new(Grassmann).Sub(l, r)
+		for _, c := range d.Cartesian() {
+			if !closeTo(new(big.Float).Add(c, big.NewFloat(8)), big.NewFloat(8), 48) {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGrassmannVectorSquare(t *testing.T) {
+	f := func(x *Grassmann) bool {
+		// t.Logf("x = %v", x)
+		v := new(Grassmann).Grade(x, 1)
+		return new(Grassmann).Wedge(v, v).Equals(NewGrassmann(3))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGrassmannComplement(t *testing.T) {
+	f := func(x *Grassmann) bool {
+		// t.Logf("x = %v", x)
+		n := x.Generators()
+		sum := NewGrassmann(n)
+		for k := 0; k <= n; k++ {
+			g := new(Grassmann).Grade(x, k)
+			sum.Add(sum, g)
+			// The complement sends grade k to grade n-k.
+			c := new(Grassmann).Complement(g)
+			if !new(Grassmann).Grade(c, n-k).Equals(c) {
+				return false
+			}
+			c.Complement(c)
+			if k*(n-k)%2 != 0 {
+				c.Neg(c)
+			}
+			if !c.Equals(g) {
+				return false
+			}
+		}
+		return sum.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	// Wedge(e, Complement(e)) is the volume element for every blade e.
+	vol := NewGrassmann(3)
+	vol.c[7].SetInt64(1)
+	for m := 0; m < 8; m++ {
+		e := NewGrassmann(3)
+		e.c[m].SetInt64(1)
+		if w := new(Grassmann).Wedge(e, new(Grassmann).Complement(e)); !w.Equals(vol) {
+			t.Errorf("Wedge(%v, Complement(%v)) = %v, want %v", e, e, w, vol)
+		}
+	}
+}
@@ -54,6 +54,12 @@ func (z *Hamilton) String() string {
 	return strings.Join(a, "")
 }
 
+// Format implements fmt.Formatter, with the conventions of the Format method
+// of Complex.
+func (z *Hamilton) Format(s fmt.State, verb rune) {
+	formatTerms(s, verb, z, symbHamilton[:], floatSlice(z.Cartesian()))
+}
+
 // Equals returns true if y and z are equal.
 func (z *Hamilton) Equals(y *Hamilton) bool {
 	if !z.l.Equals(&y.l) || !z.r.Equals(&y.r) {
@@ -122,20 +128,22 @@ func (z *Hamilton) Sub(x, y *Hamilton) *Hamilton {
 // 		Mul(j, k) = -Mul(k, j) = i
 // 		Mul(k, i) = -Mul(i, k) = j
 // This binary operation is noncommutative but associative.
+// Unless the components of x or y differ widely in scale, the product is
+// computed exactly and then rounded once, so the result is exact whenever it
+// fits the precision of z; otherwise it is computed with rounded arithmetic.
 func (z *Hamilton) Mul(x, y *Hamilton) *Hamilton {
-	a := new(Complex).Copy(&x.l)
-	b := new(Complex).Copy(&x.r)
-	c := new(Complex).Copy(&y.l)
-	d := new(Complex).Copy(&y.r)
-	temp := new(Complex)
-	z.l.Sub(
-		z.l.Mul(a, c),
-		temp.Mul(temp.Conj(d), b),
-	)
-	z.r.Add(
-		z.r.Mul(d, a),
-		temp.Mul(b, temp.Conj(c)),
+	prec := maxPrec(
+		&x.l.l, &x.l.r, &x.r.l, &x.r.r,
+		&y.l.l, &y.l.r, &y.r.l, &y.r.r,
 	)
+	if fitsExact(prec,
+		[]*big.Float{&z.l.l, &z.l.r, &z.r.l, &z.r.r},
+		[]*big.Float{&x.l.l, &x.l.r, &x.r.l, &x.r.r},
+		[]*big.Float{&y.l.l, &y.l.r, &y.r.l, &y.r.r},
+	) {
+		return z.roundHamilton(new(Hamilton).mulExact(x, y), prec)
+	}
+	mulDoubling(-1, &z.l, &z.r, &x.l, &x.r, &y.l, &y.r)
 	return z
 }
 
@@ -144,7 +152,7 @@ func (z *Hamilton) Mul(x, y *Hamilton) *Hamilton {
 // Then it returns z.
 func (z *Hamilton) Commutator(x, y *Hamilton) *Hamilton {
 	return z.Sub(
-		z.Mul(x, y),
+		new(Hamilton).Mul(x, y),
 		new(Hamilton).Mul(y, x),
 	)
 }
@@ -159,6 +167,30 @@ func (z *Hamilton) Quad() *big.Float {
 	)
 }
 
+// Abs returns the absolute value of z, which is the square root of the
+// quadrance. It is a pointer to a big.Float value rounded to the precision of
+// z.
+func (z *Hamilton) Abs() *big.Float {
+	a, b, c, d := z.Cartesian()
+	return bigHypot(maxPrec(a, b, c, d), a, b, c, d)
+}
+
+// Versor sets z equal to the unit quaternion y/|y|, and returns |y|. Both
+// results are rounded to the precision of y. If y is zero, then Versor
+// panics.
+func (z *Hamilton) Versor(y *Hamilton) *big.Float {
+	a, b, c, d := y.Cartesian()
+	prec := maxPrec(a, b, c, d)
+	w := prec + guardBits
+	abs := bigHypot(w, a, b, c, d)
+	if abs.Sign() == 0 {
+		panic("versor of zero")
+	}
+	f := newFloat(w).Quo(big.NewFloat(1), abs)
+	z.setScaledVector(newFloat(w).Mul(a, f), f, b, c, d, prec)
+	return newFloat(prec).Set(abs)
+}
+
 // Inv sets z equal to the inverse of y, and returns z. If y is zero, then Inv
 // panics.
 func (z *Hamilton) Inv(y *Hamilton) *Hamilton {
@@ -174,6 +206,35 @@ func (z *Hamilton) Inv(y *Hamilton) *Hamilton {
 	return z
 }
 
+// Pow sets z equal to y raised to the integer power n, and returns z. The
+// power is computed by repeated squaring, with O(log|n|) multiplications at
+// the precision of y. If
+// n is negative, then the inverse of y is raised to -n, so Pow panics if y is
+// zero.
+func (z *Hamilton) Pow(y *Hamilton, n int) *Hamilton {
+	x := new(Hamilton).Copy(y)
+	if n < 0 {
+		x.Inv(x)
+		n = -n
+	}
+	a, b, c, d := y.Cartesian()
+	prec := maxPrec(a, b, c, d)
+	p := new(Hamilton)
+	p.l.l.SetPrec(prec).SetInt64(1)
+	p.l.r.SetPrec(prec)
+	p.r.l.SetPrec(prec)
+	p.r.r.SetPrec(prec)
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			p.Mul(p, x)
+		}
+		if n > 1 {
+			x.Mul(x, x)
+		}
+	}
+	return z.Copy(p)
+}
+
 // QuoL sets z equal to the left quotient of x and y:
 // 		Mul(Inv(y), x)
 // Then it returns z. If y is zero, then QuoL panics.
@@ -210,60 +271,189 @@ func (z *Hamilton) QuoR(x, y *Hamilton) *Hamilton {
 
 // CrossRatioL sets z equal to the left cross-ratio of v, w, x, and y:
 // 		Inv(w - x) * (v - x) * Inv(v - y) * (w - y)
-// Then it returns z.
+// Then it returns z. If a denominator has no inverse, then CrossRatioL panics
+// and leaves z unchanged.
 func (z *Hamilton) CrossRatioL(v, w, x, y *Hamilton) *Hamilton {
-	temp := new(Hamilton)
-	z.Sub(w, x)
-	z.Inv(z)
-	temp.Sub(v, x)
-	z.Mul(z, temp)
-	temp.Sub(v, y)
-	temp.Inv(temp)
-	z.Mul(z, temp)
-	temp.Sub(w, y)
-	return z.Mul(z, temp)
+	if _, err := z.CrossRatioLChecked(v, w, x, y, ReportDegeneracy); err != nil {
+		panic(err.Error())
+	}
+	return z
+}
+
+// CrossRatioLChecked is like CrossRatioL, but it returns a *DegenerateError
+// instead of panicking when a denominator has no inverse, and leaves z
+// unchanged. The denominators vanish when w = x or v = y. If policy is
+// ProjectiveInfinity, then vanishing denominators with non-zero numerators set
+// z equal to the point at infinity instead.
+func (z *Hamilton) CrossRatioLChecked(v, w, x, y *Hamilton, policy DegeneracyPolicy) (*Hamilton, error) {
+	zero := new(Hamilton)
+	vx := new(Hamilton).Sub(v, x)
+	wy := new(Hamilton).Sub(w, y)
+	wx := new(Hamilton).Sub(w, x)
+	vy := new(Hamilton).Sub(v, y)
+	inf, err := checkQuotient(
+		"CrossRatioL",
+		vx.Equals(zero) || wy.Equals(zero),
+		wx.Equals(zero) || vy.Equals(zero),
+		false,
+		policy,
+	)
+	switch {
+	case err != nil:
+		return z, err
+	case inf:
+		setInf(&z.l.l, &z.l.r, &z.r.l, &z.r.r)
+		return z, nil
+	}
+	p := new(Hamilton).Mul(wx.Inv(wx), vx)
+	p.Mul(p, vy.Inv(vy))
+	return z.Mul(p, wy), nil
 }
 
 // CrossRatioR sets z equal to the right cross-ratio of v, w, x, and y:
 // 		(v - x) * Inv(w - x) * (w - y) * Inv(v - y)
-// Then it returns z.
+// Then it returns z. If a denominator has no inverse, then CrossRatioR panics
+// and leaves z unchanged.
 func (z *Hamilton) CrossRatioR(v, w, x, y *Hamilton) *Hamilton {
-	temp := new(Hamilton)
-	z.Sub(v, x)
-	temp.Sub(w, x)
-	temp.Inv(temp)
-	z.Mul(z, temp)
-	temp.Sub(w, y)
-	z.Mul(z, temp)
-	temp.Sub(v, y)
-	temp.Inv(temp)
-	return z.Mul(z, temp)
+	if _, err := z.CrossRatioRChecked(v, w, x, y, ReportDegeneracy); err != nil {
+		panic(err.Error())
+	}
+	return z
+}
+
+// CrossRatioRChecked is like CrossRatioR, but it returns a *DegenerateError
+// instead of panicking when a denominator has no inverse, and leaves z
+// unchanged. The denominators vanish when w = x or v = y. If policy is
+// ProjectiveInfinity, then vanishing denominators with non-zero numerators set
+// z equal to the point at infinity instead.
+func (z *Hamilton) CrossRatioRChecked(v, w, x, y *Hamilton, policy DegeneracyPolicy) (*Hamilton, error) {
+	zero := new(Hamilton)
+	vx := new(Hamilton).Sub(v, x)
+	wy := new(Hamilton).Sub(w, y)
+	wx := new(Hamilton).Sub(w, x)
+	vy := new(Hamilton).Sub(v, y)
+	inf, err := checkQuotient(
+		"CrossRatioR",
+		vx.Equals(zero) || wy.Equals(zero),
+		wx.Equals(zero) || vy.Equals(zero),
+		false,
+		policy,
+	)
+	switch {
+	case err != nil:
+		return z, err
+	case inf:
+		setInf(&z.l.l, &z.l.r, &z.r.l, &z.r.r)
+		return z, nil
+	}
+	p := new(Hamilton).Mul(vx, wx.Inv(wx))
+	p.Mul(p, wy)
+	return z.Mul(p, vy.Inv(vy)), nil
 }
 
 // MöbiusL sets z equal to the left Möbius (fractional linear) transform of y:
 // 		Inv(y*c + d) * (y*a + b)
-// Then it returns z.
+// Then it returns z. If the denominator has no inverse, then MöbiusL panics
+// and leaves z unchanged.
 func (z *Hamilton) MöbiusL(y, a, b, c, d *Hamilton) *Hamilton {
-	z.Mul(y, a)
-	z.Add(z, b)
-	temp := new(Hamilton)
-	temp.Mul(y, c)
-	temp.Add(temp, d)
-	temp.Inv(temp)
-	return z.Mul(temp, z)
+	if _, err := z.MöbiusLChecked(y, a, b, c, d, ReportDegeneracy); err != nil {
+		panic(err.Error())
+	}
+	return z
+}
+
+// MöbiusLChecked is like MöbiusL, but it returns a *DegenerateError instead of
+// panicking when the denominator has no inverse, and leaves z unchanged. If
+// policy is ProjectiveInfinity, then a zero denominator with a non-zero
+// numerator sets z equal to the point at infinity instead.
+func (z *Hamilton) MöbiusLChecked(y, a, b, c, d *Hamilton, policy DegeneracyPolicy) (*Hamilton, error) {
+	zero := new(Hamilton)
+	num := new(Hamilton).Add(new(Hamilton).Mul(y, a), b)
+	den := new(Hamilton).Add(new(Hamilton).Mul(y, c), d)
+	inf, err := checkQuotient("MöbiusL", num.Equals(zero), den.Equals(zero), false, policy)
+	switch {
+	case err != nil:
+		return z, err
+	case inf:
+		setInf(&z.l.l, &z.l.r, &z.r.l, &z.r.r)
+		return z, nil
+	}
+	return z.Mul(new(Hamilton).Inv(den), num), nil
 }
 
 // MöbiusR sets z equal to the right Möbius (fractional linear) transform of y:
 // 		(a*y + b) * Inv(c*y + d)
-// Then it returns z.
+// Then it returns z. If the denominator has no inverse, then MöbiusR panics
+// and leaves z unchanged.
 func (z *Hamilton) MöbiusR(y, a, b, c, d *Hamilton) *Hamilton {
-	z.Mul(a, y)
-	z.Add(z, b)
-	temp := new(Hamilton)
-	temp.Mul(c, y)
-	temp.Add(temp, d)
-	temp.Inv(temp)
-	return z.Mul(z, temp)
+	if _, err := z.MöbiusRChecked(y, a, b, c, d, ReportDegeneracy); err != nil {
+		panic(err.Error())
+	}
+	return z
+}
+
+// MöbiusRChecked is like MöbiusR, but it returns a *DegenerateError instead of
+// panicking when the denominator has no inverse, and leaves z unchanged. If
+// policy is ProjectiveInfinity, then a zero denominator with a non-zero
+// numerator sets z equal to the point at infinity instead.
+func (z *Hamilton) MöbiusRChecked(y, a, b, c, d *Hamilton, policy DegeneracyPolicy) (*Hamilton, error) {
+	zero := new(Hamilton)
+	num := new(Hamilton).Add(new(Hamilton).Mul(a, y), b)
+	den := new(Hamilton).Add(new(Hamilton).Mul(c, y), d)
+	inf, err := checkQuotient("MöbiusR", num.Equals(zero), den.Equals(zero), false, policy)
+	switch {
+	case err != nil:
+		return z, err
+	case inf:
+		setInf(&z.l.l, &z.l.r, &z.r.l, &z.r.r)
+		return z, nil
+	}
+	return z.Mul(num, new(Hamilton).Inv(den)), nil
+}
+
+// ToComplexMatrix returns the 2x2 complex matrix of z. If z = a+bi+cj+dk,
+// then the matrix is
+// 		[ a + bi    c + di]
+// 		[-c + di    a - bi]
+// so that Mul corresponds to the matrix product, Conj to the conjugate
+// transpose, and Quad to the determinant. Unit quaternions correspond to the
+// matrices of SU(2).
+func (z *Hamilton) ToComplexMatrix() [2][2]*Complex {
+	return [2][2]*Complex{
+		{new(Complex).Copy(&z.l), new(Complex).Copy(&z.r)},
+		{new(Complex).Neg(new(Complex).Conj(&z.r)), new(Complex).Conj(&z.l)},
+	}
+}
+
+// FromComplexMatrix sets z equal to the Hamilton value of the 2x2 complex
+// matrix m, the inverse of ToComplexMatrix, and returns z. If m is not of that
+// form, then z is the value whose matrix is closest to m in the Frobenius
+// norm. The components are rounded to the largest precision of the entries
+// of m.
+func (z *Hamilton) FromComplexMatrix(m [2][2]*Complex) *Hamilton {
+	var all []*big.Float
+	for _, row := range m {
+		for _, e := range row {
+			all = append(all, &e.l, &e.r)
+		}
+	}
+	prec := maxPrec(all...)
+	// half returns (x + y)/2 rounded to prec bits.
+	half := func(x, y *big.Float) *big.Float {
+		return newFloat(prec).SetMantExp(exactAdd(x, y), -1)
+	}
+	neg := func(x *big.Float) *big.Float {
+		return new(big.Float).Neg(x)
+	}
+	a := half(&m[0][0].l, &m[1][1].l)
+	b := half(&m[0][0].r, neg(&m[1][1].r))
+	c := half(&m[0][1].l, neg(&m[1][0].l))
+	d := half(&m[0][1].r, &m[1][0].r)
+	z.l.l.SetPrec(prec).Set(a)
+	z.l.r.SetPrec(prec).Set(b)
+	z.r.l.SetPrec(prec).Set(c)
+	z.r.r.SetPrec(prec).Set(d)
+	return z
 }
 
 // Generate returns a random Hamilton value for quick.Check testing.
@@ -343,3 +343,141 @@ func XTestHamiltonComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Integer powers
+
+func TestHamiltonPowRepeatedMul(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		y := setPrecHamilton(x, 200)
+		p := new(Hamilton).Pow(y, 5)
+		m := new(Hamilton).Mul(y, y)
+		m.Mul(m, y)
+		m.Mul(m, y)
+		m.Mul(m, y)
+		d := m.Sub(m, p).Quad()
+		return d.Sign() == 0 || expo(d) < expo(p.Quad())-380
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonPowNegative(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		y := setPrecHamilton(x, 200)
+		p := new(Hamilton).Pow(y, -3)
+		p.Mul(p, new(Hamilton).Pow(y, 3))
+		p.l.l.Sub(&p.l.l, big.NewFloat(1))
+		d := p.Quad()
+		return d.Sign() == 0 || expo(d) < -380
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonPowZero(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		one := NewHamilton(big.NewFloat(1), new(big.Float), new(big.Float), new(big.Float))
+		return new(Hamilton).Pow(x, 0).Equals(one)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Absolute value
+
+func TestHamiltonAbsSquare(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		y := setPrecHamilton(x, 200)
+		a := y.Abs()
+		return closeTo(a.Mul(a, a), y.Quad(), 195)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonVersor(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		y := setPrecHamilton(x, 200)
+		u := new(Hamilton)
+		abs := u.Versor(y)
+		if !closeTo(u.Quad(), big.NewFloat(1), 195) {
+			return false
+		}
+		d := u.Scal(u, abs).Sub(u, y).Quad()
+		return d.Sign() == 0 || expo(d) < expo(y.Quad())-390
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Complex matrix representation
+
+func TestHamiltonComplexMatrixMul(t *testing.T) {
+	f := func(x, y *Hamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		x, y = setPrecHamilton(x, 128), setPrecHamilton(y, 128)
+		l := new(Hamilton).Mul(x, y).ToComplexMatrix()
+		m, n := x.ToComplexMatrix(), y.ToComplexMatrix()
+		for i := 0; i < 2; i++ {
+			for j := 0; j < 2; j++ {
+				r := new(Complex).Add(
+					new(Complex).Mul(m[i][0], n[0][j]),
+					new(Complex).Mul(m[i][1], n[1][j]),
+				)
+				if !l[i][j].Equals(r) {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonComplexMatrixDeterminant(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		x = setPrecHamilton(x, 128)
+		m := x.ToComplexMatrix()
+		det := new(Complex).Sub(
+			new(Complex).Mul(m[0][0], m[1][1]),
+			new(Complex).Mul(m[0][1], m[1][0]),
+		)
+		return det.l.Cmp(x.Quad()) == 0 && det.r.Sign() == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonComplexMatrixRoundTrip(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		return new(Hamilton).FromComplexMatrix(x.ToComplexMatrix()).Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonCommutatorAlias(t *testing.T) {
+	f := func(x, y *Hamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		return commutatorAliased(x, y)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
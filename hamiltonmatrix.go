@@ -0,0 +1,230 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strings"
+)
+
+// A HamiltonMatrix represents a matrix with multi-precision quaternion
+// entries. Since the entries do not commute, the order of the factors in
+// every product follows the order of the matrices.
+type HamiltonMatrix struct {
+	rows, cols int
+	e          []Hamilton
+}
+
+// NewHamiltonMatrix returns a pointer to the zero HamiltonMatrix value with
+// the given number of rows and columns. If either is negative, then
+// NewHamiltonMatrix panics.
+func NewHamiltonMatrix(rows, cols int) *HamiltonMatrix {
+	if rows < 0 || cols < 0 {
+		panic("negative matrix dimension")
+	}
+	return &HamiltonMatrix{rows, cols, make([]Hamilton, rows*cols)}
+}
+
+// Dims returns the number of rows and columns of m.
+func (m *HamiltonMatrix) Dims() (int, int) {
+	return m.rows, m.cols
+}
+
+// At returns a pointer to the entry of m in row i and column j, which can be
+// modified in place. If the indices are out of range, then At panics.
+func (m *HamiltonMatrix) At(i, j int) *Hamilton {
+	if i < 0 || i >= m.rows || j < 0 || j >= m.cols {
+		panic("matrix index out of range")
+	}
+	return &m.e[i*m.cols+j]
+}
+
+// String returns the string version of a HamiltonMatrix value.
+//
+// If m has rows r[0], r[1], ..., then the string is "[r[0], r[1], ...]", with
+// each row in the same format and each entry in the format of a Hamilton
+// value.
+func (m *HamiltonMatrix) String() string {
+	rows := make([]string, m.rows)
+	for i := range rows {
+		a := make([]string, m.cols)
+		for j := range a {
+			a[j] = m.At(i, j).String()
+		}
+		rows[i] = "[" + strings.Join(a, ", ") + "]"
+	}
+	return "[" + strings.Join(rows, ", ") + "]"
+}
+
+// Format implements fmt.Formatter, with the conventions of the Format method
+// of Complex for each entry.
+func (m *HamiltonMatrix) Format(s fmt.State, verb rune) {
+	if formatBad(s, verb, m) {
+		return
+	}
+	io.WriteString(s, "[")
+	for i := 0; i < m.rows; i++ {
+		if i > 0 {
+			io.WriteString(s, ", ")
+		}
+		io.WriteString(s, "[")
+		for j := 0; j < m.cols; j++ {
+			if j > 0 {
+				io.WriteString(s, ", ")
+			}
+			m.At(i, j).Format(s, verb)
+		}
+		io.WriteString(s, "]")
+	}
+	io.WriteString(s, "]")
+}
+
+// Equals returns true if m and n are equal.
+func (m *HamiltonMatrix) Equals(n *HamiltonMatrix) bool {
+	if m.rows != n.rows || m.cols != n.cols {
+		return false
+	}
+	for k := range m.e {
+		if !m.e[k].Equals(&n.e[k]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Copy copies n onto m, and returns m.
+func (m *HamiltonMatrix) Copy(n *HamiltonMatrix) *HamiltonMatrix {
+	e := make([]Hamilton, len(n.e))
+	for k := range e {
+		e[k].Copy(&n.e[k])
+	}
+	m.rows, m.cols, m.e = n.rows, n.cols, e
+	return m
+}
+
+// Add sets m equal to the sum of x and y, and returns m. If x and y have
+// different dimensions, then Add panics.
+func (m *HamiltonMatrix) Add(x, y *HamiltonMatrix) *HamiltonMatrix {
+	if x.rows != y.rows || x.cols != y.cols {
+		panic("matrix dimensions differ")
+	}
+	e := make([]Hamilton, len(x.e))
+	for k := range e {
+		e[k].Add(&x.e[k], &y.e[k])
+	}
+	m.rows, m.cols, m.e = x.rows, x.cols, e
+	return m
+}
+
+// Sub sets m equal to the difference of x and y, and returns m. If x and y
+// have different dimensions, then Sub panics.
+func (m *HamiltonMatrix) Sub(x, y *HamiltonMatrix) *HamiltonMatrix {
+	if x.rows != y.rows || x.cols != y.cols {
+		panic("matrix dimensions differ")
+	}
+	e := make([]Hamilton, len(x.e))
+	for k := range e {
+		e[k].Sub(&x.e[k], &y.e[k])
+	}
+	m.rows, m.cols, m.e = x.rows, x.cols, e
+	return m
+}
+
+// Mul sets m equal to the product of x and y, and returns m. The entry in row
+// i and column j is
+// 		Σ x[i][k] y[k][j]
+// with each entry of x on the left. If the number of columns of x differs
+// from the number of rows of y, then Mul panics. The products of entries are
+// formed in values drawn from the free lists of NewHamiltonAt.
+func (m *HamiltonMatrix) Mul(x, y *HamiltonMatrix) *HamiltonMatrix {
+	if x.cols != y.rows {
+		panic("matrix dimensions differ")
+	}
+	n := NewHamiltonMatrix(x.rows, y.cols)
+	for i := 0; i < x.rows; i++ {
+		for j := 0; j < y.cols; j++ {
+			s := n.At(i, j)
+			for k := 0; k < x.cols; k++ {
+				a, b := x.At(i, k), y.At(k, j)
+				t := NewHamiltonAt(maxPrec(
+					&a.l.l, &a.l.r, &a.r.l, &a.r.r,
+					&b.l.l, &b.l.r, &b.r.l, &b.r.r,
+				))
+				s.Add(s, t.Mul(a, b))
+				t.Release()
+			}
+		}
+	}
+	m.rows, m.cols, m.e = n.rows, n.cols, n.e
+	return m
+}
+
+// ConjTranspose sets m equal to the conjugate transpose of y, and returns m.
+// It reverses the order of products, so that
+// 		ConjTranspose(xy) = ConjTranspose(y) ConjTranspose(x)
+func (m *HamiltonMatrix) ConjTranspose(y *HamiltonMatrix) *HamiltonMatrix {
+	n := NewHamiltonMatrix(y.cols, y.rows)
+	for i := 0; i < y.rows; i++ {
+		for j := 0; j < y.cols; j++ {
+			n.At(j, i).Conj(y.At(i, j))
+		}
+	}
+	m.rows, m.cols, m.e = n.rows, n.cols, n.e
+	return m
+}
+
+// StudyDet returns the Study determinant of the square matrix m, a pointer
+// to a big.Float value. It is the determinant of the 2n x 2n complex matrix
+// obtained by replacing each entry with its ToComplexMatrix, which is real,
+// non-negative, multiplicative, and zero exactly when m has no inverse; for a
+// 1x1 matrix it is the quadrance of the entry. The result is rounded to the
+// largest precision of the entries. If m is not square, then StudyDet panics.
+func (m *HamiltonMatrix) StudyDet() *big.Float {
+	if m.rows != m.cols {
+		panic("non-square matrix")
+	}
+	var all []*big.Float
+	for k := range m.e {
+		a, b, c, d := m.e[k].Cartesian()
+		all = append(all, a, b, c, d)
+	}
+	prec := maxPrec(all...)
+	w := prec + guardBits
+	n := m.rows
+	chi := make([][]Complex, 2*n)
+	for i := range chi {
+		chi[i] = make([]Complex, 2*n)
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			block := m.At(i, j).ToComplexMatrix()
+			for r := 0; r < 2; r++ {
+				for c := 0; c < 2; c++ {
+					chi[2*i+r][2*j+c].round(block[r][c], w)
+				}
+			}
+		}
+	}
+	det := complexDet(chi, w)
+	return newFloat(prec).Set(&det.l)
+}
+
+// Generate returns a random 3x3 HamiltonMatrix value for quick.Check
+// testing.
+func (m *HamiltonMatrix) Generate(rand *rand.Rand, size int) reflect.Value {
+	randomHamiltonMatrix := NewHamiltonMatrix(3, 3)
+	for k := range randomHamiltonMatrix.e {
+		randomHamiltonMatrix.e[k] = *NewHamilton(
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+		)
+	}
+	return reflect.ValueOf(randomHamiltonMatrix)
+}
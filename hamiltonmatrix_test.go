@@ -0,0 +1,87 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"testing"
+	"testing/quick"
+)
+
+// closeToHamiltonMatrix returns true if the entries of x and y agree to about
+// bits bits, relative to the larger of their modulus and 1.
+func closeToHamiltonMatrix(x, y *HamiltonMatrix, bits int) bool {
+	if x.rows != y.rows || x.cols != y.cols {
+		return false
+	}
+	for k := range x.e {
+		if !closeToComplex(&x.e[k].l, &y.e[k].l, bits) || !closeToComplex(&x.e[k].r, &y.e[k].r, bits) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestHamiltonMatrixMulAssociative(t *testing.T) {
+	f := func(x, y, z *HamiltonMatrix) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		l := new(HamiltonMatrix).Mul(new(HamiltonMatrix).Mul(x, y), z)
+		r := new(HamiltonMatrix).Mul(x, new(HamiltonMatrix).Mul(y, z))
+		return closeToHamiltonMatrix(l, r, 45)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonMatrixMulNonCommutative(t *testing.T) {
+	f := func(x, y *HamiltonMatrix) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(HamiltonMatrix).Mul(x, y)
+		r := new(HamiltonMatrix).Mul(y, x)
+		return !l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonMatrixConjTranspose(t *testing.T) {
+	f := func(x, y *HamiltonMatrix) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(HamiltonMatrix).ConjTranspose(new(HamiltonMatrix).Mul(x, y))
+		r := new(HamiltonMatrix).Mul(
+			new(HamiltonMatrix).ConjTranspose(y),
+			new(HamiltonMatrix).ConjTranspose(x),
+		)
+		return closeToHamiltonMatrix(l, r, 50)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonMatrixStudyDet(t *testing.T) {
+	f := func(x, y *HamiltonMatrix) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(HamiltonMatrix).Mul(x, y).StudyDet()
+		r := x.StudyDet()
+		r.Mul(r, y.StudyDet())
+		return l.Sign() >= 0 && closeTo(l, r, 30)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonMatrixStudyDetSingle(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		m := NewHamiltonMatrix(1, 1)
+		m.At(0, 0).Copy(x)
+		return closeTo(m.StudyDet(), x.Quad(), 50)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
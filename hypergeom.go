@@ -0,0 +1,286 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+)
+
+// hypergeometricSeries returns the sum of the series
+// 		Σ (p[0])_k...(p[m])_k / ((q[0])_k...(q[n])_k) x^k/k!
+// at precision prec, where (a)_k is the rising factorial, together with its
+// derivative with respect to x and the largest binary exponent among the
+// terms. The series stops once the terms are negligible and shrinking, or
+// when a numerator parameter is a non-positive integer and the series
+// terminates.
+func hypergeometricSeries(p, q []*Complex, x *Complex, prec uint) (*Complex, *Complex, int) {
+	sum := newComplexPrec(prec)
+	sum.l.SetInt64(1)
+	dsum := newComplexPrec(prec)
+	term := newComplexPrec(prec)
+	term.l.SetInt64(1)
+	top := 0
+	small := 0
+	for k := 0; ; k++ {
+		// term *= Π(p + k)/Π(q + k) x/(k+1)
+		r := newComplexPrec(prec)
+		r.l.SetInt64(1)
+		kf := newFloat(prec).SetInt64(int64(k))
+		for _, a := range p {
+			t := new(Complex).Copy(a)
+			t.l.Add(&t.l, kf)
+			r.Mul(r, t)
+		}
+		for _, b := range q {
+			t := new(Complex).Copy(b)
+			t.l.Add(&t.l, kf)
+			if t.l.Sign() == 0 && t.r.Sign() == 0 {
+				panic("hypergeometric series with a non-positive integer lower parameter")
+			}
+			r = new(Complex).Quo(r, t)
+		}
+		r.Mul(r, x)
+		r.Scal(r, newFloat(prec).Quo(big.NewFloat(1), newFloat(prec).SetInt64(int64(k+1))))
+		// d/dx of the term x^(k+1) is (k+1)/x times the term.
+		dterm := new(Complex).Copy(term)
+		dterm.Scal(dterm, newFloat(prec).SetInt64(int64(k+1)))
+		term.Mul(term, r)
+		if term.l.Sign() == 0 && term.r.Sign() == 0 {
+			break
+		}
+		dsum.Add(dsum, dterm.Mul(dterm, r))
+		sum.Add(sum, term)
+		if e := complexExpo(term); e > top {
+			top = e
+		}
+		if complexExpo(term) < complexExpo(sum)-int(prec) && complexAbs(r) < 1 {
+			if small++; small > 1 {
+				break
+			}
+		} else {
+			small = 0
+		}
+	}
+	if x.l.Sign() != 0 || x.r.Sign() != 0 {
+		dsum = new(Complex).Quo(dsum, x)
+	}
+	return sum, dsum, top
+}
+
+// hypergeometricAdaptive evaluates the series at prec bits plus guard bits,
+// and repeats the evaluation with more bits when the cancellation between the
+// largest term and the sum exceeds the guard bits.
+func hypergeometricAdaptive(p, q []*Complex, x *Complex, prec uint) *Complex {
+	w := prec + guardBits
+	for {
+		s, _, top := hypergeometricSeries(p, q, x, w)
+		loss := top - complexExpo(s)
+		if s.l.Sign() == 0 && s.r.Sign() == 0 || loss < int(guardBits)-4 {
+			return s
+		}
+		if w >= prec+uint(loss)+guardBits {
+			return s
+		}
+		w = prec + uint(loss) + guardBits
+	}
+}
+
+// roundedParams returns copies of the parameters rounded to prec bits.
+func roundedParams(prec uint, a ...*Complex) []*Complex {
+	r := make([]*Complex, len(a))
+	for i, x := range a {
+		r[i] = newComplexPrec(prec).round(x, prec)
+	}
+	return r
+}
+
+// Hyp1F1 sets z equal to the confluent hypergeometric function of Kummer
+// 		1F1(a; b; x) = Σ (a)_k/(b)_k x^k/k!
+// and returns z.
+//
+// When Re(x) < 0 the series is evaluated after the Kummer transformation
+// 		1F1(a; b; x) = exp(x) 1F1(b-a; b; -x)
+// unless a is a non-positive integer, in which case 1F1 is a polynomial. The
+// series is summed until its terms fall below the working precision, and the
+// working precision is raised to make up for any cancellation among the
+// terms. The result is rounded to the largest precision of a, b, and x. If b
+// is a non-positive integer, then Hyp1F1 panics.
+func (z *Complex) Hyp1F1(a, b, x *Complex) *Complex {
+	prec := maxPrec(&a.l, &a.r, &b.l, &b.r, &x.l, &x.r)
+	w := prec + guardBits
+	if isNonPositiveInteger(b) {
+		panic("1F1 with a non-positive integer lower parameter")
+	}
+	v := roundedParams(w, a, b, x)
+	if x.l.Sign() < 0 && !isNonPositiveInteger(a) {
+		c := new(Complex).Sub(v[1], v[0])
+		s := hypergeometricAdaptive([]*Complex{c}, v[1:2], new(Complex).Neg(v[2]), w)
+		s.Mul(s, new(Complex).Exp(v[2]))
+		return z.Copy(newComplexPrec(prec).round(s, prec))
+	}
+	s := hypergeometricAdaptive(v[0:1], v[1:2], v[2], w)
+	return z.Copy(newComplexPrec(prec).round(s, prec))
+}
+
+// hyp2F1Step continues the solution f of the hypergeometric equation
+// 		x(1-x)f'' + (c - (a+b+1)x)f' - abf = 0
+// from x0 to x0+h, given f(x0) and f'(x0). The Taylor coefficients u[n] of f
+// about x0 satisfy the recurrence
+// 		p0(n+2)(n+1)u[n+2] = -((p1 n + q0)(n+1)u[n+1] + (p2 n(n-1) + q1 n - ab)u[n])
+// where p0 = x0(1-x0), p1 = 1-2x0, p2 = -1, q0 = c-(a+b+1)x0, and
+// q1 = -(a+b+1). The step must be shorter than the distance from x0 to the
+// singular points 0 and 1.
+func hyp2F1Step(a, b, c, x0, h, f, df *Complex, prec uint) (*Complex, *Complex) {
+	one := newComplexPrec(prec)
+	one.l.SetInt64(1)
+	p0 := new(Complex).Mul(x0, new(Complex).Sub(one, x0))
+	p1 := new(Complex).Sub(one, new(Complex).Scal(x0, big.NewFloat(2)))
+	apb1 := new(Complex).Add(a, b)
+	apb1.Add(apb1, one)
+	q0 := new(Complex).Sub(c, new(Complex).Mul(apb1, x0))
+	q1 := new(Complex).Neg(apb1)
+	ab := new(Complex).Mul(a, b)
+	u0 := new(Complex).Copy(f)
+	u1 := new(Complex).Copy(df)
+	sum := new(Complex).Add(u0, new(Complex).Mul(u1, h))
+	dsum := new(Complex).Copy(u1)
+	hn := new(Complex).Copy(h) // h^(n+1)
+	small := 0
+	for n := 0; n < 64*int(prec); n++ {
+		nf := newFloat(prec).SetInt64(int64(n))
+		n1 := newFloat(prec).SetInt64(int64(n + 1))
+		// (p1 n + q0)(n+1)u[n+1]
+		t1 := new(Complex).Scal(p1, nf)
+		t1.Add(t1, q0)
+		t1.Mul(t1, u1)
+		t1.Scal(t1, n1)
+		// (p2 n(n-1) + q1 n - ab)u[n]
+		t0 := new(Complex).Scal(q1, nf)
+		t0.l.Sub(&t0.l, newFloat(prec).SetInt64(int64(n*(n-1))))
+		t0.Sub(t0, ab)
+		t0.Mul(t0, u0)
+		t1.Add(t1, t0)
+		d := new(Complex).Scal(p0, newFloat(prec).SetInt64(int64((n+2)*(n+1))))
+		u2 := new(Complex).Quo(t1, d)
+		u2.Neg(u2)
+		// sum += u[n+2] h^(n+2), dsum += (n+2) u[n+2] h^(n+1)
+		dt := new(Complex).Mul(u2, hn)
+		dt.Scal(dt, newFloat(prec).SetInt64(int64(n+2)))
+		hn.Mul(hn, h)
+		t := new(Complex).Mul(u2, hn)
+		sum.Add(sum, t)
+		dsum.Add(dsum, dt)
+		if (t.l.Sign() == 0 && t.r.Sign() == 0 || complexExpo(t) < complexExpo(sum)-int(prec)) &&
+			(dt.l.Sign() == 0 && dt.r.Sign() == 0 || complexExpo(dt) < complexExpo(dsum)-int(prec)) {
+			if small++; small > 2 {
+				break
+			}
+		} else {
+			small = 0
+		}
+		u0, u1 = u1, u2
+	}
+	return sum, dsum
+}
+
+// hyp2F1Continue continues the principal branch of 2F1(a, b; c; x) along a
+// path from a point near the origin to x, using the Taylor series of the
+// hypergeometric equation. Points on the cut (1, ∞) are reached from the side
+// selected by the sign of the imaginary part of x.
+func hyp2F1Continue(a, b, c, x *Complex, prec uint) *Complex {
+	one := newComplexPrec(prec)
+	one.l.SetInt64(1)
+	var path []*Complex
+	if x.r.Sign() == 0 && x.l.Cmp(big.NewFloat(1)) > 0 {
+		// Detour around the singular point 1.
+		m := new(Complex).Add(one, x)
+		m.Scal(m, big.NewFloat(0.5))
+		m.r.Sub(&x.l, big.NewFloat(1))
+		m.r.SetMantExp(&m.r, -1)
+		if x.r.Signbit() {
+			m.r.Neg(&m.r)
+		}
+		path = append(path, m)
+	}
+	path = append(path, x)
+	// Start on the segment towards the first point, at |x0| = 1/2.
+	x0 := new(Complex).Copy(path[0])
+	x0.Scal(x0, newFloat(prec).SetFloat64(0.5/complexAbs(x0)))
+	f, df, _ := hypergeometricSeries([]*Complex{a, b}, []*Complex{c}, x0, prec)
+	for _, target := range path {
+		for !x0.Equals(target) {
+			h := new(Complex).Sub(target, x0)
+			r := math.Min(complexAbs(x0), complexAbs(new(Complex).Sub(one, x0))) / 2
+			last := true
+			if m := complexAbs(h); m > r {
+				h.Scal(h, newFloat(prec).SetFloat64(r/m))
+				last = false
+			}
+			f, df = hyp2F1Step(a, b, c, x0, h, f, df, prec)
+			if last {
+				x0.Copy(target)
+			} else {
+				x0.Add(x0, h)
+			}
+		}
+	}
+	return f
+}
+
+// Hyp2F1 sets z equal to the Gauss hypergeometric function
+// 		2F1(a, b; c; x) = Σ (a)_k(b)_k/(c)_k x^k/k!
+// continued analytically to the plane cut along [1, ∞), and returns z. On the
+// cut, the sign of the imaginary part of x selects the side, as for Log.
+//
+// The series is used directly for |x| ≤ 3/4, and after the Pfaff
+// transformation
+// 		2F1(a, b; c; x) = (1-x)^(-a) 2F1(a, c-b; c; x/(x-1))
+// when |x/(x-1)| ≤ 3/4. Elsewhere the function is continued from |x| = 1/2
+// with Taylor steps of the hypergeometric differential equation, which needs
+// no special treatment when the parameters differ by integers. At x = 1 the
+// value follows from Gauss's theorem. The result is rounded to the largest
+// precision of a, b, c, and x. If c is a non-positive integer, or if x = 1 and
+// Re(c-a-b) ≤ 0, then Hyp2F1 panics.
+func (z *Complex) Hyp2F1(a, b, c, x *Complex) *Complex {
+	prec := maxPrec(&a.l, &a.r, &b.l, &b.r, &c.l, &c.r, &x.l, &x.r)
+	w := prec + guardBits
+	if isNonPositiveInteger(c) {
+		panic("2F1 with a non-positive integer lower parameter")
+	}
+	v := roundedParams(w, a, b, c, x)
+	a, b, c, x = v[0], v[1], v[2], v[3]
+	one := newComplexPrec(w)
+	one.l.SetInt64(1)
+	var s *Complex
+	switch {
+	case isNonPositiveInteger(a) || isNonPositiveInteger(b) || complexAbs(x) <= 0.75:
+		s = hypergeometricAdaptive([]*Complex{a, b}, []*Complex{c}, x, w)
+	case isOne(x):
+		// Γ(c)Γ(c-a-b)/(Γ(c-a)Γ(c-b))
+		cab := new(Complex).Sub(c, a)
+		cab.Sub(cab, b)
+		if cab.l.Sign() <= 0 {
+			panic("2F1 diverges at x = 1")
+		}
+		s = gammaComplex(c, w)
+		s.Mul(s, gammaComplex(cab, w))
+		d := gammaComplex(new(Complex).Sub(c, a), w)
+		d.Mul(d, gammaComplex(new(Complex).Sub(c, b), w))
+		s = new(Complex).Quo(s, d)
+	default:
+		y := new(Complex).Quo(x, new(Complex).Sub(x, one))
+		if complexAbs(y) <= 0.75 {
+			s = hypergeometricAdaptive([]*Complex{a, new(Complex).Sub(c, b)}, []*Complex{c}, y, w)
+			// (1-x)^(-a) = exp(-a log(1-x))
+			e := new(Complex).Log(new(Complex).Sub(one, x))
+			e.Mul(e, a)
+			s.Mul(s, e.Exp(e.Neg(e)))
+			break
+		}
+		e := w + guardBits
+		s = hyp2F1Continue(a, b, c, newComplexPrec(e).round(x, e), e)
+	}
+	return z.Copy(newComplexPrec(prec).round(s, prec))
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+	"math/cmplx"
+	"testing"
+)
+
+func newComplex128(c complex128) *Complex {
+	return NewComplex(big.NewFloat(real(c)), big.NewFloat(imag(c)))
+}
+
+func TestHyp1F1Elementary(t *testing.T) {
+	for _, x := range []complex128{0.5, -2, complex(3, 4), -30, complex(-10, 20), 25} {
+		// 1F1(a; a; x) = exp(x)
+		a := newComplex128(complex(0.3, 0.1))
+		if got := new(Complex).Hyp1F1(a, a, newComplex128(x)); !closeToComplex128(got, cmplx.Exp(x), 46) {
+			t.Errorf("1F1(a; a; %v) = %v, want %v", x, got, cmplx.Exp(x))
+		}
+		// 1F1(1; 2; x) = (exp(x) - 1)/x
+		want := (cmplx.Exp(x) - 1) / x
+		if got := new(Complex).Hyp1F1(newComplex128(1), newComplex128(2), newComplex128(x)); !closeToComplex128(got, want, 46) {
+			t.Errorf("1F1(1; 2; %v) = %v, want %v", x, got, want)
+		}
+	}
+}
+
+func TestHyp1F1Erf(t *testing.T) {
+	// 1F1(1/2; 3/2; -x²) = √π erf(x)/(2x)
+	for _, x := range []float64{0.1, 1, 2.5, 5} {
+		want := math.Sqrt(math.Pi) * math.Erf(x) / (2 * x)
+		got := new(Complex).Hyp1F1(newComplex128(0.5), newComplex128(1.5), newComplex128(complex(-x*x, 0)))
+		if !closeToComplex128(got, complex(want, 0), 46) {
+			t.Errorf("1F1(1/2; 3/2; %v) = %v, want %v", -x*x, got, want)
+		}
+	}
+}
+
+func TestHyp1F1Polynomial(t *testing.T) {
+	// 1F1(-2; b; x) = 1 - 2x/b + x²/(b(b+1))
+	b, x := complex(1.5, 0), complex(-7, 2)
+	want := 1 - 2*x/b + x*x/(b*(b+1))
+	if got := new(Complex).Hyp1F1(newComplex128(-2), newComplex128(b), newComplex128(x)); !closeToComplex128(got, want, 48) {
+		t.Errorf("1F1(-2; %v; %v) = %v, want %v", b, x, got, want)
+	}
+}
+
+func TestHyp2F1Elementary(t *testing.T) {
+	points := []complex128{
+		0.3, -0.5, -3, complex(0.5, 0.8660254037844386), complex(0.5, -0.9),
+		complex(1.5, 0.2), complex(-10, 5), complex(0.99, 0.01), complex(40, -30), 3,
+		complex(3, math.Copysign(0, -1)),
+	}
+	for _, x := range points {
+		// Negate the imaginary part explicitly, so that 1-x keeps its signed
+		// zero on the cut.
+		omx := complex(1-real(x), -imag(x))
+		// 2F1(a, b; b; x) = (1-x)^(-a)
+		a := complex(0.3, -0.2)
+		want := cmplx.Pow(omx, -a)
+		got := new(Complex).Hyp2F1(newComplex128(a), newComplex128(2.5), newComplex128(2.5), newComplex128(x))
+		if !closeToComplex128(got, want, 44) {
+			t.Errorf("2F1(a, b; b; %v) = %v, want %v", x, got, want)
+		}
+		// 2F1(1, 1; 2; x) = -log(1-x)/x
+		want = -cmplx.Log(omx) / x
+		got = new(Complex).Hyp2F1(newComplex128(1), newComplex128(1), newComplex128(2), newComplex128(x))
+		if !closeToComplex128(got, want, 44) {
+			t.Errorf("2F1(1, 1; 2; %v) = %v, want %v", x, got, want)
+		}
+	}
+}
+
+func TestHyp2F1Asin(t *testing.T) {
+	// 2F1(1/2, 1/2; 3/2; x²) = asin(x)/x
+	for _, x := range []complex128{0.5, 0.95, complex(0.7, 0.6), complex(2, 1)} {
+		want := cmplx.Asin(x) / x
+		got := new(Complex).Hyp2F1(newComplex128(0.5), newComplex128(0.5), newComplex128(1.5), newComplex128(x*x))
+		if !closeToComplex128(got, want, 44) {
+			t.Errorf("2F1(1/2, 1/2; 3/2; %v) = %v, want %v", x*x, got, want)
+		}
+	}
+}
+
+func TestHyp2F1Gauss(t *testing.T) {
+	// 2F1(1, 1; 3; 1) = Γ(3)Γ(1)/(Γ(2)Γ(2)) = 2
+	got := new(Complex).Hyp2F1(newComplex128(1), newComplex128(1), newComplex128(3), newComplex128(1))
+	if !closeToComplex128(got, 2, 50) {
+		t.Errorf("2F1(1, 1; 3; 1) = %v, want 2", got)
+	}
+}
+
+func TestHyp2F1HighPrecision(t *testing.T) {
+	// The continued value at 200 bits agrees with -log(1-x)/x.
+	x := complexPrec(0.5, 0.8660254037844386, 200)
+	one := complexPrec(1, 0, 200)
+	got := new(Complex).Hyp2F1(one, one, complexPrec(2, 0, 200), x)
+	want := new(Complex).Log(new(Complex).Sub(one, x))
+	want = new(Complex).Quo(want.Neg(want), x)
+	if d := new(Complex).Sub(got, want); complexExpo(d) > complexExpo(want)-190 {
+		t.Errorf("2F1(1, 1; 2; %v) = %v, want %v", x, got, want)
+	}
+}
@@ -44,6 +44,12 @@ func (z *Infra) String() string {
 	return strings.Join(a, "")
 }
 
+// Format implements fmt.Formatter, with the conventions of the Format method
+// of Complex.
+func (z *Infra) Format(s fmt.State, verb rune) {
+	formatTerms(s, verb, z, []string{"", "α"}, floatSlice(z.Cartesian()))
+}
+
 // Equals returns true if y and z are equal.
 func (z *Infra) Equals(y *Infra) bool {
 	if z.l.Cmp(&y.l) != 0 || z.r.Cmp(&y.r) != 0 {
@@ -107,17 +113,33 @@ func (z *Infra) Sub(x, y *Infra) *Infra {
 // The multiplication rule is:
 // 		Mul(α, α) = 0
 // This binary operation is commutative and associative.
+// Unless the components of x or y differ widely in scale, the product is
+// computed exactly and then rounded once, so the result is exact whenever it
+// fits the precision of z; otherwise it is computed with rounded arithmetic.
 func (z *Infra) Mul(x, y *Infra) *Infra {
+	prec := maxPrec(&x.l, &x.r, &y.l, &y.r)
+	if fitsExact(prec,
+		[]*big.Float{&z.l, &z.r},
+		[]*big.Float{&x.l, &x.r},
+		[]*big.Float{&y.l, &y.r},
+	) {
+		p := new(Infra).mulExact(x, y)
+		roundFloat(&z.l, &p.l, prec)
+		roundFloat(&z.r, &p.r, prec)
+		return z
+	}
 	a := new(big.Float).Copy(&x.l)
 	b := new(big.Float).Copy(&x.r)
 	c := new(big.Float).Copy(&y.l)
 	d := new(big.Float).Copy(&y.r)
 	temp := new(big.Float)
 	z.l.Mul(a, c)
-	z.r.Add(
-		z.r.Mul(d, a),
-		temp.Mul(b, c),
-	)
+	z.r.Mul(d, a)
+	exact := isExact(&z.r, temp.Mul(b, c))
+	z.r.Add(&z.r, temp)
+	if !exact {
+		markRounded(&z.l, &z.r)
+	}
 	return z
 }
 
@@ -160,35 +182,75 @@ func (z *Infra) Quo(x, y *Infra) *Infra {
 
 // CrossRatio sets z equal to the cross ratio
 // 		Inv(w - x) * (v - x) * Inv(v - y) * (w - y)
-// Then it returns z.
+// Then it returns z. If a denominator has no inverse, then CrossRatio panics
+// and leaves z unchanged.
 func (z *Infra) CrossRatio(v, w, x, y *Infra) *Infra {
-	temp := new(Infra)
-	z.Sub(w, x)
-	z.Inv(z)
-	temp.Sub(v, x)
-	z.Mul(z, temp)
-	temp.Sub(v, y)
-	temp.Inv(temp)
-	z.Mul(z, temp)
-	temp.Sub(w, y)
-	z.Mul(z, temp)
+	if _, err := z.CrossRatioChecked(v, w, x, y, ReportDegeneracy); err != nil {
+		panic(err.Error())
+	}
 	return z
 }
 
+// CrossRatioChecked is like CrossRatio, but it returns a *DegenerateError
+// instead of panicking when a denominator has no inverse, and leaves z
+// unchanged. The denominators vanish when w = x or v = y. If policy is
+// ProjectiveInfinity, then vanishing denominators with non-zero numerators set
+// z equal to the point at infinity instead.
+func (z *Infra) CrossRatioChecked(v, w, x, y *Infra, policy DegeneracyPolicy) (*Infra, error) {
+	zero := new(Infra)
+	vx := new(Infra).Sub(v, x)
+	wy := new(Infra).Sub(w, y)
+	wx := new(Infra).Sub(w, x)
+	vy := new(Infra).Sub(v, y)
+	inf, err := checkQuotient(
+		"CrossRatio",
+		vx.Equals(zero) || wy.Equals(zero),
+		wx.Equals(zero) || vy.Equals(zero),
+		wx.IsZeroDiv() || vy.IsZeroDiv(),
+		policy,
+	)
+	switch {
+	case err != nil:
+		return z, err
+	case inf:
+		setInf(&z.l, &z.r)
+		return z, nil
+	}
+	p := new(Infra).Mul(wx.Inv(wx), vx)
+	p.Mul(p, vy.Inv(vy))
+	return z.Mul(p, wy), nil
+}
+
 // Möbius sets z equal to the Möbius (fractional linear) transform
 // 		(a*y + b) * Inv(c*y + d)
-// Then it returns z.
+// Then it returns z. If the denominator has no inverse, then Möbius panics
+// and leaves z unchanged.
 func (z *Infra) Möbius(y, a, b, c, d *Infra) *Infra {
-	z.Mul(a, y)
-	z.Add(z, b)
-	temp := new(Infra)
-	temp.Mul(c, y)
-	temp.Add(temp, d)
-	temp.Inv(temp)
-	z.Mul(z, temp)
+	if _, err := z.MöbiusChecked(y, a, b, c, d, ReportDegeneracy); err != nil {
+		panic(err.Error())
+	}
 	return z
 }
 
+// MöbiusChecked is like Möbius, but it returns a *DegenerateError instead of
+// panicking when the denominator has no inverse, and leaves z unchanged. If
+// policy is ProjectiveInfinity, then a zero denominator with a non-zero
+// numerator sets z equal to the point at infinity instead.
+func (z *Infra) MöbiusChecked(y, a, b, c, d *Infra, policy DegeneracyPolicy) (*Infra, error) {
+	zero := new(Infra)
+	num := new(Infra).Add(new(Infra).Mul(a, y), b)
+	den := new(Infra).Add(new(Infra).Mul(c, y), d)
+	inf, err := checkQuotient("Möbius", num.Equals(zero), den.Equals(zero), den.IsZeroDiv(), policy)
+	switch {
+	case err != nil:
+		return z, err
+	case inf:
+		setInf(&z.l, &z.r)
+		return z, nil
+	}
+	return z.Mul(num, new(Infra).Inv(den)), nil
+}
+
 // Generate returns a random Infra value for quick.Check testing.
 func (z *Infra) Generate(rand *rand.Rand, size int) reflect.Value {
 	randomInfra := &Infra{
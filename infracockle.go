@@ -0,0 +1,259 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strings"
+)
+
+var symbInfraCockle = [8]string{"", "i", "t", "u", "α", "β", "γ", "δ"}
+
+// An InfraCockle represents a multi-precision floating-point infra-Cockle
+// quaternion, the Cayley-Dickson double of a Cockle quaternion by a
+// nilpotent unit, as InfraComplex is the double of Complex.
+type InfraCockle struct {
+	l, r Cockle
+}
+
+// Real returns the real part of z.
+func (z *InfraCockle) Real() *big.Float {
+	return (&z.l).Real()
+}
+
+// Cartesian returns the eight multi-precision floating-point Cartesian
+// components of z.
+func (z *InfraCockle) Cartesian() (*big.Float, *big.Float, *big.Float, *big.Float, *big.Float, *big.Float, *big.Float, *big.Float) {
+	a, b, c, d := z.l.Cartesian()
+	e, f, g, h := z.r.Cartesian()
+	return a, b, c, d, e, f, g, h
+}
+
+// String returns the string representation of an InfraCockle value.
+//
+// If z corresponds to a + bi + ct + du + eα + fβ + gγ + hδ, then the string is
+// "(a+bi+ct+du+eα+fβ+gγ+hδ)", similar to complex128 values.
+func (z *InfraCockle) String() string {
+	v := make([]*big.Float, 8)
+	v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7] = z.Cartesian()
+	a := make([]string, 17)
+	a[0] = "("
+	a[1] = fmt.Sprintf("%v", v[0])
+	i := 1
+	for j := 2; j < 16; j = j + 2 {
+		if v[i].Sign() < 0 {
+			a[j] = fmt.Sprintf("%v", v[i])
+		} else {
+			a[j] = fmt.Sprintf("+%v", v[i])
+		}
+		a[j+1] = symbInfraCockle[i]
+		i++
+	}
+	a[16] = ")"
+	return strings.Join(a, "")
+}
+
+// Format implements fmt.Formatter, with the conventions of the Format method
+// of Complex.
+func (z *InfraCockle) Format(s fmt.State, verb rune) {
+	formatTerms(s, verb, z, symbInfraCockle[:], floatSlice(z.Cartesian()))
+}
+
+// Equals returns true if y and z are equal.
+func (z *InfraCockle) Equals(y *InfraCockle) bool {
+	if !z.l.Equals(&y.l) || !z.r.Equals(&y.r) {
+		return false
+	}
+	return true
+}
+
+// Copy copies y onto z, and returns z.
+func (z *InfraCockle) Copy(y *InfraCockle) *InfraCockle {
+	z.l.Copy(&y.l)
+	z.r.Copy(&y.r)
+	return z
+}
+
+// NewInfraCockle returns a pointer to the InfraCockle value
+// a+bi+ct+du+eα+fβ+gγ+hδ.
+func NewInfraCockle(a, b, c, d, e, f, g, h *big.Float) *InfraCockle {
+	z := new(InfraCockle)
+	z.l.Copy(NewCockle(a, b, c, d))
+	z.r.Copy(NewCockle(e, f, g, h))
+	return z
+}
+
+// Scal sets z equal to y scaled by a, and returns z.
+func (z *InfraCockle) Scal(y *InfraCockle, a *big.Float) *InfraCockle {
+	z.l.Scal(&y.l, a)
+	z.r.Scal(&y.r, a)
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *InfraCockle) Neg(y *InfraCockle) *InfraCockle {
+	z.l.Neg(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Conj sets z equal to the conjugate of y, and returns z.
+func (z *InfraCockle) Conj(y *InfraCockle) *InfraCockle {
+	z.l.Conj(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Add sets z equal to x+y, and returns z.
+func (z *InfraCockle) Add(x, y *InfraCockle) *InfraCockle {
+	z.l.Add(&x.l, &y.l)
+	z.r.Add(&x.r, &y.r)
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z.
+func (z *InfraCockle) Sub(x, y *InfraCockle) *InfraCockle {
+	z.l.Sub(&x.l, &y.l)
+	z.r.Sub(&x.r, &y.r)
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z.
+//
+// With x = (a, b) and y = (c, d) as pairs of Cockle values, the product is
+// given by the Cayley-Dickson formula with a nilpotent unit
+// 		(Mul(a, c), Mul(d, a) + Mul(b, Conj(c)))
+// so that Mul(α, α) = 0 and Mul(i, α) = β, Mul(t, α) = γ, Mul(u, α) = δ.
+// This binary operation is noncommutative and nonassociative, but it is
+// alternative.
+// Unless the components of x or y differ widely in scale, the product is
+// computed exactly and then rounded once, so the result is exact whenever it
+// fits the precision of z; otherwise it is computed with rounded arithmetic.
+func (z *InfraCockle) Mul(x, y *InfraCockle) *InfraCockle {
+	a, b, c, d, e, f, g, h := x.Cartesian()
+	s, t, u, v, w, m, n, p := y.Cartesian()
+	prec := maxPrec(a, b, c, d, e, f, g, h, s, t, u, v, w, m, n, p)
+	z0, z1, z2, z3, z4, z5, z6, z7 := z.Cartesian()
+	if fitsExact(prec,
+		[]*big.Float{z0, z1, z2, z3, z4, z5, z6, z7},
+		[]*big.Float{a, b, c, d, e, f, g, h},
+		[]*big.Float{s, t, u, v, w, m, n, p},
+	) {
+		return z.roundInfraCockle(new(InfraCockle).mulExact(x, y), prec)
+	}
+	mulDoubling(0, &z.l, &z.r, &x.l, &x.r, &y.l, &y.r)
+	return z
+}
+
+// Commutator sets z equal to the commutator of x and y:
+// 		Mul(x, y) - Mul(y, x)
+// Then it returns z.
+func (z *InfraCockle) Commutator(x, y *InfraCockle) *InfraCockle {
+	return z.Sub(
+		new(InfraCockle).Mul(x, y),
+		new(InfraCockle).Mul(y, x),
+	)
+}
+
+// Associator sets z equal to the associator of w, x, and y:
+// 		Mul(Mul(w, x), y) - Mul(w, Mul(x, y))
+// Then it returns z.
+func (z *InfraCockle) Associator(w, x, y *InfraCockle) *InfraCockle {
+	t := new(InfraCockle).Mul(w, x)
+	t.Mul(t, y)
+	u := new(InfraCockle).Mul(x, y)
+	u.Mul(w, u)
+	return z.Sub(t, u)
+}
+
+// Quad returns the quadrance of z. If z = a+bi+ct+du+eα+fβ+gγ+hδ, then the
+// quadrance is
+// 		Mul(a, a) + Mul(b, b) - Mul(c, c) - Mul(d, d)
+// This can be positive, negative, or zero.
+func (z *InfraCockle) Quad() *big.Float {
+	return z.l.Quad()
+}
+
+// IsZeroDiv returns true if z is a zero divisor. This is equivalent to the
+// Cockle part a+bi+ct+du of z being a zero divisor.
+func (z *InfraCockle) IsZeroDiv() bool {
+	return z.l.IsZeroDiv()
+}
+
+// Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
+// then Inv panics.
+func (z *InfraCockle) Inv(y *InfraCockle) *InfraCockle {
+	if y.IsZeroDiv() {
+		panic("inverse of zero divisor")
+	}
+	quad := y.Quad()
+	z.Conj(y)
+	return z.quoQuad(z, quad)
+}
+
+// quoQuad sets z equal to y with every component divided by quad, and returns
+// z.
+func (z *InfraCockle) quoQuad(y *InfraCockle, quad *big.Float) *InfraCockle {
+	a, b, c, d, e, f, g, h := y.Cartesian()
+	s, t, u, v, w, m, n, p := z.Cartesian()
+	s.Quo(a, quad)
+	t.Quo(b, quad)
+	u.Quo(c, quad)
+	v.Quo(d, quad)
+	w.Quo(e, quad)
+	m.Quo(f, quad)
+	n.Quo(g, quad)
+	p.Quo(h, quad)
+	return z
+}
+
+// QuoL sets z equal to the left quotient of x and y:
+// 		Mul(Inv(y), x)
+// Then it returns z. Since Mul is alternative, this is the solution of
+// Mul(y, z) = x. If y is a zero divisor, then QuoL panics.
+func (z *InfraCockle) QuoL(x, y *InfraCockle) *InfraCockle {
+	if y.IsZeroDiv() {
+		panic("denominator is zero divisor")
+	}
+	quad := y.Quad()
+	t := new(InfraCockle).Conj(y)
+	t.Mul(t, x)
+	return z.quoQuad(t, quad)
+}
+
+// QuoR sets z equal to the right quotient of x and y:
+// 		Mul(x, Inv(y))
+// Then it returns z. Since Mul is alternative, this is the solution of
+// Mul(z, y) = x. If y is a zero divisor, then QuoR panics.
+func (z *InfraCockle) QuoR(x, y *InfraCockle) *InfraCockle {
+	if y.IsZeroDiv() {
+		panic("denominator is zero divisor")
+	}
+	quad := y.Quad()
+	t := new(InfraCockle).Conj(y)
+	t.Mul(x, t)
+	return z.quoQuad(t, quad)
+}
+
+// Generate returns a random InfraCockle value for quick.Check testing.
+func (z *InfraCockle) Generate(rand *rand.Rand, size int) reflect.Value {
+	randomInfraCockle := &InfraCockle{
+		*NewCockle(
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+		),
+		*NewCockle(
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+		),
+	}
+	return reflect.ValueOf(randomInfraCockle)
+}
@@ -54,6 +54,12 @@ func (z *InfraComplex) String() string {
 	return strings.Join(a, "")
 }
 
+// Format implements fmt.Formatter, with the conventions of the Format method
+// of Complex.
+func (z *InfraComplex) Format(s fmt.State, verb rune) {
+	formatTerms(s, verb, z, symbInfraComplex[:], floatSlice(z.Cartesian()))
+}
+
 // Equals returns true if y and z are equal.
 func (z *InfraComplex) Equals(y *InfraComplex) bool {
 	if !z.l.Equals(&y.l) || !z.r.Equals(&y.r) {
@@ -123,17 +129,22 @@ func (z *InfraComplex) Sub(x, y *InfraComplex) *InfraComplex {
 // 		Mul(i, β) = -Mul(β, i) = γ
 // 		Mul(γ, i) = -Mul(i, γ) = β
 // This binary operation is noncommutative but associative.
+// Unless the components of x or y differ widely in scale, the product is
+// computed exactly and then rounded once, so the result is exact whenever it
+// fits the precision of z; otherwise it is computed with rounded arithmetic.
 func (z *InfraComplex) Mul(x, y *InfraComplex) *InfraComplex {
-	a := new(Complex).Copy(&x.l)
-	b := new(Complex).Copy(&x.r)
-	c := new(Complex).Copy(&y.l)
-	d := new(Complex).Copy(&y.r)
-	temp := new(Complex)
-	z.l.Mul(a, c)
-	z.r.Add(
-		z.r.Mul(d, a),
-		temp.Mul(b, temp.Conj(c)),
+	prec := maxPrec(
+		&x.l.l, &x.l.r, &x.r.l, &x.r.r,
+		&y.l.l, &y.l.r, &y.r.l, &y.r.r,
 	)
+	if fitsExact(prec,
+		[]*big.Float{&z.l.l, &z.l.r, &z.r.l, &z.r.r},
+		[]*big.Float{&x.l.l, &x.l.r, &x.r.l, &x.r.r},
+		[]*big.Float{&y.l.l, &y.l.r, &y.r.l, &y.r.r},
+	) {
+		return z.roundInfraComplex(new(InfraComplex).mulExact(x, y), prec)
+	}
+	mulDoubling(0, &z.l, &z.r, &x.l, &x.r, &y.l, &y.r)
 	return z
 }
 
@@ -142,7 +153,7 @@ func (z *InfraComplex) Mul(x, y *InfraComplex) *InfraComplex {
 // Then it returns z.
 func (z *InfraComplex) Commutator(x, y *InfraComplex) *InfraComplex {
 	return z.Sub(
-		z.Mul(x, y),
+		new(InfraComplex).Mul(x, y),
 		new(InfraComplex).Mul(y, x),
 	)
 }
@@ -212,60 +223,144 @@ func (z *InfraComplex) QuoR(x, y *InfraComplex) *InfraComplex {
 
 // CrossRatioL sets z equal to the left cross-ratio of v, w, x, and y:
 // 		Inv(w - x) * (v - x) * Inv(v - y) * (w - y)
-// Then it returns z.
+// Then it returns z. If a denominator has no inverse, then CrossRatioL panics
+// and leaves z unchanged.
 func (z *InfraComplex) CrossRatioL(v, w, x, y *InfraComplex) *InfraComplex {
-	temp := new(InfraComplex)
-	z.Sub(w, x)
-	z.Inv(z)
-	temp.Sub(v, x)
-	z.Mul(z, temp)
-	temp.Sub(v, y)
-	temp.Inv(temp)
-	z.Mul(z, temp)
-	temp.Sub(w, y)
-	return z.Mul(z, temp)
+	if _, err := z.CrossRatioLChecked(v, w, x, y, ReportDegeneracy); err != nil {
+		panic(err.Error())
+	}
+	return z
+}
+
+// CrossRatioLChecked is like CrossRatioL, but it returns a *DegenerateError
+// instead of panicking when a denominator has no inverse, and leaves z
+// unchanged. The denominators vanish when w = x or v = y. If policy is
+// ProjectiveInfinity, then vanishing denominators with non-zero numerators set
+// z equal to the point at infinity instead.
+func (z *InfraComplex) CrossRatioLChecked(v, w, x, y *InfraComplex, policy DegeneracyPolicy) (*InfraComplex, error) {
+	zero := new(InfraComplex)
+	vx := new(InfraComplex).Sub(v, x)
+	wy := new(InfraComplex).Sub(w, y)
+	wx := new(InfraComplex).Sub(w, x)
+	vy := new(InfraComplex).Sub(v, y)
+	inf, err := checkQuotient(
+		"CrossRatioL",
+		vx.Equals(zero) || wy.Equals(zero),
+		wx.Equals(zero) || vy.Equals(zero),
+		wx.IsZeroDiv() || vy.IsZeroDiv(),
+		policy,
+	)
+	switch {
+	case err != nil:
+		return z, err
+	case inf:
+		setInf(&z.l.l, &z.l.r, &z.r.l, &z.r.r)
+		return z, nil
+	}
+	p := new(InfraComplex).Mul(wx.Inv(wx), vx)
+	p.Mul(p, vy.Inv(vy))
+	return z.Mul(p, wy), nil
 }
 
 // CrossRatioR sets z equal to the right cross-ratio of v, w, x, and y:
 // 		(v - x) * Inv(w - x) * (w - y) * Inv(v - y)
-// Then it returns z.
+// Then it returns z. If a denominator has no inverse, then CrossRatioR panics
+// and leaves z unchanged.
 func (z *InfraComplex) CrossRatioR(v, w, x, y *InfraComplex) *InfraComplex {
-	temp := new(InfraComplex)
-	z.Sub(v, x)
-	temp.Sub(w, x)
-	temp.Inv(temp)
-	z.Mul(z, temp)
-	temp.Sub(w, y)
-	z.Mul(z, temp)
-	temp.Sub(v, y)
-	temp.Inv(temp)
-	return z.Mul(z, temp)
+	if _, err := z.CrossRatioRChecked(v, w, x, y, ReportDegeneracy); err != nil {
+		panic(err.Error())
+	}
+	return z
+}
+
+// CrossRatioRChecked is like CrossRatioR, but it returns a *DegenerateError
+// instead of panicking when a denominator has no inverse, and leaves z
+// unchanged. The denominators vanish when w = x or v = y. If policy is
+// ProjectiveInfinity, then vanishing denominators with non-zero numerators set
+// z equal to the point at infinity instead.
+func (z *InfraComplex) CrossRatioRChecked(v, w, x, y *InfraComplex, policy DegeneracyPolicy) (*InfraComplex, error) {
+	zero := new(InfraComplex)
+	vx := new(InfraComplex).Sub(v, x)
+	wy := new(InfraComplex).Sub(w, y)
+	wx := new(InfraComplex).Sub(w, x)
+	vy := new(InfraComplex).Sub(v, y)
+	inf, err := checkQuotient(
+		"CrossRatioR",
+		vx.Equals(zero) || wy.Equals(zero),
+		wx.Equals(zero) || vy.Equals(zero),
+		wx.IsZeroDiv() || vy.IsZeroDiv(),
+		policy,
+	)
+	switch {
+	case err != nil:
+		return z, err
+	case inf:
+		setInf(&z.l.l, &z.l.r, &z.r.l, &z.r.r)
+		return z, nil
+	}
+	p := new(InfraComplex).Mul(vx, wx.Inv(wx))
+	p.Mul(p, wy)
+	return z.Mul(p, vy.Inv(vy)), nil
 }
 
 // MöbiusL sets z equal to the left Möbius (fractional linear) transform of y:
 // 		Inv(y*c + d) * (y*a + b)
-// Then it returns z.
+// Then it returns z. If the denominator has no inverse, then MöbiusL panics
+// and leaves z unchanged.
 func (z *InfraComplex) MöbiusL(y, a, b, c, d *InfraComplex) *InfraComplex {
-	z.Mul(y, a)
-	z.Add(z, b)
-	temp := new(InfraComplex)
-	temp.Mul(y, c)
-	temp.Add(temp, d)
-	temp.Inv(temp)
-	return z.Mul(temp, z)
+	if _, err := z.MöbiusLChecked(y, a, b, c, d, ReportDegeneracy); err != nil {
+		panic(err.Error())
+	}
+	return z
+}
+
+// MöbiusLChecked is like MöbiusL, but it returns a *DegenerateError instead of
+// panicking when the denominator has no inverse, and leaves z unchanged. If
+// policy is ProjectiveInfinity, then a zero denominator with a non-zero
+// numerator sets z equal to the point at infinity instead.
+func (z *InfraComplex) MöbiusLChecked(y, a, b, c, d *InfraComplex, policy DegeneracyPolicy) (*InfraComplex, error) {
+	zero := new(InfraComplex)
+	num := new(InfraComplex).Add(new(InfraComplex).Mul(y, a), b)
+	den := new(InfraComplex).Add(new(InfraComplex).Mul(y, c), d)
+	inf, err := checkQuotient("MöbiusL", num.Equals(zero), den.Equals(zero), den.IsZeroDiv(), policy)
+	switch {
+	case err != nil:
+		return z, err
+	case inf:
+		setInf(&z.l.l, &z.l.r, &z.r.l, &z.r.r)
+		return z, nil
+	}
+	return z.Mul(new(InfraComplex).Inv(den), num), nil
 }
 
 // MöbiusR sets z equal to the right Möbius (fractional linear) transform of y:
 // 		(a*y + b) * Inv(c*y + d)
-// Then it returns z.
+// Then it returns z. If the denominator has no inverse, then MöbiusR panics
+// and leaves z unchanged.
 func (z *InfraComplex) MöbiusR(y, a, b, c, d *InfraComplex) *InfraComplex {
-	z.Mul(a, y)
-	z.Add(z, b)
-	temp := new(InfraComplex)
-	temp.Mul(c, y)
-	temp.Add(temp, d)
-	temp.Inv(temp)
-	return z.Mul(z, temp)
+	if _, err := z.MöbiusRChecked(y, a, b, c, d, ReportDegeneracy); err != nil {
+		panic(err.Error())
+	}
+	return z
+}
+
+// MöbiusRChecked is like MöbiusR, but it returns a *DegenerateError instead of
+// panicking when the denominator has no inverse, and leaves z unchanged. If
+// policy is ProjectiveInfinity, then a zero denominator with a non-zero
+// numerator sets z equal to the point at infinity instead.
+func (z *InfraComplex) MöbiusRChecked(y, a, b, c, d *InfraComplex, policy DegeneracyPolicy) (*InfraComplex, error) {
+	zero := new(InfraComplex)
+	num := new(InfraComplex).Add(new(InfraComplex).Mul(a, y), b)
+	den := new(InfraComplex).Add(new(InfraComplex).Mul(c, y), d)
+	inf, err := checkQuotient("MöbiusR", num.Equals(zero), den.Equals(zero), den.IsZeroDiv(), policy)
+	switch {
+	case err != nil:
+		return z, err
+	case inf:
+		setInf(&z.l.l, &z.l.r, &z.r.l, &z.r.r)
+		return z, nil
+	}
+	return z.Mul(num, new(InfraComplex).Inv(den)), nil
 }
 
 // Generate returns a random InfraComplex value for quick.Check testing.
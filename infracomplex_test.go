@@ -343,3 +343,13 @@ func XTestInfraComplexComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestInfraComplexCommutatorAlias(t *testing.T) {
+	f := func(x, y *InfraComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		return commutatorAliased(x, y)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+// infraHamiltonBasis returns the basis element of index k, with 0 for 1.
+func infraHamiltonBasis(k int) *InfraHamilton {
+	z := new(InfraHamilton)
+	a, b, c, d, e, f, g, h := z.Cartesian()
+	[]*big.Float{a, b, c, d, e, f, g, h}[k].SetInt64(1)
+	return z
+}
+
+// closeToInfraHamilton returns true if the components of x and y agree to
+// about bits bits, relative to the larger of their modulus and 1.
+func closeToInfraHamilton(x, y *InfraHamilton, bits int) bool {
+	return closeToComplex(&x.l.l, &y.l.l, bits) && closeToComplex(&x.l.r, &y.l.r, bits) &&
+		closeToComplex(&x.r.l, &y.r.l, bits) && closeToComplex(&x.r.r, &y.r.r, bits)
+}
+
+func TestInfraHamiltonBasis(t *testing.T) {
+	// i α = β, j α = γ, k α = δ, i j = k
+	rules := [][3]int{{1, 4, 5}, {2, 4, 6}, {3, 4, 7}, {1, 2, 3}}
+	for _, r := range rules {
+		x, y, want := infraHamiltonBasis(r[0]), infraHamiltonBasis(r[1]), infraHamiltonBasis(r[2])
+		if got := new(InfraHamilton).Mul(x, y); !got.Equals(want) {
+			t.Errorf("Mul(%s, %s) = %v", symbInfraHamilton[r[0]], symbInfraHamilton[r[1]], got)
+		}
+	}
+	for k := 1; k < 4; k++ {
+		if got := new(InfraHamilton).Mul(infraHamiltonBasis(k), infraHamiltonBasis(k)); !got.Equals(new(InfraHamilton).Neg(infraHamiltonBasis(0))) {
+			t.Errorf("Mul(%s, %s) = %v", symbInfraHamilton[k], symbInfraHamilton[k], got)
+		}
+	}
+	for k := 4; k < 8; k++ {
+		if got := new(InfraHamilton).Mul(infraHamiltonBasis(k), infraHamiltonBasis(k)); !got.Equals(new(InfraHamilton)) {
+			t.Errorf("Mul(%s, %s) = %v", symbInfraHamilton[k], symbInfraHamilton[k], got)
+		}
+	}
+}
+
+func TestInfraHamiltonAddCommutative(t *testing.T) {
+	f := func(x, y *InfraHamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(InfraHamilton).Add(x, y)
+		r := new(InfraHamilton).Add(y, x)
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraHamiltonMulNonCommutative(t *testing.T) {
+	f := func(x, y *InfraHamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(InfraHamilton).Commutator(x, y)
+		zero := new(InfraHamilton)
+		return !l.Equals(zero)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraHamiltonMulNonAssociative(t *testing.T) {
+	f := func(x, y, z *InfraHamilton) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		l := new(InfraHamilton).Associator(x, y, z)
+		return !closeToInfraHamilton(l, new(InfraHamilton), 20)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraHamiltonMulAlternative(t *testing.T) {
+	f := func(x, y *InfraHamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(InfraHamilton).Associator(x, x, y)
+		r := new(InfraHamilton).Associator(x, y, y)
+		zero := new(InfraHamilton)
+		return closeToInfraHamilton(l, zero, 45) && closeToInfraHamilton(r, zero, 45)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraHamiltonConjInvolutive(t *testing.T) {
+	f := func(x *InfraHamilton) bool {
+		// t.Logf("x = %v", x)
+		l := new(InfraHamilton).Conj(new(InfraHamilton).Conj(x))
+		return l.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraHamiltonMulConjAntiDistributive(t *testing.T) {
+	f := func(x, y *InfraHamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(InfraHamilton).Conj(new(InfraHamilton).Mul(x, y))
+		r := new(InfraHamilton).Mul(new(InfraHamilton).Conj(y), new(InfraHamilton).Conj(x))
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraHamiltonQuadPositive(t *testing.T) {
+	f := func(x *InfraHamilton) bool {
+		// t.Logf("x = %v", x)
+		return x.Quad().Sign() > 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraHamiltonComposition(t *testing.T) {
+	f := func(x, y *InfraHamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(InfraHamilton).Mul(x, y).Quad()
+		r := new(big.Float).Mul(x.Quad(), y.Quad())
+		return closeTo(l, r, 45)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraHamiltonZeroDivisor(t *testing.T) {
+	x := infraHamiltonBasis(2)
+	y := infraHamiltonBasis(4)
+	if x.IsZeroDiv() || !y.IsZeroDiv() {
+		t.Errorf("IsZeroDiv(j) = %v, IsZeroDiv(α) = %v", x.IsZeroDiv(), y.IsZeroDiv())
+	}
+	if got := new(InfraHamilton).Mul(y, y); !got.Equals(new(InfraHamilton)) {
+		t.Errorf("Mul(α, α) = %v", got)
+	}
+}
+
+func TestInfraHamiltonQuo(t *testing.T) {
+	f := func(x, y *InfraHamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(InfraHamilton).Mul(y, new(InfraHamilton).QuoL(x, y))
+		r := new(InfraHamilton).Mul(new(InfraHamilton).QuoR(x, y), y)
+		return closeToInfraHamilton(l, x, 40) && closeToInfraHamilton(r, x, 40)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraHamiltonCommutatorAlias(t *testing.T) {
+	f := func(x, y *InfraHamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		return commutatorAliased(x, y)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
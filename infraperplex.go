@@ -0,0 +1,387 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strings"
+)
+
+var symbInfraPerplex = [4]string{"", "s", "τ", "υ"}
+
+// An InfraPerplex represents a multi-precision floating-point infra-perplex
+// number, the dual of a perplex number.
+type InfraPerplex struct {
+	l, r Perplex
+}
+
+// Real returns the real part of z.
+func (z *InfraPerplex) Real() *big.Float {
+	return &z.l.l
+}
+
+// Cartesian returns the four multi-precision floating-point Cartesian
+// components of z.
+func (z *InfraPerplex) Cartesian() (*big.Float, *big.Float, *big.Float, *big.Float) {
+	return &z.l.l, &z.l.r, &z.r.l, &z.r.r
+}
+
+// String returns the string representation of an InfraPerplex value.
+//
+// If z corresponds to a + bi + cβ + dγ, then the string is"(a+bs+cτ+dυ)",
+// similar to complex128 values.
+func (z *InfraPerplex) String() string {
+	v := make([]*big.Float, 4)
+	v[0], v[1] = z.l.Cartesian()
+	v[2], v[3] = z.r.Cartesian()
+	a := make([]string, 9)
+	a[0] = "("
+	a[1] = fmt.Sprintf("%v", v[0])
+	i := 1
+	for j := 2; j < 8; j = j + 2 {
+		if v[i].Sign() < 0 {
+			a[j] = fmt.Sprintf("%v", v[i])
+		} else {
+			a[j] = fmt.Sprintf("+%v", v[i])
+		}
+		a[j+1] = symbInfraPerplex[i]
+		i++
+	}
+	a[8] = ")"
+	return strings.Join(a, "")
+}
+
+// Format implements fmt.Formatter, with the conventions of the Format method
+// of Complex.
+func (z *InfraPerplex) Format(s fmt.State, verb rune) {
+	formatTerms(s, verb, z, symbInfraPerplex[:], floatSlice(z.Cartesian()))
+}
+
+// Equals returns true if y and z are equal.
+func (z *InfraPerplex) Equals(y *InfraPerplex) bool {
+	if !z.l.Equals(&y.l) || !z.r.Equals(&y.r) {
+		return false
+	}
+	return true
+}
+
+// Copy copies y onto z, and returns z.
+func (z *InfraPerplex) Copy(y *InfraPerplex) *InfraPerplex {
+	z.l.Copy(&y.l)
+	z.r.Copy(&y.r)
+	return z
+}
+
+// NewInfraPerplex returns a pointer to the InfraPerplex value a+bs+cτ+dυ.
+func NewInfraPerplex(a, b, c, d *big.Float) *InfraPerplex {
+	z := new(InfraPerplex)
+	z.l.l.Set(a)
+	z.l.r.Set(b)
+	z.r.l.Set(c)
+	z.r.r.Set(d)
+	return z
+}
+
+// Scal sets z equal to y scaled by a, and returns z.
+func (z *InfraPerplex) Scal(y *InfraPerplex, a *big.Float) *InfraPerplex {
+	z.l.Scal(&y.l, a)
+	z.r.Scal(&y.r, a)
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *InfraPerplex) Neg(y *InfraPerplex) *InfraPerplex {
+	z.l.Neg(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Conj sets z equal to the conjugate of y, and returns z.
+func (z *InfraPerplex) Conj(y *InfraPerplex) *InfraPerplex {
+	z.l.Conj(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Add sets z equal to x+y, and returns z.
+func (z *InfraPerplex) Add(x, y *InfraPerplex) *InfraPerplex {
+	z.l.Add(&x.l, &y.l)
+	z.r.Add(&x.r, &y.r)
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z.
+func (z *InfraPerplex) Sub(x, y *InfraPerplex) *InfraPerplex {
+	z.l.Sub(&x.l, &y.l)
+	z.r.Sub(&x.r, &y.r)
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z.
+//
+// The multiplication rules are:
+// 		Mul(s, s) = +1
+// 		Mul(τ, τ) = Mul(υ, υ) = 0
+// 		Mul(τ, υ) = Mul(υ, τ) = 0
+// 		Mul(s, τ) = -Mul(τ, s) = υ
+// 		Mul(s, υ) = -Mul(υ, s) = τ
+// This binary operation is noncommutative but associative.
+// Unless the components of x or y differ widely in scale, the product is
+// computed exactly and then rounded once, so the result is exact whenever it
+// fits the precision of z; otherwise it is computed with rounded arithmetic.
+func (z *InfraPerplex) Mul(x, y *InfraPerplex) *InfraPerplex {
+	prec := maxPrec(
+		&x.l.l, &x.l.r, &x.r.l, &x.r.r,
+		&y.l.l, &y.l.r, &y.r.l, &y.r.r,
+	)
+	if fitsExact(prec,
+		[]*big.Float{&z.l.l, &z.l.r, &z.r.l, &z.r.r},
+		[]*big.Float{&x.l.l, &x.l.r, &x.r.l, &x.r.r},
+		[]*big.Float{&y.l.l, &y.l.r, &y.r.l, &y.r.r},
+	) {
+		temp := new(Perplex)
+		l := new(Perplex).mulExact(&x.l, &y.l)
+		r := new(Perplex).addExact(
+			new(Perplex).mulExact(&y.r, &x.l),
+			temp.mulExact(&x.r, temp.Conj(&y.l)),
+		)
+		z.l.roundPerplex(l, prec)
+		z.r.roundPerplex(r, prec)
+		return z
+	}
+	mulDoubling(0, &z.l, &z.r, &x.l, &x.r, &y.l, &y.r)
+	return z
+}
+
+// Commutator sets z equal to the commutator of x and y:
+// 		Mul(x, y) - Mul(y, x)
+// Then it returns z.
+func (z *InfraPerplex) Commutator(x, y *InfraPerplex) *InfraPerplex {
+	return z.Sub(
+		new(InfraPerplex).Mul(x, y),
+		new(InfraPerplex).Mul(y, x),
+	)
+}
+
+// Quad returns the quadrance of z. If z = a+bs+cτ+dυ, then the quadrance is
+// 		Mul(a, a) - Mul(b, b)
+// This can be negative.
+func (z *InfraPerplex) Quad() *big.Float {
+	return z.l.Quad()
+}
+
+// IsZeroDiv returns true if z is a zero divisor. This is the case when the
+// perplex part a+bs is a zero divisor, that is, when a = ±b.
+func (z *InfraPerplex) IsZeroDiv() bool {
+	return z.l.IsZeroDiv()
+}
+
+// Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
+// then Inv panics.
+func (z *InfraPerplex) Inv(y *InfraPerplex) *InfraPerplex {
+	if y.IsZeroDiv() {
+		panic("inverse of zero divisor")
+	}
+	quad := y.Quad()
+	z.Conj(y)
+	z.l.l.Quo(&z.l.l, quad)
+	z.l.r.Quo(&z.l.r, quad)
+	z.r.l.Quo(&z.r.l, quad)
+	z.r.r.Quo(&z.r.r, quad)
+	return z
+}
+
+// QuoL sets z equal to the left quotient of x and y:
+// 		Mul(Inv(y), x)
+// Then it returns z. If y is a zero divisor, then QuoL panics.
+func (z *InfraPerplex) QuoL(x, y *InfraPerplex) *InfraPerplex {
+	if y.IsZeroDiv() {
+		panic("denominator is zero divisor")
+	}
+	quad := y.Quad()
+	z.Conj(y)
+	z.Mul(z, x)
+	z.l.l.Quo(&z.l.l, quad)
+	z.l.r.Quo(&z.l.r, quad)
+	z.r.l.Quo(&z.r.l, quad)
+	z.r.r.Quo(&z.r.r, quad)
+	return z
+}
+
+// QuoR sets z equal to the right quotient of x and y:
+// 		Mul(x, Inv(y))
+// Then it returns z. If y is a zero divisor, then QuoR panics.
+func (z *InfraPerplex) QuoR(x, y *InfraPerplex) *InfraPerplex {
+	if y.IsZeroDiv() {
+		panic("denominator is zero divisor")
+	}
+	quad := y.Quad()
+	z.Conj(y)
+	z.Mul(x, z)
+	z.l.l.Quo(&z.l.l, quad)
+	z.l.r.Quo(&z.l.r, quad)
+	z.r.l.Quo(&z.r.l, quad)
+	z.r.r.Quo(&z.r.r, quad)
+	return z
+}
+
+// CrossRatioL sets z equal to the left cross-ratio of v, w, x, and y:
+// 		Inv(w - x) * (v - x) * Inv(v - y) * (w - y)
+// Then it returns z. If a denominator has no inverse, then CrossRatioL panics
+// and leaves z unchanged.
+func (z *InfraPerplex) CrossRatioL(v, w, x, y *InfraPerplex) *InfraPerplex {
+	if _, err := z.CrossRatioLChecked(v, w, x, y, ReportDegeneracy); err != nil {
+		panic(err.Error())
+	}
+	return z
+}
+
+// CrossRatioLChecked is like CrossRatioL, but it returns a *DegenerateError
+// instead of panicking when a denominator has no inverse, and leaves z
+// unchanged. The denominators vanish when w = x or v = y. If policy is
+// ProjectiveInfinity, then vanishing denominators with non-zero numerators set
+// z equal to the point at infinity instead.
+func (z *InfraPerplex) CrossRatioLChecked(v, w, x, y *InfraPerplex, policy DegeneracyPolicy) (*InfraPerplex, error) {
+	zero := new(InfraPerplex)
+	vx := new(InfraPerplex).Sub(v, x)
+	wy := new(InfraPerplex).Sub(w, y)
+	wx := new(InfraPerplex).Sub(w, x)
+	vy := new(InfraPerplex).Sub(v, y)
+	inf, err := checkQuotient(
+		"CrossRatioL",
+		vx.Equals(zero) || wy.Equals(zero),
+		wx.Equals(zero) || vy.Equals(zero),
+		wx.IsZeroDiv() || vy.IsZeroDiv(),
+		policy,
+	)
+	switch {
+	case err != nil:
+		return z, err
+	case inf:
+		setInf(&z.l.l, &z.l.r, &z.r.l, &z.r.r)
+		return z, nil
+	}
+	p := new(InfraPerplex).Mul(wx.Inv(wx), vx)
+	p.Mul(p, vy.Inv(vy))
+	return z.Mul(p, wy), nil
+}
+
+// CrossRatioR sets z equal to the right cross-ratio of v, w, x, and y:
+// 		(v - x) * Inv(w - x) * (w - y) * Inv(v - y)
+// Then it returns z. If a denominator has no inverse, then CrossRatioR panics
+// and leaves z unchanged.
+func (z *InfraPerplex) CrossRatioR(v, w, x, y *InfraPerplex) *InfraPerplex {
+	if _, err := z.CrossRatioRChecked(v, w, x, y, ReportDegeneracy); err != nil {
+		panic(err.Error())
+	}
+	return z
+}
+
+// CrossRatioRChecked is like CrossRatioR, but it returns a *DegenerateError
+// instead of panicking when a denominator has no inverse, and leaves z
+// unchanged. The denominators vanish when w = x or v = y. If policy is
+// ProjectiveInfinity, then vanishing denominators with non-zero numerators set
+// z equal to the point at infinity instead.
+func (z *InfraPerplex) CrossRatioRChecked(v, w, x, y *InfraPerplex, policy DegeneracyPolicy) (*InfraPerplex, error) {
+	zero := new(InfraPerplex)
+	vx := new(InfraPerplex).Sub(v, x)
+	wy := new(InfraPerplex).Sub(w, y)
+	wx := new(InfraPerplex).Sub(w, x)
+	vy := new(InfraPerplex).Sub(v, y)
+	inf, err := checkQuotient(
+		"CrossRatioR",
+		vx.Equals(zero) || wy.Equals(zero),
+		wx.Equals(zero) || vy.Equals(zero),
+		wx.IsZeroDiv() || vy.IsZeroDiv(),
+		policy,
+	)
+	switch {
+	case err != nil:
+		return z, err
+	case inf:
+		setInf(&z.l.l, &z.l.r, &z.r.l, &z.r.r)
+		return z, nil
+	}
+	p := new(InfraPerplex).Mul(vx, wx.Inv(wx))
+	p.Mul(p, wy)
+	return z.Mul(p, vy.Inv(vy)), nil
+}
+
+// MöbiusL sets z equal to the left Möbius (fractional linear) transform of y:
+// 		Inv(y*c + d) * (y*a + b)
+// Then it returns z. If the denominator has no inverse, then MöbiusL panics
+// and leaves z unchanged.
+func (z *InfraPerplex) MöbiusL(y, a, b, c, d *InfraPerplex) *InfraPerplex {
+	if _, err := z.MöbiusLChecked(y, a, b, c, d, ReportDegeneracy); err != nil {
+		panic(err.Error())
+	}
+	return z
+}
+
+// MöbiusLChecked is like MöbiusL, but it returns a *DegenerateError instead of
+// panicking when the denominator has no inverse, and leaves z unchanged. If
+// policy is ProjectiveInfinity, then a zero denominator with a non-zero
+// numerator sets z equal to the point at infinity instead.
+func (z *InfraPerplex) MöbiusLChecked(y, a, b, c, d *InfraPerplex, policy DegeneracyPolicy) (*InfraPerplex, error) {
+	zero := new(InfraPerplex)
+	num := new(InfraPerplex).Add(new(InfraPerplex).Mul(y, a), b)
+	den := new(InfraPerplex).Add(new(InfraPerplex).Mul(y, c), d)
+	inf, err := checkQuotient("MöbiusL", num.Equals(zero), den.Equals(zero), den.IsZeroDiv(), policy)
+	switch {
+	case err != nil:
+		return z, err
+	case inf:
+		setInf(&z.l.l, &z.l.r, &z.r.l, &z.r.r)
+		return z, nil
+	}
+	return z.Mul(new(InfraPerplex).Inv(den), num), nil
+}
+
+// MöbiusR sets z equal to the right Möbius (fractional linear) transform of y:
+// 		(a*y + b) * Inv(c*y + d)
+// Then it returns z. If the denominator has no inverse, then MöbiusR panics
+// and leaves z unchanged.
+func (z *InfraPerplex) MöbiusR(y, a, b, c, d *InfraPerplex) *InfraPerplex {
+	if _, err := z.MöbiusRChecked(y, a, b, c, d, ReportDegeneracy); err != nil {
+		panic(err.Error())
+	}
+	return z
+}
+
+// MöbiusRChecked is like MöbiusR, but it returns a *DegenerateError instead of
+// panicking when the denominator has no inverse, and leaves z unchanged. If
+// policy is ProjectiveInfinity, then a zero denominator with a non-zero
+// numerator sets z equal to the point at infinity instead.
+func (z *InfraPerplex) MöbiusRChecked(y, a, b, c, d *InfraPerplex, policy DegeneracyPolicy) (*InfraPerplex, error) {
+	zero := new(InfraPerplex)
+	num := new(InfraPerplex).Add(new(InfraPerplex).Mul(a, y), b)
+	den := new(InfraPerplex).Add(new(InfraPerplex).Mul(c, y), d)
+	inf, err := checkQuotient("MöbiusR", num.Equals(zero), den.Equals(zero), den.IsZeroDiv(), policy)
+	switch {
+	case err != nil:
+		return z, err
+	case inf:
+		setInf(&z.l.l, &z.l.r, &z.r.l, &z.r.r)
+		return z, nil
+	}
+	return z.Mul(num, new(InfraPerplex).Inv(den)), nil
+}
+
+// Generate returns a random InfraPerplex value for quick.Check testing.
+func (z *InfraPerplex) Generate(rand *rand.Rand, size int) reflect.Value {
+	randomInfraPerplex := &InfraPerplex{
+		*NewPerplex(
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+		),
+		*NewPerplex(
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+		),
+	}
+	return reflect.ValueOf(randomInfraPerplex)
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math/big"
+
+// involutive is the arithmetic surface that SymmetricPart and
+// AntisymmetricPart need.
+type involutive[T any] interface {
+	*T
+	Add(x, y *T) *T
+	Sub(x, y *T) *T
+	Scal(y *T, a *big.Float) *T
+}
+
+// SymmetricPart sets z equal to the part of y fixed by the involution inv:
+// 		(y + inv(y))/2
+// Then it returns z. The involution is given in the form of the methods of
+// the types, such as (*Hamilton).Conj, (*DualHamilton).DualConj, or
+// (*Clifford).Reverse, and it should be linear and equal to its own inverse,
+// so that
+// 		SymmetricPart(y, inv) + AntisymmetricPart(y, inv) = y
+// For the involutions of this package, which fix or negate each component,
+// the result copies the fixed components of y and zeroes the others, and it
+// is exact.
+func SymmetricPart[T any, P involutive[T]](z, y P, inv func(z, y P) P) P {
+	t := inv(P(new(T)), y)
+	z.Add(y, t)
+	return z.Scal(z, big.NewFloat(0.5))
+}
+
+// AntisymmetricPart sets z equal to the part of y negated by the involution
+// inv:
+// 		(y - inv(y))/2
+// Then it returns z. It follows the conventions of SymmetricPart.
+func AntisymmetricPart[T any, P involutive[T]](z, y P, inv func(z, y P) P) P {
+	t := inv(P(new(T)), y)
+	z.Sub(y, t)
+	return z.Scal(z, big.NewFloat(0.5))
+}
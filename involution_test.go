@@ -0,0 +1,64 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestSymmetricPartHamilton(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		a, b, c, d := x.Cartesian()
+		zero := new(big.Float)
+		s := SymmetricPart(new(Hamilton), x, (*Hamilton).Conj)
+		v := AntisymmetricPart(new(Hamilton), x, (*Hamilton).Conj)
+		return s.Equals(NewHamilton(a, zero, zero, zero)) &&
+			v.Equals(NewHamilton(zero, b, c, d)) &&
+			new(Hamilton).Add(s, v).Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSymmetricPartDualHamilton(t *testing.T) {
+	f := func(x *DualHamilton) bool {
+		// t.Logf("x = %v", x)
+		p, q := x.Parts()
+		s := SymmetricPart(new(DualHamilton), x, (*DualHamilton).DualConj)
+		v := AntisymmetricPart(new(DualHamilton), x, (*DualHamilton).DualConj)
+		return s.Equals(NewDualHamilton(p, new(Hamilton))) &&
+			v.Equals(NewDualHamilton(new(Hamilton), q))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSymmetricPartClifford(t *testing.T) {
+	f := func(x *Clifford) bool {
+		// t.Logf("x = %v", x)
+		// Reverse fixes the grades 0, 1, and 4, and negates the grades 2 and 3.
+		p, q := x.Signature()
+		even, odd := NewClifford(p, q), NewClifford(p, q)
+		for k := 0; k <= p+q; k++ {
+			g := new(Clifford).Grade(x, k)
+			if k*(k-1)/2%2 == 0 {
+				even.Add(even, g)
+			} else {
+				odd.Add(odd, g)
+			}
+		}
+		// SymmetricPart may work in place.
+		y := new(Clifford).Copy(x)
+		return SymmetricPart(y, y, (*Clifford).Reverse).Equals(even) &&
+			AntisymmetricPart(new(Clifford), x, (*Clifford).Reverse).Equals(odd)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
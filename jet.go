@@ -0,0 +1,344 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strings"
+)
+
+// A Jet represents a multi-precision floating-point jet of order k, a
+// polynomial
+// 		a0 + a1α + a2α^2 + ... + akα^k
+// in a nilpotent unit α with α^(k+1) = 0, which is a power series truncated
+// after k terms. The jets of order 1 are Infra. If x is a real number, then
+// evaluating a function f built from the arithmetic of Jet at x + α gives the
+// jet of f at x, whose coefficient of α^n is the n-th derivative of f at x
+// divided by n!; see NewJetVariable and Derivative.
+type Jet struct {
+	c []big.Float
+}
+
+// NewJet returns a pointer to the zero Jet value of order k. If k is negative,
+// then NewJet panics.
+func NewJet(k int) *Jet {
+	if k < 0 {
+		panic("negative order")
+	}
+	return &Jet{make([]big.Float, k+1)}
+}
+
+// NewJetVariable returns a pointer to the Jet value x + α of order k, the jet
+// of the identity function at x, at the precision of x. If k is negative,
+// then NewJetVariable panics.
+func NewJetVariable(x *big.Float, k int) *Jet {
+	z := NewJet(k)
+	z.c[0].Copy(x)
+	if k > 0 {
+		z.c[1].SetPrec(x.Prec()).SetInt64(1)
+	}
+	return z
+}
+
+// Order returns the order of z.
+func (z *Jet) Order() int {
+	return len(z.c) - 1
+}
+
+// Cartesian returns the k+1 multi-precision floating-point components of z,
+// in increasing powers of α.
+func (z *Jet) Cartesian() []*big.Float {
+	c := make([]*big.Float, len(z.c))
+	for k := range c {
+		c[k] = &z.c[k]
+	}
+	return c
+}
+
+// Real returns the real part of z.
+func (z *Jet) Real() *big.Float {
+	return &z.c[0]
+}
+
+// String returns the string representation of a Jet value.
+//
+// If z corresponds to a + bα + cα^2 + ..., then the string is
+// "(a+bα+cα^2+...)", similar to complex128 values.
+func (z *Jet) String() string {
+	a := make([]string, 0, 2*len(z.c)+1)
+	a = append(a, "(", fmt.Sprintf("%v", &z.c[0]))
+	for k := 1; k < len(z.c); k++ {
+		if z.c[k].Signbit() {
+			a = append(a, fmt.Sprintf("%v", &z.c[k]))
+		} else {
+			a = append(a, fmt.Sprintf("+%v", &z.c[k]))
+		}
+		if k == 1 {
+			a = append(a, "α")
+		} else {
+			a = append(a, fmt.Sprintf("α^%d", k))
+		}
+	}
+	a = append(a, ")")
+	return strings.Join(a, "")
+}
+
+// Format implements fmt.Formatter, with the conventions of the Format method
+// of Complex.
+func (z *Jet) Format(s fmt.State, verb rune) {
+	units := make([]string, len(z.c))
+	for k := 1; k < len(units); k++ {
+		if k == 1 {
+			units[k] = "α"
+		} else {
+			units[k] = fmt.Sprintf("α^%d", k)
+		}
+	}
+	formatTerms(s, verb, z, units, z.Cartesian())
+}
+
+// Equals returns true if y and z have the same order and are equal.
+func (z *Jet) Equals(y *Jet) bool {
+	if len(z.c) != len(y.c) {
+		return false
+	}
+	for k := range z.c {
+		if z.c[k].Cmp(&y.c[k]) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// reset gives z the order k, keeping its components if it already has that
+// order, and returns z.
+func (z *Jet) reset(k int) *Jet {
+	if len(z.c) != k+1 {
+		z.c = make([]big.Float, k+1)
+	}
+	return z
+}
+
+// check panics unless every element of y has the order of z.
+func (z *Jet) check(y ...*Jet) {
+	for _, v := range y {
+		if len(v.c) != len(z.c) {
+			panic("order mismatch")
+		}
+	}
+}
+
+// Copy copies y onto z, including its order, and returns z.
+func (z *Jet) Copy(y *Jet) *Jet {
+	if z == y {
+		return z
+	}
+	z.reset(y.Order())
+	for k := range z.c {
+		z.c[k].Copy(&y.c[k])
+	}
+	return z
+}
+
+// Scal sets z equal to y scaled by a, and returns z.
+func (z *Jet) Scal(y *Jet, a *big.Float) *Jet {
+	z.reset(y.Order())
+	for k := range z.c {
+		z.c[k].Mul(&y.c[k], a)
+	}
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *Jet) Neg(y *Jet) *Jet {
+	z.reset(y.Order())
+	for k := range z.c {
+		z.c[k].Neg(&y.c[k])
+	}
+	return z
+}
+
+// Conj sets z equal to the conjugate of y, which replaces α by -α, and
+// returns z.
+func (z *Jet) Conj(y *Jet) *Jet {
+	z.reset(y.Order())
+	for k := range z.c {
+		if k%2 != 0 {
+			z.c[k].Neg(&y.c[k])
+		} else {
+			z.c[k].Copy(&y.c[k])
+		}
+	}
+	return z
+}
+
+// Add sets z equal to x+y, and returns z. If x and y have different orders,
+// then Add panics.
+func (z *Jet) Add(x, y *Jet) *Jet {
+	x.check(y)
+	z.reset(x.Order())
+	for k := range z.c {
+		z.c[k].Add(&x.c[k], &y.c[k])
+	}
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z. If x and y have different orders,
+// then Sub panics.
+func (z *Jet) Sub(x, y *Jet) *Jet {
+	x.check(y)
+	z.reset(x.Order())
+	for k := range z.c {
+		z.c[k].Sub(&x.c[k], &y.c[k])
+	}
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z. If x and y have
+// different orders, then Mul panics.
+//
+// The multiplication rule is:
+// 		Mul(α^j, α^k) = α^(j+k)
+// where α^(j+k) = 0 beyond the order. This binary operation is commutative
+// and associative.
+// Unless the components of x or y differ widely in scale, the product is
+// computed exactly and then rounded once, so the result is exact whenever it
+// fits the precision of z; otherwise it is computed with rounded arithmetic.
+func (z *Jet) Mul(x, y *Jet) *Jet {
+	x.check(y)
+	prec := maxPrec(append(x.Cartesian(), y.Cartesian()...)...)
+	o := productOps(prec, z.Cartesian(), x.Cartesian(), y.Cartesian())
+	sum := make([]*big.Float, len(x.c))
+	for n := range sum {
+		sum[n] = new(big.Float)
+		for j := 0; j <= n; j++ {
+			sum[n] = o.add(sum[n], o.mul(&x.c[j], &y.c[n-j]))
+		}
+	}
+	z.reset(x.Order())
+	for k := range z.c {
+		roundFloat(&z.c[k], sum[k], prec)
+	}
+	o.settle(z.Cartesian()...)
+	return z
+}
+
+// Quad returns the quadrance of z, the square of its real part. This is
+// always non-negative.
+func (z *Jet) Quad() *big.Float {
+	return new(big.Float).Mul(&z.c[0], &z.c[0])
+}
+
+// IsZeroDiv returns true if z is a zero divisor. This is equivalent to z being
+// nilpotent, with a zero real part.
+func (z *Jet) IsZeroDiv() bool {
+	return z.c[0].Sign() == 0
+}
+
+// Inv sets z equal to the inverse of y, and returns z. If y = a0 + a1α + ...,
+// then the components of the inverse b0 + b1α + ... are given by
+// 		b0 = 1/a0
+// 		bn = -(a1b(n-1) + a2b(n-2) + ... + anb0)/a0
+// The result is rounded to the precision of y. If y is a zero divisor, then
+// Inv panics.
+func (z *Jet) Inv(y *Jet) *Jet {
+	if y.IsZeroDiv() {
+		panic("inverse of zero divisor")
+	}
+	prec := maxPrec(y.Cartesian()...)
+	w := prec + guardBits
+	b := make([]*big.Float, len(y.c))
+	for n := range b {
+		sum := newFloat(w)
+		if n == 0 {
+			sum.SetInt64(1)
+		}
+		for j := 1; j <= n; j++ {
+			sum.Sub(sum, newFloat(w).Mul(&y.c[j], b[n-j]))
+		}
+		b[n] = sum.Quo(sum, &y.c[0])
+	}
+	return z.setJet(b, prec)
+}
+
+// Quo sets z equal to the quotient of x and y, and returns z. If x and y have
+// different orders, then Quo panics. If y is a zero divisor, then Quo panics.
+func (z *Jet) Quo(x, y *Jet) *Jet {
+	x.check(y)
+	prec := maxPrec(append(x.Cartesian(), y.Cartesian()...)...)
+	inv := new(Jet).Inv(new(Jet).setPrec(y, prec+guardBits))
+	u := new(Jet).setPrec(x, prec+guardBits)
+	return z.setPrec(u.Mul(u, inv), prec)
+}
+
+// jetSum returns
+// 		(a1b(n-1) + 2a2b(n-2) + ... + nanb0)/n
+// at w bits, the recurrence shared by the exponential and the sine and
+// cosine.
+func jetSum(a []big.Float, b []*big.Float, n int, w uint) *big.Float {
+	sum := newFloat(w)
+	for j := 1; j <= n; j++ {
+		t := newFloat(w).Mul(&a[j], b[n-j])
+		sum.Add(sum, t.Mul(t, newFloat(w).SetInt64(int64(j))))
+	}
+	return sum.Quo(sum, newFloat(w).SetInt64(int64(n)))
+}
+
+// jetSinCos returns the components of the sine and the cosine of y at w
+// bits. If y = a0 + a1α + ..., then the components of the sine s0 + s1α + ...
+// and of the cosine c0 + c1α + ... are given by
+// 		s0 = sin(a0), c0 = cos(a0)
+// 		nsn = a1c(n-1) + 2a2c(n-2) + ... + nanc0
+// 		ncn = -(a1s(n-1) + 2a2s(n-2) + ... + nans0)
+func jetSinCos(y *Jet, w uint) ([]*big.Float, []*big.Float) {
+	sin := make([]*big.Float, len(y.c))
+	cos := make([]*big.Float, len(y.c))
+	sin[0], cos[0] = bigSinCos(&y.c[0], w)
+	for n := 1; n < len(sin); n++ {
+		sin[n] = jetSum(y.c, cos, n, w)
+		cos[n] = jetSum(y.c, sin, n, w)
+		cos[n].Neg(cos[n])
+	}
+	return sin, cos
+}
+
+// Derivative returns the n-th derivative encoded by z, the coefficient of α^n
+// multiplied by n!, rounded to the precision of z. If n is negative or larger
+// than the order of z, then Derivative panics.
+func (z *Jet) Derivative(n int) *big.Float {
+	if n < 0 || n > z.Order() {
+		panic("derivative beyond the order")
+	}
+	f := new(big.Int).MulRange(1, int64(n))
+	d := exactMul(&z.c[n], new(big.Float).SetInt(f))
+	return newFloat(maxPrec(z.Cartesian()...)).Set(d)
+}
+
+// setJet sets the components of z equal to c rounded to prec bits, and
+// returns z.
+func (z *Jet) setJet(c []*big.Float, prec uint) *Jet {
+	z.reset(len(c) - 1)
+	for k := range z.c {
+		z.c[k].SetPrec(prec).Set(c[k])
+	}
+	return z
+}
+
+// setPrec sets z equal to y with every component rounded to prec bits, and
+// returns z.
+func (z *Jet) setPrec(y *Jet, prec uint) *Jet {
+	return z.setJet(y.Cartesian(), prec)
+}
+
+// Generate returns a random Jet value of order 3 for quick.Check testing.
+func (z *Jet) Generate(rand *rand.Rand, size int) reflect.Value {
+	randomJet := NewJet(3)
+	for k := range randomJet.c {
+		randomJet.c[k].SetFloat64(rand.Float64())
+	}
+	return reflect.ValueOf(randomJet)
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+// closeToJet returns true if x and y have the same order and their components
+// agree to about bits bits, relative to the larger of their modulus and 1.
+func closeToJet(x, y *Jet, bits int) bool {
+	if x.Order() != y.Order() {
+		return false
+	}
+	zero := new(big.Float)
+	for k := range x.c {
+		if !closeToComplex(NewComplex(&x.c[k], zero), NewComplex(&y.c[k], zero), bits) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestJetInfra(t *testing.T) {
+	f := func(x, y *Infra) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		u, v := NewJet(1), NewJet(1)
+		u.c[0].Copy(&x.l)
+		u.c[1].Copy(&x.r)
+		v.c[0].Copy(&y.l)
+		v.c[1].Copy(&y.r)
+		p := new(Infra).Mul(x, y)
+		w := new(Jet).Mul(u, v)
+		return w.c[0].Cmp(&p.l) == 0 && w.c[1].Cmp(&p.r) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestJetMulAssociative(t *testing.T) {
+	f := func(x, y, z *Jet) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		l, r := new(Jet), new(Jet)
+		l.Mul(l.Mul(x, y), z)
+		r.Mul(x, r.Mul(y, z))
+		return closeToJet(l, r, 48) && new(Jet).Mul(x, y).Equals(new(Jet).Mul(y, x))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestJetInv(t *testing.T) {
+	f := func(x, y *Jet) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		one := NewJet(3)
+		one.c[0].SetInt64(1)
+		// Keep the real parts away from zero, where the inverse is large.
+		x.Add(x, one)
+		y.Add(y, one)
+		q := new(Jet).Quo(x, y)
+		return closeToJet(new(Jet).Mul(x, new(Jet).Inv(x)), one, 40) &&
+			closeToJet(new(Jet).Mul(q, y), x, 40)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestJetDerivative(t *testing.T) {
+	// The derivatives of x^5 at x = 3 are 243, 405, 540, 540, 360, 120, 0.
+	x := NewJetVariable(big.NewFloat(3), 6)
+	p := new(Jet).Copy(x)
+	for k := 1; k < 5; k++ {
+		p.Mul(p, x)
+	}
+	want := []float64{243, 405, 540, 540, 360, 120, 0}
+	for n, d := range want {
+		if got := p.Derivative(n); got.Cmp(big.NewFloat(d)) != 0 {
+			t.Errorf("Derivative(%d) of x^5 = %v, want %v", n, got, d)
+		}
+	}
+	// The derivatives of 1/x at x = 2 are (-1)^n n!/2^(n+1).
+	inv := new(Jet).Inv(NewJetVariable(big.NewFloat(2), 4))
+	for n, d := range []float64{0.5, -0.25, 0.25, -0.375, 0.75} {
+		if got := inv.Derivative(n); got.Cmp(big.NewFloat(d)) != 0 {
+			t.Errorf("Derivative(%d) of 1/x = %v, want %v", n, got, d)
+		}
+	}
+}
+
+func TestJetElementary(t *testing.T) {
+	x := NewJetVariable(newFloat(100).SetFloat64(0.5), 5)
+	// The derivatives of exp are all exp.
+	e := new(Jet).Exp(x)
+	for n := 0; n <= 5; n++ {
+		if got := e.Derivative(n); !closeTo(got, big.NewFloat(math.Exp(0.5)), 50) {
+			t.Errorf("Derivative(%d) of exp = %v", n, got)
+		}
+	}
+	// The derivatives of sin cycle through cos, -sin, -cos, sin.
+	s, c := new(Jet).Sin(x), new(Jet).Cos(x)
+	sin, cos := math.Sin(0.5), math.Cos(0.5)
+	for n, d := range []float64{sin, cos, -sin, -cos, sin, cos} {
+		if got := s.Derivative(n); !closeTo(got, big.NewFloat(d), 50) {
+			t.Errorf("Derivative(%d) of sin = %v, want %v", n, got, d)
+		}
+	}
+	// sin² + cos² = 1, and Log undoes Exp.
+	one := NewJet(5)
+	one.c[0].SetInt64(1)
+	if p := new(Jet).Add(new(Jet).Mul(s, s), new(Jet).Mul(c, c)); !closeToJet(p, one, 95) {
+		t.Errorf("sin² + cos² = %v", p)
+	}
+	if l := new(Jet).Log(e); !closeToJet(l, x, 95) {
+		t.Errorf("Log(Exp(%v)) = %v", x, l)
+	}
+}
@@ -0,0 +1,1063 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// A JSONForm selects how the MarshalJSON methods write the components of a
+// value.
+type JSONForm int32
+
+const (
+	// JSONArray writes the components as an array, in the order of
+	// Cartesian, such as ["1.5","-2"] for 1.5 - 2i.
+	JSONArray JSONForm = iota
+	// JSONObject writes the components as an object keyed by "real" and the
+	// symbols of the units, such as {"real":"1.5","i":"-2"} for 1.5 - 2i.
+	JSONObject
+)
+
+// A jsonMarshaler is a value whose encoding can be written in either form.
+type jsonMarshaler interface {
+	marshalJSON(form JSONForm) []byte
+}
+
+// A JSONWithForm wraps a value of this package, such as a *Complex, so that
+// it is encoded in the form Form, and nested values, such as the components
+// of a Biquaternion, in the same form:
+// 		json.Marshal(JSONWithForm{Value: z, Form: JSONObject})
+// The MarshalJSON methods of the values themselves write the array form.
+type JSONWithForm struct {
+	Value json.Marshaler
+	Form  JSONForm
+}
+
+// MarshalJSON implements json.Marshaler. If Value is not a value of this
+// package, then its own MarshalJSON method is called, and Form is ignored.
+func (v JSONWithForm) MarshalJSON() ([]byte, error) {
+	if m, ok := v.Value.(jsonMarshaler); ok {
+		return m.marshalJSON(v.Form), nil
+	}
+	return v.Value.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler by calling the UnmarshalJSON
+// method of Value, which accepts both forms. If Value has none, then
+// UnmarshalJSON returns an error.
+func (v JSONWithForm) UnmarshalJSON(data []byte) error {
+	u, ok := v.Value.(json.Unmarshaler)
+	if !ok {
+		return fmt.Errorf("bigfloat: %T is not a json.Unmarshaler", v.Value)
+	}
+	return u.UnmarshalJSON(data)
+}
+
+// jsonKeys returns the keys of the object form for the units, with "real" in
+// place of the empty symbol of the real unit.
+func jsonKeys(units []string) []string {
+	keys := make([]string, len(units))
+	for k, u := range units {
+		if u == "" {
+			u = "real"
+		}
+		keys[k] = u
+	}
+	return keys
+}
+
+// jsonFail returns the *ParseError for the JSON encoding data of a value of
+// the type typ.
+func jsonFail(typ string, data []byte, msg string, err error) error {
+	return &ParseError{Type: typ, Input: string(data), Msg: msg, Err: err}
+}
+
+// jsonNull returns true if data is the JSON null, which the UnmarshalJSON
+// methods ignore.
+func jsonNull(data []byte) bool {
+	return string(bytes.TrimSpace(data)) == "null"
+}
+
+// marshalJSONParts returns the parts, each already encoded, as an array, or
+// as an object with the keys if form is JSONObject.
+func marshalJSONParts(form JSONForm, parts [][]byte, keys []string) []byte {
+	obj := form == JSONObject
+	var b bytes.Buffer
+	if obj {
+		b.WriteByte('{')
+	} else {
+		b.WriteByte('[')
+	}
+	for k, p := range parts {
+		if k > 0 {
+			b.WriteByte(',')
+		}
+		if obj {
+			b.WriteString(strconv.Quote(keys[k]))
+			b.WriteByte(':')
+		}
+		b.Write(p)
+	}
+	if obj {
+		b.WriteByte('}')
+	} else {
+		b.WriteByte(']')
+	}
+	return b.Bytes()
+}
+
+// jsonArray returns the parts, each already encoded, as a JSON array.
+func jsonArray(parts [][]byte) []byte {
+	return append(append([]byte("["), bytes.Join(parts, []byte(","))...), ']')
+}
+
+// jsonFloat returns x as a JSON string, the shortest decimal that gives back
+// x when parsed at its precision, followed by "@" and the precision, such as
+// "1.5@53".
+func jsonFloat(x *big.Float) []byte {
+	return []byte(strconv.Quote(x.Text('g', -1) + "@" + strconv.FormatUint(uint64(x.Prec()), 10)))
+}
+
+// marshalJSONFloats returns the components x in the format of jsonFloat, in
+// the form of marshalJSONParts.
+func marshalJSONFloats(form JSONForm, x []*big.Float, keys []string) []byte {
+	parts := make([][]byte, len(x))
+	for k, v := range x {
+		parts[k] = jsonFloat(v)
+	}
+	return marshalJSONParts(form, parts, keys)
+}
+
+// splitJSON decodes data, the JSON encoding of a value of the type typ, into
+// its parts, either an array or an object.
+func splitJSON(typ string, data []byte) ([]json.RawMessage, map[string]json.RawMessage, error) {
+	t := bytes.TrimSpace(data)
+	switch {
+	case bytes.HasPrefix(t, []byte("[")):
+		var arr []json.RawMessage
+		if err := json.Unmarshal(t, &arr); err != nil {
+			return nil, nil, jsonFail(typ, data, "malformed array", err)
+		}
+		return arr, nil, nil
+	case bytes.HasPrefix(t, []byte("{")):
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(t, &obj); err != nil {
+			return nil, nil, jsonFail(typ, data, "malformed object", err)
+		}
+		return nil, obj, nil
+	}
+	return nil, nil, jsonFail(typ, data, "want an array or an object", nil)
+}
+
+// alignJSON decodes data, the JSON encoding of a value of the type typ, into
+// the parts for the keys. The array form must have a part for every key; the
+// object form may omit keys, whose parts are nil, but must not have others.
+func alignJSON(typ string, data []byte, keys []string) ([]json.RawMessage, error) {
+	arr, obj, err := splitJSON(typ, data)
+	if err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		if len(arr) != len(keys) {
+			return nil, jsonFail(typ, data, fmt.Sprintf("want %d components, got %d", len(keys), len(arr)), nil)
+		}
+		return arr, nil
+	}
+	parts := make([]json.RawMessage, len(keys))
+	for key, p := range obj {
+		k := indexOf(keys, key)
+		if k < 0 {
+			return nil, jsonFail(typ, data, "unknown key "+strconv.Quote(key), nil)
+		}
+		parts[k] = p
+	}
+	return parts, nil
+}
+
+// indexOf returns the index of s in a, or -1 if it is absent.
+func indexOf(a []string, s string) int {
+	for k, v := range a {
+		if v == s {
+			return k
+		}
+	}
+	return -1
+}
+
+// unmarshalJSONFloat returns the component for the key decoded from part, a
+// JSON string in data, at the precision that follows "@" in the string, or
+// else at prec bits, or at 64 bits if prec is zero. If part is nil, then the
+// component is zero.
+func unmarshalJSONFloat(typ string, data []byte, key string, part json.RawMessage, prec uint) (*big.Float, error) {
+	var s string
+	if part != nil {
+		if err := json.Unmarshal(part, &s); err != nil {
+			return nil, jsonFail(typ, data, "component "+key+" is not a string", err)
+		}
+	}
+	tagged := false
+	if i := strings.LastIndexByte(s, '@'); i >= 0 {
+		p, err := strconv.ParseUint(s[i+1:], 10, 32)
+		if err != nil || p > big.MaxPrec {
+			return nil, jsonFail(typ, data, "malformed precision of component "+key, err)
+		}
+		s, prec, tagged = s[:i], uint(p), true
+	}
+	v := newFloat(parsePrec(prec))
+	if part == nil {
+		return v, nil
+	}
+	if _, _, err := v.Parse(s, 0); err != nil {
+		return nil, jsonFail(typ, data, "malformed component "+key, err)
+	}
+	if tagged && prec == 0 {
+		// Only zeros and infinities have no precision.
+		v.SetPrec(0)
+	}
+	return v, nil
+}
+
+// unmarshalJSONFloats sets the components x equal to the strings decoded
+// from data, in either form of marshalJSONParts, at the precisions written
+// with them, as for unmarshalJSONFloat. Components missing from the object form
+// are set to zero. If data is malformed, then unmarshalJSONFloats returns a
+// *ParseError and leaves x unchanged.
+func unmarshalJSONFloats(typ string, data []byte, x []*big.Float, keys []string) error {
+	if jsonNull(data) {
+		return nil
+	}
+	parts, err := alignJSON(typ, data, keys)
+	if err != nil {
+		return err
+	}
+	v := make([]*big.Float, len(x))
+	for k := range v {
+		if v[k], err = unmarshalJSONFloat(typ, data, keys[k], parts[k], x[k].Prec()); err != nil {
+			return err
+		}
+	}
+	for k := range x {
+		x[k].SetPrec(v[k].Prec()).Set(v[k])
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The components are written as
+// strings, each the shortest decimal that gives back the component when
+// parsed at its precision, followed by "@" and the precision, so no digits
+// are lost as they would be with float64. They are written as an array, or
+// as an object when z is wrapped in a JSONWithForm:
+// 		["1.5@53","-2@53"]
+// 		{"real":"1.5@53","i":"-2@53"}
+func (z *Complex) MarshalJSON() ([]byte, error) {
+	return z.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of z in the form.
+func (z *Complex) marshalJSON(form JSONForm) []byte {
+	return marshalJSONFloats(form, floatSlice(z.Cartesian()), []string{"real", "i"})
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts both forms of
+// MarshalJSON, with components missing from the object form taken as zero,
+// and the JSON null, which leaves z unchanged. The components take the
+// precisions written with them, so z gets back the encoded value exactly,
+// whatever its own precisions. A component written without a precision is
+// rounded, like SetString, to its precision in z, or to 64 bits if that is
+// zero. If data is malformed, then UnmarshalJSON returns a *ParseError and
+// leaves z unchanged.
+func (z *Complex) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONFloats("Complex", data, floatSlice(z.Cartesian()), []string{"real", "i"})
+}
+
+// MarshalJSON implements json.Marshaler, with the conventions of the
+// MarshalJSON method of Complex.
+func (z *Perplex) MarshalJSON() ([]byte, error) {
+	return z.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of z in the form.
+func (z *Perplex) marshalJSON(form JSONForm) []byte {
+	return marshalJSONFloats(form, floatSlice(z.Cartesian()), []string{"real", "s"})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the conventions of the
+// UnmarshalJSON method of Complex.
+func (z *Perplex) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONFloats("Perplex", data, floatSlice(z.Cartesian()), []string{"real", "s"})
+}
+
+// MarshalJSON implements json.Marshaler, with the conventions of the
+// MarshalJSON method of Complex.
+func (z *Infra) MarshalJSON() ([]byte, error) {
+	return z.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of z in the form.
+func (z *Infra) marshalJSON(form JSONForm) []byte {
+	return marshalJSONFloats(form, floatSlice(z.Cartesian()), []string{"real", "α"})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the conventions of the
+// UnmarshalJSON method of Complex.
+func (z *Infra) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONFloats("Infra", data, floatSlice(z.Cartesian()), []string{"real", "α"})
+}
+
+// MarshalJSON implements json.Marshaler, with the conventions of the
+// MarshalJSON method of Complex.
+func (z *Hamilton) MarshalJSON() ([]byte, error) {
+	return z.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of z in the form.
+func (z *Hamilton) marshalJSON(form JSONForm) []byte {
+	return marshalJSONFloats(form, floatSlice(z.Cartesian()), jsonKeys(symbHamilton[:]))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the conventions of the
+// UnmarshalJSON method of Complex.
+func (z *Hamilton) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONFloats("Hamilton", data, floatSlice(z.Cartesian()), jsonKeys(symbHamilton[:]))
+}
+
+// MarshalJSON implements json.Marshaler, with the conventions of the
+// MarshalJSON method of Complex.
+func (z *Cockle) MarshalJSON() ([]byte, error) {
+	return z.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of z in the form.
+func (z *Cockle) marshalJSON(form JSONForm) []byte {
+	return marshalJSONFloats(form, floatSlice(z.Cartesian()), jsonKeys(symbCockle[:]))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the conventions of the
+// UnmarshalJSON method of Complex.
+func (z *Cockle) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONFloats("Cockle", data, floatSlice(z.Cartesian()), jsonKeys(symbCockle[:]))
+}
+
+// MarshalJSON implements json.Marshaler, with the conventions of the
+// MarshalJSON method of Complex.
+func (z *DualComplex) MarshalJSON() ([]byte, error) {
+	return z.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of z in the form.
+func (z *DualComplex) marshalJSON(form JSONForm) []byte {
+	return marshalJSONFloats(form, floatSlice(z.Cartesian()), jsonKeys(symbDualComplex[:]))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the conventions of the
+// UnmarshalJSON method of Complex.
+func (z *DualComplex) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONFloats("DualComplex", data, floatSlice(z.Cartesian()), jsonKeys(symbDualComplex[:]))
+}
+
+// MarshalJSON implements json.Marshaler, with the conventions of the
+// MarshalJSON method of Complex.
+func (z *InfraComplex) MarshalJSON() ([]byte, error) {
+	return z.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of z in the form.
+func (z *InfraComplex) marshalJSON(form JSONForm) []byte {
+	return marshalJSONFloats(form, floatSlice(z.Cartesian()), jsonKeys(symbInfraComplex[:]))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the conventions of the
+// UnmarshalJSON method of Complex.
+func (z *InfraComplex) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONFloats("InfraComplex", data, floatSlice(z.Cartesian()), jsonKeys(symbInfraComplex[:]))
+}
+
+// MarshalJSON implements json.Marshaler, with the conventions of the
+// MarshalJSON method of Complex.
+func (z *InfraPerplex) MarshalJSON() ([]byte, error) {
+	return z.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of z in the form.
+func (z *InfraPerplex) marshalJSON(form JSONForm) []byte {
+	return marshalJSONFloats(form, floatSlice(z.Cartesian()), jsonKeys(symbInfraPerplex[:]))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the conventions of the
+// UnmarshalJSON method of Complex.
+func (z *InfraPerplex) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONFloats("InfraPerplex", data, floatSlice(z.Cartesian()), jsonKeys(symbInfraPerplex[:]))
+}
+
+// MarshalJSON implements json.Marshaler, with the conventions of the
+// MarshalJSON method of Complex.
+func (z *Supra) MarshalJSON() ([]byte, error) {
+	return z.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of z in the form.
+func (z *Supra) marshalJSON(form JSONForm) []byte {
+	return marshalJSONFloats(form, floatSlice(z.Cartesian()), jsonKeys(symbSupra[:]))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the conventions of the
+// UnmarshalJSON method of Complex.
+func (z *Supra) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONFloats("Supra", data, floatSlice(z.Cartesian()), jsonKeys(symbSupra[:]))
+}
+
+// MarshalJSON implements json.Marshaler, with the conventions of the
+// MarshalJSON method of Complex.
+func (z *Macfarlane) MarshalJSON() ([]byte, error) {
+	return z.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of z in the form.
+func (z *Macfarlane) marshalJSON(form JSONForm) []byte {
+	return marshalJSONFloats(form, floatSlice(z.Cartesian()), jsonKeys(symbMacfarlane[:]))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the conventions of the
+// UnmarshalJSON method of Complex.
+func (z *Macfarlane) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONFloats("Macfarlane", data, floatSlice(z.Cartesian()), jsonKeys(symbMacfarlane[:]))
+}
+
+// MarshalJSON implements json.Marshaler, with the conventions of the
+// MarshalJSON method of Complex.
+func (z *Cayley) MarshalJSON() ([]byte, error) {
+	return z.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of z in the form.
+func (z *Cayley) marshalJSON(form JSONForm) []byte {
+	return marshalJSONFloats(form, floatSlice(z.Cartesian()), jsonKeys(symbCayley[:]))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the conventions of the
+// UnmarshalJSON method of Complex.
+func (z *Cayley) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONFloats("Cayley", data, floatSlice(z.Cartesian()), jsonKeys(symbCayley[:]))
+}
+
+// MarshalJSON implements json.Marshaler, with the conventions of the
+// MarshalJSON method of Complex.
+func (z *DualHamilton) MarshalJSON() ([]byte, error) {
+	return z.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of z in the form.
+func (z *DualHamilton) marshalJSON(form JSONForm) []byte {
+	return marshalJSONFloats(form, floatSlice(z.Cartesian()), jsonKeys(symbDualHamilton[:]))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the conventions of the
+// UnmarshalJSON method of Complex.
+func (z *DualHamilton) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONFloats("DualHamilton", data, floatSlice(z.Cartesian()), jsonKeys(symbDualHamilton[:]))
+}
+
+// MarshalJSON implements json.Marshaler, with the conventions of the
+// MarshalJSON method of Complex.
+func (z *InfraCockle) MarshalJSON() ([]byte, error) {
+	return z.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of z in the form.
+func (z *InfraCockle) marshalJSON(form JSONForm) []byte {
+	return marshalJSONFloats(form, floatSlice(z.Cartesian()), jsonKeys(symbInfraCockle[:]))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the conventions of the
+// UnmarshalJSON method of Complex.
+func (z *InfraCockle) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONFloats("InfraCockle", data, floatSlice(z.Cartesian()), jsonKeys(symbInfraCockle[:]))
+}
+
+// MarshalJSON implements json.Marshaler, with the conventions of the
+// MarshalJSON method of Complex.
+func (z *InfraHamilton) MarshalJSON() ([]byte, error) {
+	return z.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of z in the form.
+func (z *InfraHamilton) marshalJSON(form JSONForm) []byte {
+	return marshalJSONFloats(form, floatSlice(z.Cartesian()), jsonKeys(symbInfraHamilton[:]))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the conventions of the
+// UnmarshalJSON method of Complex.
+func (z *InfraHamilton) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONFloats("InfraHamilton", data, floatSlice(z.Cartesian()), jsonKeys(symbInfraHamilton[:]))
+}
+
+// MarshalJSON implements json.Marshaler, with the conventions of the
+// MarshalJSON method of Complex.
+func (z *SupraComplex) MarshalJSON() ([]byte, error) {
+	return z.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of z in the form.
+func (z *SupraComplex) marshalJSON(form JSONForm) []byte {
+	return marshalJSONFloats(form, floatSlice(z.Cartesian()), jsonKeys(symbSupraComplex[:]))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the conventions of the
+// UnmarshalJSON method of Complex.
+func (z *SupraComplex) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONFloats("SupraComplex", data, floatSlice(z.Cartesian()), jsonKeys(symbSupraComplex[:]))
+}
+
+// MarshalJSON implements json.Marshaler, with the conventions of the
+// MarshalJSON method of Complex.
+func (z *Ultra) MarshalJSON() ([]byte, error) {
+	return z.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of z in the form.
+func (z *Ultra) marshalJSON(form JSONForm) []byte {
+	return marshalJSONFloats(form, floatSlice(z.Cartesian()), jsonKeys(symbUltra[:]))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the conventions of the
+// UnmarshalJSON method of Complex.
+func (z *Ultra) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONFloats("Ultra", data, floatSlice(z.Cartesian()), jsonKeys(symbUltra[:]))
+}
+
+// MarshalJSON implements json.Marshaler, with the conventions of the
+// MarshalJSON method of Complex.
+func (z *Sedenion) MarshalJSON() ([]byte, error) {
+	return z.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of z in the form.
+func (z *Sedenion) marshalJSON(form JSONForm) []byte {
+	c := z.Cartesian()
+	return marshalJSONFloats(form, c[:], jsonKeys(symbSedenion[:]))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the conventions of the
+// UnmarshalJSON method of Complex.
+func (z *Sedenion) UnmarshalJSON(data []byte) error {
+	c := z.Cartesian()
+	return unmarshalJSONFloats("Sedenion", data, c[:], jsonKeys(symbSedenion[:]))
+}
+
+// MarshalJSON implements json.Marshaler, with the conventions of the
+// MarshalJSON method of Complex.
+func (z *SupraCockle) MarshalJSON() ([]byte, error) {
+	return z.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of z in the form.
+func (z *SupraCockle) marshalJSON(form JSONForm) []byte {
+	c := z.Cartesian()
+	return marshalJSONFloats(form, c[:], jsonKeys(symbSupraCockle[:]))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the conventions of the
+// UnmarshalJSON method of Complex.
+func (z *SupraCockle) UnmarshalJSON(data []byte) error {
+	c := z.Cartesian()
+	return unmarshalJSONFloats("SupraCockle", data, c[:], jsonKeys(symbSupraCockle[:]))
+}
+
+// MarshalJSON implements json.Marshaler, with the conventions of the
+// MarshalJSON method of Complex.
+func (z *SupraHamilton) MarshalJSON() ([]byte, error) {
+	return z.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of z in the form.
+func (z *SupraHamilton) marshalJSON(form JSONForm) []byte {
+	c := z.Cartesian()
+	return marshalJSONFloats(form, c[:], jsonKeys(symbSupraHamilton[:]))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the conventions of the
+// UnmarshalJSON method of Complex.
+func (z *SupraHamilton) UnmarshalJSON(data []byte) error {
+	c := z.Cartesian()
+	return unmarshalJSONFloats("SupraHamilton", data, c[:], jsonKeys(symbSupraHamilton[:]))
+}
+
+// MarshalJSON implements json.Marshaler, with the keys "x", "y", and "z" in
+// the object form, and otherwise with the conventions of the MarshalJSON
+// method of Complex.
+func (v *Vec3) MarshalJSON() ([]byte, error) {
+	return v.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of v in the form.
+func (v *Vec3) marshalJSON(form JSONForm) []byte {
+	return marshalJSONFloats(form, floatSlice(v.Cartesian()), []string{"x", "y", "z"})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the conventions of the
+// UnmarshalJSON method of Complex.
+func (v *Vec3) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONFloats("Vec3", data, floatSlice(v.Cartesian()), []string{"x", "y", "z"})
+}
+
+// jetKeys returns the keys of the object form of a Jet value of order k.
+func jetKeys(k int) []string {
+	keys := make([]string, k+1)
+	keys[0] = "real"
+	for n := 1; n <= k; n++ {
+		if n == 1 {
+			keys[n] = "α"
+		} else {
+			keys[n] = fmt.Sprintf("α^%d", n)
+		}
+	}
+	return keys
+}
+
+// MarshalJSON implements json.Marshaler, with the keys "real", "α", "α^2",
+// and so on in the object form, and otherwise with the conventions of the
+// MarshalJSON method of Complex.
+func (z *Jet) MarshalJSON() ([]byte, error) {
+	return z.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of z in the form.
+func (z *Jet) marshalJSON(form JSONForm) []byte {
+	return marshalJSONFloats(form, z.Cartesian(), jetKeys(z.Order()))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the conventions of the
+// UnmarshalJSON method of Complex. The order of z becomes the number of
+// components of the array form less one, or the largest power of α in the
+// object form.
+func (z *Jet) UnmarshalJSON(data []byte) error {
+	if jsonNull(data) {
+		return nil
+	}
+	arr, obj, err := splitJSON("Jet", data)
+	if err != nil {
+		return err
+	}
+	k := len(arr) - 1
+	for key := range obj {
+		n := 0
+		switch {
+		case key == "real":
+		case key == "α":
+			n = 1
+		case strings.HasPrefix(key, "α^"):
+			n, err = strconv.Atoi(key[len("α^"):])
+			if err != nil || n < 2 {
+				return jsonFail("Jet", data, "unknown key "+strconv.Quote(key), nil)
+			}
+		default:
+			return jsonFail("Jet", data, "unknown key "+strconv.Quote(key), nil)
+		}
+		if n > k {
+			k = n
+		}
+	}
+	if k < 0 {
+		return jsonFail("Jet", data, "no components", nil)
+	}
+	y := new(Jet)
+	if k == z.Order() {
+		y.Copy(z)
+	}
+	y.reset(k)
+	if err := unmarshalJSONFloats("Jet", data, y.Cartesian(), jetKeys(k)); err != nil {
+		return err
+	}
+	z.Copy(y)
+	return nil
+}
+
+// bladeKeys returns the keys of the object form of a multivector with 2^n
+// components.
+func bladeKeys(n int) []string {
+	keys := make([]string, 1<<uint(n))
+	for k := range keys {
+		keys[k] = bladeName(k)
+	}
+	return jsonKeys(keys)
+}
+
+// MarshalJSON implements json.Marshaler, with the keys "real", "e1", "e2",
+// "e1e2", and so on in the object form, and otherwise with the conventions of
+// the MarshalJSON method of Complex. The signature is not written.
+func (z *Clifford) MarshalJSON() ([]byte, error) {
+	return z.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of z in the form.
+func (z *Clifford) marshalJSON(form JSONForm) []byte {
+	return marshalJSONFloats(form, z.Cartesian(), bladeKeys(z.p+z.q))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the conventions of the
+// UnmarshalJSON method of Complex. Since the encoding does not hold the
+// signature, z keeps its signature, and the array form must have one
+// component for each of its blades.
+func (z *Clifford) UnmarshalJSON(data []byte) error {
+	y := new(Clifford)
+	if len(z.c) > 0 {
+		y.Copy(z)
+	}
+	y.reset(z.p, z.q)
+	if err := unmarshalJSONFloats("Clifford", data, y.Cartesian(), bladeKeys(z.p+z.q)); err != nil {
+		return err
+	}
+	z.Copy(y)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, with the keys of the MarshalJSON
+// method of Clifford.
+func (z *Grassmann) MarshalJSON() ([]byte, error) {
+	return z.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of z in the form.
+func (z *Grassmann) marshalJSON(form JSONForm) []byte {
+	return marshalJSONFloats(form, z.Cartesian(), bladeKeys(z.n))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the conventions of the
+// UnmarshalJSON method of Complex. The number of generators of z becomes n
+// for the array form with 2^n components; the object form keeps the
+// generators of z.
+func (z *Grassmann) UnmarshalJSON(data []byte) error {
+	if jsonNull(data) {
+		return nil
+	}
+	arr, _, err := splitJSON("Grassmann", data)
+	if err != nil {
+		return err
+	}
+	n := z.n
+	if arr != nil && len(arr) != len(z.c) {
+		if len(arr) == 0 || len(arr)&(len(arr)-1) != 0 {
+			return jsonFail("Grassmann", data, "want a power of two components", nil)
+		}
+		n = bits.TrailingZeros(uint(len(arr)))
+	}
+	y := new(Grassmann)
+	if len(z.c) > 0 {
+		y.Copy(z)
+	}
+	y.reset(n)
+	if err := unmarshalJSONFloats("Grassmann", data, y.Cartesian(), bladeKeys(n)); err != nil {
+		return err
+	}
+	z.Copy(y)
+	return nil
+}
+
+// marshalJSONValues returns the values, each in the form, as the parts of
+// marshalJSONParts.
+func marshalJSONValues(form JSONForm, v []jsonMarshaler, keys []string) []byte {
+	parts := make([][]byte, len(v))
+	for k := range v {
+		parts[k] = v[k].marshalJSON(form)
+	}
+	return marshalJSONParts(form, parts, keys)
+}
+
+// MarshalJSON implements json.Marshaler, with each component in the format of
+// the MarshalJSON method of Complex, such as [["1","2"],["0","0"],...], and
+// the keys "real", "i", "j", and "k" in the object form.
+func (z *Biquaternion) MarshalJSON() ([]byte, error) {
+	return z.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of z in the form.
+func (z *Biquaternion) marshalJSON(form JSONForm) []byte {
+	return marshalJSONValues(form, []jsonMarshaler{&z.w, &z.x, &z.y, &z.z}, jsonKeys(symbBiquaternion[:]))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the conventions of the
+// UnmarshalJSON method of Complex for each component.
+func (z *Biquaternion) UnmarshalJSON(data []byte) error {
+	if jsonNull(data) {
+		return nil
+	}
+	parts, err := alignJSON("Biquaternion", data, jsonKeys(symbBiquaternion[:]))
+	if err != nil {
+		return err
+	}
+	y := new(Biquaternion).Copy(z)
+	for k, v := range []*Complex{&y.w, &y.x, &y.y, &y.z} {
+		if err := v.UnmarshalJSON(jsonPart(parts[k])); err != nil {
+			return err
+		}
+	}
+	z.Copy(y)
+	return nil
+}
+
+// jsonPart returns part, or the empty object, which decodes to zero, if part
+// is nil.
+func jsonPart(part json.RawMessage) json.RawMessage {
+	if part == nil {
+		return json.RawMessage("{}")
+	}
+	return part
+}
+
+// MarshalJSON implements json.Marshaler, with the diagonal entries a and b
+// as strings and the vectors u and v in the format of the MarshalJSON method
+// of Vec3, in the order of NewZorn, and the keys "a", "b", "u", and "v" in
+// the object form.
+func (z *Zorn) MarshalJSON() ([]byte, error) {
+	return z.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of z in the form.
+func (z *Zorn) marshalJSON(form JSONForm) []byte {
+	parts := [][]byte{jsonFloat(&z.a), jsonFloat(&z.b), z.u.marshalJSON(form), z.v.marshalJSON(form)}
+	return marshalJSONParts(form, parts, []string{"a", "b", "u", "v"})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the conventions of the
+// UnmarshalJSON method of Complex.
+func (z *Zorn) UnmarshalJSON(data []byte) error {
+	if jsonNull(data) {
+		return nil
+	}
+	keys := []string{"a", "b", "u", "v"}
+	parts, err := alignJSON("Zorn", data, keys)
+	if err != nil {
+		return err
+	}
+	a, err := unmarshalJSONFloat("Zorn", data, keys[0], parts[0], z.a.Prec())
+	if err != nil {
+		return err
+	}
+	b, err := unmarshalJSONFloat("Zorn", data, keys[1], parts[1], z.b.Prec())
+	if err != nil {
+		return err
+	}
+	u, v := new(Vec3).Copy(&z.u), new(Vec3).Copy(&z.v)
+	if err := u.UnmarshalJSON(jsonPart(parts[2])); err != nil {
+		return err
+	}
+	if err := v.UnmarshalJSON(jsonPart(parts[3])); err != nil {
+		return err
+	}
+	z.a.SetPrec(a.Prec()).Set(a)
+	z.b.SetPrec(b.Prec()).Set(b)
+	z.u.Copy(u)
+	z.v.Copy(v)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The coefficients are written as an
+// array in increasing order of degree, each in the format of the MarshalJSON
+// method of Complex, so the zero polynomial is [].
+func (p *ComplexPoly) MarshalJSON() ([]byte, error) {
+	return p.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of p in the form.
+func (p *ComplexPoly) marshalJSON(form JSONForm) []byte {
+	v := make([][]byte, len(p.c))
+	for k := range v {
+		v[k] = p.c[k].marshalJSON(form)
+	}
+	return jsonArray(v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts the array of
+// MarshalJSON, with each coefficient in either form of the MarshalJSON
+// method of Complex. Leading zero coefficients are dropped. If
+// data is malformed, then UnmarshalJSON returns a *ParseError and leaves p
+// unchanged.
+func (p *ComplexPoly) UnmarshalJSON(data []byte) error {
+	if jsonNull(data) {
+		return nil
+	}
+	var parts []json.RawMessage
+	if err := json.Unmarshal(data, &parts); err != nil {
+		return jsonFail("ComplexPoly", data, "want an array", err)
+	}
+	c := make([]*Complex, len(parts))
+	for k := range c {
+		c[k] = new(Complex)
+		if err := c[k].UnmarshalJSON(parts[k]); err != nil {
+			return err
+		}
+	}
+	p.c = NewComplexPoly(c...).c
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The rows are written as an array of
+// arrays, with each entry in the format of the MarshalJSON method of
+// Hamilton.
+func (m *HamiltonMatrix) MarshalJSON() ([]byte, error) {
+	return m.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of m in the form.
+func (m *HamiltonMatrix) marshalJSON(form JSONForm) []byte {
+	rows := make([][]byte, m.rows)
+	for i := range rows {
+		row := make([][]byte, m.cols)
+		for j := range row {
+			row[j] = m.At(i, j).marshalJSON(form)
+		}
+		rows[i] = jsonArray(row)
+	}
+	return jsonArray(rows)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts the array of rows of
+// MarshalJSON, with each entry in either form of the MarshalJSON method of
+// Hamilton, and the dimensions of m become those of the rows. If
+// data is malformed, or the rows have different lengths, then UnmarshalJSON
+// returns a *ParseError and leaves m unchanged.
+func (m *HamiltonMatrix) UnmarshalJSON(data []byte) error {
+	if jsonNull(data) {
+		return nil
+	}
+	var rows [][]json.RawMessage
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return jsonFail("HamiltonMatrix", data, "want an array of rows", err)
+	}
+	cols := 0
+	if len(rows) > 0 {
+		cols = len(rows[0])
+	}
+	n := NewHamiltonMatrix(len(rows), cols)
+	for i, row := range rows {
+		if len(row) != cols {
+			return jsonFail("HamiltonMatrix", data, "rows of different lengths", nil)
+		}
+		for j := range row {
+			if err := n.At(i, j).UnmarshalJSON(row[j]); err != nil {
+				return err
+			}
+		}
+	}
+	*m = *n
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, with the coordinates x and y of
+// [x : y] in the format of the MarshalJSON method of Complex, and the keys "x"
+// and "y" in the object form.
+func (z *ProjectiveComplex) MarshalJSON() ([]byte, error) {
+	return z.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of z in the form.
+func (z *ProjectiveComplex) marshalJSON(form JSONForm) []byte {
+	return marshalJSONValues(form, []jsonMarshaler{&z.x, &z.y}, []string{"x", "y"})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the conventions of the
+// UnmarshalJSON method of Complex for each coordinate. If both coordinates are
+// zero, then UnmarshalJSON returns a *ParseError and leaves z unchanged.
+func (z *ProjectiveComplex) UnmarshalJSON(data []byte) error {
+	if jsonNull(data) {
+		return nil
+	}
+	keys := []string{"x", "y"}
+	parts, err := alignJSON("ProjectiveComplex", data, keys)
+	if err != nil {
+		return err
+	}
+	y := new(ProjectiveComplex).Copy(z)
+	for k, v := range []*Complex{&y.x, &y.y} {
+		if err := v.UnmarshalJSON(jsonPart(parts[k])); err != nil {
+			return err
+		}
+	}
+	if zero := new(Complex); y.x.Equals(zero) && y.y.Equals(zero) {
+		return jsonFail("ProjectiveComplex", data, "both coordinates are zero", nil)
+	}
+	z.Copy(y)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, with the coordinates x and y of
+// [x : y] in the format of the MarshalJSON method of Hamilton, and the keys "x"
+// and "y" in the object form.
+func (z *ProjectiveHamilton) MarshalJSON() ([]byte, error) {
+	return z.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of z in the form.
+func (z *ProjectiveHamilton) marshalJSON(form JSONForm) []byte {
+	return marshalJSONValues(form, []jsonMarshaler{&z.x, &z.y}, []string{"x", "y"})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the conventions of the
+// UnmarshalJSON method of Hamilton for each coordinate. If both coordinates are
+// zero, then UnmarshalJSON returns a *ParseError and leaves z unchanged.
+func (z *ProjectiveHamilton) UnmarshalJSON(data []byte) error {
+	if jsonNull(data) {
+		return nil
+	}
+	keys := []string{"x", "y"}
+	parts, err := alignJSON("ProjectiveHamilton", data, keys)
+	if err != nil {
+		return err
+	}
+	y := new(ProjectiveHamilton).Copy(z)
+	for k, v := range []*Hamilton{&y.x, &y.y} {
+		if err := v.UnmarshalJSON(jsonPart(parts[k])); err != nil {
+			return err
+		}
+	}
+	if zero := new(Hamilton); y.x.Equals(zero) && y.y.Equals(zero) {
+		return jsonFail("ProjectiveHamilton", data, "both coordinates are zero", nil)
+	}
+	z.Copy(y)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, with the coordinates x and y of
+// [x : y] in the format of the MarshalJSON method of Cockle, and the keys "x"
+// and "y" in the object form.
+func (z *ProjectiveCockle) MarshalJSON() ([]byte, error) {
+	return z.marshalJSON(JSONArray), nil
+}
+
+// marshalJSON returns the encoding of z in the form.
+func (z *ProjectiveCockle) marshalJSON(form JSONForm) []byte {
+	return marshalJSONValues(form, []jsonMarshaler{&z.x, &z.y}, []string{"x", "y"})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the conventions of the
+// UnmarshalJSON method of Cockle for each coordinate. If both coordinates are
+// zero, then UnmarshalJSON returns a *ParseError and leaves z unchanged.
+func (z *ProjectiveCockle) UnmarshalJSON(data []byte) error {
+	if jsonNull(data) {
+		return nil
+	}
+	keys := []string{"x", "y"}
+	parts, err := alignJSON("ProjectiveCockle", data, keys)
+	if err != nil {
+		return err
+	}
+	y := new(ProjectiveCockle).Copy(z)
+	for k, v := range []*Cockle{&y.x, &y.y} {
+		if err := v.UnmarshalJSON(jsonPart(parts[k])); err != nil {
+			return err
+		}
+	}
+	if zero := new(Cockle); y.x.Equals(zero) && y.y.Equals(zero) {
+		return jsonFail("ProjectiveCockle", data, "both coordinates are zero", nil)
+	}
+	z.Copy(y)
+	return nil
+}
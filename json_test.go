@@ -0,0 +1,176 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	f := func(x *Hamilton, y *Cayley, z *DualComplex) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		for _, form := range []JSONForm{JSONArray, JSONObject} {
+			data, err := json.Marshal([]interface{}{
+				JSONWithForm{x, form}, JSONWithForm{y, form}, JSONWithForm{z, form},
+			})
+			if err != nil {
+				return false
+			}
+			// The values are decoded at the precisions written with them.
+			u, v, w := new(Hamilton), new(Cayley), new(DualComplex)
+			if err := json.Unmarshal(data, &[]interface{}{u, v, w}); err != nil {
+				return false
+			}
+			if !u.Equals(x) || !v.Equals(y) || !w.Equals(z) {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestJSONForms(t *testing.T) {
+	z := NewComplex(big.NewFloat(1.5), big.NewFloat(-2))
+	cases := []struct {
+		form JSONForm
+		want string
+	}{
+		{JSONArray, `["1.5@53","-2@53"]`},
+		{JSONObject, `{"real":"1.5@53","i":"-2@53"}`},
+	}
+	for _, c := range cases {
+		data, err := json.Marshal(JSONWithForm{z, c.form})
+		if err != nil || string(data) != c.want {
+			t.Errorf("form %d: Marshal = %s, %v, want %s", c.form, data, err, c.want)
+		}
+	}
+	if data, _ := json.Marshal(z); string(data) != cases[0].want {
+		t.Errorf("Marshal = %s, want %s", data, cases[0].want)
+	}
+	data, _ := json.Marshal(JSONWithForm{NewJetVariable(big.NewFloat(2), 2), JSONObject})
+	if want := `{"real":"2@53","α":"1@53","α^2":"0@0"}`; string(data) != want {
+		t.Errorf("Marshal = %s, want %s", data, want)
+	}
+	// Nested values follow the form of the wrapper.
+	b := NewBiquaternion(z, new(Complex), new(Complex), new(Complex))
+	data, _ = json.Marshal(JSONWithForm{b, JSONObject})
+	want := `{"real":{"real":"1.5@53","i":"-2@53"},"i":{"real":"0@0","i":"0@0"},` +
+		`"j":{"real":"0@0","i":"0@0"},"k":{"real":"0@0","i":"0@0"}}`
+	if string(data) != want {
+		t.Errorf("Marshal = %s, want %s", data, want)
+	}
+}
+
+func TestJSONPrecision(t *testing.T) {
+	// π at 300 bits keeps all of its digits and its precision in a zero
+	// value.
+	pi := bigPi(300)
+	x := NewComplex(pi, new(big.Float).SetPrec(100).Neg(pi))
+	data, err := json.Marshal(x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	y := new(Complex)
+	if err := json.Unmarshal(data, y); err != nil {
+		t.Fatal(err)
+	}
+	if !y.Equals(x) || y.l.Prec() != 300 || y.r.Prec() != 100 {
+		t.Errorf("Unmarshal(%s) = %v at %d and %d bits, want %v", data, y, y.l.Prec(), y.r.Prec(), x)
+	}
+	// The written precision wins over that of the receiver.
+	y.SetPrec(53)
+	if err := json.Unmarshal(data, y); err != nil || !y.Equals(x) {
+		t.Errorf("Unmarshal(%s) = %v, %v, want %v", data, y, err, x)
+	}
+	// Components missing from the object form are zero, and components
+	// without a precision are read at 64 bits.
+	z := new(Complex)
+	if err := json.Unmarshal([]byte(`{"i":"3"}`), z); err != nil || z.l.Sign() != 0 || z.r.Cmp(big.NewFloat(3)) != 0 {
+		t.Errorf("Unmarshal = %v, %v, want (0+3i)", z, err)
+	}
+	if z.l.Prec() != 64 || z.r.Prec() != 64 {
+		t.Errorf("precisions %d and %d, want 64", z.l.Prec(), z.r.Prec())
+	}
+	// Infinities and zeros may have no precision.
+	z.l.SetPrec(0).SetInf(true)
+	z.r.SetPrec(0)
+	data, _ = json.Marshal(z)
+	w := new(Complex)
+	if err := json.Unmarshal(data, w); err != nil || !w.Equals(z) || w.l.Prec() != 0 || w.r.Prec() != 0 {
+		t.Errorf("Unmarshal(%s) = %v, %v, want %v", data, w, err, z)
+	}
+}
+
+func TestJSONStructured(t *testing.T) {
+	one, two := big.NewFloat(1), big.NewFloat(2)
+	m := NewHamiltonMatrix(2, 3)
+	m.At(1, 2).Copy(NewHamilton(one, two, one, two))
+	u := NewVec3(one, two, one)
+	g := NewGrassmann(2)
+	g.c[3].SetInt64(5)
+	values := []struct{ x, y interface{} }{
+		{m, new(HamiltonMatrix)},
+		{NewComplexPoly(NewComplex(one, two), NewComplex(two, one)), new(ComplexPoly)},
+		{NewZorn(one, two, u, u), new(Zorn)},
+		{NewBiquaternion(NewComplex(one, two), new(Complex), NewComplex(two, two), new(Complex)), new(Biquaternion)},
+		{NewProjectiveHamilton(NewHamilton(one, two, one, two), new(Hamilton)), new(ProjectiveHamilton)},
+		{NewJetVariable(two, 3), new(Jet)},
+		{g, new(Grassmann)},
+	}
+	for _, form := range []JSONForm{JSONArray, JSONObject} {
+		for _, v := range values {
+			data, err := json.Marshal(JSONWithForm{v.x.(json.Marshaler), form})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal(data, v.y); err != nil {
+				t.Fatalf("Unmarshal(%s): %v", data, err)
+			}
+			if got, want := v.y.(interface{ String() string }).String(), v.x.(interface{ String() string }).String(); got != want {
+				t.Errorf("form %d: %T round trip = %s, want %s", form, v.x, got, want)
+			}
+		}
+	}
+}
+
+func TestJSONErrors(t *testing.T) {
+	cases := []string{
+		`"1.5"`,
+		`["1.5"]`,
+		`["1.5","2","3"]`,
+		`[1.5,2]`,
+		`["1.5","x"]`,
+		`{"real":"1","j":"2"}`,
+		`{"real":"1"`,
+		`["1.5@x","2"]`,
+		`["1.5@-1","2"]`,
+		`["1.5@99999999999","2"]`,
+	}
+	for _, c := range cases {
+		z := NewComplex(big.NewFloat(7), big.NewFloat(8))
+		err := z.UnmarshalJSON([]byte(c))
+		var pe *ParseError
+		if !errors.As(err, &pe) {
+			t.Errorf("Unmarshal(%s) = %v, want a *ParseError", c, err)
+		}
+		if !z.Equals(NewComplex(big.NewFloat(7), big.NewFloat(8))) {
+			t.Errorf("Unmarshal(%s) changed z to %v", c, z)
+		}
+	}
+	z := NewComplex(big.NewFloat(7), big.NewFloat(8))
+	if err := z.UnmarshalJSON([]byte("null")); err != nil || z.l.Cmp(big.NewFloat(7)) != 0 {
+		t.Errorf("UnmarshalJSON(null) = %v, %v", z, err)
+	}
+	p := new(ProjectiveComplex)
+	if err := json.Unmarshal([]byte(`[["0","0"],["0","0"]]`), p); err == nil {
+		t.Error("Unmarshal of a projective point with zero coordinates succeeded")
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math/big"
+
+// JuliaHamilton iterates the quaternionic Julia map
+// 		z ↦ z² + c
+// from z0 for at most n steps, stopping once |z| exceeds radius, and returns
+// the number of steps taken, a distance estimate, and whether the orbit
+// escaped. Along the orbit the derivative grows by |z'| ↦ 2|z||z'|, and for
+// an escaping point the distance to the Julia set is estimated by
+// 		|z| log|z| / (2|z'|)
+// which is within a factor of about two of the true distance once the radius
+// is large. For a point that does not escape the distance estimate is zero.
+// The iteration runs at the largest precision of z0 and c, so that the
+// samples of a deep zoom do not collapse onto each other. The radius should
+// be at least 2, and larger radii give better distance estimates.
+func JuliaHamilton(z0, c *Hamilton, n int, radius *big.Float) (int, *big.Float, bool) {
+	a, b, u, v := z0.Cartesian()
+	ca, cb, cu, cv := c.Cartesian()
+	prec := maxPrec(a, b, u, v, ca, cb, cu, cv)
+	w := prec + guardBits
+	z := new(Hamilton)
+	z.l.round(&z0.l, w)
+	z.r.round(&z0.r, w)
+	r2 := newFloat(w).Mul(radius, radius)
+	dr := newFloat(w).SetInt64(1)
+	for k := 0; k < n; k++ {
+		if z.Quad().Cmp(r2) > 0 {
+			return k, juliaDistance(z, dr, prec), true
+		}
+		dr.Mul(dr, z.Abs())
+		dr.SetMantExp(dr, 1)
+		z.Add(z.Mul(z, z), c)
+	}
+	if z.Quad().Cmp(r2) > 0 {
+		return n, juliaDistance(z, dr, prec), true
+	}
+	return n, newFloat(prec), false
+}
+
+// juliaDistance returns |z| log|z| / (2dr) rounded to prec bits.
+func juliaDistance(z *Hamilton, dr *big.Float, prec uint) *big.Float {
+	w := prec + guardBits
+	abs := z.Abs()
+	d := newFloat(w).Mul(abs, bigLog(abs, w))
+	d.Quo(d, dr)
+	return newFloat(prec).SetMantExp(d, -1)
+}
+
+// JuliaCayley iterates the octonionic Julia map
+// 		z ↦ z^d + c
+// from z0 for at most n steps, stopping once |z| exceeds radius, and returns
+// the number of steps taken and whether the orbit escaped. Since Mul is not
+// associative, products such as Mul(Mul(z, z), z) and Mul(z, Mul(z, z)) are
+// rounded differently, so the power is computed with PowInt, which does not
+// depend on a parenthesization, and the iteration is reproducible. It runs at
+// the largest precision of z0 and c, like JuliaHamilton. The degree d should
+// be at least 2, and the radius should be at least 2.
+func JuliaCayley(z0, c *Cayley, d, n int, radius *big.Float) (int, bool) {
+	a, b, e, f, g, h, u, v := z0.Cartesian()
+	ca, cb, ce, cf, cg, ch, cu, cv := c.Cartesian()
+	w := maxPrec(a, b, e, f, g, h, u, v, ca, cb, ce, cf, cg, ch, cu, cv) + guardBits
+	z := new(Cayley).setPrec(z0, w)
+	r2 := newFloat(w).Mul(radius, radius)
+	for k := 0; k < n; k++ {
+		if z.Quad().Cmp(r2) > 0 {
+			return k, true
+		}
+		z.Add(z.PowInt(z, d), c)
+	}
+	return n, z.Quad().Cmp(r2) > 0
+}
+
+// JuliaSedenion iterates the Julia map z ↦ z^d + c on the sedenions, with the
+// conventions of JuliaCayley.
+func JuliaSedenion(z0, c *Sedenion, d, n int, radius *big.Float) (int, bool) {
+	x, y := z0.Cartesian(), c.Cartesian()
+	w := maxPrec(append(x[:], y[:]...)...) + guardBits
+	z := new(Sedenion)
+	z.l.setPrec(&z0.l, w)
+	z.r.setPrec(&z0.r, w)
+	r2 := newFloat(w).Mul(radius, radius)
+	for k := 0; k < n; k++ {
+		if z.Quad().Cmp(r2) > 0 {
+			return k, true
+		}
+		z.Add(z.PowInt(z, d), c)
+	}
+	return n, z.Quad().Cmp(r2) > 0
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"math/cmplx"
+	"testing"
+	"testing/quick"
+)
+
+func TestJuliaHamiltonUnitSphere(t *testing.T) {
+	// For c = 0 the Julia set is the unit sphere.
+	zero := new(big.Float)
+	c := new(Hamilton)
+	z0 := NewHamilton(big.NewFloat(1.2), big.NewFloat(0.9), zero, big.NewFloat(1.2))
+	k, d, escaped := JuliaHamilton(z0, c, 100, big.NewFloat(1e10))
+	if !escaped || k == 100 {
+		t.Fatalf("JuliaHamilton(%v) = %d, %v, %t", z0, k, d, escaped)
+	}
+	// |z0| = 1.9, so the distance to the sphere is 0.9.
+	if f, _ := d.Float64(); f < 0.45 || f > 1.8 {
+		t.Errorf("distance estimate = %v", d)
+	}
+	z0 = NewHamilton(big.NewFloat(0.5), big.NewFloat(0.5), big.NewFloat(0.5), zero)
+	if k, d, escaped = JuliaHamilton(z0, c, 100, big.NewFloat(4)); escaped || k != 100 || d.Sign() != 0 {
+		t.Errorf("JuliaHamilton(%v) = %d, %v, %t", z0, k, d, escaped)
+	}
+}
+
+func TestJuliaHamiltonComplexSubalgebra(t *testing.T) {
+	// On the complex subalgebra the iteration counts agree with complex128.
+	f := func(x, y *Complex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		z0 := new(Hamilton)
+		z0.l.Scal(x, big.NewFloat(2))
+		c := new(Hamilton)
+		c.l.Scal(y, big.NewFloat(-1))
+		k, _, _ := JuliaHamilton(z0, c, 20, big.NewFloat(2))
+		a, _ := z0.l.l.Float64()
+		b, _ := z0.l.r.Float64()
+		ca, _ := c.l.l.Float64()
+		cb, _ := c.l.r.Float64()
+		z, cc := complex(a, b), complex(ca, cb)
+		j := 0
+		for ; j < 20 && cmplx.Abs(z) <= 2; j++ {
+			z = z*z + cc
+		}
+		// Allow for points whose orbit touches the escape radius.
+		return k == j || k == j+1 || k == j-1
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestJuliaCayleyUnitSphere(t *testing.T) {
+	// For c = 0 the Julia set of z ↦ z^3 is the unit sphere.
+	zero := new(big.Float)
+	c := new(Cayley)
+	z0 := NewCayley(big.NewFloat(0.6), zero, zero, big.NewFloat(0.6), zero, zero, big.NewFloat(0.6), zero)
+	if k, escaped := JuliaCayley(z0, c, 3, 100, big.NewFloat(4)); !escaped || k == 100 {
+		t.Errorf("JuliaCayley(%v) = %d, %t", z0, k, escaped)
+	}
+	z0.Scal(z0, big.NewFloat(0.9))
+	if k, escaped := JuliaCayley(z0, c, 3, 100, big.NewFloat(4)); escaped || k != 100 {
+		t.Errorf("JuliaCayley(%v) = %d, %t", z0, k, escaped)
+	}
+}
+
+func TestJuliaSedenionCayleySubalgebra(t *testing.T) {
+	// On the Cayley subalgebra the iterates are the same.
+	f := func(x, y *Cayley) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		z0, c := new(Sedenion), new(Sedenion)
+		z0.l.Scal(x, big.NewFloat(1.5))
+		c.l.Scal(y, big.NewFloat(-0.5))
+		k, escaped := JuliaSedenion(z0, c, 2, 30, big.NewFloat(2))
+		j, e := JuliaCayley(&z0.l, &c.l, 2, 30, big.NewFloat(2))
+		return k == j && escaped == e
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math/big"
+
+// lambertWGuess returns a starting point for Halley's iteration on branch k
+// of the Lambert W function, following Corless et al. Near the branch point
+// -1/e it uses the series
+// 		W ≈ -1 + p - p²/3 + 11p³/72,    p = ±√(2(ey+1))
+// and elsewhere the asymptotic form
+// 		W ≈ L1 - L2 + L2/L1,    L1 = log(y) + 2πki,    L2 = log(L1)
+// except for small arguments on the principal branch, where it uses
+// log(1+y).
+func lambertWGuess(k int, y *Complex, prec uint) *Complex {
+	e := bigExp(big.NewFloat(1), prec)
+	one := newComplexPrec(prec)
+	one.l.SetInt64(1)
+	t := new(Complex).Scal(y, e)
+	t.Add(t, one)
+	near := complexAbs(t) < 0.3
+	switch {
+	case near && (k == 0 || k == -1 && !y.r.Signbit() || k == 1 && y.r.Signbit()):
+		p := new(Complex).Sqrt(t.Scal(t, big.NewFloat(2)))
+		if k != 0 {
+			p.Neg(p)
+		}
+		// -1 + p(1 + p(-1/3 + 11p/72))
+		g := new(Complex).Scal(p, newFloat(prec).Quo(big.NewFloat(11), big.NewFloat(72)))
+		g.l.Sub(&g.l, newFloat(prec).Quo(big.NewFloat(1), big.NewFloat(3)))
+		g.Mul(g, p)
+		g.l.Add(&g.l, big.NewFloat(1))
+		g.Mul(g, p)
+		g.l.Sub(&g.l, big.NewFloat(1))
+		return g
+	case k == 0 && complexAbs(y) < 3 && complexAbs(new(Complex).Add(one, y)) > 0.5:
+		return new(Complex).Log(one.Add(one, y))
+	}
+	l1 := new(Complex).Log(y)
+	twoPi := bigPi(prec)
+	twoPi.SetMantExp(twoPi, 1)
+	l1.r.Add(&l1.r, twoPi.Mul(twoPi, newFloat(prec).SetInt64(int64(k))))
+	l2 := new(Complex).Log(l1)
+	g := new(Complex).Sub(l1, l2)
+	return g.Add(g, new(Complex).Quo(l2, l1))
+}
+
+// LambertW sets z equal to branch k of the Lambert W function of y, and
+// returns z. The value W = W[k](y) solves
+// 		W exp(W) = y
+// and the branches follow Corless et al.: W[0] is the principal branch, and
+// for k ≠ 0 the imaginary part of W[k] lies roughly between (2k-2)π and
+// (2k+1)π. On the interval (-1/e, 0), W[-1] is real when the imaginary part of
+// y is +0, and W[1] is real when it is -0.
+//
+// The value is refined with Halley's iteration
+// 		W ← W - f/(exp(W)(W+1) - (W+2)f/(2W+2)),    f = W exp(W) - y
+// at the working precision, and rounded to the precision of y. If y is zero
+// and k is not zero, then LambertW panics.
+func (z *Complex) LambertW(k int, y *Complex) *Complex {
+	prec := maxPrec(&y.l, &y.r)
+	if y.l.Sign() == 0 && y.r.Sign() == 0 {
+		if k != 0 {
+			panic("Lambert W at a singularity")
+		}
+		z.l.SetPrec(prec).SetInt64(0)
+		z.r.SetPrec(prec).SetInt64(0)
+		return z
+	}
+	w := prec + guardBits
+	x := newComplexPrec(w).round(y, w)
+	g := lambertWGuess(k, newComplexPrec(64).round(x, 64), 64)
+	g = newComplexPrec(w).round(g, w)
+	one := newComplexPrec(w)
+	one.l.SetInt64(1)
+	for i := 0; i < 4*int(w); i++ {
+		e := new(Complex).Exp(g)
+		f := new(Complex).Mul(g, e)
+		f.Sub(f, x)
+		if f.l.Sign() == 0 && f.r.Sign() == 0 {
+			break
+		}
+		g1 := new(Complex).Add(g, one)
+		d := new(Complex).Mul(e, g1)
+		h := new(Complex).Add(g1, one)
+		h.Mul(h, f)
+		h = new(Complex).Quo(h, g1.Scal(g1, big.NewFloat(2)))
+		d.Sub(d, h)
+		if d.l.Sign() == 0 && d.r.Sign() == 0 {
+			break
+		}
+		step := new(Complex).Quo(f, d)
+		g.Sub(g, step)
+		if complexExpo(step) < complexExpo(g)-int(w)+2 {
+			break
+		}
+	}
+	if realLambertW(k, x) {
+		g.r.SetInt64(0)
+	}
+	return z.Copy(newComplexPrec(prec).round(g, prec))
+}
+
+// realLambertW returns true if branch k of the Lambert W function is real at
+// y, so that rounding errors in the imaginary part can be discarded.
+func realLambertW(k int, y *Complex) bool {
+	if y.r.Sign() != 0 {
+		return false
+	}
+	t := newFloat(y.l.Prec()).Mul(&y.l, bigExp(big.NewFloat(1), y.l.Prec()))
+	if t.Cmp(big.NewFloat(-1)) < 0 {
+		return false
+	}
+	switch k {
+	case 0:
+		return true
+	case -1:
+		return y.l.Sign() < 0 && !y.r.Signbit()
+	case 1:
+		return y.l.Sign() < 0 && y.r.Signbit()
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestLambertWValues(t *testing.T) {
+	cases := []struct {
+		k    int
+		y    complex128
+		want complex128
+	}{
+		{0, 1, 0.56714329040978387300},
+		{0, math.E, 1},
+		{0, -0.2, -0.25917110181907374},
+		{-1, -0.2, -2.5426413577735265},
+		{0, 1e-30, 1e-30},
+		{0, -1, complex(-0.31813150520476413531, 1.3372357014306894089)},
+		{0, 1e300, 684.247208629761},
+	}
+	for _, c := range cases {
+		y := NewComplex(big.NewFloat(real(c.y)), big.NewFloat(imag(c.y)))
+		if got := new(Complex).LambertW(c.k, y); !closeToComplex128(got, c.want, 48) {
+			t.Errorf("LambertW(%d, %v) = %v, want %v", c.k, c.y, got, c.want)
+		}
+	}
+}
+
+func TestLambertWInverse(t *testing.T) {
+	points := [][2]float64{
+		{1, 0}, {-0.2, 0}, {-0.36, 0.01}, {-1, 0}, {-0.3, -0.001},
+		{2, 3}, {-5, -1}, {0.01, -0.02}, {1e5, 1e5}, {-1e-10, 1e-12},
+	}
+	for k := -3; k <= 3; k++ {
+		for _, p := range points {
+			y := complexPrec(p[0], p[1], 200)
+			w := new(Complex).LambertW(k, y)
+			r := new(Complex).Mul(w, new(Complex).Exp(w))
+			if d := new(Complex).Sub(r, y); complexExpo(d) > complexExpo(y)-180 {
+				t.Errorf("W[%d](%v) = %v does not invert: %v", k, p, w, r)
+			}
+			// Branch k has its imaginary part between (2k-2)π and (2k+1)π.
+			if k != 0 {
+				im, _ := w.r.Float64()
+				n := k
+				if k < 0 {
+					n, im = -k, -im
+				}
+				lo, hi := float64(2*n-2)*math.Pi, float64(2*n+1)*math.Pi
+				if im < lo || im > hi {
+					t.Errorf("W[%d](%v) = %v lies outside its branch", k, p, w)
+				}
+			}
+		}
+	}
+}
+
+func TestLambertWRealBranches(t *testing.T) {
+	// On (-1/e, 0), W[-1] is real from above and W[1] is real from below.
+	y := NewComplex(big.NewFloat(-0.1), new(big.Float))
+	if w := new(Complex).LambertW(-1, y); w.r.Sign() != 0 {
+		t.Errorf("LambertW(-1, %v) = %v, want real", y, w)
+	}
+	y.r.Neg(&y.r)
+	if w := new(Complex).LambertW(1, y); w.r.Sign() != 0 {
+		t.Errorf("LambertW(1, %v) = %v, want real", y, w)
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strings"
+)
+
+var symbMacfarlane = [4]string{"", "i", "j", "k"}
+
+// A Macfarlane represents a multi-precision floating-point hyperbolic
+// quaternion of Macfarlane, as a real part a and a vector part v in
+// three-dimensional space.
+type Macfarlane struct {
+	a big.Float
+	v Vec3
+}
+
+// components returns the four components of z, in the order a, i, j, k.
+func (z *Macfarlane) components() []*big.Float {
+	return []*big.Float{&z.a, &z.v.x, &z.v.y, &z.v.z}
+}
+
+// Real returns the real part of z.
+func (z *Macfarlane) Real() *big.Float {
+	return &z.a
+}
+
+// Cartesian returns the four multi-precision floating-point Cartesian
+// components of z.
+func (z *Macfarlane) Cartesian() (*big.Float, *big.Float, *big.Float, *big.Float) {
+	return &z.a, &z.v.x, &z.v.y, &z.v.z
+}
+
+// String returns the string representation of a Macfarlane value.
+//
+// If z corresponds to a + bi + cj + dk, then the string is"(a+bi+cj+dk)",
+// similar to complex128 values.
+func (z *Macfarlane) String() string {
+	v := z.components()
+	a := make([]string, 9)
+	a[0] = "("
+	a[1] = fmt.Sprintf("%v", v[0])
+	i := 1
+	for j := 2; j < 8; j = j + 2 {
+		if v[i].Sign() < 0 {
+			a[j] = fmt.Sprintf("%v", v[i])
+		} else {
+			a[j] = fmt.Sprintf("+%v", v[i])
+		}
+		a[j+1] = symbMacfarlane[i]
+		i++
+	}
+	a[8] = ")"
+	return strings.Join(a, "")
+}
+
+// Format implements fmt.Formatter, with the conventions of the Format method
+// of Complex.
+func (z *Macfarlane) Format(s fmt.State, verb rune) {
+	formatTerms(s, verb, z, symbMacfarlane[:], floatSlice(z.Cartesian()))
+}
+
+// Equals returns true if y and z are equal.
+func (z *Macfarlane) Equals(y *Macfarlane) bool {
+	if z.a.Cmp(&y.a) != 0 || !z.v.Equals(&y.v) {
+		return false
+	}
+	return true
+}
+
+// Copy copies y onto z, and returns z.
+func (z *Macfarlane) Copy(y *Macfarlane) *Macfarlane {
+	z.a.Copy(&y.a)
+	z.v.Copy(&y.v)
+	return z
+}
+
+// NewMacfarlane returns a pointer to the Macfarlane value a+bi+cj+dk.
+func NewMacfarlane(a, b, c, d *big.Float) *Macfarlane {
+	z := new(Macfarlane)
+	z.a.Copy(a)
+	z.v.x.Copy(b)
+	z.v.y.Copy(c)
+	z.v.z.Copy(d)
+	return z
+}
+
+// Scal sets z equal to y scaled by a, and returns z.
+func (z *Macfarlane) Scal(y *Macfarlane, a *big.Float) *Macfarlane {
+	s, t := z.components(), y.components()
+	for k := range s {
+		s[k].Mul(t[k], a)
+	}
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *Macfarlane) Neg(y *Macfarlane) *Macfarlane {
+	s, t := z.components(), y.components()
+	for k := range s {
+		s[k].Neg(t[k])
+	}
+	return z
+}
+
+// Conj sets z equal to the conjugate of y, and returns z.
+func (z *Macfarlane) Conj(y *Macfarlane) *Macfarlane {
+	z.a.Copy(&y.a)
+	s, t := z.components(), y.components()
+	for k := 1; k < 4; k++ {
+		s[k].Neg(t[k])
+	}
+	return z
+}
+
+// Add sets z equal to x+y, and returns z.
+func (z *Macfarlane) Add(x, y *Macfarlane) *Macfarlane {
+	s, t, u := z.components(), x.components(), y.components()
+	for k := range s {
+		s[k].Add(t[k], u[k])
+	}
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z.
+func (z *Macfarlane) Sub(x, y *Macfarlane) *Macfarlane {
+	s, t, u := z.components(), x.components(), y.components()
+	for k := range s {
+		s[k].Sub(t[k], u[k])
+	}
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z.
+//
+// The multiplication rules are:
+// 		Mul(i, i) = Mul(j, j) = Mul(k, k) = +1
+// 		Mul(i, j) = -Mul(j, i) = k
+// 		Mul(j, k) = -Mul(k, j) = i
+// 		Mul(k, i) = -Mul(i, k) = j
+// so that for real parts a, b and vector parts u, v the product is
+// 		ab + u·v + av + bu + u×v
+// This binary operation is noncommutative and nonassociative, and it is not
+// alternative: for example, Mul(Mul(i, i), j) = j but Mul(i, Mul(i, j)) = -j.
+// It is flexible, and Conj reverses products.
+// Unless the components of x or y differ widely in scale, the product is
+// computed exactly and then rounded once, so the result is exact whenever it
+// fits the precision of z; otherwise it is computed with rounded arithmetic.
+func (z *Macfarlane) Mul(x, y *Macfarlane) *Macfarlane {
+	prec := maxPrec(append(x.components(), y.components()...)...)
+	o := productOps(prec, z.components(), x.components(), y.components())
+	a := o.add(o.mul(&x.a, &y.a), o.dot(&x.v, &y.v))
+	v := o.comb(new(Vec3), &x.a, &y.v, &y.a, &x.v)
+	o.addVec(v, v, o.cross(new(Vec3), &x.v, &y.v))
+	roundFloat(&z.a, a, prec)
+	roundFloat(&z.v.x, &v.x, prec)
+	roundFloat(&z.v.y, &v.y, prec)
+	roundFloat(&z.v.z, &v.z, prec)
+	o.settle(z.components()...)
+	return z
+}
+
+// Commutator sets z equal to the commutator of x and y:
+// 		Mul(x, y) - Mul(y, x)
+// Then it returns z.
+func (z *Macfarlane) Commutator(x, y *Macfarlane) *Macfarlane {
+	return z.Sub(
+		new(Macfarlane).Mul(x, y),
+		new(Macfarlane).Mul(y, x),
+	)
+}
+
+// Associator sets z equal to the associator of w, x, and y:
+// 		Mul(Mul(w, x), y) - Mul(w, Mul(x, y))
+// Then it returns z.
+func (z *Macfarlane) Associator(w, x, y *Macfarlane) *Macfarlane {
+	t := new(Macfarlane).Mul(w, x)
+	t.Mul(t, y)
+	u := new(Macfarlane).Mul(x, y)
+	u.Mul(w, u)
+	return z.Sub(t, u)
+}
+
+// Quad returns the quadrance of z. If z = a+bi+cj+dk, then the quadrance is
+// 		Mul(a, a) - Mul(b, b) - Mul(c, c) - Mul(d, d)
+// which equals the real part of Mul(z, Conj(z)). This can be positive,
+// negative, or zero. Unlike the quadrance of Hamilton, it is not
+// multiplicative. The components are prescaled by a power of two, so the
+// result overflows or underflows only if the quadrance is out of the exponent
+// range of big.Float, even when the squares of the components are not.
+func (z *Macfarlane) Quad() *big.Float {
+	y, e := prescaled(z.components()...)
+	v := NewVec3(y[1], y[2], y[3])
+	quad := new(big.Float).Mul(y[0], y[0])
+	return rescaleQuad(quad.Sub(quad, v.Dot(v)), e)
+}
+
+// IsZeroDiv returns true if z is a zero divisor. This is equivalent to z
+// having zero quadrance.
+func (z *Macfarlane) IsZeroDiv() bool {
+	y, _ := prescaled(z.components()...)
+	v := NewVec3(y[1], y[2], y[3])
+	return exactOps.dot(v, v).Cmp(exactMul(y[0], y[0])) == 0
+}
+
+// Inv sets z equal to the inverse of y, and returns z. The inverse is
+// Conj(y)/Quad(y), which is both a left and a right inverse. If y is a zero
+// divisor, then Inv panics.
+func (z *Macfarlane) Inv(y *Macfarlane) *Macfarlane {
+	if y.IsZeroDiv() {
+		panic("inverse of zero divisor")
+	}
+	quad := y.Quad()
+	z.Conj(y)
+	s := z.components()
+	for k := range s {
+		s[k].Quo(s[k], quad)
+	}
+	return z
+}
+
+// Generate returns a random Macfarlane value for quick.Check testing.
+func (z *Macfarlane) Generate(rand *rand.Rand, size int) reflect.Value {
+	randomMacfarlane := new(Macfarlane)
+	for _, v := range randomMacfarlane.components() {
+		v.SetFloat64(rand.Float64())
+	}
+	return reflect.ValueOf(randomMacfarlane)
+}
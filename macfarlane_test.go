@@ -0,0 +1,109 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+// closeToMacfarlane returns true if the components of x and y agree to about
+// bits bits, relative to the larger of their modulus and 1.
+func closeToMacfarlane(x, y *Macfarlane, bits int) bool {
+	return closeToComplex(NewComplex(&x.a, &x.v.x), NewComplex(&y.a, &y.v.x), bits) &&
+		closeToComplex(NewComplex(&x.v.y, &x.v.z), NewComplex(&y.v.y, &y.v.z), bits)
+}
+
+// macfarlaneBasis returns the basis element of index k, with 0 for 1.
+func macfarlaneBasis(k int) *Macfarlane {
+	z := new(Macfarlane)
+	z.components()[k].SetInt64(1)
+	return z
+}
+
+func TestMacfarlaneBasis(t *testing.T) {
+	// {u, v, w, sign} for Mul(u, v) = sign w.
+	rules := [][4]int{
+		{1, 1, 0, 1}, {2, 2, 0, 1}, {3, 3, 0, 1},
+		{1, 2, 3, 1}, {2, 1, 3, -1},
+		{2, 3, 1, 1}, {3, 2, 1, -1},
+		{3, 1, 2, 1}, {1, 3, 2, -1},
+	}
+	for _, r := range rules {
+		want := new(Macfarlane).Scal(macfarlaneBasis(r[2]), big.NewFloat(float64(r[3])))
+		if got := new(Macfarlane).Mul(macfarlaneBasis(r[0]), macfarlaneBasis(r[1])); !got.Equals(want) {
+			t.Errorf("Mul(%s, %s) = %v, want %v", symbMacfarlane[r[0]], symbMacfarlane[r[1]], got, want)
+		}
+	}
+}
+
+func TestMacfarlaneMulNonAssociative(t *testing.T) {
+	i, j := macfarlaneBasis(1), macfarlaneBasis(2)
+	l := new(Macfarlane).Associator(i, i, j)
+	if want := new(Macfarlane).Scal(j, big.NewFloat(2)); !l.Equals(want) {
+		t.Errorf("Associator(i, i, j) = %v, want %v", l, want)
+	}
+}
+
+func TestMacfarlaneMulFlexible(t *testing.T) {
+	f := func(x, y *Macfarlane) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l, r := new(Macfarlane), new(Macfarlane)
+		l.Mul(l.Mul(x, y), x)
+		r.Mul(x, r.Mul(y, x))
+		return closeToMacfarlane(l, r, 50)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMacfarlaneConjAntiDistributive(t *testing.T) {
+	f := func(x, y *Macfarlane) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l, r := new(Macfarlane), new(Macfarlane)
+		l.Conj(l.Mul(x, y))
+		r.Mul(new(Macfarlane).Conj(y), new(Macfarlane).Conj(x))
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMacfarlaneInv(t *testing.T) {
+	f := func(x *Macfarlane) bool {
+		// t.Logf("x = %v", x)
+		// Keep the quadrance away from zero.
+		x.a.Add(&x.a, big.NewFloat(2))
+		one := macfarlaneBasis(0)
+		l := new(Macfarlane).Mul(x, new(Macfarlane).Inv(x))
+		r := new(Macfarlane).Mul(new(Macfarlane).Inv(x), x)
+		return closeToMacfarlane(l, one, 50) && closeToMacfarlane(r, one, 50)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMacfarlaneIsZeroDiv(t *testing.T) {
+	x := NewMacfarlane(big.NewFloat(1), big.NewFloat(1), new(big.Float), new(big.Float))
+	if !x.IsZeroDiv() {
+		t.Errorf("IsZeroDiv(%v) = false, want true", x)
+	}
+	if l := new(Macfarlane).Mul(x, new(Macfarlane).Conj(x)); !l.Equals(new(Macfarlane)) {
+		t.Errorf("Mul(%v, Conj(%v)) = %v, want 0", x, x, l)
+	}
+}
+
+func TestMacfarlaneCommutatorAlias(t *testing.T) {
+	f := func(x, y *Macfarlane) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		return commutatorAliased(x, y)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,304 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+//go:build mpfr && cgo
+
+package bigfloat
+
+/*
+#cgo LDFLAGS: -lmpfr -lgmp
+#include <stdlib.h>
+#include <mpfr.h>
+
+// Some of the MPFR interface is made of macros, which cgo cannot call.
+static int bf_sgn(mpfr_srcptr x) { return mpfr_sgn(x); }
+static int bf_set_str(mpfr_ptr x, const char *s) { return mpfr_set_str(x, s, 0, MPFR_RNDN); }
+static char *bf_get_str(mpfr_srcptr x) {
+	char *s;
+	if (mpfr_asprintf(&s, "%Ra", x) < 0) {
+		return NULL;
+	}
+	return s;
+}
+static void bf_free_str(char *s) { mpfr_free_str(s); }
+*/
+import "C"
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"runtime"
+	"strconv"
+	"unsafe"
+)
+
+// An MPFR is a multi-precision floating-point number held by the MPFR
+// library. Its memory is released by a finalizer.
+type MPFR struct {
+	m C.mpfr_t
+}
+
+// newMPFR returns a pointer to a NaN MPFR value with prec bits.
+func newMPFR(prec uint) *MPFR {
+	x := new(MPFR)
+	C.mpfr_init2(x.ptr(), C.mpfr_prec_t(prec))
+	runtime.SetFinalizer(x, func(x *MPFR) {
+		C.mpfr_clear(x.ptr())
+	})
+	return x
+}
+
+// ptr returns the pointer to the MPFR number of x that the library functions
+// take. It does not keep x alive, so a caller must call runtime.KeepAlive(x)
+// after the last use of the pointer, or the finalizer may clear the number
+// while the library still reads it.
+func (x *MPFR) ptr() C.mpfr_ptr {
+	return &x.m[0]
+}
+
+// setString sets x equal to the number s, correctly rounded, and returns x.
+// The string s is either decimal or hexadecimal with a binary exponent.
+func (x *MPFR) setString(s string) *MPFR {
+	cs := C.CString(s)
+	defer C.free(unsafe.Pointer(cs))
+	if C.bf_set_str(x.ptr(), cs) != 0 {
+		panic("invalid number")
+	}
+	return x
+}
+
+// String returns the exact hexadecimal representation of x.
+func (x *MPFR) String() string {
+	cs := C.bf_get_str(x.ptr())
+	if cs == nil {
+		panic("out of memory")
+	}
+	defer C.bf_free_str(cs)
+	runtime.KeepAlive(x)
+	return C.GoString(cs)
+}
+
+// Format implements fmt.Formatter. The verbs 'v' and 's' write the exact
+// representation of String; the other verbs of big.Float, with their flags,
+// width, and precision, write the value of x as a big.Float would.
+func (x *MPFR) Format(s fmt.State, verb rune) {
+	switch {
+	case formatBad(s, verb, x):
+	case verb == 'v' || verb == 's':
+		io.WriteString(s, x.String())
+	case C.mpfr_nan_p(x.ptr()) != 0:
+		io.WriteString(s, "NaN")
+	default:
+		formatFloat(s, verb, MPFRField{}.BigFloat(x), false)
+	}
+}
+
+// MPFRField is the Field of MPFR values, with every result correctly rounded
+// to nearest with ties to even at Prec bits, or at 64 bits if Prec is zero.
+// Unlike BigFloatField, it also offers the elementary transcendental functions,
+// correctly rounded for every argument by the MPFR library.
+//
+// MPFRField is only built with the mpfr build tag, and needs cgo and the MPFR
+// and GMP libraries. Since it implements Field, code written against Field can
+// select it at run time in place of BigFloatField.
+type MPFRField struct {
+	Prec uint
+}
+
+// prec returns the precision of the results of f.
+func (f MPFRField) prec() uint {
+	if f.Prec == 0 {
+		return 64
+	}
+	return f.Prec
+}
+
+// SetBigFloat returns x correctly rounded to the precision of f.
+func (f MPFRField) SetBigFloat(x *big.Float) *MPFR {
+	z := newMPFR(f.prec())
+	if x.IsInf() {
+		return z.setString(x.String())
+	}
+	return z.setString(x.Text('p', 0))
+}
+
+// BigFloat returns the exact value of x as a big.Float value with the
+// precision of x. If x is NaN, then BigFloat panics.
+func (f MPFRField) BigFloat(x *MPFR) *big.Float {
+	if C.mpfr_nan_p(x.ptr()) != 0 {
+		panic("NaN MPFR value")
+	}
+	prec := uint(C.mpfr_get_prec(x.ptr()))
+	z, _, err := big.ParseFloat(x.String(), 0, prec, big.ToNearestEven)
+	if err != nil {
+		panic(err)
+	}
+	return z
+}
+
+// FromInt64 returns n as an MPFR value.
+func (f MPFRField) FromInt64(n int64) *MPFR {
+	return newMPFR(f.prec()).setString(strconv.FormatInt(n, 10))
+}
+
+// Add returns x+y.
+func (f MPFRField) Add(x, y *MPFR) *MPFR {
+	z := newMPFR(f.prec())
+	C.mpfr_add(z.ptr(), x.ptr(), y.ptr(), C.MPFR_RNDN)
+	runtime.KeepAlive(x)
+	runtime.KeepAlive(y)
+	return z
+}
+
+// Sub returns x-y.
+func (f MPFRField) Sub(x, y *MPFR) *MPFR {
+	z := newMPFR(f.prec())
+	C.mpfr_sub(z.ptr(), x.ptr(), y.ptr(), C.MPFR_RNDN)
+	runtime.KeepAlive(x)
+	runtime.KeepAlive(y)
+	return z
+}
+
+// Mul returns x*y.
+func (f MPFRField) Mul(x, y *MPFR) *MPFR {
+	z := newMPFR(f.prec())
+	C.mpfr_mul(z.ptr(), x.ptr(), y.ptr(), C.MPFR_RNDN)
+	runtime.KeepAlive(x)
+	runtime.KeepAlive(y)
+	return z
+}
+
+// Quo returns x/y.
+func (f MPFRField) Quo(x, y *MPFR) *MPFR {
+	z := newMPFR(f.prec())
+	C.mpfr_div(z.ptr(), x.ptr(), y.ptr(), C.MPFR_RNDN)
+	runtime.KeepAlive(x)
+	runtime.KeepAlive(y)
+	return z
+}
+
+// Neg returns -x.
+func (f MPFRField) Neg(x *MPFR) *MPFR {
+	z := newMPFR(f.prec())
+	C.mpfr_neg(z.ptr(), x.ptr(), C.MPFR_RNDN)
+	runtime.KeepAlive(x)
+	return z
+}
+
+// Sign returns the sign of x.
+func (MPFRField) Sign(x *MPFR) int {
+	s := int(C.bf_sgn(x.ptr()))
+	runtime.KeepAlive(x)
+	switch {
+	case s < 0:
+		return -1
+	case s > 0:
+		return +1
+	}
+	return 0
+}
+
+// Float64 returns the float64 value nearest to x.
+func (MPFRField) Float64(x *MPFR) float64 {
+	v := float64(C.mpfr_get_d(x.ptr(), C.MPFR_RNDN))
+	runtime.KeepAlive(x)
+	return v
+}
+
+// Pi returns π.
+func (f MPFRField) Pi() *MPFR {
+	z := newMPFR(f.prec())
+	C.mpfr_const_pi(z.ptr(), C.MPFR_RNDN)
+	return z
+}
+
+// Sqrt returns the square root of x.
+func (f MPFRField) Sqrt(x *MPFR) *MPFR {
+	z := newMPFR(f.prec())
+	C.mpfr_sqrt(z.ptr(), x.ptr(), C.MPFR_RNDN)
+	runtime.KeepAlive(x)
+	return z
+}
+
+// Exp returns the exponential of x.
+func (f MPFRField) Exp(x *MPFR) *MPFR {
+	z := newMPFR(f.prec())
+	C.mpfr_exp(z.ptr(), x.ptr(), C.MPFR_RNDN)
+	runtime.KeepAlive(x)
+	return z
+}
+
+// Log returns the natural logarithm of x.
+func (f MPFRField) Log(x *MPFR) *MPFR {
+	z := newMPFR(f.prec())
+	C.mpfr_log(z.ptr(), x.ptr(), C.MPFR_RNDN)
+	runtime.KeepAlive(x)
+	return z
+}
+
+// Pow returns x raised to the power y.
+func (f MPFRField) Pow(x, y *MPFR) *MPFR {
+	z := newMPFR(f.prec())
+	C.mpfr_pow(z.ptr(), x.ptr(), y.ptr(), C.MPFR_RNDN)
+	runtime.KeepAlive(x)
+	runtime.KeepAlive(y)
+	return z
+}
+
+// Sin returns the sine of x.
+func (f MPFRField) Sin(x *MPFR) *MPFR {
+	z := newMPFR(f.prec())
+	C.mpfr_sin(z.ptr(), x.ptr(), C.MPFR_RNDN)
+	runtime.KeepAlive(x)
+	return z
+}
+
+// Cos returns the cosine of x.
+func (f MPFRField) Cos(x *MPFR) *MPFR {
+	z := newMPFR(f.prec())
+	C.mpfr_cos(z.ptr(), x.ptr(), C.MPFR_RNDN)
+	runtime.KeepAlive(x)
+	return z
+}
+
+// Tan returns the tangent of x.
+func (f MPFRField) Tan(x *MPFR) *MPFR {
+	z := newMPFR(f.prec())
+	C.mpfr_tan(z.ptr(), x.ptr(), C.MPFR_RNDN)
+	runtime.KeepAlive(x)
+	return z
+}
+
+// Atan returns the arctangent of x.
+func (f MPFRField) Atan(x *MPFR) *MPFR {
+	z := newMPFR(f.prec())
+	C.mpfr_atan(z.ptr(), x.ptr(), C.MPFR_RNDN)
+	runtime.KeepAlive(x)
+	return z
+}
+
+// Atan2 returns the argument of the point (x, y), in (-π, π].
+func (f MPFRField) Atan2(y, x *MPFR) *MPFR {
+	z := newMPFR(f.prec())
+	C.mpfr_atan2(z.ptr(), y.ptr(), x.ptr(), C.MPFR_RNDN)
+	runtime.KeepAlive(x)
+	runtime.KeepAlive(y)
+	return z
+}
+
+// Sinh returns the hyperbolic sine of x.
+func (f MPFRField) Sinh(x *MPFR) *MPFR {
+	z := newMPFR(f.prec())
+	C.mpfr_sinh(z.ptr(), x.ptr(), C.MPFR_RNDN)
+	runtime.KeepAlive(x)
+	return z
+}
+
+// Cosh returns the hyperbolic cosine of x.
+func (f MPFRField) Cosh(x *MPFR) *MPFR {
+	z := newMPFR(f.prec())
+	C.mpfr_cosh(z.ptr(), x.ptr(), C.MPFR_RNDN)
+	runtime.KeepAlive(x)
+	return z
+}
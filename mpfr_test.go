@@ -0,0 +1,89 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+//go:build mpfr && cgo
+
+package bigfloat
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestMPFRBigFloatRoundTrip(t *testing.T) {
+	f := MPFRField{Prec: 100}
+	for _, v := range []float64{0, 1, -0.375, 1.0 / 3, 1e300, -1e-300} {
+		x := big.NewFloat(v)
+		if got := f.BigFloat(f.SetBigFloat(x)); got.Cmp(x) != 0 {
+			t.Errorf("BigFloat(SetBigFloat(%v)) = %v", x, got)
+		}
+	}
+}
+
+func TestMPFRPi(t *testing.T) {
+	for _, prec := range []uint{53, 200, 1000} {
+		f := MPFRField{Prec: prec}
+		got := f.BigFloat(f.Pi())
+		if want := bigPi(prec); got.Cmp(want) != 0 {
+			t.Errorf("Pi() at %d bits = %v, want %v", prec, got, want)
+		}
+	}
+}
+
+func TestMPFRExpLog(t *testing.T) {
+	f := MPFRField{Prec: 200}
+	x := f.FromInt64(3)
+	got := f.Log(f.Exp(x))
+	if diff := f.Float64(f.Sub(got, x)); diff > 1e-55 || diff < -1e-55 {
+		t.Errorf("Log(Exp(3)) - 3 = %v", diff)
+	}
+}
+
+func TestMPFRCayleyDicksonHamilton(t *testing.T) {
+	f := MPFRField{Prec: 53}
+	alg := NewCayleyDickson[*MPFR](f, -1, -1)
+	g := func(x, y *Hamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a, b, c, d := x.Cartesian()
+		s, u, v, w := y.Cartesian()
+		got := alg.Mul(
+			[]*MPFR{f.SetBigFloat(a), f.SetBigFloat(b), f.SetBigFloat(c), f.SetBigFloat(d)},
+			[]*MPFR{f.SetBigFloat(s), f.SetBigFloat(u), f.SetBigFloat(v), f.SetBigFloat(w)},
+		)
+		p, q, r, h := new(Hamilton).Mul(x, y).Cartesian()
+		for k, want := range []*big.Float{p, q, r, h} {
+			zero := new(big.Float)
+			if !closeToComplex(NewComplex(f.BigFloat(got[k]), zero), NewComplex(want, zero), 48) {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(g, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMPFRFormatNaN(t *testing.T) {
+	f := MPFRField{Prec: 53}
+	x := f.Quo(f.FromInt64(3), f.FromInt64(2))
+	if got := fmt.Sprintf("%.3f", x); got != "1.500" {
+		t.Errorf("Sprintf(%%.3f, 3/2) = %q, want \"1.500\"", got)
+	}
+	if s := f.Sign(f.Neg(x)); s != -1 {
+		t.Errorf("Sign(-3/2) = %d, want -1", s)
+	}
+	zero := f.FromInt64(0)
+	nan := f.Quo(zero, zero)
+	if got := fmt.Sprintf("%f", nan); got != "NaN" {
+		t.Errorf("Sprintf(%%f, 0/0) = %q, want \"NaN\"", got)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("BigFloat(0/0) did not panic")
+		}
+	}()
+	f.BigFloat(nan)
+}
@@ -0,0 +1,375 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math/big"
+	"sync"
+)
+
+// An OpCode identifies the operation of an OpRecord.
+type OpCode byte
+
+const (
+	// OpInput introduces a value from outside of the computation.
+	OpInput OpCode = iota
+	// OpFromInt64 is the FromInt64 method of Field.
+	OpFromInt64
+	OpAdd
+	OpSub
+	OpMul
+	OpQuo
+	OpNeg
+	numOpCodes
+)
+
+var opNames = [...]string{"Input", "FromInt64", "Add", "Sub", "Mul", "Quo", "Neg"}
+
+// String returns the name of op, such as "Add".
+func (op OpCode) String() string {
+	if op < numOpCodes {
+		return opNames[op]
+	}
+	return fmt.Sprintf("OpCode(%d)", byte(op))
+}
+
+// arity returns the number of operands of op.
+func (op OpCode) arity() int {
+	switch op {
+	case OpAdd, OpSub, OpMul, OpQuo:
+		return 2
+	case OpNeg:
+		return 1
+	}
+	return 0
+}
+
+// An OpRecord is one operation of an OpLog.
+type OpRecord struct {
+	Op OpCode
+	// Prec is the precision of the result.
+	Prec uint
+	// Args are the hashes of the operands, as returned by HashFloat.
+	Args []uint64
+	// Result is the hash of the result.
+	Result uint64
+	// Value is the encoding of the GobEncode method of big.Float of the value
+	// of an OpInput record, or the varint of the integer of an OpFromInt64
+	// record, and nil otherwise.
+	Value []byte
+}
+
+// HashFloat returns the 64-bit FNV-1a hash of the GobEncode encoding of x,
+// which covers its value, precision, rounding mode, and accuracy.
+func HashFloat(x *big.Float) uint64 {
+	b, _ := x.GobEncode()
+	h := fnv.New64a()
+	h.Write(b)
+	return h.Sum64()
+}
+
+// An OpLog is a sequence of operations on big.Float values, in which each
+// operand is named by its hash. Values from outside of the computation enter
+// through OpInput records, which hold the values themselves, so an OpLog is
+// self-contained: Replay re-executes it on any machine and checks every
+// result against its recorded hash.
+type OpLog struct {
+	Records []OpRecord
+}
+
+// opLogMagic begins the encoding of WriteTo, followed by a version byte.
+var opLogMagic = []byte("BFOL\x01")
+
+// WriteTo implements io.WriterTo. It writes l in a compact binary form: each
+// record is its op code, its precision as a uvarint, the hashes of its
+// operands and its result as 8 bytes each, and, for OpInput and OpFromInt64,
+// the length and bytes of its value.
+func (l *OpLog) WriteTo(w io.Writer) (int64, error) {
+	var b bytes.Buffer
+	b.Write(opLogMagic)
+	var h [8]byte
+	for _, r := range l.Records {
+		b.WriteByte(byte(r.Op))
+		b.Write(binary.AppendUvarint(nil, uint64(r.Prec)))
+		for _, a := range r.Args {
+			binary.BigEndian.PutUint64(h[:], a)
+			b.Write(h[:])
+		}
+		binary.BigEndian.PutUint64(h[:], r.Result)
+		b.Write(h[:])
+		if r.Op == OpInput || r.Op == OpFromInt64 {
+			b.Write(binary.AppendUvarint(nil, uint64(len(r.Value))))
+			b.Write(r.Value)
+		}
+	}
+	return b.WriteTo(w)
+}
+
+// errOpLog is the error of ReadFrom for a malformed encoding.
+var errOpLog = errors.New("bigfloat: malformed operation log")
+
+// ReadFrom implements io.ReaderFrom. It replaces the records of l with those
+// read from r, in the form of WriteTo. If the encoding is malformed, then
+// ReadFrom returns an error and leaves l unchanged.
+func (l *OpLog) ReadFrom(r io.Reader) (int64, error) {
+	cr := &countingReader{r: bufio.NewReader(r)}
+	magic := make([]byte, len(opLogMagic))
+	if _, err := io.ReadFull(cr, magic); err != nil || !bytes.Equal(magic, opLogMagic) {
+		return cr.n, errOpLog
+	}
+	var records []OpRecord
+	for {
+		op, err := cr.ReadByte()
+		if err == io.EOF {
+			break
+		}
+		if err != nil || OpCode(op) >= numOpCodes {
+			return cr.n, errOpLog
+		}
+		rec := OpRecord{Op: OpCode(op)}
+		prec, err := binary.ReadUvarint(cr)
+		if err != nil || prec > big.MaxPrec {
+			return cr.n, errOpLog
+		}
+		rec.Prec = uint(prec)
+		h := make([]uint64, rec.Op.arity()+1)
+		for k := range h {
+			if err := binary.Read(cr, binary.BigEndian, &h[k]); err != nil {
+				return cr.n, errOpLog
+			}
+		}
+		if len(h) > 1 {
+			rec.Args = h[:len(h)-1]
+		}
+		rec.Result = h[len(h)-1]
+		if rec.Op == OpInput || rec.Op == OpFromInt64 {
+			n, err := binary.ReadUvarint(cr)
+			if err != nil || n > 1<<32 {
+				return cr.n, errOpLog
+			}
+			rec.Value = make([]byte, n)
+			if _, err := io.ReadFull(cr, rec.Value); err != nil {
+				return cr.n, errOpLog
+			}
+		}
+		records = append(records, rec)
+	}
+	l.Records = records
+	return cr.n, nil
+}
+
+// countingReader is a buffered reader that counts the bytes read.
+type countingReader struct {
+	r *bufio.Reader
+	n int64
+}
+
+// Read implements io.Reader.
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// ReadByte implements io.ByteReader.
+func (c *countingReader) ReadByte() (byte, error) {
+	b, err := c.r.ReadByte()
+	if err == nil {
+		c.n++
+	}
+	return b, err
+}
+
+// A ReplayError reports the first record of an OpLog that Replay cannot
+// reproduce.
+type ReplayError struct {
+	// Step is the index of the record.
+	Step int
+	Op   OpCode
+	// Msg describes the problem.
+	Msg string
+}
+
+// Error returns the string version of a ReplayError value.
+func (e *ReplayError) Error() string {
+	return fmt.Sprintf("bigfloat: replay of step %d (%v): %s", e.Step, e.Op, e.Msg)
+}
+
+// Replay re-executes the records of l in order, and returns their results.
+// Each result is checked against its recorded hash, so a successful replay
+// reproduces the computation bit for bit. If an operand is unknown, a value is
+// malformed, or a result differs, then Replay returns the results before that
+// record and a *ReplayError.
+func (l *OpLog) Replay() ([]*big.Float, error) {
+	values := make(map[uint64]*big.Float)
+	results := make([]*big.Float, 0, len(l.Records))
+	for k, r := range l.Records {
+		fail := func(msg string) ([]*big.Float, error) {
+			return results, &ReplayError{k, r.Op, msg}
+		}
+		if len(r.Args) != r.Op.arity() {
+			return fail(fmt.Sprintf("want %d operands, got %d", r.Op.arity(), len(r.Args)))
+		}
+		x := make([]*big.Float, len(r.Args))
+		for i, a := range r.Args {
+			if x[i] = values[a]; x[i] == nil {
+				return fail(fmt.Sprintf("unknown operand %016x", a))
+			}
+		}
+		f := BigFloatField{Prec: r.Prec}
+		var z *big.Float
+		switch r.Op {
+		case OpInput:
+			z = new(big.Float)
+			if err := z.GobDecode(r.Value); err != nil {
+				return fail("malformed value")
+			}
+		case OpFromInt64:
+			n, size := binary.Varint(r.Value)
+			if size <= 0 || size != len(r.Value) {
+				return fail("malformed value")
+			}
+			z = f.FromInt64(n)
+		case OpAdd:
+			z = f.Add(x[0], x[1])
+		case OpSub:
+			z = f.Sub(x[0], x[1])
+		case OpMul:
+			z = f.Mul(x[0], x[1])
+		case OpQuo:
+			z = f.Quo(x[0], x[1])
+		case OpNeg:
+			z = f.Neg(x[0])
+		default:
+			return fail("unknown op code")
+		}
+		if HashFloat(z) != r.Result {
+			return fail(fmt.Sprintf("result %v does not match its hash", z))
+		}
+		values[r.Result] = z
+		results = append(results, z)
+	}
+	return results, nil
+}
+
+// A RecordingField is a BigFloatField that records every operation that
+// produces a value in an OpLog, so that a long computation with the generic
+// code of CayleyDickson can be reproduced exactly on another machine, or
+// replayed step by step for debugging. Only the operations carried out through
+// the field are recorded: the methods of the concrete types, such as Mul on
+// Hamilton, use big.Float directly and leave no trace, so only computations
+// with CayleyDickson[*big.Float], or other code written against Field, are
+// logged. Operands that were not produced by the field are recorded as OpInput
+// records the first time they are seen. Sign and Float64 produce no values,
+// and are not recorded. A RecordingField is safe for concurrent use.
+type RecordingField struct {
+	big BigFloatField
+
+	mu    sync.Mutex
+	known map[uint64]bool
+	log   OpLog
+}
+
+// NewRecordingField returns a RecordingField whose results are rounded to
+// prec bits, or to 64 bits if prec is zero.
+func NewRecordingField(prec uint) *RecordingField {
+	return &RecordingField{big: BigFloatField{Prec: prec}, known: make(map[uint64]bool)}
+}
+
+// Log returns a copy of the operations recorded so far.
+func (f *RecordingField) Log() *OpLog {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return &OpLog{append([]OpRecord(nil), f.log.Records...)}
+}
+
+// Reset clears the recorded operations.
+func (f *RecordingField) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.known = make(map[uint64]bool)
+	f.log.Records = nil
+}
+
+// input returns the hash of x, and records x as an input if it is new. The
+// caller must hold the lock.
+func (f *RecordingField) input(x *big.Float) uint64 {
+	h := HashFloat(x)
+	if !f.known[h] {
+		b, _ := x.GobEncode()
+		f.log.Records = append(f.log.Records, OpRecord{Op: OpInput, Prec: x.Prec(), Result: h, Value: b})
+		f.known[h] = true
+	}
+	return h
+}
+
+// record logs the operation op that produced z from the operands x, with the
+// value of OpFromInt64, and returns z.
+func (f *RecordingField) record(op OpCode, value []byte, z *big.Float, x ...*big.Float) *big.Float {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var args []uint64
+	for _, v := range x {
+		args = append(args, f.input(v))
+	}
+	h := HashFloat(z)
+	f.log.Records = append(f.log.Records, OpRecord{op, f.big.prec(), args, h, value})
+	f.known[h] = true
+	return z
+}
+
+// FromFloat returns x, and records it as an input.
+func (f *RecordingField) FromFloat(x *big.Float) *big.Float {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.input(x)
+	return x
+}
+
+// FromInt64 returns n as a scalar.
+func (f *RecordingField) FromInt64(n int64) *big.Float {
+	return f.record(OpFromInt64, binary.AppendVarint(nil, n), f.big.FromInt64(n))
+}
+
+// Add returns x+y.
+func (f *RecordingField) Add(x, y *big.Float) *big.Float {
+	return f.record(OpAdd, nil, f.big.Add(x, y), x, y)
+}
+
+// Sub returns x-y.
+func (f *RecordingField) Sub(x, y *big.Float) *big.Float {
+	return f.record(OpSub, nil, f.big.Sub(x, y), x, y)
+}
+
+// Mul returns x*y.
+func (f *RecordingField) Mul(x, y *big.Float) *big.Float {
+	return f.record(OpMul, nil, f.big.Mul(x, y), x, y)
+}
+
+// Quo returns x/y.
+func (f *RecordingField) Quo(x, y *big.Float) *big.Float {
+	return f.record(OpQuo, nil, f.big.Quo(x, y), x, y)
+}
+
+// Neg returns -x.
+func (f *RecordingField) Neg(x *big.Float) *big.Float {
+	return f.record(OpNeg, nil, f.big.Neg(x), x)
+}
+
+// Sign returns the sign of x.
+func (f *RecordingField) Sign(x *big.Float) int {
+	return x.Sign()
+}
+
+// Float64 returns the float64 value nearest to x.
+func (f *RecordingField) Float64(x *big.Float) float64 {
+	return f.big.Float64(x)
+}
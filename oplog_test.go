@@ -0,0 +1,93 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+)
+
+// recordedInverse returns the inverse of a Cayley value computed by f, and the
+// components of the value.
+func recordedInverse(f *RecordingField) []*big.Float {
+	alg := NewCayleyDickson[*big.Float](f, -1, -1, -1)
+	x := alg.Zero()
+	for k := range x {
+		x[k] = f.FromFloat(new(big.Float).SetPrec(200).SetFloat64(float64(k+1) / 3))
+	}
+	// Repeated squaring makes a chain of dependent operations.
+	for k := 0; k < 5; k++ {
+		x = alg.Mul(x, x)
+	}
+	return alg.Inv(x)
+}
+
+func TestRecordingFieldReplay(t *testing.T) {
+	f := NewRecordingField(200)
+	want := recordedInverse(f)
+	var buf bytes.Buffer
+	if _, err := f.Log().WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	l := new(OpLog)
+	if _, err := l.ReadFrom(bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatal(err)
+	}
+	if len(l.Records) != len(f.Log().Records) {
+		t.Fatalf("read %d records, want %d", len(l.Records), len(f.Log().Records))
+	}
+	results, err := l.Replay()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The last results are the components of the inverse.
+	got := results[len(results)-len(want):]
+	for k := range want {
+		if got[k].Cmp(want[k]) != 0 || got[k].Prec() != want[k].Prec() {
+			t.Errorf("component %d = %v, want %v", k, got[k], want[k])
+		}
+	}
+	f.Reset()
+	if len(f.Log().Records) != 0 {
+		t.Errorf("Log() after Reset has %d records", len(f.Log().Records))
+	}
+}
+
+func TestRecordingFieldInputs(t *testing.T) {
+	f := NewRecordingField(64)
+	x := big.NewFloat(1.5)
+	// An operand from outside of the field is recorded once.
+	f.Add(f.Mul(x, x), x)
+	l := f.Log()
+	ops := []OpCode{OpInput, OpMul, OpAdd}
+	if len(l.Records) != len(ops) {
+		t.Fatalf("Records = %v, want op codes %v", l.Records, ops)
+	}
+	for k, op := range ops {
+		if l.Records[k].Op != op {
+			t.Errorf("Records[%d].Op = %v, want %v", k, l.Records[k].Op, op)
+		}
+	}
+	if h := HashFloat(x); l.Records[1].Args[0] != h || l.Records[2].Args[1] != h {
+		t.Errorf("operand hashes %v and %v, want %x", l.Records[1].Args, l.Records[2].Args, h)
+	}
+}
+
+func TestReplayMismatch(t *testing.T) {
+	f := NewRecordingField(100)
+	recordedInverse(f)
+	l := f.Log()
+	// A result computed at another precision does not reproduce.
+	l.Records[20].Prec = 99
+	_, err := l.Replay()
+	var re *ReplayError
+	if !errors.As(err, &re) || re.Step != 20 {
+		t.Errorf("Replay() = %v, want a *ReplayError at step 20", err)
+	}
+	if _, err := new(OpLog).ReadFrom(bytes.NewReader([]byte("BFOL\x01\x02"))); err == nil {
+		t.Error("ReadFrom of a truncated log succeeded")
+	}
+}
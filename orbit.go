@@ -0,0 +1,201 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math/big"
+
+// An Orbit describes how the iteration of a map ended.
+type Orbit struct {
+	// Steps is the number of times the map was applied.
+	Steps int
+	// Escaped is true if the last point lies outside the escape radius.
+	Escaped bool
+	// Period is the length of the cycle that was found, or zero if the
+	// iteration stopped without finding one.
+	Period int
+	// Start is the index of the first point of the cycle.
+	Start int
+}
+
+// brentOrbit runs Brent's cycle detection on an orbit whose point i is
+// produced by the i-th call of next, with point 0 given. The function same
+// reports whether two points agree to within the tolerance, and escaped
+// whether a point lies outside the escape radius. At most n steps are taken.
+func brentOrbit(n int, next func(), same func(i, j int) bool, escaped func(i int) bool) Orbit {
+	if escaped(0) {
+		return Orbit{Escaped: true}
+	}
+	if n == 0 {
+		return Orbit{}
+	}
+	next()
+	power, lam, t, h := 1, 1, 0, 1
+	for {
+		if escaped(h) {
+			return Orbit{Steps: h, Escaped: true}
+		}
+		if same(t, h) {
+			break
+		}
+		if h == n {
+			return Orbit{Steps: h}
+		}
+		if power == lam {
+			t = h
+			power *= 2
+			lam = 0
+		}
+		next()
+		h++
+		lam++
+	}
+	// The hare is lam steps ahead of the tortoise, so the first repeat at
+	// that distance is the start of the cycle.
+	mu := 0
+	for !same(mu, mu+lam) {
+		mu++
+	}
+	return Orbit{Steps: h, Period: lam, Start: mu}
+}
+
+// OrbitComplex iterates f starting from z0 for at most n steps, and returns
+// the points of the orbit together with a description of how it ended. The
+// map f receives a copy of the current point. The iteration stops when it
+// finds a cycle with Brent's algorithm, where two points are taken as equal
+// if they are within tol of each other, or when a point lies outside the
+// escape radius. If tol is nil, then points must be exactly equal; if radius
+// is nil, then escape is not checked.
+func OrbitComplex(f func(z *Complex) *Complex, z0 *Complex, n int, tol, radius *big.Float) ([]*Complex, Orbit) {
+	points := []*Complex{new(Complex).Copy(z0)}
+	next := func() {
+		last := new(Complex).Copy(points[len(points)-1])
+		points = append(points, new(Complex).Copy(f(last)))
+	}
+	same := func(i, j int) bool {
+		if tol == nil {
+			return points[i].Equals(points[j])
+		}
+		return withinTol(new(Complex).Sub(points[i], points[j]).Quad(), tol)
+	}
+	escaped := func(i int) bool {
+		return radius != nil && !withinTol(points[i].Quad(), radius)
+	}
+	o := brentOrbit(n, next, same, escaped)
+	return points, o
+}
+
+// OrbitHamilton iterates f starting from z0 for at most n steps, and returns
+// the points of the orbit together with a description of how it ended. It
+// follows the conventions of OrbitComplex.
+func OrbitHamilton(f func(z *Hamilton) *Hamilton, z0 *Hamilton, n int, tol, radius *big.Float) ([]*Hamilton, Orbit) {
+	points := []*Hamilton{new(Hamilton).Copy(z0)}
+	next := func() {
+		last := new(Hamilton).Copy(points[len(points)-1])
+		points = append(points, new(Hamilton).Copy(f(last)))
+	}
+	same := func(i, j int) bool {
+		if tol == nil {
+			return points[i].Equals(points[j])
+		}
+		return withinTol(new(Hamilton).Sub(points[i], points[j]).Quad(), tol)
+	}
+	escaped := func(i int) bool {
+		return radius != nil && !withinTol(points[i].Quad(), radius)
+	}
+	o := brentOrbit(n, next, same, escaped)
+	return points, o
+}
+
+// OrbitCayley iterates f starting from z0 for at most n steps. It follows the
+// conventions of OrbitComplex. Since Mul is not associative, f should compute
+// powers with PowInt, so that the orbit does not depend on how its products
+// are parenthesized.
+func OrbitCayley(f func(z *Cayley) *Cayley, z0 *Cayley, n int, tol, radius *big.Float) ([]*Cayley, Orbit) {
+	points := []*Cayley{new(Cayley).Copy(z0)}
+	next := func() {
+		last := new(Cayley).Copy(points[len(points)-1])
+		points = append(points, new(Cayley).Copy(f(last)))
+	}
+	same := func(i, j int) bool {
+		if tol == nil {
+			return points[i].Equals(points[j])
+		}
+		return withinTol(new(Cayley).Sub(points[i], points[j]).Quad(), tol)
+	}
+	escaped := func(i int) bool {
+		return radius != nil && !withinTol(points[i].Quad(), radius)
+	}
+	o := brentOrbit(n, next, same, escaped)
+	return points, o
+}
+
+// OrbitSedenion iterates f starting from z0 for at most n steps. It follows
+// the conventions of OrbitCayley.
+func OrbitSedenion(f func(z *Sedenion) *Sedenion, z0 *Sedenion, n int, tol, radius *big.Float) ([]*Sedenion, Orbit) {
+	points := []*Sedenion{new(Sedenion).Copy(z0)}
+	next := func() {
+		last := new(Sedenion).Copy(points[len(points)-1])
+		points = append(points, new(Sedenion).Copy(f(last)))
+	}
+	same := func(i, j int) bool {
+		if tol == nil {
+			return points[i].Equals(points[j])
+		}
+		return withinTol(new(Sedenion).Sub(points[i], points[j]).Quad(), tol)
+	}
+	escaped := func(i int) bool {
+		return radius != nil && !withinTol(points[i].Quad(), radius)
+	}
+	o := brentOrbit(n, next, same, escaped)
+	return points, o
+}
+
+// OrbitComplexVerified computes the orbit of OrbitComplex with the
+// components of z0 at their own precision, and then again at twice that
+// precision, doubling until two successive orbits end in the same way or the
+// precision exceeds limit. It returns the last orbit, and true if its
+// description is confirmed by the run before it. The map f should compute at
+// the precision of its argument, so that an outcome which is an artifact of
+// rounding is exposed by the escalation.
+func OrbitComplexVerified(f func(z *Complex) *Complex, z0 *Complex, n int, tol, radius *big.Float, limit uint) ([]*Complex, Orbit, bool) {
+	prec := maxPrec(&z0.l, &z0.r)
+	var prev Orbit
+	for k := 0; ; k++ {
+		points, o := OrbitComplex(f, newComplexPrec(prec).round(z0, prec), n, tol, radius)
+		if k > 0 && o == prev {
+			return points, o, true
+		}
+		if 2*prec > limit {
+			return points, o, false
+		}
+		prev = o
+		prec *= 2
+	}
+}
+
+// OrbitHamiltonVerified computes the orbit of OrbitHamilton with escalating
+// precision. It follows the conventions of OrbitComplexVerified.
+func OrbitHamiltonVerified(f func(z *Hamilton) *Hamilton, z0 *Hamilton, n int, tol, radius *big.Float, limit uint) ([]*Hamilton, Orbit, bool) {
+	prec := maxPrec(&z0.l.l, &z0.l.r, &z0.r.l, &z0.r.r)
+	var prev Orbit
+	for k := 0; ; k++ {
+		y := new(Hamilton)
+		y.l.Copy(newComplexPrec(prec).round(&z0.l, prec))
+		y.r.Copy(newComplexPrec(prec).round(&z0.r, prec))
+		points, o := OrbitHamilton(f, y, n, tol, radius)
+		if k > 0 && o == prev {
+			return points, o, true
+		}
+		if 2*prec > limit {
+			return points, o, false
+		}
+		prev = o
+		prec *= 2
+	}
+}
+
+// withinTol returns true if the quadrance q is at most tol².
+func withinTol(q, tol *big.Float) bool {
+	return q.Cmp(new(big.Float).Mul(tol, tol)) <= 0
+}
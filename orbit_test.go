@@ -0,0 +1,103 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+)
+
+// quadraticMap returns the map z ↦ z² + c.
+func quadraticMap(c *Complex) func(z *Complex) *Complex {
+	return func(z *Complex) *Complex {
+		return z.Add(z.Mul(z, z), c)
+	}
+}
+
+func TestOrbitComplexEscape(t *testing.T) {
+	zero := new(big.Float)
+	f := quadraticMap(NewComplex(big.NewFloat(1), zero))
+	points, o := OrbitComplex(f, new(Complex), 100, nil, big.NewFloat(10))
+	// 0, 1, 2, 5, 26
+	if !o.Escaped || o.Steps != 4 || len(points) != 5 {
+		t.Errorf("orbit = %v, %+v", points, o)
+	}
+}
+
+func TestOrbitComplexCycle(t *testing.T) {
+	zero := new(big.Float)
+	f := quadraticMap(NewComplex(big.NewFloat(-1), zero))
+	// 0.5, -0.75, -0.4375, ... is attracted to the cycle 0, -1.
+	points, o := OrbitComplex(f, NewComplex(big.NewFloat(0.5), zero), 1000, big.NewFloat(1e-12), big.NewFloat(2))
+	if o.Escaped || o.Period != 2 {
+		t.Fatalf("orbit = %+v", o)
+	}
+	if d := new(Complex).Sub(points[o.Start], points[o.Start+2]).Quad(); d.Cmp(big.NewFloat(1e-24)) > 0 {
+		t.Errorf("points %d and %d differ by %v", o.Start, o.Start+2, d)
+	}
+	// From 0 the cycle is exact.
+	_, o = OrbitComplex(f, new(Complex), 10, nil, nil)
+	if o.Period != 2 || o.Start != 0 {
+		t.Errorf("orbit of 0 = %+v", o)
+	}
+}
+
+func TestOrbitHamiltonCycle(t *testing.T) {
+	zero := new(big.Float)
+	i := NewHamilton(zero, big.NewFloat(1), zero, zero)
+	f := func(z *Hamilton) *Hamilton {
+		return z.Mul(i, z)
+	}
+	z0 := NewHamilton(big.NewFloat(1), big.NewFloat(2), big.NewFloat(3), big.NewFloat(4))
+	points, o := OrbitHamilton(f, z0, 100, nil, nil)
+	if o.Period != 4 || o.Start != 0 || !points[4].Equals(z0) {
+		t.Errorf("orbit = %v, %+v", points, o)
+	}
+}
+
+func TestOrbitCayleyCycle(t *testing.T) {
+	l := cayleyBasis(4)
+	f := func(z *Cayley) *Cayley {
+		return z.Mul(l, z)
+	}
+	z0 := NewCayley(big.NewFloat(1), big.NewFloat(2), big.NewFloat(3), big.NewFloat(4),
+		big.NewFloat(5), big.NewFloat(6), big.NewFloat(7), big.NewFloat(8))
+	points, o := OrbitCayley(f, z0, 100, nil, nil)
+	if o.Period != 4 || o.Start != 0 || !points[4].Equals(z0) {
+		t.Errorf("orbit = %v, %+v", points, o)
+	}
+	// The cube map escapes from outside the unit sphere: |z| grows from
+	// about 14.3 to 2.9e3 and then 2.5e10.
+	g := func(z *Cayley) *Cayley {
+		return z.PowInt(z, 3)
+	}
+	if _, o = OrbitCayley(g, z0, 100, nil, big.NewFloat(1e6)); !o.Escaped || o.Steps != 2 {
+		t.Errorf("orbit = %+v", o)
+	}
+}
+
+func TestOrbitSedenionCycle(t *testing.T) {
+	e := sedenionBasis(9)
+	f := func(z *Sedenion) *Sedenion {
+		return z.Mul(e, z)
+	}
+	z0 := new(Sedenion).Add(sedenionBasis(0), sedenionBasis(15))
+	points, o := OrbitSedenion(f, z0, 100, nil, nil)
+	if o.Period != 4 || o.Start != 0 || !points[4].Equals(z0) {
+		t.Errorf("orbit = %v, %+v", points, o)
+	}
+}
+
+func TestOrbitComplexVerified(t *testing.T) {
+	zero := new(big.Float)
+	f := quadraticMap(NewComplex(big.NewFloat(-0.5), zero))
+	_, o, ok := OrbitComplexVerified(f, NewComplex(big.NewFloat(0.25), zero), 1000, big.NewFloat(1e-10), big.NewFloat(2), 512)
+	if !ok || o.Period != 1 {
+		t.Errorf("orbit = %+v, %t", o, ok)
+	}
+	_, _, ok = OrbitComplexVerified(f, NewComplex(big.NewFloat(0.25), zero), 5, big.NewFloat(1e-10), big.NewFloat(2), 53)
+	if ok {
+		t.Error("orbit confirmed without a second run")
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math/big"
+
+// A recurrence returns the coefficients α, β, and γ of the three-term
+// recurrence
+// 		γ P[k+1] = α x P[k] - β P[k-1]
+// satisfied by a family of orthogonal polynomials, with P[0] = 1.
+type recurrence func(k int64) (alpha, beta, gamma int64)
+
+// chebyshevT is the recurrence of the Chebyshev polynomials of the first kind.
+func chebyshevT(k int64) (int64, int64, int64) {
+	if k == 0 {
+		return 1, 0, 1
+	}
+	return 2, 1, 1
+}
+
+// chebyshevU is the recurrence of the Chebyshev polynomials of the second kind.
+func chebyshevU(k int64) (int64, int64, int64) {
+	return 2, 1, 1
+}
+
+// legendre is the recurrence of the Legendre polynomials.
+func legendre(k int64) (int64, int64, int64) {
+	return 2*k + 1, k, k + 1
+}
+
+// evalRecurrence returns the two components of P[n](a + bu), where u² = sq,
+// computed at precision prec with the recurrence rec. If n is negative, then
+// evalRecurrence panics.
+func evalRecurrence(n int, a, b *big.Float, sq int, rec recurrence, prec uint) (*big.Float, *big.Float) {
+	if n < 0 {
+		panic("negative degree")
+	}
+	w := prec + guardBits
+	p0l, p0r := newFloat(w), newFloat(w)
+	p1l, p1r := newFloat(w).SetInt64(1), newFloat(w)
+	nl, nr, temp, c := newFloat(w), newFloat(w), newFloat(w), newFloat(w)
+	for k := int64(0); k < int64(n); k++ {
+		alpha, beta, gamma := rec(k)
+		// x P[k]
+		nl.Mul(a, p1l)
+		temp.Mul(b, p1r)
+		if sq < 0 {
+			nl.Sub(nl, temp)
+		} else {
+			nl.Add(nl, temp)
+		}
+		nr.Mul(a, p1r)
+		nr.Add(nr, temp.Mul(b, p1l))
+		// α x P[k] - β P[k-1]
+		c.SetInt64(alpha)
+		nl.Mul(nl, c)
+		nr.Mul(nr, c)
+		c.SetInt64(beta)
+		nl.Sub(nl, temp.Mul(p0l, c))
+		nr.Sub(nr, temp.Mul(p0r, c))
+		if gamma != 1 {
+			c.SetInt64(gamma)
+			nl.Quo(nl, c)
+			nr.Quo(nr, c)
+		}
+		p0l, p1l, nl = p1l, nl, p0l
+		p0r, p1r, nr = p1r, nr, p0r
+	}
+	return newFloat(prec).Set(p1l), newFloat(prec).Set(p1r)
+}
+
+// orthoPrec returns the precision of the receiver components l and r, or the
+// precision of the argument components a and b if the receiver has zero
+// precision.
+func orthoPrec(l, r, a, b *big.Float) uint {
+	if l.Prec() != 0 || r.Prec() != 0 {
+		return maxPrec(l, r)
+	}
+	return maxPrec(a, b)
+}
+
+// ChebyshevT sets z equal to the Chebyshev polynomial of the first kind of
+// degree n evaluated at x, and returns z. The polynomial is evaluated with the
+// recurrence
+// 		T[k+1](x) = 2x T[k](x) - T[k-1](x)
+// at the precision of z, or at the precision of x if z has zero precision. If
+// n is negative, then ChebyshevT panics.
+func (z *Complex) ChebyshevT(n int, x *Complex) *Complex {
+	return z.setRecurrence(n, x, chebyshevT)
+}
+
+// ChebyshevU sets z equal to the Chebyshev polynomial of the second kind of
+// degree n evaluated at x, and returns z. The polynomial is evaluated with the
+// recurrence
+// 		U[k+1](x) = 2x U[k](x) - U[k-1](x)
+// at the precision of z, or at the precision of x if z has zero precision. If
+// n is negative, then ChebyshevU panics.
+func (z *Complex) ChebyshevU(n int, x *Complex) *Complex {
+	return z.setRecurrence(n, x, chebyshevU)
+}
+
+// Legendre sets z equal to the Legendre polynomial of degree n evaluated at x,
+// and returns z. The polynomial is evaluated with Bonnet's recurrence
+// 		(k+1)P[k+1](x) = (2k+1)x P[k](x) - k P[k-1](x)
+// at the precision of z, or at the precision of x if z has zero precision. If
+// n is negative, then Legendre panics.
+func (z *Complex) Legendre(n int, x *Complex) *Complex {
+	return z.setRecurrence(n, x, legendre)
+}
+
+// setRecurrence sets z equal to P[n](x) for the polynomials defined by rec,
+// and returns z.
+func (z *Complex) setRecurrence(n int, x *Complex, rec recurrence) *Complex {
+	prec := orthoPrec(&z.l, &z.r, &x.l, &x.r)
+	l, r := evalRecurrence(n, &x.l, &x.r, -1, rec, prec)
+	z.l.SetPrec(prec).Set(l)
+	z.r.SetPrec(prec).Set(r)
+	return z
+}
+
+// ChebyshevT sets z equal to the Chebyshev polynomial of the first kind of
+// degree n evaluated at x, and returns z. The polynomial is evaluated with the
+// recurrence
+// 		T[k+1](x) = 2x T[k](x) - T[k-1](x)
+// at the precision of z, or at the precision of x if z has zero precision. If
+// n is negative, then ChebyshevT panics.
+func (z *Perplex) ChebyshevT(n int, x *Perplex) *Perplex {
+	return z.setRecurrence(n, x, chebyshevT)
+}
+
+// ChebyshevU sets z equal to the Chebyshev polynomial of the second kind of
+// degree n evaluated at x, and returns z. The polynomial is evaluated with the
+// recurrence
+// 		U[k+1](x) = 2x U[k](x) - U[k-1](x)
+// at the precision of z, or at the precision of x if z has zero precision. If
+// n is negative, then ChebyshevU panics.
+func (z *Perplex) ChebyshevU(n int, x *Perplex) *Perplex {
+	return z.setRecurrence(n, x, chebyshevU)
+}
+
+// Legendre sets z equal to the Legendre polynomial of degree n evaluated at x,
+// and returns z. The polynomial is evaluated with Bonnet's recurrence
+// 		(k+1)P[k+1](x) = (2k+1)x P[k](x) - k P[k-1](x)
+// at the precision of z, or at the precision of x if z has zero precision. If
+// n is negative, then Legendre panics.
+func (z *Perplex) Legendre(n int, x *Perplex) *Perplex {
+	return z.setRecurrence(n, x, legendre)
+}
+
+// setRecurrence sets z equal to P[n](x) for the polynomials defined by rec,
+// and returns z.
+func (z *Perplex) setRecurrence(n int, x *Perplex, rec recurrence) *Perplex {
+	prec := orthoPrec(&z.l, &z.r, &x.l, &x.r)
+	l, r := evalRecurrence(n, &x.l, &x.r, 1, rec, prec)
+	z.l.SetPrec(prec).Set(l)
+	z.r.SetPrec(prec).Set(r)
+	return z
+}
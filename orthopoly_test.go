@@ -0,0 +1,58 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestComplexChebyshevTCos(t *testing.T) {
+	// T[n](cos θ) = cos(nθ)
+	f := func(x *Complex, m uint8) bool {
+		n := int(m % 40)
+		y := setPrecComplex(x, 150)
+		c := new(Complex).Cos(y)
+		l := new(Complex).ChebyshevT(n, c)
+		r := new(Complex).Cos(y.Scal(y, new(big.Float).SetInt64(int64(n))))
+		d := l.Sub(l, r).Quad()
+		return d.Sign() == 0 || expo(d) < 2*expo(r.Quad())-200
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexLegendreValues(t *testing.T) {
+	// P[3](x) = (5x³ - 3x)/2
+	x := NewComplex(big.NewFloat(0.5), big.NewFloat(-1.25))
+	x3 := new(Complex).Mul(x, x)
+	x3.Mul(x3, x)
+	want := new(Complex).Sub(
+		new(Complex).Scal(x3, big.NewFloat(5)),
+		new(Complex).Scal(x, big.NewFloat(3)),
+	)
+	want.Scal(want, big.NewFloat(0.5))
+	if got := new(Complex).Legendre(3, x); !got.Equals(want) {
+		t.Errorf("Legendre(3, %v) = %v, want %v", x, got, want)
+	}
+}
+
+func TestPerplexChebyshevU(t *testing.T) {
+	// U[2](x) = 4x² - 1
+	x := NewPerplex(big.NewFloat(1.5), big.NewFloat(0.25))
+	want := new(Perplex).Mul(x, x)
+	want.Scal(want, big.NewFloat(4))
+	want.l.Sub(&want.l, big.NewFloat(1))
+	if got := new(Perplex).ChebyshevU(2, x); !got.Equals(want) {
+		t.Errorf("ChebyshevU(2, %v) = %v, want %v", x, got, want)
+	}
+}
+
+func TestPerplexLegendreZero(t *testing.T) {
+	if got := new(Perplex).Legendre(0, new(Perplex)); got.l.Cmp(big.NewFloat(1)) != 0 || got.r.Sign() != 0 {
+		t.Errorf("Legendre(0, 0) = %v", got)
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// A ParseError is returned by the Parse functions when a string is not the
+// representation of a value.
+type ParseError struct {
+	// Type is the name of the type being parsed, such as "Complex".
+	Type string
+	// Input is the string being parsed.
+	Input string
+	// Msg describes the problem.
+	Msg string
+	// Err is the error of big.Float for a malformed component, or nil.
+	Err error
+}
+
+// Error returns the string version of a ParseError value.
+func (e *ParseError) Error() string {
+	s := "bigfloat: parsing " + e.Type + " " + strconv.Quote(e.Input) + ": " + e.Msg
+	if e.Err != nil {
+		s += ": " + e.Err.Error()
+	}
+	return s
+}
+
+// Unwrap returns the error of big.Float for a malformed component, or nil.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// parsePair parses s, the representation of a value of the type typ with the
+// two components l and r and the unit symbol unit, into l and r, at their
+// precisions. The accepted forms are those of String, such as "(1.5+2.25i)",
+// including the "++Inf" that String writes for an infinite second component,
+// and a bare big.Float, such as "1.5", "-2e10", or "Inf", for a value whose
+// second component is zero. Surrounding white space is ignored. If s is
+// malformed, then parsePair returns a *ParseError and leaves l and r
+// unchanged.
+func parsePair(typ, unit, s string, l, r *big.Float) error {
+	fail := func(msg string, err error) error {
+		return &ParseError{Type: typ, Input: s, Msg: msg, Err: err}
+	}
+	t := strings.TrimSpace(s)
+	a, b := newFloat(l.Prec()), newFloat(r.Prec())
+	if !strings.HasPrefix(t, "(") {
+		if strings.HasSuffix(t, ")") {
+			return fail("missing opening parenthesis", nil)
+		}
+		if _, _, err := a.Parse(t, 0); err != nil {
+			return fail("malformed real number", err)
+		}
+		l.Set(a)
+		r.SetPrec(r.Prec()).SetInt64(0)
+		return nil
+	}
+	if !strings.HasSuffix(t, ")") {
+		return fail("missing closing parenthesis", nil)
+	}
+	t = t[1 : len(t)-1]
+	if !strings.HasSuffix(t, unit) {
+		return fail("missing unit "+strconv.Quote(unit), nil)
+	}
+	t = t[:len(t)-len(unit)]
+	// The second component starts at the last sign that is neither leading
+	// nor part of an exponent nor the second of a pair of signs.
+	k := -1
+	for i := 1; i < len(t); i++ {
+		if t[i] != '+' && t[i] != '-' {
+			continue
+		}
+		if p := t[i-1]; strings.IndexByte("eEpP+-", p) < 0 {
+			k = i
+		}
+	}
+	if k < 0 {
+		return fail("missing sign of the "+unit+" component", nil)
+	}
+	first, second := t[:k], t[k:]
+	if strings.HasPrefix(second, "++") || strings.HasPrefix(second, "+-") {
+		second = second[1:]
+	}
+	if _, _, err := a.Parse(first, 0); err != nil {
+		return fail("malformed real component", err)
+	}
+	if _, _, err := b.Parse(second, 0); err != nil {
+		return fail("malformed "+unit+" component", err)
+	}
+	l.Set(a)
+	r.Set(b)
+	return nil
+}
+
+// parsePrec returns prec, or 64 if prec is zero, as for big.Float.Parse.
+func parsePrec(prec uint) uint {
+	if prec == 0 {
+		return 64
+	}
+	return prec
+}
+
+// SetString sets z equal to the value represented by s, and returns z and a
+// boolean indicating success. The accepted forms are the output of String,
+// such as "(1.5+2.25i)", and a bare big.Float, such as "1.5", for a real
+// value. The components are rounded to their precisions in z, or to 64 bits
+// if those are zero. If s is malformed, then SetString returns nil and false,
+// and the value of z is unchanged; ParseComplex reports the reason.
+func (z *Complex) SetString(s string) (*Complex, bool) {
+	z.l.SetPrec(parsePrec(z.l.Prec()))
+	z.r.SetPrec(parsePrec(z.r.Prec()))
+	if parsePair("Complex", "i", s, &z.l, &z.r) != nil {
+		return nil, false
+	}
+	return z, true
+}
+
+// ParseComplex returns a pointer to the Complex value represented by s, with
+// components rounded to prec bits, or to 64 bits if prec is zero. It accepts
+// the forms of SetString. If s is malformed, then ParseComplex returns a
+// *ParseError.
+func ParseComplex(s string, prec uint) (*Complex, error) {
+	z := newComplexPrec(parsePrec(prec))
+	if err := parsePair("Complex", "i", s, &z.l, &z.r); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// SetString sets z equal to the value represented by s, such as "(3-0.5s)",
+// and returns z and a boolean indicating success. It follows the conventions
+// of the SetString method of Complex.
+func (z *Perplex) SetString(s string) (*Perplex, bool) {
+	z.l.SetPrec(parsePrec(z.l.Prec()))
+	z.r.SetPrec(parsePrec(z.r.Prec()))
+	if parsePair("Perplex", "s", s, &z.l, &z.r) != nil {
+		return nil, false
+	}
+	return z, true
+}
+
+// ParsePerplex returns a pointer to the Perplex value represented by s. It
+// follows the conventions of ParseComplex.
+func ParsePerplex(s string, prec uint) (*Perplex, error) {
+	z := new(Perplex)
+	z.l.SetPrec(parsePrec(prec))
+	z.r.SetPrec(parsePrec(prec))
+	if err := parsePair("Perplex", "s", s, &z.l, &z.r); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// SetString sets z equal to the value represented by s, such as "(0+1α)",
+// and returns z and a boolean indicating success. It follows the conventions
+// of the SetString method of Complex.
+func (z *Infra) SetString(s string) (*Infra, bool) {
+	z.l.SetPrec(parsePrec(z.l.Prec()))
+	z.r.SetPrec(parsePrec(z.r.Prec()))
+	if parsePair("Infra", "α", s, &z.l, &z.r) != nil {
+		return nil, false
+	}
+	return z, true
+}
+
+// ParseInfra returns a pointer to the Infra value represented by s. It
+// follows the conventions of ParseComplex.
+func ParseInfra(s string, prec uint) (*Infra, error) {
+	z := new(Infra)
+	z.l.SetPrec(parsePrec(prec))
+	z.r.SetPrec(parsePrec(prec))
+	if err := parsePair("Infra", "α", s, &z.l, &z.r); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// ScanComplex reads Complex values from r, one per line in the forms accepted
+// by ParseComplex, with components rounded to prec bits, and calls f with
+// each value in turn, so that a stream too large to hold in memory can be
+// summarized in one pass, as with ComplexStats and ComplexWindow. Blank lines
+// and lines starting with "#" are ignored. Scanning stops at the first
+// malformed line, with an error wrapping its *ParseError, or at the first
+// error returned by f, which ScanComplex returns. The value passed to f is
+// reused for the next line.
+func ScanComplex(r io.Reader, prec uint, f func(*Complex) error) error {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(nil, 1<<20)
+	z := newComplexPrec(parsePrec(prec))
+	for line := 1; sc.Scan(); line++ {
+		text := strings.TrimSpace(sc.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		if err := parsePair("Complex", "i", text, &z.l, &z.r); err != nil {
+			return fmt.Errorf("bigfloat: line %d: %w", line, err)
+		}
+		if err := f(z); err != nil {
+			return err
+		}
+	}
+	return sc.Err()
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+	"testing"
+	"testing/quick"
+)
+
+func TestParseRoundTrip(t *testing.T) {
+	f := func(x *Complex, y *Perplex, z *Infra) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		// Negative and large components exercise the signs and exponents.
+		x.r.Neg(&x.r)
+		y.l.SetMantExp(&y.l, 200)
+		z.r.SetMantExp(&z.r, -200)
+		u, err := ParseComplex(x.String(), 53)
+		if err != nil || !u.Equals(x) {
+			return false
+		}
+		v, err := ParsePerplex(y.String(), 53)
+		if err != nil || !v.Equals(y) {
+			return false
+		}
+		// SetString keeps the precisions of w.
+		w := NewInfra(newFloat(53), newFloat(53))
+		_, ok := w.SetString(z.String())
+		return ok && w.Equals(z)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestParseForms(t *testing.T) {
+	inf := math.Inf(1)
+	tests := []struct {
+		s    string
+		a, b float64
+	}{
+		{"(1.5+2.25i)", 1.5, 2.25},
+		{" (1e+10-3e-05i) ", 1e10, -3e-5},
+		{"(-Inf-Infi)", -inf, -inf},
+		{"(+Inf++Infi)", inf, inf},
+		{"0x1p-3", 0.125, 0},
+		{"-2.5", -2.5, 0},
+	}
+	for _, test := range tests {
+		z, err := ParseComplex(test.s, 0)
+		if err != nil {
+			t.Errorf("ParseComplex(%q) error: %v", test.s, err)
+			continue
+		}
+		a, _ := z.l.Float64()
+		b, _ := z.r.Float64()
+		if a != test.a || b != test.b || z.l.Prec() != 64 {
+			t.Errorf("ParseComplex(%q) = %v at %d bits", test.s, z, z.l.Prec())
+		}
+	}
+	// String writes "++Inf" for an infinite positive second component.
+	x := NewComplex(big.NewFloat(1), big.NewFloat(inf))
+	if z, err := ParseComplex(x.String(), 53); err != nil || !z.Equals(x) {
+		t.Errorf("ParseComplex(%q) = %v, %v", x.String(), z, err)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		s, msg string
+	}{
+		{"(1.5+2.25i", "missing closing parenthesis"},
+		{"1.5+2.25i)", "missing opening parenthesis"},
+		{"(1.5+2.25s)", "missing unit \"i\""},
+		{"(1.5i)", "missing sign of the i component"},
+		{"(1.5+x2i)", "malformed i component"},
+		{"(1..5+2i)", "malformed real component"},
+		{"abc", "malformed real number"},
+	}
+	for _, test := range tests {
+		z := NewComplex(big.NewFloat(7), big.NewFloat(8))
+		if _, ok := z.SetString(test.s); ok || z.l.Cmp(big.NewFloat(7)) != 0 || z.r.Cmp(big.NewFloat(8)) != 0 {
+			t.Errorf("SetString(%q) succeeded or changed z to %v", test.s, z)
+		}
+		_, err := ParseComplex(test.s, 53)
+		var e *ParseError
+		if !errors.As(err, &e) || e.Msg != test.msg || e.Type != "Complex" || e.Input != test.s {
+			t.Errorf("ParseComplex(%q) error = %v, want %s", test.s, err, strconv.Quote(test.msg))
+		}
+	}
+}
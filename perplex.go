@@ -39,6 +39,12 @@ func (z *Perplex) String() string {
 	return strings.Join(a, "")
 }
 
+// Format implements fmt.Formatter, with the conventions of the Format method
+// of Complex.
+func (z *Perplex) Format(s fmt.State, verb rune) {
+	formatTerms(s, verb, z, []string{"", "s"}, floatSlice(z.Cartesian()))
+}
+
 // Equals returns true if y and z are equal.
 func (z *Perplex) Equals(y *Perplex) bool {
 	if z.l.Cmp(&y.l) != 0 || z.r.Cmp(&y.r) != 0 {
@@ -102,30 +108,47 @@ func (z *Perplex) Sub(x, y *Perplex) *Perplex {
 // The multiplication rule is:
 // 		Mul(s, s) = +1
 // This binary operation is commutative and associative.
+// Unless the components of x or y differ widely in scale, the product is
+// computed exactly and then rounded once, so the result is exact whenever it
+// fits the precision of z; otherwise it is computed with rounded arithmetic.
 func (z *Perplex) Mul(x, y *Perplex) *Perplex {
+	prec := maxPrec(&x.l, &x.r, &y.l, &y.r)
+	if fitsExact(prec,
+		[]*big.Float{&z.l, &z.r},
+		[]*big.Float{&x.l, &x.r},
+		[]*big.Float{&y.l, &y.r},
+	) {
+		return z.roundPerplex(new(Perplex).mulExact(x, y), prec)
+	}
 	a := new(big.Float).Copy(&x.l)
 	b := new(big.Float).Copy(&x.r)
 	c := new(big.Float).Copy(&y.l)
 	d := new(big.Float).Copy(&y.r)
 	temp := new(big.Float)
-	z.l.Add(
-		z.l.Mul(a, c),
-		temp.Mul(d, b),
-	)
-	z.r.Add(
-		z.r.Mul(d, a),
-		temp.Mul(b, c),
-	)
+	z.l.Mul(a, c)
+	exact := isExact(&z.l, temp.Mul(d, b))
+	z.l.Add(&z.l, temp)
+	z.r.Mul(d, a)
+	temp.Mul(b, c)
+	exact = exact && isExact(&z.r, temp)
+	z.r.Add(&z.r, temp)
+	if !exact {
+		markRounded(&z.l, &z.r)
+	}
 	return z
 }
 
 // Quad returns the quadrance of z, a pointer to a big.Float value.
+// The components are prescaled by a power of two, so the result overflows or
+// underflows only if the quadrance is out of the exponent range of big.Float,
+// even when the squares of the components are not.
 func (z *Perplex) Quad() *big.Float {
+	y, e := prescaled(&z.l, &z.r)
 	quad := new(big.Float)
-	return quad.Sub(
-		quad.Mul(&z.l, &z.l),
-		new(big.Float).Mul(&z.r, &z.r),
-	)
+	return rescaleQuad(quad.Sub(
+		quad.Mul(y[0], y[0]),
+		new(big.Float).Mul(y[1], y[1]),
+	), e)
 }
 
 // IsZeroDiv returns true if z is a zero divisor.
@@ -175,37 +198,170 @@ func (z *Perplex) Idempotent(sign int) *Perplex {
 	return z
 }
 
+// Split returns the components u and v of z in the idempotent basis, so that
+// 		z = u(1+s)/2 + v(1-s)/2
+// If z = a+bs, then u = a+b and v = a-b, computed without rounding. In this
+// basis multiplication acts componentwise, and z is a zero divisor if and only
+// if u or v vanishes.
+func (z *Perplex) Split() (*big.Float, *big.Float) {
+	prec := exactPrec(&z.l, &z.r)
+	u := new(big.Float).SetPrec(prec).Add(&z.l, &z.r)
+	v := new(big.Float).SetPrec(prec).Sub(&z.l, &z.r)
+	return u, v
+}
+
+// Join sets z equal to the Perplex value with components u and v in the
+// idempotent basis:
+// 		u(1+s)/2 + v(1-s)/2
+// Then it returns z. It is the inverse of Split, and the components of z are
+// computed without rounding.
+func (z *Perplex) Join(u, v *big.Float) *Perplex {
+	prec := exactPrec(u, v)
+	a := new(big.Float).SetPrec(prec).Add(u, v)
+	b := new(big.Float).SetPrec(prec).Sub(u, v)
+	z.l.SetMantExp(a, -1)
+	z.r.SetMantExp(b, -1)
+	return z
+}
+
 // CrossRatio sets z equal to the cross ratio
 // 		Inv(w - x) * (v - x) * Inv(v - y) * (w - y)
-// Then it returns z.
+// Then it returns z. If a denominator has no inverse, then CrossRatio panics
+// and leaves z unchanged.
 func (z *Perplex) CrossRatio(v, w, x, y *Perplex) *Perplex {
-	temp := new(Perplex)
-	z.Sub(w, x)
-	z.Inv(z)
-	temp.Sub(v, x)
-	z.Mul(z, temp)
-	temp.Sub(v, y)
-	temp.Inv(temp)
-	z.Mul(z, temp)
-	temp.Sub(w, y)
-	z.Mul(z, temp)
+	if _, err := z.CrossRatioChecked(v, w, x, y, ReportDegeneracy); err != nil {
+		panic(err.Error())
+	}
 	return z
 }
 
+// CrossRatioChecked is like CrossRatio, but it returns a *DegenerateError
+// instead of panicking when a denominator has no inverse, and leaves z
+// unchanged. The denominators vanish when w = x or v = y. If policy is
+// ProjectiveInfinity, then vanishing denominators with non-zero numerators set
+// z equal to the point at infinity instead.
+func (z *Perplex) CrossRatioChecked(v, w, x, y *Perplex, policy DegeneracyPolicy) (*Perplex, error) {
+	zero := new(Perplex)
+	vx := new(Perplex).Sub(v, x)
+	wy := new(Perplex).Sub(w, y)
+	wx := new(Perplex).Sub(w, x)
+	vy := new(Perplex).Sub(v, y)
+	inf, err := checkQuotient(
+		"CrossRatio",
+		vx.Equals(zero) || wy.Equals(zero),
+		wx.Equals(zero) || vy.Equals(zero),
+		wx.IsZeroDiv() || vy.IsZeroDiv(),
+		policy,
+	)
+	switch {
+	case err != nil:
+		return z, err
+	case inf:
+		setInf(&z.l, &z.r)
+		return z, nil
+	}
+	p := new(Perplex).Mul(wx.Inv(wx), vx)
+	p.Mul(p, vy.Inv(vy))
+	return z.Mul(p, wy), nil
+}
+
 // Möbius sets z equal to the Möbius (fractional linear) transform
 // 		(a*y + b) * Inv(c*y + d)
-// Then it returns z.
+// Then it returns z. If the denominator has no inverse, then Möbius panics
+// and leaves z unchanged.
 func (z *Perplex) Möbius(y, a, b, c, d *Perplex) *Perplex {
-	z.Mul(a, y)
-	z.Add(z, b)
-	temp := new(Perplex)
-	temp.Mul(c, y)
-	temp.Add(temp, d)
-	temp.Inv(temp)
-	z.Mul(z, temp)
+	if _, err := z.MöbiusChecked(y, a, b, c, d, ReportDegeneracy); err != nil {
+		panic(err.Error())
+	}
 	return z
 }
 
+// MöbiusChecked is like Möbius, but it returns a *DegenerateError instead of
+// panicking when the denominator has no inverse, and leaves z unchanged. If
+// policy is ProjectiveInfinity, then a zero denominator with a non-zero
+// numerator sets z equal to the point at infinity instead.
+func (z *Perplex) MöbiusChecked(y, a, b, c, d *Perplex, policy DegeneracyPolicy) (*Perplex, error) {
+	zero := new(Perplex)
+	num := new(Perplex).Add(new(Perplex).Mul(a, y), b)
+	den := new(Perplex).Add(new(Perplex).Mul(c, y), d)
+	inf, err := checkQuotient("Möbius", num.Equals(zero), den.Equals(zero), den.IsZeroDiv(), policy)
+	switch {
+	case err != nil:
+		return z, err
+	case inf:
+		setInf(&z.l, &z.r)
+		return z, nil
+	}
+	return z.Mul(num, new(Perplex).Inv(den)), nil
+}
+
+// A PerplexClass identifies the region of the plane of perplex numbers that
+// contains a value a+bs.
+type PerplexClass int
+
+// The five regions of the plane of perplex numbers.
+const (
+	// LightLike values satisfy |a| = |b|; these are the zero divisors.
+	LightLike PerplexClass = iota
+	// RightQuadrant values satisfy a > |b|.
+	RightQuadrant
+	// LeftQuadrant values satisfy a < -|b|.
+	LeftQuadrant
+	// UpperQuadrant values satisfy b > |a|.
+	UpperQuadrant
+	// LowerQuadrant values satisfy b < -|a|.
+	LowerQuadrant
+)
+
+// Class returns the region of the plane of perplex numbers that contains z.
+func (z *Perplex) Class() PerplexClass {
+	a := new(big.Float).Abs(&z.l)
+	b := new(big.Float).Abs(&z.r)
+	switch a.Cmp(b) {
+	case 1:
+		if z.l.Sign() > 0 {
+			return RightQuadrant
+		}
+		return LeftQuadrant
+	case -1:
+		if z.r.Sign() > 0 {
+			return UpperQuadrant
+		}
+		return LowerQuadrant
+	}
+	return LightLike
+}
+
+// HyperbolicPolar returns the hyperbolic polar decomposition of z: the
+// modulus ρ = √|Quad(z)|, the rapidity φ, and the class of z. Depending on the
+// class, z is equal to
+// 		+ρ(cosh(φ) + sinh(φ)s)    RightQuadrant
+// 		-ρ(cosh(φ) + sinh(φ)s)    LeftQuadrant
+// 		+ρ(sinh(φ) + cosh(φ)s)    UpperQuadrant
+// 		-ρ(sinh(φ) + cosh(φ)s)    LowerQuadrant
+// If z is LightLike, then both ρ and φ are zero. The results are rounded to
+// the precision of z.
+func (z *Perplex) HyperbolicPolar() (*big.Float, *big.Float, PerplexClass) {
+	prec := maxPrec(&z.l, &z.r)
+	class := z.Class()
+	if class == LightLike {
+		return newFloat(prec), newFloat(prec), class
+	}
+	e := 2*maxPrec(&z.l, &z.r) + 2
+	sum := newFloat(e).Add(&z.l, &z.r)
+	diff := newFloat(e).Sub(&z.l, &z.r)
+	quad := newFloat(2*e).Mul(sum, diff)
+	rho := newFloat(prec).Sqrt(quad.Abs(quad))
+	var phi *big.Float
+	switch class {
+	case RightQuadrant, LeftQuadrant:
+		phi = bigAtanhQuo(&z.r, &z.l, prec)
+	default:
+		phi = bigAtanhQuo(&z.l, &z.r, prec)
+	}
+	return rho, phi, class
+}
+
 // Generate returns a random Perplex value for quick.Check testing.
 func (z *Perplex) Generate(rand *rand.Rand, size int) reflect.Value {
 	randomPerplex := &Perplex{
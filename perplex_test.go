@@ -9,6 +9,12 @@ import (
 	"testing/quick"
 )
 
+// closeToPerplex returns true if the components of x and y agree to about
+// bits bits, relative to the larger of their modulus and 1.
+func closeToPerplex(x, y *Perplex, bits int) bool {
+	return closeToComplex(NewComplex(&x.l, &x.r), NewComplex(&y.l, &y.r), bits)
+}
+
 // Commutativity
 
 func TestPerplexAddCommutative(t *testing.T) {
@@ -327,3 +333,32 @@ func XTestPerplexComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Idempotent basis
+
+func TestPerplexSplitJoin(t *testing.T) {
+	f := func(x *Perplex) bool {
+		// t.Logf("x = %v", x)
+		l := new(Perplex).Join(x.Split())
+		return l.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPerplexSplitMul(t *testing.T) {
+	f := func(x, y *Perplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		u, v := new(Perplex).Mul(x, y).Split()
+		xu, xv := x.Split()
+		yu, yv := y.Split()
+		// The random components lie in [0, 1), so compare absolutely.
+		du := xu.SetPrec(200).Mul(xu, yu).Sub(xu, u)
+		dv := xv.SetPrec(200).Mul(xv, yv).Sub(xv, v)
+		return expo(du) < -45 && expo(dv) < -45
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
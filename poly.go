@@ -0,0 +1,265 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strings"
+)
+
+// A ComplexPoly represents a polynomial with multi-precision complex
+// coefficients
+// 		c[0] + c[1]x + c[2]x² + ... + c[n]xⁿ
+// with a non-zero leading coefficient c[n]. The zero polynomial has no
+// coefficients.
+type ComplexPoly struct {
+	c []Complex
+}
+
+// NewComplexPoly returns a pointer to the ComplexPoly value with coefficients
+// c, in increasing order of degree. Leading zero coefficients are dropped.
+func NewComplexPoly(c ...*Complex) *ComplexPoly {
+	p := &ComplexPoly{make([]Complex, len(c))}
+	for k, v := range c {
+		p.c[k].Copy(v)
+	}
+	return p.trim()
+}
+
+// trim removes the leading zero coefficients of p, and returns p.
+func (p *ComplexPoly) trim() *ComplexPoly {
+	n := len(p.c)
+	for n > 0 && p.c[n-1].l.Sign() == 0 && p.c[n-1].r.Sign() == 0 {
+		n--
+	}
+	p.c = p.c[:n]
+	return p
+}
+
+// Degree returns the degree of p, or -1 if p is zero.
+func (p *ComplexPoly) Degree() int {
+	return len(p.c) - 1
+}
+
+// Coeff returns a copy of the coefficient of xᵏ in p.
+func (p *ComplexPoly) Coeff(k int) *Complex {
+	if k < 0 || k >= len(p.c) {
+		return new(Complex)
+	}
+	return new(Complex).Copy(&p.c[k])
+}
+
+// String returns the string version of a ComplexPoly value.
+//
+// If p corresponds to c[0] + c[1]x + ... + c[n]xⁿ, then the string is
+// "c[0] + c[1]x + ... + c[n]x^n", with each coefficient in the format of a
+// Complex value. The zero polynomial is "(0+0i)".
+func (p *ComplexPoly) String() string {
+	if len(p.c) == 0 {
+		return "(0+0i)"
+	}
+	a := make([]string, len(p.c))
+	for k := range p.c {
+		switch k {
+		case 0:
+			a[k] = p.c[k].String()
+		case 1:
+			a[k] = p.c[k].String() + "x"
+		default:
+			a[k] = fmt.Sprintf("%vx^%d", &p.c[k], k)
+		}
+	}
+	return strings.Join(a, " + ")
+}
+
+// Format implements fmt.Formatter, with the conventions of the Format method
+// of Complex for each coefficient.
+func (p *ComplexPoly) Format(s fmt.State, verb rune) {
+	if formatBad(s, verb, p) {
+		return
+	}
+	if len(p.c) == 0 {
+		new(Complex).Format(s, verb)
+		return
+	}
+	for k := range p.c {
+		if k > 0 {
+			io.WriteString(s, " + ")
+		}
+		p.c[k].Format(s, verb)
+		switch {
+		case k == 1:
+			io.WriteString(s, "x")
+		case k > 1:
+			fmt.Fprintf(s, "x^%d", k)
+		}
+	}
+}
+
+// Equals returns true if p and q are equal.
+func (p *ComplexPoly) Equals(q *ComplexPoly) bool {
+	if len(p.c) != len(q.c) {
+		return false
+	}
+	for k := range p.c {
+		if !p.c[k].Equals(&q.c[k]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Copy copies q onto p, and returns p.
+func (p *ComplexPoly) Copy(q *ComplexPoly) *ComplexPoly {
+	c := make([]Complex, len(q.c))
+	for k := range q.c {
+		c[k].Copy(&q.c[k])
+	}
+	p.c = c
+	return p
+}
+
+// Add sets p equal to the sum of x and y, and returns p.
+func (p *ComplexPoly) Add(x, y *ComplexPoly) *ComplexPoly {
+	if len(x.c) < len(y.c) {
+		x, y = y, x
+	}
+	c := make([]Complex, len(x.c))
+	for k := range x.c {
+		if k < len(y.c) {
+			c[k].Add(&x.c[k], &y.c[k])
+		} else {
+			c[k].Copy(&x.c[k])
+		}
+	}
+	p.c = c
+	return p.trim()
+}
+
+// Sub sets p equal to the difference of x and y, and returns p.
+func (p *ComplexPoly) Sub(x, y *ComplexPoly) *ComplexPoly {
+	n := len(x.c)
+	if len(y.c) > n {
+		n = len(y.c)
+	}
+	c := make([]Complex, n)
+	for k := range c {
+		switch {
+		case k < len(x.c) && k < len(y.c):
+			c[k].Sub(&x.c[k], &y.c[k])
+		case k < len(x.c):
+			c[k].Copy(&x.c[k])
+		default:
+			c[k].Neg(&y.c[k])
+		}
+	}
+	p.c = c
+	return p.trim()
+}
+
+// Scale sets p equal to q with every coefficient multiplied by a, and returns
+// p.
+func (p *ComplexPoly) Scale(q *ComplexPoly, a *Complex) *ComplexPoly {
+	c := make([]Complex, len(q.c))
+	for k := range q.c {
+		c[k].Mul(&q.c[k], a)
+	}
+	p.c = c
+	return p.trim()
+}
+
+// Mul sets p equal to the product of x and y, and returns p.
+//
+// If both operands have fewer than fftThreshold coefficients, then the
+// product is computed by the schoolbook rule
+// 		Σ x[j]y[k-j]
+// for the coefficient of xᵏ. Otherwise it is computed with the fast Fourier
+// transform, as described in mulFFT.
+func (p *ComplexPoly) Mul(x, y *ComplexPoly) *ComplexPoly {
+	if len(x.c) == 0 || len(y.c) == 0 {
+		p.c = nil
+		return p
+	}
+	var c []Complex
+	if len(x.c) < fftThreshold && len(y.c) < fftThreshold {
+		c = mulSchoolbook(x.c, y.c)
+	} else {
+		c = mulFFT(x.c, y.c)
+	}
+	p.c = c
+	return p.trim()
+}
+
+// mulSchoolbook returns the coefficients of the product of the polynomials
+// with coefficients x and y, by the schoolbook rule. The products are formed
+// in values drawn from the free lists of NewComplexAt.
+func mulSchoolbook(x, y []Complex) []Complex {
+	c := make([]Complex, len(x)+len(y)-1)
+	for i := range x {
+		for j := range y {
+			t := NewComplexAt(maxPrec(&x[i].l, &x[i].r, &y[j].l, &y[j].r))
+			c[i+j].Add(&c[i+j], t.Mul(&x[i], &y[j]))
+			t.Release()
+		}
+	}
+	return c
+}
+
+// Derivative sets p equal to the derivative of q, and returns p.
+func (p *ComplexPoly) Derivative(q *ComplexPoly) *ComplexPoly {
+	if len(q.c) < 2 {
+		p.c = nil
+		return p
+	}
+	c := make([]Complex, len(q.c)-1)
+	for k := range c {
+		m := new(big.Float).SetInt64(int64(k + 1))
+		c[k].Scal(&q.c[k+1], m)
+	}
+	p.c = c
+	return p.trim()
+}
+
+// Eval returns the value of p at x, a pointer to a Complex value, computed
+// by Horner's rule
+// 		c[0] + x(c[1] + x(c[2] + ... + x c[n]))
+// at the largest precision of x and the coefficients.
+func (p *ComplexPoly) Eval(x *Complex) *Complex {
+	prec := maxPrec(&x.l, &x.r)
+	for k := range p.c {
+		if q := maxPrec(&p.c[k].l, &p.c[k].r); q > prec {
+			prec = q
+		}
+	}
+	v, _ := p.evalDeriv(x, prec)
+	return v
+}
+
+// evalDeriv returns the values of p and its derivative at x, computed
+// together by Horner's rule at prec bits.
+func (p *ComplexPoly) evalDeriv(x *Complex, prec uint) (*Complex, *Complex) {
+	v := newComplexPrec(prec)
+	d := newComplexPrec(prec)
+	for k := len(p.c) - 1; k >= 0; k-- {
+		d.Add(d.Mul(d, x), v)
+		v.Add(v.Mul(v, x), &p.c[k])
+	}
+	return v, d
+}
+
+// Generate returns a random ComplexPoly value of degree at most four for
+// quick.Check testing.
+func (p *ComplexPoly) Generate(rand *rand.Rand, size int) reflect.Value {
+	c := make([]Complex, 1+rand.Intn(5))
+	for k := range c {
+		c[k].l.SetFloat64(rand.Float64())
+		c[k].r.SetFloat64(rand.Float64())
+	}
+	randomComplexPoly := &ComplexPoly{c}
+	return reflect.ValueOf(randomComplexPoly.trim())
+}
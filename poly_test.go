@@ -0,0 +1,105 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"testing"
+	"testing/quick"
+)
+
+// closeToComplex returns true if x and y agree to about bits bits, relative
+// to the larger of |y| and 1.
+func closeToComplex(x, y *Complex, bits int) bool {
+	d := new(Complex).Sub(x, y)
+	if d.l.Sign() == 0 && d.r.Sign() == 0 {
+		return true
+	}
+	e := complexExpo(y)
+	if e < 1 {
+		e = 1
+	}
+	return complexExpo(d) <= e-bits
+}
+
+func TestComplexPolyAddEval(t *testing.T) {
+	f := func(x, y *ComplexPoly, a *Complex) bool {
+		// t.Logf("x = %v, y = %v, a = %v", x, y, a)
+		l := new(ComplexPoly).Add(x, y).Eval(a)
+		r := new(Complex).Add(x.Eval(a), y.Eval(a))
+		return closeToComplex(l, r, 45)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexPolySubAdd(t *testing.T) {
+	f := func(x, y *ComplexPoly) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		d := new(ComplexPoly).Sub(x, x)
+		if d.Degree() != -1 {
+			return false
+		}
+		l := new(ComplexPoly).Sub(x, y)
+		return l.Equals(new(ComplexPoly).Add(x, new(ComplexPoly).Scale(y, newComplex128(-1))))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexPolyMulEval(t *testing.T) {
+	f := func(x, y *ComplexPoly, a *Complex) bool {
+		// t.Logf("x = %v, y = %v, a = %v", x, y, a)
+		l := new(ComplexPoly).Mul(x, y).Eval(a)
+		r := new(Complex).Mul(x.Eval(a), y.Eval(a))
+		return closeToComplex(l, r, 40)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexPolyScaleEval(t *testing.T) {
+	f := func(x *ComplexPoly, a, b *Complex) bool {
+		// t.Logf("x = %v, a = %v, b = %v", x, a, b)
+		l := new(ComplexPoly).Scale(x, b).Eval(a)
+		r := new(Complex).Mul(b, x.Eval(a))
+		return closeToComplex(l, r, 45)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexPolyDerivative(t *testing.T) {
+	f := func(x, y *ComplexPoly, a *Complex) bool {
+		// t.Logf("x = %v, y = %v, a = %v", x, y, a)
+		dx := new(ComplexPoly).Derivative(x)
+		dy := new(ComplexPoly).Derivative(y)
+		l := new(ComplexPoly).Derivative(new(ComplexPoly).Mul(x, y)).Eval(a)
+		r := new(Complex).Add(
+			new(Complex).Mul(dx.Eval(a), y.Eval(a)),
+			new(Complex).Mul(x.Eval(a), dy.Eval(a)),
+		)
+		if !closeToComplex(l, r, 40) {
+			return false
+		}
+		_, d := x.evalDeriv(a, 64)
+		return closeToComplex(d, dx.Eval(a), 45)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexPolyString(t *testing.T) {
+	p := NewComplexPoly(newComplex128(1), newComplex128(2i), newComplex128(-3))
+	if got, want := p.String(), "(1+0i) + (0+2i)x + (-3+0i)x^2"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	if got := new(ComplexPoly).String(); got != "(0+0i)" {
+		t.Errorf("String() = %q for zero polynomial", got)
+	}
+}
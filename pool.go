@@ -0,0 +1,135 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"math/bits"
+	"sync"
+)
+
+// A precPool holds free lists of values of one type, so that a value handed
+// out again already has mantissa storage of about the right size. The lists
+// are bucketed by the number of words of a mantissa of the precision,
+// rounded to a power of two, so there are few of them no matter how many
+// precisions are used. It is safe for concurrent use.
+type precPool[T any] struct {
+	lists [bits.UintSize]sync.Pool
+}
+
+// mantWords returns the number of words in a mantissa of prec bits.
+func mantWords(prec uint) uint {
+	return (prec + bits.UintSize - 1) / bits.UintSize
+}
+
+// getList returns the index of the first free list whose values all have
+// mantissa storage for prec bits, which holds values of at least 2ᵏ words.
+func getList(prec uint) int {
+	if n := mantWords(prec); n > 1 {
+		return bits.Len(n - 1)
+	}
+	return 0
+}
+
+// putList returns the index of the free list for a value with positive
+// precision prec, the last one whose values need no more words than it has.
+func putList(prec uint) int {
+	return bits.Len(mantWords(prec)) - 1
+}
+
+// get returns a value from a free list whose values have at least prec bits
+// of storage, or a new value if the list is empty. The value is not cleared.
+// A value of precision zero gets the size of its storage from the operands of
+// its first operation, so it is always new and leaves the lists to requests
+// of a known size.
+func (p *precPool[T]) get(prec uint) *T {
+	if prec == 0 {
+		return new(T)
+	}
+	if x := p.lists[getList(prec)].Get(); x != nil {
+		return x.(*T)
+	}
+	return new(T)
+}
+
+// put adds x, whose components have at least prec bits, to a free list. A
+// value with no precision holds no storage, and is left to the garbage
+// collector.
+func (p *precPool[T]) put(prec uint, x *T) {
+	if prec == 0 {
+		return
+	}
+	p.lists[putList(prec)].Put(x)
+}
+
+var (
+	floatPool    precPool[big.Float]
+	complexPool  precPool[Complex]
+	hamiltonPool precPool[Hamilton]
+)
+
+// NewFloatAt returns a pointer to a zero big.Float value with precision prec,
+// drawn from a free list of values of about that precision when one is
+// available.
+// Constructor-heavy code, such as the evaluation of polynomials and products
+// of matrices, can return its temporaries with ReleaseFloat to recycle them
+// instead of leaving them to the garbage collector. Unlike an Arena, the free
+// lists are shared by all goroutines and need no scope. The values on a free
+// list may still be reclaimed by the garbage collector at any time.
+// NewFloatAt(0) returns a new value of precision zero, which takes its
+// precision from the operands of the first operation that sets it.
+func NewFloatAt(prec uint) *big.Float {
+	return zeroFloat(floatPool.get(prec), prec)
+}
+
+// ReleaseFloat adds x to a free list for its precision, for NewFloatAt. The
+// caller must not use x afterwards.
+func ReleaseFloat(x *big.Float) {
+	floatPool.put(x.Prec(), x)
+}
+
+// NewComplexAt returns a pointer to a zero Complex value whose components
+// have precision prec, drawn from a free list as for NewFloatAt.
+func NewComplexAt(prec uint) *Complex {
+	z := complexPool.get(prec)
+	zeroFloat(&z.l, prec)
+	zeroFloat(&z.r, prec)
+	return z
+}
+
+// Release adds z to a free list for the smallest precision of its
+// components, for NewComplexAt. The caller must not use z afterwards.
+func (z *Complex) Release() {
+	complexPool.put(minPrec(&z.l, &z.r), z)
+}
+
+// NewHamiltonAt returns a pointer to a zero Hamilton value whose components
+// have precision prec, drawn from a free list as for NewFloatAt.
+func NewHamiltonAt(prec uint) *Hamilton {
+	z := hamiltonPool.get(prec)
+	a, b, c, d := z.Cartesian()
+	zeroFloat(a, prec)
+	zeroFloat(b, prec)
+	zeroFloat(c, prec)
+	zeroFloat(d, prec)
+	return z
+}
+
+// Release adds z to a free list for the smallest precision of its
+// components, for NewHamiltonAt. The caller must not use z afterwards.
+func (z *Hamilton) Release() {
+	a, b, c, d := z.Cartesian()
+	hamiltonPool.put(minPrec(a, b, c, d), z)
+}
+
+// minPrec returns the smallest precision of x.
+func minPrec(x ...*big.Float) uint {
+	prec := x[0].Prec()
+	for _, v := range x[1:] {
+		if p := v.Prec(); p < prec {
+			prec = p
+		}
+	}
+	return prec
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+)
+
+func TestPoolValuesAreZero(t *testing.T) {
+	for k := 0; k < 10; k++ {
+		x := NewFloatAt(100)
+		if x.Sign() != 0 || x.Prec() != 100 {
+			t.Fatalf("NewFloatAt(100) = %v at %d bits", x, x.Prec())
+		}
+		x.SetFloat64(1.5)
+		ReleaseFloat(x)
+
+		z := NewComplexAt(200)
+		if !z.Equals(new(Complex)) || z.l.Prec() != 200 || z.r.Prec() != 200 {
+			t.Fatalf("NewComplexAt(200) = %v at %d, %d bits", z, z.l.Prec(), z.r.Prec())
+		}
+		z.l.SetFloat64(2)
+		z.r.SetFloat64(-3)
+		z.Release()
+
+		h := NewHamiltonAt(64)
+		a, b, c, d := h.Cartesian()
+		if !h.Equals(new(Hamilton)) || maxPrec(a, b, c, d) != 64 || a.Prec() != d.Prec() {
+			t.Fatalf("NewHamiltonAt(64) = %v", h)
+		}
+		d.SetInt64(7)
+		h.Release()
+	}
+}
+
+func TestPoolZeroPrec(t *testing.T) {
+	for k := 0; k < 10; k++ {
+		x := NewFloatAt(64)
+		x.SetFloat64(1.5)
+		ReleaseFloat(x)
+		z := NewComplexAt(64)
+		z.Release()
+		h := NewHamiltonAt(64)
+		h.Release()
+
+		if y := NewFloatAt(0); y == x || y.Prec() != 0 || y.Sign() != 0 {
+			t.Fatalf("NewFloatAt(0) = %v at %d bits", y, y.Prec())
+		}
+		w := NewComplexAt(0)
+		if w == z || w.l.Prec() != 0 || w.r.Prec() != 0 {
+			t.Fatalf("NewComplexAt(0) at %d, %d bits", w.l.Prec(), w.r.Prec())
+		}
+		if g := NewHamiltonAt(0); g == h || g.r.r.Prec() != 0 || g.l.l.Prec() != 0 {
+			t.Fatalf("NewHamiltonAt(0) = %v", g)
+		}
+		a := NewComplex(newFloat(300).SetInt64(1), newFloat(300).SetInt64(2))
+		if w.Mul(a, a); w.l.Prec() != 300 || w.r.Prec() != 300 {
+			t.Errorf("product at %d, %d bits, want 300", w.l.Prec(), w.r.Prec())
+		}
+		w.Release()
+	}
+}
+
+func TestPoolConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(prec uint) {
+			defer wg.Done()
+			for k := 0; k < 100; k++ {
+				z := NewComplexAt(prec)
+				if z.l.Prec() != prec || z.l.Sign() != 0 {
+					t.Errorf("NewComplexAt(%d) = %v at %d bits", prec, z, z.l.Prec())
+					return
+				}
+				z.l.Add(&z.l, big.NewFloat(1))
+				z.Release()
+			}
+		}(uint(64 * (1 + g%3)))
+	}
+	wg.Wait()
+}
+
+func TestPoolLists(t *testing.T) {
+	for _, prec := range []uint{1, 63, 64, 65, 128, 129, 1000, 4096, 4097, big.MaxPrec} {
+		// A value put at prec has as many words as any value taken from its
+		// list, and a value taken at prec has at most as many as any value
+		// put on its list.
+		g, p := getList(prec), putList(prec)
+		if n := mantWords(prec); uint(1)<<g < n || uint(1)<<p > n {
+			t.Errorf("%d bits: %d words, get list %d, put list %d", prec, n, g, p)
+		}
+		if g >= len(floatPool.lists) {
+			t.Errorf("%d bits: get list %d out of range", prec, g)
+		}
+	}
+	if g := getList(0); g != 0 {
+		t.Errorf("getList(0) = %d, want 0", g)
+	}
+}
+
+func TestPoolMulUnchanged(t *testing.T) {
+	x := &ComplexPoly{[]Complex{*newComplex128(1 + 2i), *newComplex128(3 - 1i)}}
+	y := &ComplexPoly{[]Complex{*newComplex128(0.5i), *newComplex128(-2)}}
+	want := []complex128{-1 + 0.5i, -1.5 - 2.5i, -6 + 2i}
+	p := new(ComplexPoly).Mul(x, y)
+	for k, w := range want {
+		if !p.c[k].Equals(newComplex128(w)) || p.c[k].l.Prec() != 53 {
+			t.Errorf("c[%d] = %v at %d bits, want %v", k, &p.c[k], p.c[k].l.Prec(), w)
+		}
+	}
+}
@@ -0,0 +1,638 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math/big"
+
+// setPrecFloats sets the precision of each of x to prec, and returns x.
+func setPrecFloats(x []*big.Float, prec uint) []*big.Float {
+	for _, v := range x {
+		v.SetPrec(prec)
+	}
+	return x
+}
+
+// precFloats returns the largest precision of x, which is zero if all of them
+// have zero precision, unlike maxPrec.
+func precFloats(x []*big.Float) uint {
+	var prec uint
+	for _, v := range x {
+		if p := v.Prec(); p > prec {
+			prec = p
+		}
+	}
+	return prec
+}
+
+// mixedFloats returns true if the precisions of x differ.
+func mixedFloats(x []*big.Float) bool {
+	for k := 1; k < len(x); k++ {
+		if x[k].Prec() != x[0].Prec() {
+			return true
+		}
+	}
+	return false
+}
+
+// SetPrec sets the precision of every component of z to prec, and returns z.
+// As with the SetPrec method of big.Float, each component is rounded with its
+// rounding mode if prec is less than its precision, raising the precision is
+// exact, and if prec is zero, then the finite components become zero and the
+// infinite ones are kept.
+//
+// The precision is not kept by later operations with z as the receiver. Each
+// method chooses the precisions of its results from its operands, as
+// described in its documentation, so after
+// 		new(Complex).SetPrec(200).Exp(x)
+// the components have the precision of x, and Conj may leave them at
+// different precisions. To round the results of operations to a chosen
+// precision, use a Context.
+func (z *Complex) SetPrec(prec uint) *Complex {
+	setPrecFloats(floatSlice(z.Cartesian()), prec)
+	return z
+}
+
+// Prec returns the largest precision of the components of z, which is their
+// common precision after SetPrec. The precision of the zero value is zero.
+func (z *Complex) Prec() uint {
+	return precFloats(floatSlice(z.Cartesian()))
+}
+
+// MixedPrec returns true if the components of z have different precisions,
+// as they may after an operation.
+func (z *Complex) MixedPrec() bool {
+	return mixedFloats(floatSlice(z.Cartesian()))
+}
+
+// SetPrec sets the precision of every component of z to prec, with the
+// conventions of the SetPrec method of Complex, and returns z.
+func (z *Perplex) SetPrec(prec uint) *Perplex {
+	setPrecFloats(floatSlice(z.Cartesian()), prec)
+	return z
+}
+
+// Prec returns the largest precision of the components of z, with the
+// conventions of the Prec method of Complex.
+func (z *Perplex) Prec() uint {
+	return precFloats(floatSlice(z.Cartesian()))
+}
+
+// MixedPrec returns true if the components of z have different precisions.
+func (z *Perplex) MixedPrec() bool {
+	return mixedFloats(floatSlice(z.Cartesian()))
+}
+
+// SetPrec sets the precision of every component of z to prec, with the
+// conventions of the SetPrec method of Complex, and returns z.
+func (z *Infra) SetPrec(prec uint) *Infra {
+	setPrecFloats(floatSlice(z.Cartesian()), prec)
+	return z
+}
+
+// Prec returns the largest precision of the components of z, with the
+// conventions of the Prec method of Complex.
+func (z *Infra) Prec() uint {
+	return precFloats(floatSlice(z.Cartesian()))
+}
+
+// MixedPrec returns true if the components of z have different precisions.
+func (z *Infra) MixedPrec() bool {
+	return mixedFloats(floatSlice(z.Cartesian()))
+}
+
+// SetPrec sets the precision of every component of z to prec, with the
+// conventions of the SetPrec method of Complex, and returns z.
+func (z *Hamilton) SetPrec(prec uint) *Hamilton {
+	setPrecFloats(floatSlice(z.Cartesian()), prec)
+	return z
+}
+
+// Prec returns the largest precision of the components of z, with the
+// conventions of the Prec method of Complex.
+func (z *Hamilton) Prec() uint {
+	return precFloats(floatSlice(z.Cartesian()))
+}
+
+// MixedPrec returns true if the components of z have different precisions.
+func (z *Hamilton) MixedPrec() bool {
+	return mixedFloats(floatSlice(z.Cartesian()))
+}
+
+// SetPrec sets the precision of every component of z to prec, with the
+// conventions of the SetPrec method of Complex, and returns z.
+func (z *Cockle) SetPrec(prec uint) *Cockle {
+	setPrecFloats(floatSlice(z.Cartesian()), prec)
+	return z
+}
+
+// Prec returns the largest precision of the components of z, with the
+// conventions of the Prec method of Complex.
+func (z *Cockle) Prec() uint {
+	return precFloats(floatSlice(z.Cartesian()))
+}
+
+// MixedPrec returns true if the components of z have different precisions.
+func (z *Cockle) MixedPrec() bool {
+	return mixedFloats(floatSlice(z.Cartesian()))
+}
+
+// SetPrec sets the precision of every component of z to prec, with the
+// conventions of the SetPrec method of Complex, and returns z.
+func (z *DualComplex) SetPrec(prec uint) *DualComplex {
+	setPrecFloats(floatSlice(z.Cartesian()), prec)
+	return z
+}
+
+// Prec returns the largest precision of the components of z, with the
+// conventions of the Prec method of Complex.
+func (z *DualComplex) Prec() uint {
+	return precFloats(floatSlice(z.Cartesian()))
+}
+
+// MixedPrec returns true if the components of z have different precisions.
+func (z *DualComplex) MixedPrec() bool {
+	return mixedFloats(floatSlice(z.Cartesian()))
+}
+
+// SetPrec sets the precision of every component of z to prec, with the
+// conventions of the SetPrec method of Complex, and returns z.
+func (z *InfraComplex) SetPrec(prec uint) *InfraComplex {
+	setPrecFloats(floatSlice(z.Cartesian()), prec)
+	return z
+}
+
+// Prec returns the largest precision of the components of z, with the
+// conventions of the Prec method of Complex.
+func (z *InfraComplex) Prec() uint {
+	return precFloats(floatSlice(z.Cartesian()))
+}
+
+// MixedPrec returns true if the components of z have different precisions.
+func (z *InfraComplex) MixedPrec() bool {
+	return mixedFloats(floatSlice(z.Cartesian()))
+}
+
+// SetPrec sets the precision of every component of z to prec, with the
+// conventions of the SetPrec method of Complex, and returns z.
+func (z *InfraPerplex) SetPrec(prec uint) *InfraPerplex {
+	setPrecFloats(floatSlice(z.Cartesian()), prec)
+	return z
+}
+
+// Prec returns the largest precision of the components of z, with the
+// conventions of the Prec method of Complex.
+func (z *InfraPerplex) Prec() uint {
+	return precFloats(floatSlice(z.Cartesian()))
+}
+
+// MixedPrec returns true if the components of z have different precisions.
+func (z *InfraPerplex) MixedPrec() bool {
+	return mixedFloats(floatSlice(z.Cartesian()))
+}
+
+// SetPrec sets the precision of every component of z to prec, with the
+// conventions of the SetPrec method of Complex, and returns z.
+func (z *Supra) SetPrec(prec uint) *Supra {
+	setPrecFloats(floatSlice(z.Cartesian()), prec)
+	return z
+}
+
+// Prec returns the largest precision of the components of z, with the
+// conventions of the Prec method of Complex.
+func (z *Supra) Prec() uint {
+	return precFloats(floatSlice(z.Cartesian()))
+}
+
+// MixedPrec returns true if the components of z have different precisions.
+func (z *Supra) MixedPrec() bool {
+	return mixedFloats(floatSlice(z.Cartesian()))
+}
+
+// SetPrec sets the precision of every component of z to prec, with the
+// conventions of the SetPrec method of Complex, and returns z.
+func (z *Macfarlane) SetPrec(prec uint) *Macfarlane {
+	setPrecFloats(floatSlice(z.Cartesian()), prec)
+	return z
+}
+
+// Prec returns the largest precision of the components of z, with the
+// conventions of the Prec method of Complex.
+func (z *Macfarlane) Prec() uint {
+	return precFloats(floatSlice(z.Cartesian()))
+}
+
+// MixedPrec returns true if the components of z have different precisions.
+func (z *Macfarlane) MixedPrec() bool {
+	return mixedFloats(floatSlice(z.Cartesian()))
+}
+
+// SetPrec sets the precision of every component of z to prec, with the
+// conventions of the SetPrec method of Complex, and returns z.
+func (z *Cayley) SetPrec(prec uint) *Cayley {
+	setPrecFloats(floatSlice(z.Cartesian()), prec)
+	return z
+}
+
+// Prec returns the largest precision of the components of z, with the
+// conventions of the Prec method of Complex.
+func (z *Cayley) Prec() uint {
+	return precFloats(floatSlice(z.Cartesian()))
+}
+
+// MixedPrec returns true if the components of z have different precisions.
+func (z *Cayley) MixedPrec() bool {
+	return mixedFloats(floatSlice(z.Cartesian()))
+}
+
+// SetPrec sets the precision of every component of z to prec, with the
+// conventions of the SetPrec method of Complex, and returns z.
+func (z *DualHamilton) SetPrec(prec uint) *DualHamilton {
+	setPrecFloats(floatSlice(z.Cartesian()), prec)
+	return z
+}
+
+// Prec returns the largest precision of the components of z, with the
+// conventions of the Prec method of Complex.
+func (z *DualHamilton) Prec() uint {
+	return precFloats(floatSlice(z.Cartesian()))
+}
+
+// MixedPrec returns true if the components of z have different precisions.
+func (z *DualHamilton) MixedPrec() bool {
+	return mixedFloats(floatSlice(z.Cartesian()))
+}
+
+// SetPrec sets the precision of every component of z to prec, with the
+// conventions of the SetPrec method of Complex, and returns z.
+func (z *InfraCockle) SetPrec(prec uint) *InfraCockle {
+	setPrecFloats(floatSlice(z.Cartesian()), prec)
+	return z
+}
+
+// Prec returns the largest precision of the components of z, with the
+// conventions of the Prec method of Complex.
+func (z *InfraCockle) Prec() uint {
+	return precFloats(floatSlice(z.Cartesian()))
+}
+
+// MixedPrec returns true if the components of z have different precisions.
+func (z *InfraCockle) MixedPrec() bool {
+	return mixedFloats(floatSlice(z.Cartesian()))
+}
+
+// SetPrec sets the precision of every component of z to prec, with the
+// conventions of the SetPrec method of Complex, and returns z.
+func (z *InfraHamilton) SetPrec(prec uint) *InfraHamilton {
+	setPrecFloats(floatSlice(z.Cartesian()), prec)
+	return z
+}
+
+// Prec returns the largest precision of the components of z, with the
+// conventions of the Prec method of Complex.
+func (z *InfraHamilton) Prec() uint {
+	return precFloats(floatSlice(z.Cartesian()))
+}
+
+// MixedPrec returns true if the components of z have different precisions.
+func (z *InfraHamilton) MixedPrec() bool {
+	return mixedFloats(floatSlice(z.Cartesian()))
+}
+
+// SetPrec sets the precision of every component of z to prec, with the
+// conventions of the SetPrec method of Complex, and returns z.
+func (z *SupraComplex) SetPrec(prec uint) *SupraComplex {
+	setPrecFloats(floatSlice(z.Cartesian()), prec)
+	return z
+}
+
+// Prec returns the largest precision of the components of z, with the
+// conventions of the Prec method of Complex.
+func (z *SupraComplex) Prec() uint {
+	return precFloats(floatSlice(z.Cartesian()))
+}
+
+// MixedPrec returns true if the components of z have different precisions.
+func (z *SupraComplex) MixedPrec() bool {
+	return mixedFloats(floatSlice(z.Cartesian()))
+}
+
+// SetPrec sets the precision of every component of z to prec, with the
+// conventions of the SetPrec method of Complex, and returns z.
+func (z *Ultra) SetPrec(prec uint) *Ultra {
+	setPrecFloats(floatSlice(z.Cartesian()), prec)
+	return z
+}
+
+// Prec returns the largest precision of the components of z, with the
+// conventions of the Prec method of Complex.
+func (z *Ultra) Prec() uint {
+	return precFloats(floatSlice(z.Cartesian()))
+}
+
+// MixedPrec returns true if the components of z have different precisions.
+func (z *Ultra) MixedPrec() bool {
+	return mixedFloats(floatSlice(z.Cartesian()))
+}
+
+// SetPrec sets the precision of every component of z to prec, with the
+// conventions of the SetPrec method of Complex, and returns z.
+func (z *Sedenion) SetPrec(prec uint) *Sedenion {
+	c := z.Cartesian()
+	setPrecFloats(c[:], prec)
+	return z
+}
+
+// Prec returns the largest precision of the components of z, with the
+// conventions of the Prec method of Complex.
+func (z *Sedenion) Prec() uint {
+	c := z.Cartesian()
+	return precFloats(c[:])
+}
+
+// MixedPrec returns true if the components of z have different precisions.
+func (z *Sedenion) MixedPrec() bool {
+	c := z.Cartesian()
+	return mixedFloats(c[:])
+}
+
+// SetPrec sets the precision of every component of z to prec, with the
+// conventions of the SetPrec method of Complex, and returns z.
+func (z *SupraCockle) SetPrec(prec uint) *SupraCockle {
+	c := z.Cartesian()
+	setPrecFloats(c[:], prec)
+	return z
+}
+
+// Prec returns the largest precision of the components of z, with the
+// conventions of the Prec method of Complex.
+func (z *SupraCockle) Prec() uint {
+	c := z.Cartesian()
+	return precFloats(c[:])
+}
+
+// MixedPrec returns true if the components of z have different precisions.
+func (z *SupraCockle) MixedPrec() bool {
+	c := z.Cartesian()
+	return mixedFloats(c[:])
+}
+
+// SetPrec sets the precision of every component of z to prec, with the
+// conventions of the SetPrec method of Complex, and returns z.
+func (z *SupraHamilton) SetPrec(prec uint) *SupraHamilton {
+	c := z.Cartesian()
+	setPrecFloats(c[:], prec)
+	return z
+}
+
+// Prec returns the largest precision of the components of z, with the
+// conventions of the Prec method of Complex.
+func (z *SupraHamilton) Prec() uint {
+	c := z.Cartesian()
+	return precFloats(c[:])
+}
+
+// MixedPrec returns true if the components of z have different precisions.
+func (z *SupraHamilton) MixedPrec() bool {
+	c := z.Cartesian()
+	return mixedFloats(c[:])
+}
+
+// SetPrec sets the precision of every component of v to prec, with the
+// conventions of the SetPrec method of Complex, and returns v.
+func (v *Vec3) SetPrec(prec uint) *Vec3 {
+	setPrecFloats(floatSlice(v.Cartesian()), prec)
+	return v
+}
+
+// Prec returns the largest precision of the components of v, with the
+// conventions of the Prec method of Complex.
+func (v *Vec3) Prec() uint {
+	return precFloats(floatSlice(v.Cartesian()))
+}
+
+// MixedPrec returns true if the components of v have different precisions.
+func (v *Vec3) MixedPrec() bool {
+	return mixedFloats(floatSlice(v.Cartesian()))
+}
+
+// SetPrec sets the precision of every component of z to prec, with the
+// conventions of the SetPrec method of Complex, and returns z.
+func (z *Jet) SetPrec(prec uint) *Jet {
+	setPrecFloats(z.Cartesian(), prec)
+	return z
+}
+
+// Prec returns the largest precision of the components of z, with the
+// conventions of the Prec method of Complex.
+func (z *Jet) Prec() uint {
+	return precFloats(z.Cartesian())
+}
+
+// MixedPrec returns true if the components of z have different precisions.
+func (z *Jet) MixedPrec() bool {
+	return mixedFloats(z.Cartesian())
+}
+
+// SetPrec sets the precision of every component of z to prec, with the
+// conventions of the SetPrec method of Complex, and returns z.
+func (z *Clifford) SetPrec(prec uint) *Clifford {
+	setPrecFloats(z.Cartesian(), prec)
+	return z
+}
+
+// Prec returns the largest precision of the components of z, with the
+// conventions of the Prec method of Complex.
+func (z *Clifford) Prec() uint {
+	return precFloats(z.Cartesian())
+}
+
+// MixedPrec returns true if the components of z have different precisions.
+func (z *Clifford) MixedPrec() bool {
+	return mixedFloats(z.Cartesian())
+}
+
+// SetPrec sets the precision of every component of z to prec, with the
+// conventions of the SetPrec method of Complex, and returns z.
+func (z *Grassmann) SetPrec(prec uint) *Grassmann {
+	setPrecFloats(z.Cartesian(), prec)
+	return z
+}
+
+// Prec returns the largest precision of the components of z, with the
+// conventions of the Prec method of Complex.
+func (z *Grassmann) Prec() uint {
+	return precFloats(z.Cartesian())
+}
+
+// MixedPrec returns true if the components of z have different precisions.
+func (z *Grassmann) MixedPrec() bool {
+	return mixedFloats(z.Cartesian())
+}
+
+// SetPrec sets the precision of every component of z to prec, with the
+// conventions of the SetPrec method of Complex, and returns z.
+func (z *Biquaternion) SetPrec(prec uint) *Biquaternion {
+	setPrecFloats(z.floats(), prec)
+	return z
+}
+
+// Prec returns the largest precision of the components of z, with the
+// conventions of the Prec method of Complex.
+func (z *Biquaternion) Prec() uint {
+	return precFloats(z.floats())
+}
+
+// MixedPrec returns true if the components of z have different precisions.
+func (z *Biquaternion) MixedPrec() bool {
+	return mixedFloats(z.floats())
+}
+
+// SetPrec sets the precision of every component of z to prec, with the
+// conventions of the SetPrec method of Complex, and returns z.
+func (z *Zorn) SetPrec(prec uint) *Zorn {
+	setPrecFloats(z.components(), prec)
+	return z
+}
+
+// Prec returns the largest precision of the components of z, with the
+// conventions of the Prec method of Complex.
+func (z *Zorn) Prec() uint {
+	return precFloats(z.components())
+}
+
+// MixedPrec returns true if the components of z have different precisions.
+func (z *Zorn) MixedPrec() bool {
+	return mixedFloats(z.components())
+}
+
+// SetPrec sets the precision of every coefficient of p to prec, with the
+// conventions of the SetPrec method of Complex, and returns p. If prec is zero,
+// then p becomes the zero polynomial, unless a coefficient is infinite.
+func (p *ComplexPoly) SetPrec(prec uint) *ComplexPoly {
+	setPrecFloats(p.floats(), prec)
+	return p.trim()
+}
+
+// Prec returns the largest precision of the coefficients of p, with the
+// conventions of the Prec method of Complex. The precision of the zero
+// polynomial is zero.
+func (p *ComplexPoly) Prec() uint {
+	return precFloats(p.floats())
+}
+
+// MixedPrec returns true if the coefficients of p have different precisions.
+func (p *ComplexPoly) MixedPrec() bool {
+	return mixedFloats(p.floats())
+}
+
+// SetPrec sets the precision of every entry of m to prec, with the conventions
+// of the SetPrec method of Complex, and returns m.
+func (m *HamiltonMatrix) SetPrec(prec uint) *HamiltonMatrix {
+	setPrecFloats(m.floats(), prec)
+	return m
+}
+
+// Prec returns the largest precision of the entries of m, with the conventions
+// of the Prec method of Complex.
+func (m *HamiltonMatrix) Prec() uint {
+	return precFloats(m.floats())
+}
+
+// MixedPrec returns true if the entries of m have different precisions.
+func (m *HamiltonMatrix) MixedPrec() bool {
+	return mixedFloats(m.floats())
+}
+
+// SetPrec sets the precision of both coordinates of z to prec, with the
+// conventions of the SetPrec method of Complex, and returns z. If both
+// coordinates would become zero, which can only happen if prec is zero, then
+// SetPrec panics and leaves z unchanged.
+func (z *ProjectiveComplex) SetPrec(prec uint) *ProjectiveComplex {
+	return z.Unflatten(setPrecFloats(z.Flatten(), prec))
+}
+
+// Prec returns the largest precision of the components of z, with the
+// conventions of the Prec method of Complex.
+func (z *ProjectiveComplex) Prec() uint {
+	return precFloats(z.floats())
+}
+
+// MixedPrec returns true if the components of z have different precisions.
+func (z *ProjectiveComplex) MixedPrec() bool {
+	return mixedFloats(z.floats())
+}
+
+// SetPrec sets the precision of both coordinates of z to prec, with the
+// conventions of the SetPrec method of Complex, and returns z. If both
+// coordinates would become zero, which can only happen if prec is zero, then
+// SetPrec panics and leaves z unchanged.
+func (z *ProjectiveHamilton) SetPrec(prec uint) *ProjectiveHamilton {
+	return z.Unflatten(setPrecFloats(z.Flatten(), prec))
+}
+
+// Prec returns the largest precision of the components of z, with the
+// conventions of the Prec method of Complex.
+func (z *ProjectiveHamilton) Prec() uint {
+	return precFloats(z.floats())
+}
+
+// MixedPrec returns true if the components of z have different precisions.
+func (z *ProjectiveHamilton) MixedPrec() bool {
+	return mixedFloats(z.floats())
+}
+
+// SetPrec sets the precision of both coordinates of z to prec, with the
+// conventions of the SetPrec method of Complex, and returns z. If both
+// coordinates would become zero, which can only happen if prec is zero, then
+// SetPrec panics and leaves z unchanged.
+func (z *ProjectiveCockle) SetPrec(prec uint) *ProjectiveCockle {
+	return z.Unflatten(setPrecFloats(z.Flatten(), prec))
+}
+
+// Prec returns the largest precision of the components of z, with the
+// conventions of the Prec method of Complex.
+func (z *ProjectiveCockle) Prec() uint {
+	return precFloats(z.floats())
+}
+
+// MixedPrec returns true if the components of z have different precisions.
+func (z *ProjectiveCockle) MixedPrec() bool {
+	return mixedFloats(z.floats())
+}
+
+// SetPrec sets the precision of every component of z to prec, with the
+// conventions of the SetPrec method of Complex, and returns z.
+func (z *MöbiusComplex) SetPrec(prec uint) *MöbiusComplex {
+	setPrecFloats(z.floats(), prec)
+	return z
+}
+
+// Prec returns the largest precision of the components of z, with the
+// conventions of the Prec method of Complex.
+func (z *MöbiusComplex) Prec() uint {
+	return precFloats(z.floats())
+}
+
+// MixedPrec returns true if the components of z have different precisions.
+func (z *MöbiusComplex) MixedPrec() bool {
+	return mixedFloats(z.floats())
+}
+
+// SetPrec sets the precision of every component of z to prec, with the
+// conventions of the SetPrec method of Complex, and returns z.
+func (z *MöbiusHamilton) SetPrec(prec uint) *MöbiusHamilton {
+	setPrecFloats(z.floats(), prec)
+	return z
+}
+
+// Prec returns the largest precision of the components of z, with the
+// conventions of the Prec method of Complex.
+func (z *MöbiusHamilton) Prec() uint {
+	return precFloats(z.floats())
+}
+
+// MixedPrec returns true if the components of z have different precisions.
+func (z *MöbiusHamilton) MixedPrec() bool {
+	return mixedFloats(z.floats())
+}
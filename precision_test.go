@@ -0,0 +1,81 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestSetPrecRaise(t *testing.T) {
+	f := func(x *Cayley) bool {
+		// t.Logf("x = %v", x)
+		y := new(Cayley).Copy(x).SetPrec(200)
+		return y.Prec() == 200 && !y.MixedPrec() && y.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSetPrecLower(t *testing.T) {
+	third := new(big.Float).SetPrec(200).Quo(big.NewFloat(1), big.NewFloat(3))
+	z := NewHamilton(third, new(big.Float).SetPrec(10).SetInt64(1), new(big.Float), third)
+	if p, mixed := z.Prec(), z.MixedPrec(); p != 200 || !mixed {
+		t.Errorf("Prec = %d, MixedPrec = %v, want the largest precision 200 and mixed", p, mixed)
+	}
+	z.SetPrec(24)
+	want := new(big.Float).SetPrec(24).Set(third)
+	a, b, _, d := z.Cartesian()
+	if z.Prec() != 24 || z.MixedPrec() || b.Prec() != 24 || a.Cmp(want) != 0 || d.Cmp(want) != 0 {
+		t.Errorf("SetPrec(24) = %v, want components rounded to 24 bits", z)
+	}
+	if j := new(Jet); j.Prec() != 0 || j.MixedPrec() {
+		t.Errorf("Prec of the zero value = %d, MixedPrec = %v, want 0", j.Prec(), j.MixedPrec())
+	}
+}
+
+func TestSetPrecShapes(t *testing.T) {
+	one, two := big.NewFloat(1), big.NewFloat(2)
+	p := NewComplexPoly(NewComplex(one, two), NewComplex(two, one))
+	if p.SetPrec(100).Prec() != 100 || len(p.c) != 2 {
+		t.Errorf("SetPrec(100) = %v", p)
+	}
+	if p.SetPrec(0); len(p.c) != 0 {
+		t.Errorf("SetPrec(0) = %v, want the zero polynomial", p)
+	}
+	u := NewVec3(one, two, one)
+	z := NewZorn(one, two, u, u).SetPrec(7)
+	if z.Prec() != 7 || z.u.y.Prec() != 7 {
+		t.Errorf("Zorn SetPrec(7) = %v", z)
+	}
+	x := NewProjectiveComplex(NewComplex(one, two), new(Complex))
+	func() {
+		defer func() {
+			if r := recover(); r != "projective point with zero coordinates" {
+				t.Errorf("recovered %v", r)
+			}
+		}()
+		x.SetPrec(0)
+	}()
+	if x.Prec() != 53 || x.x.r.Cmp(two) != 0 {
+		t.Errorf("failed SetPrec changed x to %v", x)
+	}
+}
+
+func TestSetPrecNotKept(t *testing.T) {
+	x := NewComplex(big.NewFloat(1), big.NewFloat(2))
+	// The transcendental functions round to the precision of the operand.
+	z := new(Complex).SetPrec(200).Exp(x)
+	if p, mixed := z.Prec(), z.MixedPrec(); p != 53 || mixed {
+		t.Errorf("Exp after SetPrec(200): Prec = %d, MixedPrec = %v, want 53", p, mixed)
+	}
+	// Conj copies the real part with its precision, and rounds the others to
+	// those of z.
+	z = new(Complex).SetPrec(200).Conj(x)
+	if p, mixed := z.Prec(), z.MixedPrec(); p != 200 || !mixed || z.l.Prec() != 53 {
+		t.Errorf("Conj after SetPrec(200): Prec = %d, MixedPrec = %v, want 200 and mixed", p, mixed)
+	}
+}
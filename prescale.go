@@ -0,0 +1,64 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math/big"
+
+// quadMargin is the number of binary orders of magnitude kept free at each end
+// of the exponent range of big.Float when squaring components, to absorb the
+// growth of the sums of several squares.
+const quadMargin = 64
+
+// quadScale returns the exponent e such that the components x, scaled by
+// 2^(-e), can be squared and multiplied together without overflow or
+// underflow. It is zero when the components are already safe, so that the
+// common case is unchanged; otherwise it is the largest exponent of the
+// components, which brings the largest of them into [1/2, 1).
+func quadScale(x ...*big.Float) int {
+	e, seen, safe := 0, false, true
+	for _, v := range x {
+		if v.Sign() == 0 || v.IsInf() {
+			continue
+		}
+		m := v.MantExp(nil)
+		if m > big.MaxExp/2-quadMargin || m < big.MinExp/2+quadMargin {
+			safe = false
+		}
+		if !seen || m > e {
+			e, seen = m, true
+		}
+	}
+	if safe {
+		return 0
+	}
+	return e
+}
+
+// prescaled returns the components x scaled by 2^(-e), and e, for
+// e = quadScale(x). If e is zero, then it returns x itself. The scaling is
+// exact, except that components smaller than the largest by more than the
+// exponent range of big.Float underflow to zero.
+func prescaled(x ...*big.Float) ([]*big.Float, int) {
+	e := quadScale(x...)
+	if e == 0 {
+		return x, 0
+	}
+	y := make([]*big.Float, len(x))
+	for k, v := range x {
+		y[k] = new(big.Float).SetMantExp(v, -e)
+	}
+	return y, e
+}
+
+// rescaleQuad scales quad, a quadrance computed from components prescaled by
+// 2^(-e), back by 2^(2e), and returns it. Since the scaling is exact, the
+// result is the quadrance computed without prescaling whenever that does not
+// overflow or underflow, and otherwise it is ±Inf or zero only if the
+// quadrance itself is out of the exponent range of big.Float.
+func rescaleQuad(quad *big.Float, e int) *big.Float {
+	if e == 0 {
+		return quad
+	}
+	return quad.SetMantExp(quad, 2*e)
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+)
+
+// pow2 returns x scaled by 2^e, at the precision of x.
+func pow2(x float64, e int) *big.Float {
+	return new(big.Float).SetMantExp(big.NewFloat(x), e)
+}
+
+func TestPrescaledQuadHuge(t *testing.T) {
+	// The squares of the components overflow, but the quadrances, which are
+	// differences of nearly equal squares, do not.
+	e := big.MaxExp/2 + 10
+	a, b := pow2(1+0x1p-52, e), pow2(1, e)
+	want := pow2(0x1p-51, 2*e)
+	zero := new(big.Float)
+	tests := []struct {
+		name string
+		quad func() *big.Float
+	}{
+		{"Perplex", func() *big.Float { return NewPerplex(a, b).Quad() }},
+		{"Cockle", func() *big.Float { return NewCockle(a, zero, b, zero).Quad() }},
+		{"Macfarlane", func() *big.Float { return NewMacfarlane(a, b, zero, zero).Quad() }},
+		{"Zorn", func() *big.Float {
+			return NewZorn(a, a, NewVec3(b, zero, zero), NewVec3(b, zero, zero)).Quad()
+		}},
+	}
+	for _, test := range tests {
+		if got := test.quad(); got.IsInf() || !closeTo(got, want, 50) {
+			t.Errorf("%s Quad = %v, want %v", test.name, got, want)
+		}
+	}
+	if z := NewZorn(a, a, NewVec3(b, zero, zero), NewVec3(b, zero, zero)); z.IsZeroDiv() {
+		t.Errorf("IsZeroDiv(%v) = true, want false", z)
+	}
+	if z := NewMacfarlane(a, b, zero, zero); z.IsZeroDiv() {
+		t.Errorf("IsZeroDiv(%v) = true, want false", z)
+	}
+}
+
+func TestPrescaledIsZeroDivTiny(t *testing.T) {
+	// The squares of the components underflow to zero, but the values are
+	// not zero divisors.
+	e := big.MinExp/2 - 10
+	a, b := pow2(1, e), pow2(0.5, e)
+	zero := new(big.Float)
+	if z := NewCockle(a, zero, b, zero); z.IsZeroDiv() {
+		t.Errorf("IsZeroDiv(%v) = true, want false", z)
+	}
+	if z := NewMacfarlane(a, b, zero, zero); z.IsZeroDiv() {
+		t.Errorf("IsZeroDiv(%v) = true, want false", z)
+	}
+	if z := NewZorn(a, a, NewVec3(b, zero, zero), NewVec3(b, zero, zero)); z.IsZeroDiv() {
+		t.Errorf("IsZeroDiv(%v) = true, want false", z)
+	}
+	c := NewComplex(a, zero)
+	if z := NewBiquaternion(c, c, new(Complex), new(Complex)); z.IsZeroDiv() {
+		t.Errorf("IsZeroDiv(%v) = true, want false", z)
+	}
+	// A true zero divisor is still found.
+	if z := NewCockle(a, zero, a, zero); !z.IsZeroDiv() {
+		t.Errorf("IsZeroDiv(%v) = false, want true", z)
+	}
+}
+
+func TestPrescaledQuadUnchanged(t *testing.T) {
+	// Away from the exponent limits, no scaling takes place.
+	x := []*big.Float{big.NewFloat(3), big.NewFloat(-0.25), pow2(1, 1000)}
+	if y, e := prescaled(x...); e != 0 || &y[0] != &x[0] {
+		t.Errorf("prescaled(%v) scaled by 2^%d", x, -e)
+	}
+}
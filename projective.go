@@ -0,0 +1,417 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"fmt"
+	"io"
+)
+
+// A ProjectiveComplex represents a point [x : y] of the projective line over
+// the complex numbers, in homogeneous coordinates. The pairs [x : y] and [xλ :
+// yλ] are the same point for every invertible λ. The finite points [w : 1]
+// correspond to the values w = x Inv(y), and [1 : 0] is the point at infinity.
+type ProjectiveComplex struct {
+	x, y Complex
+}
+
+// NewProjectiveComplex returns a pointer to the ProjectiveComplex value [x :
+// y]. If x and y are both zero, then NewProjectiveComplex panics.
+func NewProjectiveComplex(x, y *Complex) *ProjectiveComplex {
+	zero := new(Complex)
+	if x.Equals(zero) && y.Equals(zero) {
+		panic("projective point with zero coordinates")
+	}
+	z := new(ProjectiveComplex)
+	z.x.Copy(x)
+	z.y.Copy(y)
+	return z
+}
+
+// Coordinates returns the homogeneous coordinates x and y of z.
+func (z *ProjectiveComplex) Coordinates() (*Complex, *Complex) {
+	return &z.x, &z.y
+}
+
+// String returns the string version of a ProjectiveComplex value.
+//
+// If z corresponds to [x : y], then the string is "[x : y]".
+func (z *ProjectiveComplex) String() string {
+	return "[" + z.x.String() + " : " + z.y.String() + "]"
+}
+
+// Format implements fmt.Formatter, with the conventions of the Format method
+// of Complex for each coordinate.
+func (z *ProjectiveComplex) Format(s fmt.State, verb rune) {
+	if formatBad(s, verb, z) {
+		return
+	}
+	io.WriteString(s, "[")
+	z.x.Format(s, verb)
+	io.WriteString(s, " : ")
+	z.y.Format(s, verb)
+	io.WriteString(s, "]")
+}
+
+// Copy copies y onto z, and returns z.
+func (z *ProjectiveComplex) Copy(y *ProjectiveComplex) *ProjectiveComplex {
+	z.x.Copy(&y.x)
+	z.y.Copy(&y.y)
+	return z
+}
+
+// SetAffine sets z equal to the finite point [y : 1], and returns z.
+func (z *ProjectiveComplex) SetAffine(y *Complex) *ProjectiveComplex {
+	prec := maxPrec(&y.l, &y.r)
+	z.x.Copy(y)
+	z.y.Copy(new(Complex))
+	z.y.l.SetPrec(prec).SetInt64(1)
+	return z
+}
+
+// SetInfinity sets z equal to the point at infinity [1 : 0], and returns z.
+func (z *ProjectiveComplex) SetInfinity() *ProjectiveComplex {
+	z.x.Copy(new(Complex))
+	z.x.l.SetInt64(1)
+	z.y.Copy(new(Complex))
+	return z
+}
+
+// IsInfinity returns true if y has no inverse, so that z is not a finite
+// point.
+func (z *ProjectiveComplex) IsInfinity() bool {
+	return !!z.y.Equals(new(Complex))
+}
+
+// Affine returns the value x Inv(y) of the finite point z = [x : y] and true.
+// If z is not a finite point, then Affine returns nil and false.
+func (z *ProjectiveComplex) Affine() (*Complex, bool) {
+	if z.IsInfinity() {
+		return nil, false
+	}
+	return new(Complex).Quo(&z.x, &z.y), true
+}
+
+// Normalize sets z equal to the representative of y with y = 1, or with x = 1
+// if y has no inverse, and returns z.
+func (z *ProjectiveComplex) Normalize(y *ProjectiveComplex) *ProjectiveComplex {
+	switch {
+	case !y.y.Equals(new(Complex)):
+		w := new(Complex).Quo(&y.x, &y.y)
+		return z.SetAffine(w)
+	case !y.x.Equals(new(Complex)):
+		w := new(Complex).Quo(&y.y, &y.x)
+		z.SetAffine(w)
+		z.x, z.y = z.y, z.x
+		return z
+	}
+	return z.Copy(y)
+}
+
+// Equals returns true if y and z are the same point, which is decided by
+// comparing their normalized coordinates.
+func (z *ProjectiveComplex) Equals(y *ProjectiveComplex) bool {
+	p := new(ProjectiveComplex).Normalize(z)
+	q := new(ProjectiveComplex).Normalize(y)
+	return p.x.Equals(&q.x) && p.y.Equals(&q.y)
+}
+
+// Möbius sets z equal to the image of p under the Möbius transform with the
+// matrix [[a, b], [c, d]]:
+// 		[a*x + b*y : c*x + d*y]
+// Then it returns z. On finite points with a finite image this agrees with
+// Möbius, and it is defined at the points at infinity as well. If the matrix
+// is singular, so that both new coordinates vanish, then Möbius panics.
+func (z *ProjectiveComplex) Möbius(p *ProjectiveComplex, a, b, c, d *Complex) *ProjectiveComplex {
+	x := new(Complex).Add(new(Complex).Mul(a, &p.x), new(Complex).Mul(b, &p.y))
+	y := new(Complex).Add(new(Complex).Mul(c, &p.x), new(Complex).Mul(d, &p.y))
+	zero := new(Complex)
+	if x.Equals(zero) && y.Equals(zero) {
+		panic("projective point with zero coordinates")
+	}
+	z.x.Copy(x)
+	z.y.Copy(y)
+	return z
+}
+
+// CrossRatio sets z equal to the cross ratio of the points v, w, x, and y,
+// which in terms of the determinants [p, q] = p₁q₂ - p₂q₁ of homogeneous
+// coordinates is
+// 		[[v, x][w, y] : [w, x][v, y]]
+// Then it returns z. On finite points this agrees with CrossRatio on Complex,
+// and it is the point at infinity when w = x or v = y. If three of the points
+// coincide, then both coordinates vanish and CrossRatio panics.
+func (z *ProjectiveComplex) CrossRatio(v, w, x, y *ProjectiveComplex) *ProjectiveComplex {
+	det := func(p, q *ProjectiveComplex) *Complex {
+		return new(Complex).Sub(new(Complex).Mul(&p.x, &q.y), new(Complex).Mul(&p.y, &q.x))
+	}
+	num := new(Complex).Mul(det(v, x), det(w, y))
+	den := new(Complex).Mul(det(w, x), det(v, y))
+	zero := new(Complex)
+	if num.Equals(zero) && den.Equals(zero) {
+		panic("projective point with zero coordinates")
+	}
+	z.x.Copy(num)
+	z.y.Copy(den)
+	return z
+}
+
+// A ProjectiveHamilton represents a point [x : y] of the projective line over
+// the Hamilton quaternions, in homogeneous coordinates. The pairs [x : y] and
+// [xλ : yλ] are the same point for every invertible λ. Scalars act on the
+// right, so that Möbius transforms act on the left. The finite points [w : 1]
+// correspond to the values w = x Inv(y), and [1 : 0] is the point at infinity.
+type ProjectiveHamilton struct {
+	x, y Hamilton
+}
+
+// NewProjectiveHamilton returns a pointer to the ProjectiveHamilton value [x :
+// y]. If x and y are both zero, then NewProjectiveHamilton panics.
+func NewProjectiveHamilton(x, y *Hamilton) *ProjectiveHamilton {
+	zero := new(Hamilton)
+	if x.Equals(zero) && y.Equals(zero) {
+		panic("projective point with zero coordinates")
+	}
+	z := new(ProjectiveHamilton)
+	z.x.Copy(x)
+	z.y.Copy(y)
+	return z
+}
+
+// Coordinates returns the homogeneous coordinates x and y of z.
+func (z *ProjectiveHamilton) Coordinates() (*Hamilton, *Hamilton) {
+	return &z.x, &z.y
+}
+
+// String returns the string version of a ProjectiveHamilton value.
+//
+// If z corresponds to [x : y], then the string is "[x : y]".
+func (z *ProjectiveHamilton) String() string {
+	return "[" + z.x.String() + " : " + z.y.String() + "]"
+}
+
+// Format implements fmt.Formatter, with the conventions of the Format method
+// of Complex for each coordinate.
+func (z *ProjectiveHamilton) Format(s fmt.State, verb rune) {
+	if formatBad(s, verb, z) {
+		return
+	}
+	io.WriteString(s, "[")
+	z.x.Format(s, verb)
+	io.WriteString(s, " : ")
+	z.y.Format(s, verb)
+	io.WriteString(s, "]")
+}
+
+// Copy copies y onto z, and returns z.
+func (z *ProjectiveHamilton) Copy(y *ProjectiveHamilton) *ProjectiveHamilton {
+	z.x.Copy(&y.x)
+	z.y.Copy(&y.y)
+	return z
+}
+
+// SetAffine sets z equal to the finite point [y : 1], and returns z.
+func (z *ProjectiveHamilton) SetAffine(y *Hamilton) *ProjectiveHamilton {
+	prec := maxPrec(&y.l.l, &y.l.r, &y.r.l, &y.r.r)
+	z.x.Copy(y)
+	z.y.Copy(new(Hamilton))
+	z.y.l.l.SetPrec(prec).SetInt64(1)
+	return z
+}
+
+// SetInfinity sets z equal to the point at infinity [1 : 0], and returns z.
+func (z *ProjectiveHamilton) SetInfinity() *ProjectiveHamilton {
+	z.x.Copy(new(Hamilton))
+	z.x.l.l.SetInt64(1)
+	z.y.Copy(new(Hamilton))
+	return z
+}
+
+// IsInfinity returns true if y has no inverse, so that z is not a finite
+// point.
+func (z *ProjectiveHamilton) IsInfinity() bool {
+	return !!z.y.Equals(new(Hamilton))
+}
+
+// Affine returns the value x Inv(y) of the finite point z = [x : y] and true.
+// If z is not a finite point, then Affine returns nil and false.
+func (z *ProjectiveHamilton) Affine() (*Hamilton, bool) {
+	if z.IsInfinity() {
+		return nil, false
+	}
+	return new(Hamilton).QuoR(&z.x, &z.y), true
+}
+
+// Normalize sets z equal to the representative of y with y = 1, or with x = 1
+// if y has no inverse, and returns z.
+func (z *ProjectiveHamilton) Normalize(y *ProjectiveHamilton) *ProjectiveHamilton {
+	switch {
+	case !y.y.Equals(new(Hamilton)):
+		w := new(Hamilton).QuoR(&y.x, &y.y)
+		return z.SetAffine(w)
+	case !y.x.Equals(new(Hamilton)):
+		w := new(Hamilton).QuoR(&y.y, &y.x)
+		z.SetAffine(w)
+		z.x, z.y = z.y, z.x
+		return z
+	}
+	return z.Copy(y)
+}
+
+// Equals returns true if y and z are the same point, which is decided by
+// comparing their normalized coordinates.
+func (z *ProjectiveHamilton) Equals(y *ProjectiveHamilton) bool {
+	p := new(ProjectiveHamilton).Normalize(z)
+	q := new(ProjectiveHamilton).Normalize(y)
+	return p.x.Equals(&q.x) && p.y.Equals(&q.y)
+}
+
+// Möbius sets z equal to the image of p under the Möbius transform with the
+// matrix [[a, b], [c, d]]:
+// 		[a*x + b*y : c*x + d*y]
+// Then it returns z. On finite points with a finite image this agrees with
+// MöbiusR, and it is defined at the points at infinity as well. If the matrix
+// is singular, so that both new coordinates vanish, then Möbius panics.
+func (z *ProjectiveHamilton) Möbius(p *ProjectiveHamilton, a, b, c, d *Hamilton) *ProjectiveHamilton {
+	x := new(Hamilton).Add(new(Hamilton).Mul(a, &p.x), new(Hamilton).Mul(b, &p.y))
+	y := new(Hamilton).Add(new(Hamilton).Mul(c, &p.x), new(Hamilton).Mul(d, &p.y))
+	zero := new(Hamilton)
+	if x.Equals(zero) && y.Equals(zero) {
+		panic("projective point with zero coordinates")
+	}
+	z.x.Copy(x)
+	z.y.Copy(y)
+	return z
+}
+
+// A ProjectiveCockle represents a point [x : y] of the projective line over the
+// Cockle quaternions, in homogeneous coordinates. The pairs [x : y] and [xλ :
+// yλ] are the same point for every invertible λ. Scalars act on the right, so
+// that Möbius transforms act on the left. The finite points [w : 1] correspond
+// to the values w = x Inv(y), and [1 : 0] is the point at infinity. Since the
+// Cockle quaternions have zero divisors, every [x : y] where y is a zero
+// divisor is a point at infinity, not only [1 : 0].
+type ProjectiveCockle struct {
+	x, y Cockle
+}
+
+// NewProjectiveCockle returns a pointer to the ProjectiveCockle value [x : y].
+// If x and y are both zero, then NewProjectiveCockle panics.
+func NewProjectiveCockle(x, y *Cockle) *ProjectiveCockle {
+	zero := new(Cockle)
+	if x.Equals(zero) && y.Equals(zero) {
+		panic("projective point with zero coordinates")
+	}
+	z := new(ProjectiveCockle)
+	z.x.Copy(x)
+	z.y.Copy(y)
+	return z
+}
+
+// Coordinates returns the homogeneous coordinates x and y of z.
+func (z *ProjectiveCockle) Coordinates() (*Cockle, *Cockle) {
+	return &z.x, &z.y
+}
+
+// String returns the string version of a ProjectiveCockle value.
+//
+// If z corresponds to [x : y], then the string is "[x : y]".
+func (z *ProjectiveCockle) String() string {
+	return "[" + z.x.String() + " : " + z.y.String() + "]"
+}
+
+// Format implements fmt.Formatter, with the conventions of the Format method
+// of Complex for each coordinate.
+func (z *ProjectiveCockle) Format(s fmt.State, verb rune) {
+	if formatBad(s, verb, z) {
+		return
+	}
+	io.WriteString(s, "[")
+	z.x.Format(s, verb)
+	io.WriteString(s, " : ")
+	z.y.Format(s, verb)
+	io.WriteString(s, "]")
+}
+
+// Copy copies y onto z, and returns z.
+func (z *ProjectiveCockle) Copy(y *ProjectiveCockle) *ProjectiveCockle {
+	z.x.Copy(&y.x)
+	z.y.Copy(&y.y)
+	return z
+}
+
+// SetAffine sets z equal to the finite point [y : 1], and returns z.
+func (z *ProjectiveCockle) SetAffine(y *Cockle) *ProjectiveCockle {
+	prec := maxPrec(&y.l.l, &y.l.r, &y.r.l, &y.r.r)
+	z.x.Copy(y)
+	z.y.Copy(new(Cockle))
+	z.y.l.l.SetPrec(prec).SetInt64(1)
+	return z
+}
+
+// SetInfinity sets z equal to the point at infinity [1 : 0], and returns z.
+func (z *ProjectiveCockle) SetInfinity() *ProjectiveCockle {
+	z.x.Copy(new(Cockle))
+	z.x.l.l.SetInt64(1)
+	z.y.Copy(new(Cockle))
+	return z
+}
+
+// IsInfinity returns true if y has no inverse, so that z is not a finite
+// point.
+func (z *ProjectiveCockle) IsInfinity() bool {
+	return !!z.y.IsZeroDiv()
+}
+
+// Affine returns the value x Inv(y) of the finite point z = [x : y] and true.
+// If z is not a finite point, then Affine returns nil and false.
+func (z *ProjectiveCockle) Affine() (*Cockle, bool) {
+	if z.IsInfinity() {
+		return nil, false
+	}
+	return new(Cockle).QuoR(&z.x, &z.y), true
+}
+
+// Normalize sets z equal to the representative of y with y = 1, or with x = 1
+// if y has no inverse, and returns z. If neither coordinate of y has an
+// inverse, then z is set equal to y.
+func (z *ProjectiveCockle) Normalize(y *ProjectiveCockle) *ProjectiveCockle {
+	switch {
+	case !y.y.IsZeroDiv():
+		w := new(Cockle).QuoR(&y.x, &y.y)
+		return z.SetAffine(w)
+	case !y.x.IsZeroDiv():
+		w := new(Cockle).QuoR(&y.y, &y.x)
+		z.SetAffine(w)
+		z.x, z.y = z.y, z.x
+		return z
+	}
+	return z.Copy(y)
+}
+
+// Equals returns true if y and z are the same point, which is decided by
+// comparing their normalized coordinates.
+func (z *ProjectiveCockle) Equals(y *ProjectiveCockle) bool {
+	p := new(ProjectiveCockle).Normalize(z)
+	q := new(ProjectiveCockle).Normalize(y)
+	return p.x.Equals(&q.x) && p.y.Equals(&q.y)
+}
+
+// Möbius sets z equal to the image of p under the Möbius transform with the
+// matrix [[a, b], [c, d]]:
+// 		[a*x + b*y : c*x + d*y]
+// Then it returns z. On finite points with a finite image this agrees with
+// MöbiusR, and it is defined at the points at infinity as well. If the matrix
+// is singular, so that both new coordinates vanish, then Möbius panics.
+func (z *ProjectiveCockle) Möbius(p *ProjectiveCockle, a, b, c, d *Cockle) *ProjectiveCockle {
+	x := new(Cockle).Add(new(Cockle).Mul(a, &p.x), new(Cockle).Mul(b, &p.y))
+	y := new(Cockle).Add(new(Cockle).Mul(c, &p.x), new(Cockle).Mul(d, &p.y))
+	zero := new(Cockle)
+	if x.Equals(zero) && y.Equals(zero) {
+		panic("projective point with zero coordinates")
+	}
+	z.x.Copy(x)
+	z.y.Copy(y)
+	return z
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestProjectiveComplexMöbiusAgrees(t *testing.T) {
+	f := func(y, a, b, c, d *Complex) bool {
+		// t.Logf("y = %v, a = %v, b = %v, c = %v, d = %v", y, a, b, c, d)
+		p := new(ProjectiveComplex).SetAffine(y)
+		p.Möbius(p, a, b, c, d)
+		w, ok := p.Affine()
+		m := new(Complex).Möbius(y, a, b, c, d)
+		diff := new(Complex).Sub(w, m)
+		return ok && (diff.Quad().Sign() == 0 || complexExpo(diff) < complexExpo(m)-45)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestProjectiveComplexInfinity(t *testing.T) {
+	zero := new(big.Float)
+	one := NewComplex(big.NewFloat(1), zero)
+	two := NewComplex(big.NewFloat(2), zero)
+	// y ↦ 2y/(y - 1) sends 1 to infinity and infinity to 2.
+	p := new(ProjectiveComplex).SetAffine(one)
+	p.Möbius(p, two, new(Complex), one, new(Complex).Neg(one))
+	if !p.IsInfinity() || !p.Equals(new(ProjectiveComplex).SetInfinity()) {
+		t.Errorf("image of 1 = %v", p)
+	}
+	p.Möbius(p, two, new(Complex), one, new(Complex).Neg(one))
+	if w, ok := p.Affine(); !ok || !w.Equals(two) {
+		t.Errorf("image of infinity = %v", p)
+	}
+}
+
+func TestProjectiveComplexCrossRatio(t *testing.T) {
+	f := func(v, w, x, y *Complex) bool {
+		// t.Logf("v = %v, w = %v, x = %v, y = %v", v, w, x, y)
+		p := func(z *Complex) *ProjectiveComplex {
+			return new(ProjectiveComplex).SetAffine(z)
+		}
+		r := new(ProjectiveComplex).CrossRatio(p(v), p(w), p(x), p(y))
+		a, ok := r.Affine()
+		c := new(Complex).CrossRatio(v, w, x, y)
+		diff := new(Complex).Sub(a, c)
+		return ok && (diff.Quad().Sign() == 0 || complexExpo(diff) < complexExpo(c)-40)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	zero := new(big.Float)
+	v := new(ProjectiveComplex).SetAffine(NewComplex(big.NewFloat(1), zero))
+	w := new(ProjectiveComplex).SetAffine(NewComplex(big.NewFloat(2), zero))
+	y := new(ProjectiveComplex).SetAffine(NewComplex(big.NewFloat(3), zero))
+	if r := new(ProjectiveComplex).CrossRatio(v, w, w, y); !r.IsInfinity() {
+		t.Errorf("CrossRatio with w = x = %v", r)
+	}
+}
+
+func TestProjectiveHamiltonEqualsScaled(t *testing.T) {
+	f := func(x, y, l *Hamilton) bool {
+		// t.Logf("x = %v, y = %v, l = %v", x, y, l)
+		p := NewProjectiveHamilton(x, y)
+		q := NewProjectiveHamilton(new(Hamilton).Mul(x, l), new(Hamilton).Mul(y, l))
+		a, _ := p.Affine()
+		b, _ := q.Affine()
+		d := new(Hamilton).Sub(a, b).Quad()
+		return d.Sign() == 0 || expo(d) < expo(a.Quad())-80
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestProjectiveCockleZeroDivisorAtInfinity(t *testing.T) {
+	zero := new(big.Float)
+	one := big.NewFloat(1)
+	// 1+t is a zero divisor, so [1 : 1+t] is a point at infinity.
+	p := NewProjectiveCockle(NewCockle(one, zero, zero, zero), NewCockle(one, zero, one, zero))
+	if !p.IsInfinity() {
+		t.Errorf("%v is finite", p)
+	}
+	if _, ok := p.Affine(); ok {
+		t.Errorf("Affine(%v) succeeded", p)
+	}
+	n := new(ProjectiveCockle).Normalize(p)
+	if x, _ := n.Coordinates(); !x.Equals(NewCockle(one, zero, zero, zero)) {
+		t.Errorf("Normalize(%v) = %v", p, n)
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// A QFTSide selects the side on which the kernel of a quaternion Fourier
+// transform multiplies the signal. Since Mul is noncommutative, the two
+// sides give different transforms.
+type QFTSide int
+
+const (
+	// LeftQFT multiplies the signal on the left:
+	// 		F[k] = Σ exp(-2πμnk/N) f[n]
+	LeftQFT QFTSide = iota
+	// RightQFT multiplies the signal on the right:
+	// 		F[k] = Σ f[n] exp(-2πμnk/N)
+	RightQFT
+)
+
+// qftAxes holds the vector parts of an orthonormal basis 1, μ, ν, μν of the
+// quaternions, where μ is the axis of a transform and ν is perpendicular to
+// it. With respect to it, every quaternion is
+// 		(a + bμ) + (c + dμ)ν = (a + bμ) + ν(c - dμ)
+// a pair of complex numbers in the subalgebra of μ, which the kernel
+// exp(-2πμnk/N) multiplies like a complex number.
+type qftAxes struct {
+	mu, nu, munu [3]*big.Float
+}
+
+// newQFTAxes returns the axes of the transform with the axis given by the
+// vector part of mu, normalized, computed at w bits. If the vector part of mu
+// is zero, then newQFTAxes panics.
+func newQFTAxes(mu *Hamilton, w uint) qftAxes {
+	_, b, c, d := mu.Cartesian()
+	norm := bigHypot(w, b, c, d)
+	if norm.Sign() == 0 {
+		panic("zero axis")
+	}
+	var ax qftAxes
+	for k, v := range []*big.Float{b, c, d} {
+		ax.mu[k] = newFloat(w).Quo(v, norm)
+	}
+	// ν is the normalized cross product of μ and the coordinate axis along
+	// which μ is smallest.
+	e := 0
+	for k := 1; k < 3; k++ {
+		if new(big.Float).Abs(ax.mu[k]).Cmp(new(big.Float).Abs(ax.mu[e])) < 0 {
+			e = k
+		}
+	}
+	var unit [3]*big.Float
+	for k := range unit {
+		unit[k] = newFloat(w)
+	}
+	unit[e].SetInt64(1)
+	ax.nu = qftCross(ax.mu, unit, w)
+	norm = bigHypot(w, ax.nu[0], ax.nu[1], ax.nu[2])
+	for k := range ax.nu {
+		ax.nu[k].Quo(ax.nu[k], norm)
+	}
+	ax.munu = qftCross(ax.mu, ax.nu, w)
+	return ax
+}
+
+// qftCross returns the cross product of u and v at w bits.
+func qftCross(u, v [3]*big.Float, w uint) [3]*big.Float {
+	var c [3]*big.Float
+	for k := range c {
+		i, j := (k+1)%3, (k+2)%3
+		c[k] = newFloat(w).Mul(u[i], v[j])
+		c[k].Sub(c[k], newFloat(w).Mul(u[j], v[i]))
+	}
+	return c
+}
+
+// qftDot returns the dot product of u and the vector (b, c, d) at w bits.
+func qftDot(u [3]*big.Float, b, c, d *big.Float, w uint) *big.Float {
+	s := newFloat(w).Mul(u[0], b)
+	s.Add(s, newFloat(w).Mul(u[1], c))
+	return s.Add(s, newFloat(w).Mul(u[2], d))
+}
+
+// complexDFT replaces a with its discrete Fourier transform, with the
+// conventions of fft, at w bits. Lengths that are not powers of two use the
+// direct sum.
+func complexDFT(a []Complex, inverse bool, w uint) {
+	n := len(a)
+	roots := RootsOfUnity(n, w)
+	if n&(n-1) == 0 {
+		fft(a, roots, inverse)
+		return
+	}
+	b := make([]Complex, n)
+	t, r := new(Complex), new(Complex)
+	for k := range b {
+		b[k].l.SetPrec(w)
+		b[k].r.SetPrec(w)
+		for j := range a {
+			r.Copy(roots[j*k%n])
+			if !inverse {
+				r.Conj(r)
+			}
+			b[k].Add(&b[k], t.Mul(r, &a[j]))
+		}
+	}
+	copy(a, b)
+}
+
+// qftLine returns the one-sided transform of f, whose components have w
+// bits, with the axes ax, at w bits. The inverse transform is normalized.
+func qftLine(f []*Hamilton, ax qftAxes, side QFTSide, inverse bool, w uint) []*Hamilton {
+	n := len(f)
+	z1, z2 := make([]Complex, n), make([]Complex, n)
+	for k, q := range f {
+		a, b, c, d := q.Cartesian()
+		z1[k].l.SetPrec(w).Set(a)
+		z1[k].r.Set(qftDot(ax.mu, b, c, d, w))
+		z2[k].l.Set(qftDot(ax.nu, b, c, d, w))
+		z2[k].r.Set(qftDot(ax.munu, b, c, d, w))
+		if side == RightQFT {
+			z2[k].r.Neg(&z2[k].r)
+		}
+	}
+	complexDFT(z1, inverse, w)
+	complexDFT(z2, inverse, w)
+	g := make([]*Hamilton, n)
+	size := newFloat(w).SetInt64(int64(n))
+	for k := range g {
+		if inverse {
+			for _, v := range []*big.Float{&z1[k].l, &z1[k].r, &z2[k].l, &z2[k].r} {
+				v.Quo(v, size)
+			}
+		}
+		if side == RightQFT {
+			z2[k].r.Neg(&z2[k].r)
+		}
+		var v [3]*big.Float
+		for i := range v {
+			v[i] = newFloat(w).Mul(&z1[k].r, ax.mu[i])
+			v[i].Add(v[i], newFloat(w).Mul(&z2[k].l, ax.nu[i]))
+			v[i].Add(v[i], newFloat(w).Mul(&z2[k].r, ax.munu[i]))
+		}
+		g[k] = NewHamilton(&z1[k].l, v[0], v[1], v[2])
+	}
+	return g
+}
+
+// qftPrec returns the largest precision of the components of f.
+func qftPrec(f []*Hamilton) uint {
+	var x []*big.Float
+	for _, q := range f {
+		a, b, c, d := q.Cartesian()
+		x = append(x, a, b, c, d)
+	}
+	return maxPrec(x...)
+}
+
+// QFT returns the one-sided quaternion Fourier transform of the signal f
+// with the axis μ given by the vector part of mu, normalized, on the given
+// side, or the inverse transform
+// 		f[n] = (1/N) Σ exp(2πμnk/N) F[k]
+// (with the kernel on the right for RightQFT) if inverse is true, so that
+// the inverse undoes the transform. Each value is split into two complex
+// numbers in the subalgebra of μ, the symplectic decomposition, which are
+// transformed with the complex fast Fourier transform; lengths that are not
+// powers of two use the direct sum. For a signal in the subalgebra of μ, both
+// sides give the discrete Fourier transform. The result is rounded to the
+// largest precision of the components of f. If the vector part of mu is zero,
+// then QFT panics.
+func QFT(f []*Hamilton, mu *Hamilton, side QFTSide, inverse bool) []*Hamilton {
+	if len(f) == 0 {
+		return nil
+	}
+	prec := qftPrec(f)
+	w := prec + guardBits + 2*uint(bits.Len(uint(len(f))))
+	g := make([]*Hamilton, len(f))
+	for k, q := range f {
+		g[k] = new(Hamilton).setPrec(q, w)
+	}
+	g = qftLine(g, newQFTAxes(mu, w), side, inverse, w)
+	for k := range g {
+		g[k].setPrec(g[k], prec)
+	}
+	return g
+}
+
+// QFT2 returns the two-sided quaternion Fourier transform of the
+// two-dimensional signal f, whose rows all have the same length N, with the
+// axes μ1 and μ2 given by mu1 and mu2 as for QFT:
+// 		F[u][v] = Σ exp(-2πμ1mu/M) f[m][n] exp(-2πμ2nv/N)
+// where M is the number of rows, or the inverse transform if inverse is true.
+// The kernels act on opposite sides, so it is computed as the left transform
+// of every column with μ1 and the right transform of every row with μ2, in
+// either order. The result is rounded to the largest precision of the
+// components of f. If the rows of f have different lengths, or if the vector
+// part of mu1 or mu2 is zero, then QFT2 panics.
+func QFT2(f [][]*Hamilton, mu1, mu2 *Hamilton, inverse bool) [][]*Hamilton {
+	if len(f) == 0 || len(f[0]) == 0 {
+		return nil
+	}
+	m, n := len(f), len(f[0])
+	var all []*Hamilton
+	for _, row := range f {
+		if len(row) != n {
+			panic("ragged signal")
+		}
+		all = append(all, row...)
+	}
+	prec := qftPrec(all)
+	w := prec + guardBits + 2*uint(bits.Len(uint(m))+bits.Len(uint(n)))
+	ax1, ax2 := newQFTAxes(mu1, w), newQFTAxes(mu2, w)
+	g := make([][]*Hamilton, m)
+	for i, row := range f {
+		g[i] = make([]*Hamilton, n)
+		for j, q := range row {
+			g[i][j] = new(Hamilton).setPrec(q, w)
+		}
+		g[i] = qftLine(g[i], ax2, RightQFT, inverse, w)
+	}
+	col := make([]*Hamilton, m)
+	for j := 0; j < n; j++ {
+		for i := range col {
+			col[i] = g[i][j]
+		}
+		col = qftLine(col, ax1, LeftQFT, inverse, w)
+		for i := range col {
+			g[i][j] = col[i].setPrec(col[i], prec)
+		}
+	}
+	return g
+}
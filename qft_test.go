@@ -0,0 +1,158 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// closeToHamilton returns true if the components of x and y agree to about
+// bits bits, relative to the larger of their modulus and 1.
+func closeToHamilton(x, y *Hamilton, bits int) bool {
+	return closeToComplex(&x.l, &y.l, bits) && closeToComplex(&x.r, &y.r, bits)
+}
+
+// randomSignal returns n random Hamilton values at 100 bits.
+func randomSignal(r *rand.Rand, n int) []*Hamilton {
+	f := make([]*Hamilton, n)
+	for k := range f {
+		v := make([]*big.Float, 4)
+		for i := range v {
+			v[i] = new(big.Float).SetPrec(100).SetFloat64(r.Float64() - 0.5)
+		}
+		f[k] = NewHamilton(v[0], v[1], v[2], v[3])
+	}
+	return f
+}
+
+// qftKernel returns cos(θ) - μsin(θ), with θ = 2πnk/N and the unit vector μ
+// along the vector part of mu, at 150 bits.
+func qftKernel(mu *Hamilton, n, k, size int, inverse bool) *Hamilton {
+	theta := 2 * math.Pi * float64(n*k%size) / float64(size)
+	if !inverse {
+		theta = -theta
+	}
+	_, b, c, d := mu.Cartesian()
+	return NewHamiltonFromAxisAngle([3]*big.Float{b, c, d},
+		new(big.Float).SetPrec(150).SetFloat64(2*theta))
+}
+
+// directQFT returns the one-sided transform of f on the given side by the
+// direct sum.
+func directQFT(f []*Hamilton, mu *Hamilton, side QFTSide) []*Hamilton {
+	g := make([]*Hamilton, len(f))
+	for k := range g {
+		g[k] = new(Hamilton)
+		for n := range f {
+			kernel := qftKernel(mu, n, k, len(f), false)
+			if side == LeftQFT {
+				g[k].Add(g[k], new(Hamilton).Mul(kernel, f[n]))
+			} else {
+				g[k].Add(g[k], new(Hamilton).Mul(f[n], kernel))
+			}
+		}
+	}
+	return g
+}
+
+func TestQFT(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	mu := NewHamilton(big.NewFloat(5), big.NewFloat(1), big.NewFloat(-2), big.NewFloat(3))
+	for _, n := range []int{1, 6, 8} {
+		f := randomSignal(r, n)
+		for _, side := range []QFTSide{LeftQFT, RightQFT} {
+			got := QFT(f, mu, side, false)
+			want := directQFT(f, mu, side)
+			for k := range got {
+				if !closeToHamilton(got[k], want[k], 45) {
+					t.Errorf("n = %d, side %d: F[%d] = %v, want %v", n, side, k, got[k], want[k])
+				}
+			}
+			back := QFT(got, mu, side, true)
+			for k := range back {
+				if !closeToHamilton(back[k], f[k], 90) {
+					t.Errorf("n = %d, side %d: f[%d] = %v, want %v", n, side, k, back[k], f[k])
+				}
+			}
+		}
+	}
+}
+
+func TestQFTSides(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	mu := NewHamilton(big.NewFloat(0), big.NewFloat(0), big.NewFloat(0), big.NewFloat(1))
+	f := randomSignal(r, 8)
+	// A signal in the subalgebra of μ commutes with the kernel.
+	for _, q := range f {
+		q.l.r.SetInt64(0)
+		q.r.l.SetInt64(0)
+	}
+	left := QFT(f, mu, LeftQFT, false)
+	right := QFT(f, mu, RightQFT, false)
+	for k := range left {
+		if !closeToHamilton(left[k], right[k], 90) {
+			t.Errorf("left F[%d] = %v, right F[%d] = %v", k, left[k], k, right[k])
+		}
+	}
+}
+
+func TestQFT2(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	mu1 := NewHamilton(big.NewFloat(0), big.NewFloat(1), big.NewFloat(1), big.NewFloat(0))
+	mu2 := NewHamilton(big.NewFloat(0), big.NewFloat(0), big.NewFloat(2), big.NewFloat(-1))
+	const rows, cols = 3, 4
+	f := make([][]*Hamilton, rows)
+	for m := range f {
+		f[m] = randomSignal(r, cols)
+	}
+	got := QFT2(f, mu1, mu2, false)
+	for u := 0; u < rows; u++ {
+		for v := 0; v < cols; v++ {
+			want := new(Hamilton)
+			for m := range f {
+				for n := range f[m] {
+					q := new(Hamilton).Mul(qftKernel(mu1, m, u, rows, false), f[m][n])
+					q.Mul(q, qftKernel(mu2, n, v, cols, false))
+					want.Add(want, q)
+				}
+			}
+			if !closeToHamilton(got[u][v], want, 45) {
+				t.Errorf("F[%d][%d] = %v, want %v", u, v, got[u][v], want)
+			}
+		}
+	}
+	back := QFT2(got, mu1, mu2, true)
+	for m := range back {
+		for n := range back[m] {
+			if !closeToHamilton(back[m][n], f[m][n], 90) {
+				t.Errorf("f[%d][%d] = %v, want %v", m, n, back[m][n], f[m][n])
+			}
+		}
+	}
+}
+
+func TestQFTPanics(t *testing.T) {
+	f := randomSignal(rand.New(rand.NewSource(4)), 4)
+	mu := NewHamilton(big.NewFloat(1), big.NewFloat(0), big.NewFloat(0), big.NewFloat(0))
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("QFT with a real axis did not panic")
+			}
+		}()
+		QFT(f, mu, LeftQFT, false)
+	}()
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("QFT2 of a ragged signal did not panic")
+			}
+		}()
+		axis := NewHamilton(big.NewFloat(0), big.NewFloat(1), big.NewFloat(0), big.NewFloat(0))
+		QFT2([][]*Hamilton{f, f[:3]}, axis, axis, false)
+	}()
+}
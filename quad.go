@@ -0,0 +1,258 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+)
+
+// maxTanhSinhLevel is the deepest level of tanh-sinh nodes, with step size
+// 2^(-maxTanhSinhLevel).
+const maxTanhSinhLevel = 12
+
+// maxGaussLegendre is the largest number of Gauss–Legendre nodes.
+const maxGaussLegendre = 512
+
+// A quadNode is a node of a quadrature rule on [-1, 1], symmetric about zero.
+// The abscissas are ±(1 - d), held through d so that nodes close to the
+// endpoints keep their full precision.
+type quadNode struct {
+	d, w   *big.Float
+	center bool
+}
+
+// tanhSinhLevel returns the tanh-sinh nodes of level k at prec bits, the
+// nodes with step size 2^(-k) that are not in a lower level. The nodes are
+// kept in the cache.
+func tanhSinhLevel(k int, prec uint) []quadNode {
+	nodes := cached(cacheKey{cacheTanhSinh, k, prec}, func() (interface{}, int64) {
+		nodes := newTanhSinhLevel(k, prec)
+		return nodes, nodesSize(nodes, prec)
+	})
+	return nodes.([]quadNode)
+}
+
+// newTanhSinhLevel computes the tanh-sinh nodes of level k at prec bits. At
+// t = jh the abscissa and the weight are
+// 		x = tanh(π/2 sinh t)
+// 		w = π/2 cosh t (1 - x²)
+// and the nodes stop where 1 - x falls below 2^(-4prec), far enough for the
+// tail of an integrand with an endpoint singularity like |x|^(-3/4) to be
+// negligible.
+func newTanhSinhLevel(k int, prec uint) []quadNode {
+	halfPi := bigPi(prec)
+	halfPi.SetMantExp(halfPi, -1)
+	tiny := newFloat(prec).SetMantExp(big.NewFloat(1), -4*int(prec))
+	one := newFloat(prec).SetInt64(1)
+	two := newFloat(prec).SetInt64(2)
+	start, step := 1, 2
+	if k == 0 {
+		start, step = 0, 1
+	}
+	var nodes []quadNode
+	for j := start; ; j += step {
+		t := newFloat(prec).SetMantExp(newFloat(prec).SetInt64(int64(j)), -k)
+		s, c := bigSinhCosh(t, prec)
+		u := newFloat(prec).Mul(halfPi, s)
+		e := bigExp(u.SetMantExp(u, 1), prec)
+		// 1 - tanh(u) = 2/(exp(2u) + 1)
+		d := newFloat(prec).Quo(two, e.Add(e, one))
+		if d.Cmp(tiny) < 0 {
+			break
+		}
+		w := newFloat(prec).Sub(two, d)
+		w.Mul(w, d)
+		w.Mul(w, c)
+		w.Mul(w, halfPi)
+		nodes = append(nodes, quadNode{d, w, j == 0})
+	}
+	return nodes
+}
+
+// gaussLegendreRule returns the nodes of the n-point Gauss–Legendre rule at
+// prec bits, with abscissa d = 1 - x for the non-negative roots x of the
+// Legendre polynomial P[n]. The nodes are kept in the cache.
+func gaussLegendreRule(n int, prec uint) []quadNode {
+	rule := cached(cacheKey{cacheGaussLegendre, n, prec}, func() (interface{}, int64) {
+		rule := newGaussLegendreRule(n, prec)
+		return rule, nodesSize(rule, prec)
+	})
+	return rule.([]quadNode)
+}
+
+// nodesSize returns the approximate size in bytes of nodes at prec bits.
+func nodesSize(nodes []quadNode, prec uint) int64 {
+	return int64(len(nodes)) * (16 + 2*floatSize(prec))
+}
+
+// newGaussLegendreRule computes the nodes of the n-point Gauss–Legendre rule
+// at prec bits. Each root of P[n] is found by Newton's method from the
+// asymptotic estimate cos(π(i + 3/4)/(n + 1/2)), and its weight is
+// 		2 / ((1 - x²) P'[n](x)²)
+func newGaussLegendreRule(n int, prec uint) []quadNode {
+	w := prec + guardBits
+	one := newFloat(w).SetInt64(1)
+	nf := newFloat(w).SetInt64(int64(n))
+	tiny := -int(w) + 2
+	nodes := make([]quadNode, (n+1)/2)
+	for i := range nodes {
+		x := newFloat(w).SetFloat64(math.Cos(math.Pi * (float64(i) + 0.75) / (float64(n) + 0.5)))
+		if n%2 == 1 && i == len(nodes)-1 {
+			x.SetInt64(0)
+		}
+		var dp *big.Float
+		for k := 0; k < 100; k++ {
+			p, q := legendrePair(n, x, w)
+			// P'[n](x) = n (x P[n] - P[n-1]) / (x² - 1)
+			x2 := newFloat(w).Mul(x, x)
+			dp = newFloat(w).Mul(x, p)
+			dp.Sub(dp, q)
+			dp.Mul(dp, nf)
+			dp.Quo(dp, x2.Sub(x2, one))
+			corr := newFloat(w).Quo(p, dp)
+			x.Sub(x, corr)
+			if corr.Sign() == 0 || expo(corr) < tiny {
+				break
+			}
+		}
+		p, q := legendrePair(n, x, w)
+		x2 := newFloat(w).Mul(x, x)
+		dp = newFloat(w).Mul(x, p)
+		dp.Sub(dp, q)
+		dp.Mul(dp, nf)
+		dp.Quo(dp, newFloat(w).Sub(x2, one))
+		weight := newFloat(w).Sub(one, x2)
+		weight.Mul(weight, dp)
+		weight.Mul(weight, dp)
+		weight.Quo(newFloat(w).SetInt64(2), weight)
+		d := newFloat(w).Sub(one, x)
+		nodes[i] = quadNode{newFloat(prec).Set(d), newFloat(prec).Set(weight), x.Sign() == 0}
+	}
+	return nodes
+}
+
+// legendrePair returns P[n](x) and P[n-1](x) at prec bits, from the
+// recurrence
+// 		(k + 1) P[k+1] = (2k + 1) x P[k] - k P[k-1]
+func legendrePair(n int, x *big.Float, prec uint) (*big.Float, *big.Float) {
+	p0, p1 := newFloat(prec).SetInt64(1), newFloat(prec).Set(x)
+	t, c := newFloat(prec), newFloat(prec)
+	for k := int64(1); k < int64(n); k++ {
+		t.Mul(x, p1)
+		t.Mul(t, c.SetInt64(2*k+1))
+		t.Sub(t, c.Mul(p0, c.SetInt64(k)))
+		t.Quo(t, c.SetInt64(k+1))
+		p0, p1, t = p1, t, p0
+	}
+	return p1, p0
+}
+
+// quadSum returns the sum of the weighted values of f over the nodes,
+// mapped from [-1, 1] to [a, b], at prec bits. The abscissas near each
+// endpoint are measured from that endpoint, and those that round to the
+// endpoint itself are skipped, so that f is never evaluated there.
+func quadSum(f func(x *big.Float) *Complex, a, b *big.Float, nodes []quadNode, prec uint) *Complex {
+	rad := newFloat(prec).Sub(b, a)
+	rad.SetMantExp(rad, -1)
+	sum := newComplexPrec(prec)
+	for _, n := range nodes {
+		x := newFloat(prec).Mul(rad, n.d)
+		v := newComplexPrec(prec)
+		if r := newFloat(prec).Sub(b, x); r.Cmp(b) != 0 {
+			v.Add(v, f(r))
+		}
+		if l := newFloat(prec).Add(a, x); !n.center && l.Cmp(a) != 0 {
+			v.Add(v, f(l))
+		}
+		sum.Add(sum, v.Scal(v, n.w))
+	}
+	return sum.Scal(sum, rad)
+}
+
+// quadConverged returns true if the difference between two successive
+// estimates s and t is below 2^(-prec) relative to t.
+func quadConverged(s, t *Complex, prec uint) bool {
+	d := new(Complex).Sub(s, t)
+	if d.l.Sign() == 0 && d.r.Sign() == 0 {
+		return true
+	}
+	return complexExpo(d) < complexExpo(t)-int(prec)
+}
+
+// IntegrateTanhSinhComplex returns the integral of f over [a, b], rounded to
+// prec bits, and true if the estimated relative error is below 2^(-prec). It
+// uses the tanh-sinh rule, which samples f at the doubly exponentially
+// clustered points
+// 		x = tanh(π/2 sinh t)
+// of [-1, 1], mapped to [a, b], with step sizes h = 1, 1/2, 1/4, ... and
+// stops when two successive estimates agree. Since the rule never samples the
+// endpoints and its weights decay doubly exponentially, it handles integrable
+// singularities at the endpoints. The nodes are cached for each precision.
+// The function f receives its argument with some guard bits beyond prec and
+// should compute at the precision of its argument.
+func IntegrateTanhSinhComplex(f func(x *big.Float) *Complex, a, b *big.Float, prec uint) (*Complex, bool) {
+	w := prec + guardBits
+	var sum, last *Complex
+	for k := 0; k <= maxTanhSinhLevel; k++ {
+		s := quadSum(f, a, b, tanhSinhLevel(k, w), w)
+		if sum == nil {
+			sum = s
+		} else {
+			sum.Add(sum, s)
+		}
+		est := newComplexPrec(w).Copy(sum)
+		est.l.SetMantExp(&est.l, -k)
+		est.r.SetMantExp(&est.r, -k)
+		if last != nil && quadConverged(est, last, prec) {
+			return newComplexPrec(prec).round(est, prec), true
+		}
+		last = est
+	}
+	return newComplexPrec(prec).round(last, prec), false
+}
+
+// IntegrateTanhSinh returns the integral of the real function f over [a, b],
+// rounded to prec bits, and true if the estimated relative error is below
+// 2^(-prec). It follows IntegrateTanhSinhComplex.
+func IntegrateTanhSinh(f func(x *big.Float) *big.Float, a, b *big.Float, prec uint) (*big.Float, bool) {
+	z, ok := IntegrateTanhSinhComplex(realIntegrand(f), a, b, prec)
+	return &z.l, ok
+}
+
+// IntegrateGaussLegendreComplex returns the integral of f over [a, b],
+// rounded to prec bits, and true if the estimated relative error is below
+// 2^(-prec). It uses Gauss–Legendre rules with 8, 16, 32, ...,
+// maxGaussLegendre nodes, and stops when two successive estimates agree.
+// These rules converge fastest for integrands that are analytic on [a, b].
+// The nodes are cached for each precision and number of nodes. The function
+// f receives its argument with some guard bits beyond prec and should compute
+// at the precision of its argument.
+func IntegrateGaussLegendreComplex(f func(x *big.Float) *Complex, a, b *big.Float, prec uint) (*Complex, bool) {
+	w := prec + guardBits
+	var last *Complex
+	for n := 8; n <= maxGaussLegendre; n *= 2 {
+		s := quadSum(f, a, b, gaussLegendreRule(n, w), w)
+		if last != nil && quadConverged(s, last, prec) {
+			return newComplexPrec(prec).round(s, prec), true
+		}
+		last = s
+	}
+	return newComplexPrec(prec).round(last, prec), false
+}
+
+// IntegrateGaussLegendre returns the integral of the real function f over
+// [a, b], rounded to prec bits, and true if the estimated relative error is
+// below 2^(-prec). It follows IntegrateGaussLegendreComplex.
+func IntegrateGaussLegendre(f func(x *big.Float) *big.Float, a, b *big.Float, prec uint) (*big.Float, bool) {
+	z, ok := IntegrateGaussLegendreComplex(realIntegrand(f), a, b, prec)
+	return &z.l, ok
+}
+
+// realIntegrand returns f as a function with Complex values.
+func realIntegrand(f func(x *big.Float) *big.Float) func(x *big.Float) *Complex {
+	return func(x *big.Float) *Complex {
+		return NewComplex(f(x), new(big.Float))
+	}
+}
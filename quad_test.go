@@ -0,0 +1,105 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestIntegrateTanhSinh(t *testing.T) {
+	one := big.NewFloat(1)
+	cases := []struct {
+		f    func(x *big.Float) *big.Float
+		a, b *big.Float
+		want *big.Float
+	}{
+		// ∫ exp(x) dx over [0, 1] = e - 1
+		{
+			func(x *big.Float) *big.Float { return bigExp(x, x.Prec()) },
+			new(big.Float), one,
+			new(big.Float).Sub(bigExp(one, 200), one),
+		},
+		// ∫ 1/√x dx over [0, 1] = 2
+		{
+			func(x *big.Float) *big.Float {
+				s := new(big.Float).SetPrec(x.Prec()).Sqrt(x)
+				return s.Quo(one, s)
+			},
+			new(big.Float), one,
+			big.NewFloat(2),
+		},
+		// ∫ log(x) dx over [0, 1] = -1
+		{
+			func(x *big.Float) *big.Float { return bigLog(x, x.Prec()) },
+			new(big.Float), one,
+			big.NewFloat(-1),
+		},
+	}
+	for _, c := range cases {
+		got, ok := IntegrateTanhSinh(c.f, c.a, c.b, 150)
+		if !ok || !closeTo(got, c.want, 140) {
+			t.Errorf("IntegrateTanhSinh = %v, %v, want %v", got, ok, c.want)
+		}
+	}
+}
+
+func TestIntegrateGaussLegendre(t *testing.T) {
+	// ∫ x³ dx over [-1, 2] = 15/4
+	cube := func(x *big.Float) *big.Float {
+		return new(big.Float).Mul(x, new(big.Float).Mul(x, x))
+	}
+	got, ok := IntegrateGaussLegendre(cube, big.NewFloat(-1), big.NewFloat(2), 120)
+	if !ok || !closeTo(got, big.NewFloat(3.75), 110) {
+		t.Errorf("IntegrateGaussLegendre(x³) = %v, %v", got, ok)
+	}
+	// ∫ exp(x) dx over [0, 1] = e - 1
+	one := big.NewFloat(1)
+	got, ok = IntegrateGaussLegendre(func(x *big.Float) *big.Float {
+		return bigExp(x, x.Prec())
+	}, new(big.Float), one, 200)
+	if want := new(big.Float).Sub(bigExp(one, 200), one); !ok || !closeTo(got, want, 190) {
+		t.Errorf("IntegrateGaussLegendre(exp) = %v, %v, want %v", got, ok, want)
+	}
+}
+
+func TestIntegrateComplex(t *testing.T) {
+	// ∫ exp(ix) dx over [0, π] = 2i
+	f := func(x *big.Float) *Complex {
+		s, c := bigSinCos(x, x.Prec())
+		return NewComplex(c, s)
+	}
+	pi := bigPi(120)
+	want := newComplex128(2i)
+	if got, ok := IntegrateTanhSinhComplex(f, new(big.Float), pi, 100); !ok || !closeToComplex(got, want, 90) {
+		t.Errorf("IntegrateTanhSinhComplex = %v, %v", got, ok)
+	}
+	if got, ok := IntegrateGaussLegendreComplex(f, new(big.Float), pi, 100); !ok || !closeToComplex(got, want, 90) {
+		t.Errorf("IntegrateGaussLegendreComplex = %v, %v", got, ok)
+	}
+}
+
+func TestQuadNodeCache(t *testing.T) {
+	a := tanhSinhLevel(3, 77)
+	b := tanhSinhLevel(3, 77)
+	if &a[0] != &b[0] {
+		t.Error("tanh-sinh nodes were recomputed")
+	}
+	c := gaussLegendreRule(16, 77)
+	d := gaussLegendreRule(16, 77)
+	if &c[0] != &d[0] {
+		t.Error("Gauss–Legendre nodes were recomputed")
+	}
+	// The weights of the Gauss–Legendre rule on [-1, 1] add up to 2.
+	sum := new(big.Float)
+	for _, n := range c {
+		sum.Add(sum, n.w)
+		if !n.center {
+			sum.Add(sum, n.w)
+		}
+	}
+	if !closeTo(sum, big.NewFloat(2), 70) {
+		t.Errorf("sum of weights = %v", sum)
+	}
+}
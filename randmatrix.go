@@ -0,0 +1,165 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"math/rand"
+)
+
+// The generators below draw their randomness from rng, so a fixed seed gives
+// reproducible benchmarks and tests. The random draws are float64 values, but
+// every result is computed at prec bits, so properties such as unitarity and
+// unit norm hold to prec bits.
+
+// randomGaussianComplex returns a complex number whose components are
+// independent standard normal variates, at prec bits.
+func randomGaussianComplex(rng *rand.Rand, prec uint) *Complex {
+	z := newComplexPrec(prec)
+	z.l.SetFloat64(rng.NormFloat64())
+	z.r.SetFloat64(rng.NormFloat64())
+	return z
+}
+
+// complexColumnDot returns the inner product Σ Conj(u[i][j]) v[i][k] of the
+// columns j of u and k of v, at prec bits.
+func complexColumnDot(u [][]*Complex, j int, v [][]*Complex, k int, prec uint) *Complex {
+	s := newComplexPrec(prec)
+	for i := range u {
+		s.Add(s, newComplexPrec(prec).Mul(newComplexPrec(prec).Conj(u[i][j]), v[i][k]))
+	}
+	return s
+}
+
+// RandomUnitaryComplex returns a random n x n unitary matrix, as rows of
+// Complex values rounded to prec bits, distributed according to the Haar
+// measure on the unitary group. It orthonormalizes the columns of a matrix of
+// independent complex normal variates with the modified Gram-Schmidt process,
+// applied twice, which is the QR decomposition with a positive real diagonal
+// in R, so that the distribution is exactly Haar. If n is negative or prec is
+// zero, then RandomUnitaryComplex panics.
+func RandomUnitaryComplex(rng *rand.Rand, n int, prec uint) [][]*Complex {
+	if n < 0 {
+		panic("negative matrix dimension")
+	}
+	if prec == 0 {
+		panic("zero precision")
+	}
+	w := prec + guardBits
+	q := make([][]*Complex, n)
+	for i := range q {
+		q[i] = make([]*Complex, n)
+		for j := range q[i] {
+			q[i][j] = randomGaussianComplex(rng, w)
+		}
+	}
+	for j := 0; j < n; j++ {
+		for pass := 0; pass < 2; pass++ {
+			for k := 0; k < j; k++ {
+				d := complexColumnDot(q, k, q, j, w)
+				for i := 0; i < n; i++ {
+					q[i][j].Sub(q[i][j], newComplexPrec(w).Mul(d, q[i][k]))
+				}
+			}
+		}
+		norm := newFloat(w).Sqrt(complexColumnDot(q, j, q, j, w).Real())
+		for i := 0; i < n; i++ {
+			q[i][j].l.Quo(&q[i][j].l, norm)
+			q[i][j].r.Quo(&q[i][j].r, norm)
+		}
+	}
+	for i := range q {
+		for j := range q[i] {
+			q[i][j].round(q[i][j], prec)
+		}
+	}
+	return q
+}
+
+// RandomUnitHamiltonVector returns a random vector of n Hamilton values
+// rounded to prec bits, with unit norm
+// 		Σ Quad(v[i]) = 1
+// distributed uniformly on that sphere. For n = 1 it is a uniformly random
+// unit quaternion, which represents a uniformly random rotation. If n is
+// negative or prec is zero, then RandomUnitHamiltonVector panics; if n is
+// zero, then it returns an empty vector.
+func RandomUnitHamiltonVector(rng *rand.Rand, n int, prec uint) []*Hamilton {
+	if n < 0 {
+		panic("negative vector dimension")
+	}
+	if prec == 0 {
+		panic("zero precision")
+	}
+	w := prec + guardBits
+	v := make([]*Hamilton, n)
+	quad := newFloat(w)
+	for i := range v {
+		v[i] = new(Hamilton)
+		a, b, c, d := v[i].Cartesian()
+		for _, x := range []*big.Float{a, b, c, d} {
+			x.SetPrec(w).SetFloat64(rng.NormFloat64())
+			quad.Add(quad, newFloat(w).Mul(x, x))
+		}
+	}
+	if n == 0 {
+		return v
+	}
+	norm := newFloat(w).Sqrt(quad)
+	for _, z := range v {
+		a, b, c, d := z.Cartesian()
+		for _, x := range []*big.Float{a, b, c, d} {
+			x.Quo(x, norm)
+			x.SetPrec(prec)
+		}
+	}
+	return v
+}
+
+// RandomConditionedComplex returns a random n x n matrix, as rows of Complex
+// values rounded to prec bits, with 2-norm condition number cond. It is
+// 		U Σ ConjTranspose(V)
+// for independent Haar unitary matrices U and V, and the diagonal Σ of the
+// singular values cond^(-k/(n-1)), for k = 0, ..., n-1, which are spaced
+// geometrically from 1 down to 1/cond. A cond near 1 gives a well-conditioned
+// matrix; a cond near 2^prec gives one that is numerically singular at prec
+// bits. If n is not positive, prec is zero, or cond is less than 1, then
+// RandomConditionedComplex panics.
+func RandomConditionedComplex(rng *rand.Rand, n int, cond *big.Float, prec uint) [][]*Complex {
+	if n <= 0 {
+		panic("non-positive matrix dimension")
+	}
+	if prec == 0 {
+		panic("zero precision")
+	}
+	if cond.Cmp(big.NewFloat(1)) < 0 {
+		panic("condition number less than 1")
+	}
+	w := prec + guardBits
+	u := RandomUnitaryComplex(rng, n, w)
+	v := RandomUnitaryComplex(rng, n, w)
+	sigma := make([]*big.Float, n)
+	logCond := bigLog(cond, w)
+	for k := range sigma {
+		if k == 0 {
+			sigma[k] = newFloat(w).SetInt64(1)
+			continue
+		}
+		e := newFloat(w).Mul(logCond, newFloat(w).SetInt64(int64(k)))
+		e.Quo(e, newFloat(w).SetInt64(int64(n-1)))
+		sigma[k] = bigExp(e.Neg(e), w)
+	}
+	a := make([][]*Complex, n)
+	for i := range a {
+		a[i] = make([]*Complex, n)
+		for j := range a[i] {
+			s := newComplexPrec(w)
+			for k := 0; k < n; k++ {
+				t := newComplexPrec(w).Mul(u[i][k], newComplexPrec(w).Conj(v[j][k]))
+				s.Add(s, t.Scal(t, sigma[k]))
+			}
+			a[i][j] = s.round(s, prec)
+		}
+	}
+	return a
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestRandomUnitaryComplex(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 5} {
+		q := RandomUnitaryComplex(rng, n, 200)
+		for j := 0; j < n; j++ {
+			for k := 0; k < n; k++ {
+				want := newComplexPrec(200)
+				if j == k {
+					want.l.SetInt64(1)
+				}
+				if got := complexColumnDot(q, j, q, k, 200); !closeToComplex(got, want, 190) {
+					t.Errorf("n = %d: column %d · column %d = %v", n, j, k, got)
+				}
+			}
+		}
+	}
+	// The same seed gives the same matrix.
+	a := RandomUnitaryComplex(rand.New(rand.NewSource(7)), 3, 100)
+	b := RandomUnitaryComplex(rand.New(rand.NewSource(7)), 3, 100)
+	if !a[2][1].Equals(b[2][1]) || a[2][1].l.Prec() != 100 {
+		t.Errorf("RandomUnitaryComplex is not reproducible: %v, %v", a[2][1], b[2][1])
+	}
+}
+
+func TestRandomUnitHamiltonVector(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for _, n := range []int{1, 4} {
+		v := RandomUnitHamiltonVector(rng, n, 200)
+		quad := newFloat(200)
+		for _, z := range v {
+			quad.Add(quad, z.Quad())
+		}
+		if !closeTo(quad, big.NewFloat(1), 190) {
+			t.Errorf("n = %d: Σ Quad = %v", n, quad)
+		}
+	}
+	if v := RandomUnitHamiltonVector(rng, 0, 200); len(v) != 0 {
+		t.Errorf("RandomUnitHamiltonVector(0) = %v", v)
+	}
+}
+
+func TestRandomConditionedComplex(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	cond := big.NewFloat(1e30)
+	for _, n := range []int{2, 3} {
+		a := RandomConditionedComplex(rng, n, cond, 200)
+		// The singular values are 1, ..., 1/cond, so their product is |det(a)|
+		// and the sum of their squares is the squared Frobenius norm.
+		m := make([][]Complex, n)
+		frob := newFloat(200)
+		for i := range a {
+			m[i] = make([]Complex, n)
+			for j := range a[i] {
+				m[i][j].Copy(a[i][j])
+				frob.Add(frob, a[i][j].Quad())
+			}
+		}
+		det := complexDet(m, 200).Quad()
+		want := newFloat(200).Quo(big.NewFloat(1), newFloat(200).Mul(cond, cond))
+		wantFrob := newFloat(200).SetInt64(1)
+		if n == 3 {
+			want.Quo(want, cond)
+			wantFrob.Add(wantFrob, newFloat(200).Quo(big.NewFloat(1), cond))
+		}
+		wantFrob.Add(wantFrob, newFloat(200).Quo(big.NewFloat(1), newFloat(200).Mul(cond, cond)))
+		if !closeTo(det, want, 100) || !closeTo(frob, wantFrob, 100) {
+			t.Errorf("n = %d: |det|² = %v, want %v; Frobenius² = %v, want %v", n, det, want, frob, wantFrob)
+		}
+	}
+}
@@ -0,0 +1,527 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+)
+
+// defaultPrec is the precision used by the transcendental functions when every
+// operand has zero precision.
+const defaultPrec = 64
+
+// guardBits is the number of extra bits carried by the transcendental
+// functions to absorb the rounding errors of intermediate steps.
+const guardBits = 32
+
+// maxPrec returns the largest precision of the given values, or defaultPrec
+// if all of them have zero precision.
+func maxPrec(x ...*big.Float) uint {
+	var prec uint
+	for _, v := range x {
+		if p := v.Prec(); p > prec {
+			prec = p
+		}
+	}
+	if prec == 0 {
+		return defaultPrec
+	}
+	return prec
+}
+
+// newFloat returns a pointer to a zero big.Float with the given precision.
+func newFloat(prec uint) *big.Float {
+	return new(big.Float).SetPrec(prec)
+}
+
+// expo returns the binary exponent of x, or 0 if x is zero or infinite.
+func expo(x *big.Float) int {
+	if x.Sign() == 0 || x.IsInf() {
+		return 0
+	}
+	return x.MantExp(nil)
+}
+
+// negligible returns true if term is too small to change sum at precision
+// prec.
+func negligible(term, sum *big.Float, prec uint) bool {
+	if term.Sign() == 0 {
+		return true
+	}
+	if sum.Sign() == 0 {
+		return false
+	}
+	return expo(term) < expo(sum)-int(prec)
+}
+
+// prod sets z equal to x*y and returns z. Unlike big.Float.Mul, a zero factor
+// makes the product zero even if the other factor is infinite.
+func prod(z, x, y *big.Float) *big.Float {
+	if x.Sign() == 0 || y.Sign() == 0 {
+		return z.SetInt64(0)
+	}
+	return z.Mul(x, y)
+}
+
+// computePi returns π rounded to prec bits, computed with the Gauss–Legendre
+// iteration.
+func computePi(prec uint) *big.Float {
+	w := prec + guardBits
+	a := newFloat(w).SetInt64(1)
+	b := newFloat(w).SetInt64(2)
+	b.Sqrt(b)
+	b.Quo(a, b)
+	t := newFloat(w).SetFloat64(0.25)
+	p := newFloat(w).SetInt64(1)
+	an, temp := newFloat(w), newFloat(w)
+	for n := w; n > 0; n >>= 1 {
+		an.Add(a, b)
+		an.SetMantExp(an, -1)
+		b.Sqrt(b.Mul(a, b))
+		temp.Sub(a, an)
+		temp.Mul(temp, temp)
+		t.Sub(t, temp.Mul(temp, p))
+		p.SetMantExp(p, 1)
+		a.Set(an)
+	}
+	a.Add(a, b)
+	a.Mul(a, a)
+	t.SetMantExp(t, 2)
+	return newFloat(prec).Quo(a, t)
+}
+
+// atanhSeries returns the sum of the series
+// 		t + t³/3 + t⁵/5 + ...
+// at precision prec. It converges quickly for small |t|.
+func atanhSeries(t *big.Float, prec uint) *big.Float {
+	sum := newFloat(prec).Set(t)
+	t2 := newFloat(prec).Mul(t, t)
+	term := newFloat(prec).Set(t)
+	q := newFloat(prec)
+	for k := int64(3); ; k += 2 {
+		term.Mul(term, t2)
+		q.Quo(term, q.SetInt64(k))
+		if negligible(q, sum, prec) {
+			return sum
+		}
+		sum.Add(sum, q)
+	}
+}
+
+// computeLn2 returns log(2) rounded to prec bits, computed from the series
+// 		log(2) = 2 atanh(1/3)
+func computeLn2(prec uint) *big.Float {
+	w := prec + guardBits
+	t := newFloat(w).SetInt64(3)
+	t.Quo(newFloat(w).SetInt64(1), t)
+	ln2 := atanhSeries(t, w)
+	return newFloat(prec).SetMantExp(ln2, 1)
+}
+
+// bigExp returns exp(x) rounded to prec bits.
+func bigExp(x *big.Float, prec uint) *big.Float {
+	z := newFloat(prec)
+	switch {
+	case x.IsInf():
+		if x.Sign() > 0 {
+			return z.SetInf(false)
+		}
+		return z
+	case x.Sign() == 0:
+		return z.SetInt64(1)
+	case expo(x) > 33:
+		// |x| exceeds the exponent range of big.Float.
+		if x.Sign() > 0 {
+			return z.SetInf(false)
+		}
+		return z
+	}
+	const halvings = 16
+	w := prec + guardBits + halvings
+	if e := expo(x); e > 0 {
+		w += uint(e)
+	}
+	ln2 := bigLn2(w)
+	k, _ := newFloat(w).Quo(x, ln2).Int64()
+	r := newFloat(w).SetInt64(k)
+	r.Sub(x, r.Mul(r, ln2))
+	r.SetMantExp(r, -halvings)
+	sum := newFloat(w).SetInt64(1)
+	term := newFloat(w).SetInt64(1)
+	n := newFloat(w)
+	for i := int64(1); ; i++ {
+		term.Mul(term, r)
+		term.Quo(term, n.SetInt64(i))
+		if negligible(term, sum, w) {
+			break
+		}
+		sum.Add(sum, term)
+	}
+	for i := 0; i < halvings; i++ {
+		sum.Mul(sum, sum)
+	}
+	return z.SetMantExp(sum, int(k))
+}
+
+// bigLog returns log(x) rounded to prec bits. If x is negative, then bigLog
+// panics.
+func bigLog(x *big.Float, prec uint) *big.Float {
+	z := newFloat(prec)
+	switch {
+	case x.Sign() < 0:
+		panic("logarithm of negative number")
+	case x.Sign() == 0:
+		return z.SetInf(true)
+	case x.IsInf():
+		return z.SetInf(false)
+	}
+	const roots = 4
+	w := prec + guardBits + roots
+	m := new(big.Float)
+	e := x.MantExp(m)
+	m.SetPrec(w)
+	if m.Cmp(big.NewFloat(0.7071067811865476)) < 0 {
+		m.SetMantExp(m, 1)
+		e--
+	}
+	one := newFloat(w).SetInt64(1)
+	t := newFloat(w).Sub(m, one)
+	k := 0
+	if t.Sign() != 0 && expo(t) > -4 {
+		for ; k < roots; k++ {
+			m.Sqrt(m)
+		}
+		t.Sub(m, one)
+	}
+	t.Quo(t, m.Add(m, one))
+	sum := atanhSeries(t, w)
+	sum.SetMantExp(sum, k+1)
+	if e != 0 {
+		w += 64
+		ln2 := bigLn2(w)
+		sum.SetPrec(w).Add(sum, ln2.Mul(ln2, newFloat(w).SetInt64(int64(e))))
+	}
+	return z.Set(sum)
+}
+
+// bigSinCos returns sin(x) and cos(x) rounded to prec bits. If x is infinite,
+// then bigSinCos panics.
+func bigSinCos(x *big.Float, prec uint) (*big.Float, *big.Float) {
+	sin, cos := newFloat(prec), newFloat(prec)
+	switch {
+	case x.IsInf():
+		panic("sine or cosine of infinity")
+	case x.Sign() == 0:
+		return sin, cos.SetInt64(1)
+	}
+	w := prec + guardBits
+	if e := expo(x); e > 0 {
+		w += uint(e)
+	}
+	halfPi := bigPi(w)
+	halfPi.SetMantExp(halfPi, -1)
+	k := newFloat(w).Quo(x, halfPi)
+	if k.Sign() < 0 {
+		k.Sub(k, big.NewFloat(0.5))
+	} else {
+		k.Add(k, big.NewFloat(0.5))
+	}
+	n, _ := k.Int(nil)
+	k.SetInt(n)
+	r := newFloat(w).Mul(k, halfPi)
+	r.Sub(x, r)
+	r2 := newFloat(w).Mul(r, r)
+	s := newFloat(w).Set(r)
+	c := newFloat(w).SetInt64(1)
+	term := newFloat(w)
+	d := newFloat(w)
+	term.Set(r)
+	for i := int64(2); ; i += 2 {
+		term.Mul(term, r2)
+		term.Quo(term, d.SetInt64(-i*(i+1)))
+		if negligible(term, s, w) {
+			break
+		}
+		s.Add(s, term)
+	}
+	term.SetInt64(1)
+	for i := int64(1); ; i += 2 {
+		term.Mul(term, r2)
+		term.Quo(term, d.SetInt64(-i*(i+1)))
+		if negligible(term, c, w) {
+			break
+		}
+		c.Add(c, term)
+	}
+	switch new(big.Int).And(n, big.NewInt(3)).Int64() {
+	case 0:
+		sin.Set(s)
+		cos.Set(c)
+	case 1:
+		sin.Set(c)
+		cos.Neg(s)
+	case 2:
+		sin.Neg(s)
+		cos.Neg(c)
+	default:
+		sin.Neg(c)
+		cos.Set(s)
+	}
+	return sin, cos
+}
+
+// bigSinhCosh returns sinh(x) and cosh(x) rounded to prec bits.
+func bigSinhCosh(x *big.Float, prec uint) (*big.Float, *big.Float) {
+	sinh, cosh := newFloat(prec), newFloat(prec)
+	switch {
+	case x.IsInf():
+		return sinh.Set(x), cosh.SetInf(false)
+	case x.Sign() == 0:
+		return sinh, cosh.SetInt64(1)
+	}
+	w := prec + guardBits
+	if expo(x) < -2 {
+		// Sum the Taylor series to avoid cancellation in exp(x)-exp(-x).
+		x2 := newFloat(w).Mul(x, x)
+		s := newFloat(w).Set(x)
+		c := newFloat(w).SetInt64(1)
+		term := newFloat(w).Set(x)
+		d := newFloat(w)
+		for i := int64(2); ; i += 2 {
+			term.Mul(term, x2)
+			term.Quo(term, d.SetInt64(i*(i+1)))
+			if negligible(term, s, w) {
+				break
+			}
+			s.Add(s, term)
+		}
+		term.SetInt64(1)
+		for i := int64(1); ; i += 2 {
+			term.Mul(term, x2)
+			term.Quo(term, d.SetInt64(i*(i+1)))
+			if negligible(term, c, w) {
+				break
+			}
+			c.Add(c, term)
+		}
+		return sinh.Set(s), cosh.Set(c)
+	}
+	e := bigExp(x, w)
+	inv := newFloat(w)
+	if !e.IsInf() {
+		inv.Quo(inv.SetInt64(1), e)
+	}
+	s := newFloat(w).Sub(e, inv)
+	c := newFloat(w).Add(e, inv)
+	sinh.SetMantExp(s, -1)
+	cosh.SetMantExp(c, -1)
+	return sinh, cosh
+}
+
+// bigAtan returns atan(x) rounded to prec bits.
+func bigAtan(x *big.Float, prec uint) *big.Float {
+	z := newFloat(prec)
+	w := prec + guardBits
+	switch {
+	case x.Sign() == 0:
+		return z
+	case x.IsInf():
+		z.Set(bigPi(prec))
+		z.SetMantExp(z, -1)
+		if x.Sign() < 0 {
+			z.Neg(z)
+		}
+		return z
+	}
+	const halvings = 4
+	w += halvings
+	one := newFloat(w).SetInt64(1)
+	y := newFloat(w).Abs(x)
+	invert := y.Cmp(one) > 0
+	if invert {
+		y.Quo(one, y)
+	}
+	temp := newFloat(w)
+	for i := 0; i < halvings; i++ {
+		temp.Mul(y, y)
+		temp.Add(temp, one)
+		temp.Sqrt(temp)
+		y.Quo(y, temp.Add(temp, one))
+	}
+	y2 := newFloat(w).Mul(y, y)
+	sum := newFloat(w).Set(y)
+	term := newFloat(w).Set(y)
+	for k := int64(3); ; k += 2 {
+		term.Neg(term.Mul(term, y2))
+		temp.Quo(term, temp.SetInt64(k))
+		if negligible(temp, sum, w) {
+			break
+		}
+		sum.Add(sum, temp)
+	}
+	sum.SetMantExp(sum, halvings)
+	if invert {
+		halfPi := bigPi(w)
+		halfPi.SetMantExp(halfPi, -1)
+		sum.Sub(halfPi, sum)
+	}
+	if x.Sign() < 0 {
+		sum.Neg(sum)
+	}
+	return z.Set(sum)
+}
+
+// bigAtan2 returns the angle of the point (x, y), in the interval [-π, π],
+// rounded to prec bits. It follows the conventions of math.Atan2.
+func bigAtan2(y, x *big.Float, prec uint) *big.Float {
+	switch {
+	case x.Sign() == 0 && y.Sign() == 0:
+		if x.Signbit() {
+			z := bigPi(prec)
+			if y.Signbit() {
+				z.Neg(z)
+			}
+			return z
+		}
+		return newFloat(prec).Set(y)
+	case x.Sign() == 0:
+		z := bigPi(prec)
+		z.SetMantExp(z, -1)
+		if y.Sign() < 0 {
+			z.Neg(z)
+		}
+		return z
+	}
+	w := prec + guardBits
+	z := bigAtan(newFloat(w).Quo(y, x), w)
+	if x.Sign() < 0 {
+		if y.Signbit() {
+			z.Sub(z, bigPi(w))
+		} else {
+			z.Add(z, bigPi(w))
+		}
+	}
+	return newFloat(prec).Set(z)
+}
+
+// bigHypot returns the square root of the sum of the squares of x, rounded to
+// prec bits.
+func bigHypot(prec uint, x ...*big.Float) *big.Float {
+	w := prec + guardBits
+	sum, temp := newFloat(w), newFloat(w)
+	for _, v := range x {
+		sum.Add(sum, temp.Mul(v, v))
+	}
+	return newFloat(prec).Sqrt(sum)
+}
+
+// bigAtanhQuo returns atanh(y/x) rounded to prec bits. It requires |y| < |x|.
+// The quotient is never formed when it is close to ±1, so the result stays
+// accurate near the boundary of the domain.
+func bigAtanhQuo(y, x *big.Float, prec uint) *big.Float {
+	w := prec + guardBits
+	t := newFloat(w).Quo(y, x)
+	if expo(t) < 0 {
+		return newFloat(prec).Set(atanhSeries(t, w))
+	}
+	// With |y/x| ≥ 1/2, x±y are computed exactly.
+	e := w + maxPrec(x, y) + 2
+	num := newFloat(e).Add(x, y)
+	den := newFloat(e).Sub(x, y)
+	l := bigLog(num.Quo(num, den), w)
+	return newFloat(prec).SetMantExp(l, -1)
+}
+
+// newComplexPrec returns a pointer to a zero Complex value whose components
+// have precision prec.
+func newComplexPrec(prec uint) *Complex {
+	z := new(Complex)
+	z.l.SetPrec(prec)
+	z.r.SetPrec(prec)
+	return z
+}
+
+// round sets z equal to y rounded to prec bits, and returns z.
+func (z *Complex) round(y *Complex, prec uint) *Complex {
+	z.l.SetPrec(prec).Set(&y.l)
+	z.r.SetPrec(prec).Set(&y.r)
+	return z
+}
+
+// complexExpo returns the larger of the binary exponents of the components of
+// z, or math.MinInt32 if z is zero.
+func complexExpo(z *Complex) int {
+	e := math.MinInt32
+	if z.l.Sign() != 0 {
+		e = expo(&z.l)
+	}
+	if z.r.Sign() != 0 && expo(&z.r) > e {
+		e = expo(&z.r)
+	}
+	return e
+}
+
+// complexAbs returns an approximation of the modulus of z as a float64.
+func complexAbs(z *Complex) float64 {
+	a, _ := z.l.Float64()
+	b, _ := z.r.Float64()
+	return math.Hypot(a, b)
+}
+
+// complexArg returns an approximation of the argument of z as a float64.
+func complexArg(z *Complex) float64 {
+	a, _ := z.l.Float64()
+	b, _ := z.r.Float64()
+	return math.Atan2(b, a)
+}
+
+// bigEuler returns the Euler–Mascheroni constant γ rounded to prec bits,
+// computed with the Brent–McMillan algorithm.
+func bigEuler(prec uint) *big.Float {
+	w := prec + guardBits
+	n := int64(float64(w)*math.Ln2/4) + 2
+	n2 := newFloat(w).SetInt64(n * n)
+	a := bigLog(newFloat(w).SetInt64(n), w)
+	a.Neg(a)
+	b := newFloat(w).SetInt64(1)
+	u := newFloat(w).Set(a)
+	v := newFloat(w).SetInt64(1)
+	k2 := newFloat(w)
+	for k := int64(1); ; k++ {
+		k2.SetInt64(k * k)
+		b.Mul(b, n2)
+		b.Quo(b, k2)
+		a.Mul(a, n2)
+		a.Quo(a, k2.SetInt64(k))
+		a.Add(a, b)
+		a.Quo(a, k2)
+		u.Add(u, a)
+		v.Add(v, b)
+		if k > n && negligible(b, v, w) && negligible(a, u, w) {
+			break
+		}
+	}
+	return newFloat(prec).Quo(u, v)
+}
+
+// exactPrec returns a precision at which the sum or difference of x and y is
+// exact.
+func exactPrec(x, y *big.Float) uint {
+	switch {
+	case x.Sign() == 0 || x.IsInf():
+		return maxPrec(x, y)
+	case y.Sign() == 0 || y.IsInf():
+		return maxPrec(x, y)
+	}
+	hi, lo := expo(x), expo(y)
+	if lo > hi {
+		hi, lo = lo, hi
+	}
+	lsb := expo(x) - int(x.Prec())
+	if l := expo(y) - int(y.Prec()); l < lsb {
+		lsb = l
+	}
+	return uint(hi-lsb) + 1
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+const piDigits = "3.14159265358979323846264338327950288419716939937510582097494459230781640628620899862803482534211706798214808651328230664709384460955058223172535940812848111745028410270193852110555964462294895493038196"
+
+// closeTo returns true if x and y agree to within a relative error of
+// 2**(-bits).
+func closeTo(x, y *big.Float, bits int) bool {
+	d := new(big.Float).Sub(x, y)
+	if d.Sign() == 0 {
+		return true
+	}
+	m := new(big.Float).Abs(y)
+	if m.Sign() == 0 {
+		m.SetInt64(1)
+	}
+	return d.MantExp(nil) <= m.MantExp(nil)-bits
+}
+
+func TestBigPi(t *testing.T) {
+	want, _ := new(big.Float).SetPrec(600).SetString(piDigits)
+	got := bigPi(600)
+	if !closeTo(got, want, 598) {
+		t.Errorf("bigPi(600) = %v", got)
+	}
+}
+
+func TestBigExpLog(t *testing.T) {
+	f := func(n int16) bool {
+		y := new(big.Float).SetPrec(300).SetFloat64(float64(n) / 100)
+		l := bigLog(bigExp(y, 300), 300)
+		return closeTo(l, y, 280)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBigExpFloat64(t *testing.T) {
+	for _, x := range []float64{-20.5, -1, 1e-9, 0.5, 1, 2, 37.25} {
+		got, _ := bigExp(big.NewFloat(x), 53).Float64()
+		if want := math.Exp(x); math.Abs(got-want) > 1e-15*want {
+			t.Errorf("bigExp(%v) = %v, want %v", x, got, want)
+		}
+	}
+}
+
+func TestBigSinCosPythagorean(t *testing.T) {
+	f := func(n int32) bool {
+		y := new(big.Float).SetPrec(250).SetFloat64(float64(n) / 7)
+		s, c := bigSinCos(y, 250)
+		s.Mul(s, s)
+		c.Mul(c, c)
+		return closeTo(s.Add(s, c), big.NewFloat(1), 240)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBigAtan2Float64(t *testing.T) {
+	points := [][2]float64{{1, 1}, {-1, 1}, {1, -1}, {-1, -1}, {0.5, 3}, {-7, 0.25}}
+	for _, p := range points {
+		got, _ := bigAtan2(big.NewFloat(p[0]), big.NewFloat(p[1]), 53).Float64()
+		if want := math.Atan2(p[0], p[1]); math.Abs(got-want) > 1e-15 {
+			t.Errorf("bigAtan2(%v, %v) = %v, want %v", p[0], p[1], got, want)
+		}
+	}
+}
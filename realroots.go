@@ -0,0 +1,216 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math/big"
+
+// A ratPoly holds the coefficients of a polynomial with rational
+// coefficients, in increasing order of degree, with a non-zero leading
+// coefficient.
+type ratPoly []*big.Rat
+
+// newRatPoly returns the exact rational polynomial with coefficients c. If
+// every coefficient is zero, then newRatPoly panics.
+func newRatPoly(c []*big.Float) ratPoly {
+	p := make(ratPoly, len(c))
+	for k, v := range c {
+		if v.IsInf() {
+			panic("infinite coefficient")
+		}
+		p[k], _ = v.Rat(nil)
+	}
+	p = p.trim()
+	if len(p) == 0 {
+		panic("zero polynomial")
+	}
+	return p
+}
+
+// trim removes the leading zero coefficients of p, and returns p.
+func (p ratPoly) trim() ratPoly {
+	for len(p) > 0 && p[len(p)-1].Sign() == 0 {
+		p = p[:len(p)-1]
+	}
+	return p
+}
+
+// derivative returns the derivative of p.
+func (p ratPoly) derivative() ratPoly {
+	if len(p) < 2 {
+		return nil
+	}
+	d := make(ratPoly, len(p)-1)
+	for k := range d {
+		d[k] = new(big.Rat).Mul(p[k+1], new(big.Rat).SetInt64(int64(k+1)))
+	}
+	return d.trim()
+}
+
+// negRem returns the negative of the remainder of p divided by q.
+func (p ratPoly) negRem(q ratPoly) ratPoly {
+	r := make(ratPoly, len(p))
+	for k, v := range p {
+		r[k] = new(big.Rat).Set(v)
+	}
+	lead := q[len(q)-1]
+	for len(r) >= len(q) {
+		f := new(big.Rat).Quo(r[len(r)-1], lead)
+		shift := len(r) - len(q)
+		for k, v := range q {
+			r[shift+k].Sub(r[shift+k], new(big.Rat).Mul(f, v))
+		}
+		// The leading coefficient cancels exactly.
+		r = r[:len(r)-1].trim()
+	}
+	for _, v := range r {
+		v.Neg(v)
+	}
+	return r
+}
+
+// eval returns the value of p at x.
+func (p ratPoly) eval(x *big.Rat) *big.Rat {
+	s := new(big.Rat)
+	for k := len(p) - 1; k >= 0; k-- {
+		s.Mul(s, x)
+		s.Add(s, p[k])
+	}
+	return s
+}
+
+// sturmSequence returns the Sturm sequence of p: p, p', and then the
+// negated remainders of the Euclidean algorithm.
+func sturmSequence(p ratPoly) []ratPoly {
+	seq := []ratPoly{p}
+	q := p.derivative()
+	for len(q) > 0 {
+		seq = append(seq, q)
+		q = seq[len(seq)-2].negRem(q)
+	}
+	return seq
+}
+
+// signChanges returns the number of sign changes in the Sturm sequence seq
+// evaluated at x, ignoring zeros.
+func signChanges(seq []ratPoly, x *big.Rat) int {
+	n, last := 0, 0
+	for _, p := range seq {
+		s := p.eval(x).Sign()
+		if s == 0 {
+			continue
+		}
+		if last != 0 && s != last {
+			n++
+		}
+		last = s
+	}
+	return n
+}
+
+// rootBound returns a power of two that is larger than the absolute value of
+// every real root of p, from the Cauchy bound 1 + max|p[k]/p[n]|.
+func (p ratPoly) rootBound() *big.Rat {
+	lead := new(big.Rat).Abs(p[len(p)-1])
+	m := new(big.Rat)
+	for _, v := range p[:len(p)-1] {
+		q := new(big.Rat).Quo(new(big.Rat).Abs(v), lead)
+		if q.Cmp(m) > 0 {
+			m = q
+		}
+	}
+	m.Add(m, big.NewRat(1, 1))
+	b := big.NewRat(1, 1)
+	for b.Cmp(m) <= 0 {
+		b.Add(b, b)
+	}
+	return b
+}
+
+// CountRealRoots returns the number of distinct real roots in the interval
+// (a, b] of the polynomial
+// 		c[0] + c[1]x + c[2]x² + ... + c[n]xⁿ
+// The count uses Sturm's theorem in exact rational arithmetic, since every
+// big.Float value is a dyadic rational, so it is certified and does not
+// depend on the precision of the coefficients. A nil bound stands for the
+// corresponding infinity. If every coefficient is zero, then CountRealRoots
+// panics.
+func CountRealRoots(c []*big.Float, a, b *big.Float) int {
+	p := newRatPoly(c)
+	seq := sturmSequence(p)
+	bound := p.rootBound()
+	lo := new(big.Rat).Neg(bound)
+	hi := bound
+	switch {
+	case a == nil || a.IsInf() && a.Signbit():
+	case a.IsInf():
+		return 0
+	default:
+		lo, _ = a.Rat(nil)
+	}
+	switch {
+	case b == nil || b.IsInf() && !b.Signbit():
+	case b.IsInf():
+		return 0
+	default:
+		hi, _ = b.Rat(nil)
+	}
+	if lo.Cmp(hi) >= 0 {
+		return 0
+	}
+	return signChanges(seq, lo) - signChanges(seq, hi)
+}
+
+// IsolateRealRoots returns the distinct real roots of the polynomial
+// 		c[0] + c[1]x + c[2]x² + ... + c[n]xⁿ
+// as intervals (lo, hi], in increasing order, each containing exactly one
+// root and no wider than tol. The intervals come from bisection guided by
+// Sturm sequences in exact rational arithmetic, so the isolation is
+// certified; their endpoints are dyadic rationals, which are returned exactly
+// as big.Float values. If every coefficient is zero, or tol is not positive,
+// then IsolateRealRoots panics.
+func IsolateRealRoots(c []*big.Float, tol *big.Float) [][2]*big.Float {
+	if tol.Sign() <= 0 {
+		panic("non-positive tolerance")
+	}
+	p := newRatPoly(c)
+	seq := sturmSequence(p)
+	width, _ := tol.Rat(nil)
+	bound := p.rootBound()
+	type interval struct {
+		lo, hi   *big.Rat
+		vlo, vhi int
+	}
+	lo := new(big.Rat).Neg(bound)
+	stack := []interval{{lo, bound, signChanges(seq, lo), signChanges(seq, bound)}}
+	var roots [][2]*big.Float
+	for len(stack) > 0 {
+		iv := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		n := iv.vlo - iv.vhi
+		if n == 0 {
+			continue
+		}
+		size := new(big.Rat).Sub(iv.hi, iv.lo)
+		if n == 1 && size.Cmp(width) <= 0 {
+			roots = append(roots, [2]*big.Float{ratToFloat(iv.lo), ratToFloat(iv.hi)})
+			continue
+		}
+		mid := new(big.Rat).Add(iv.lo, iv.hi)
+		mid.Quo(mid, big.NewRat(2, 1))
+		vmid := signChanges(seq, mid)
+		// Push the upper half first, so that roots come out in order.
+		stack = append(stack,
+			interval{mid, iv.hi, vmid, iv.vhi},
+			interval{iv.lo, mid, iv.vlo, vmid},
+		)
+	}
+	return roots
+}
+
+// ratToFloat returns the dyadic rational x as an exact big.Float value.
+func ratToFloat(x *big.Rat) *big.Float {
+	num := new(big.Float).SetInt(x.Num())
+	// The denominator is a power of two.
+	return num.SetMantExp(num, 1-x.Denom().BitLen())
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+// floats returns the big.Float values of x.
+func floats(x ...float64) []*big.Float {
+	f := make([]*big.Float, len(x))
+	for k, v := range x {
+		f[k] = big.NewFloat(v)
+	}
+	return f
+}
+
+func TestCountRealRoots(t *testing.T) {
+	cases := []struct {
+		c    []float64
+		a, b *big.Float
+		n    int
+	}{
+		// (x - 1)(x - 2)(x - 3)
+		{[]float64{-6, 11, -6, 1}, nil, nil, 3},
+		{[]float64{-6, 11, -6, 1}, big.NewFloat(0), big.NewFloat(2), 2},
+		{[]float64{-6, 11, -6, 1}, big.NewFloat(1), big.NewFloat(2), 1},
+		{[]float64{-6, 11, -6, 1}, big.NewFloat(2.5), new(big.Float).SetInf(false), 1},
+		// (x - 1)²(x + 1) has two distinct roots.
+		{[]float64{1, -1, -1, 1}, nil, nil, 2},
+		// x² + 1
+		{[]float64{1, 0, 1}, nil, nil, 0},
+		{[]float64{5}, nil, nil, 0},
+	}
+	for _, c := range cases {
+		if n := CountRealRoots(floats(c.c...), c.a, c.b); n != c.n {
+			t.Errorf("CountRealRoots(%v, %v, %v) = %d, want %d", c.c, c.a, c.b, n, c.n)
+		}
+	}
+}
+
+func TestIsolateRealRoots(t *testing.T) {
+	// x² - 2
+	tol := big.NewFloat(1e-30)
+	roots := IsolateRealRoots(floats(-2, 0, 1), tol)
+	if len(roots) != 2 {
+		t.Fatalf("IsolateRealRoots = %v", roots)
+	}
+	for k, want := range []float64{-math.Sqrt2, math.Sqrt2} {
+		lo, _ := roots[k][0].Float64()
+		hi, _ := roots[k][1].Float64()
+		if lo > want || hi < want || new(big.Float).Sub(roots[k][1], roots[k][0]).Cmp(tol) > 0 {
+			t.Errorf("root %d in (%v, %v]", k, roots[k][0], roots[k][1])
+		}
+	}
+}
+
+func TestIsolateRealRootsCluster(t *testing.T) {
+	// (x - 1)(x - 1 - 2**(-60)) has roots that no float64 can separate.
+	one := big.NewFloat(1)
+	e := new(big.Float).SetMantExp(one, -60)
+	r := new(big.Float).SetPrec(128).Add(one, e)
+	c := []*big.Float{r, new(big.Float).SetPrec(128).Neg(new(big.Float).SetPrec(128).Add(one, r)), one}
+	roots := IsolateRealRoots(c, big.NewFloat(1))
+	if len(roots) != 2 {
+		t.Fatalf("IsolateRealRoots = %v", roots)
+	}
+	if roots[0][1].Cmp(roots[1][0]) > 0 || roots[0][1].Cmp(one) < 0 || roots[1][1].Cmp(r) < 0 {
+		t.Errorf("IsolateRealRoots = %v", roots)
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+// complexDet returns the determinant of the square matrix m, computed by
+// Gaussian elimination with partial pivoting at prec bits. The entries of m
+// are overwritten.
+func complexDet(m [][]Complex, prec uint) *Complex {
+	n := len(m)
+	det := newComplexPrec(prec)
+	det.l.SetInt64(1)
+	for k := 0; k < n; k++ {
+		pivot := k
+		for i := k + 1; i < n; i++ {
+			if m[i][k].Quad().Cmp(m[pivot][k].Quad()) > 0 {
+				pivot = i
+			}
+		}
+		if m[pivot][k].l.Sign() == 0 && m[pivot][k].r.Sign() == 0 {
+			return newComplexPrec(prec)
+		}
+		if pivot != k {
+			m[pivot], m[k] = m[k], m[pivot]
+			det.Neg(det)
+		}
+		det.Mul(det, &m[k][k])
+		for i := k + 1; i < n; i++ {
+			f := newComplexPrec(prec).Quo(&m[i][k], &m[k][k])
+			for j := k + 1; j < n; j++ {
+				m[i][j].Sub(&m[i][j], newComplexPrec(prec).Mul(f, &m[k][j]))
+			}
+		}
+	}
+	return det
+}
+
+// Resultant returns the resultant of p and q, a pointer to a Complex value.
+// If p has degree m and roots aᵢ, and q has degree n and roots bⱼ, then the
+// resultant is
+// 		cₘⁿ dₙᵐ ∏ (aᵢ - bⱼ)
+// where cₘ and dₙ are the leading coefficients, which vanishes exactly when p
+// and q have a common root. It is computed as the determinant of the
+// Sylvester matrix of p and q, and rounded to the largest precision of the
+// coefficients. The resultant is zero if p or q is zero.
+func Resultant(p, q *ComplexPoly) *Complex {
+	prec := uint(0)
+	for _, c := range [][]Complex{p.c, q.c} {
+		for k := range c {
+			if v := maxPrec(&c[k].l, &c[k].r); v > prec {
+				prec = v
+			}
+		}
+	}
+	if prec == 0 {
+		prec = defaultPrec
+	}
+	m, n := p.Degree(), q.Degree()
+	if m < 0 || n < 0 {
+		return newComplexPrec(prec)
+	}
+	w := prec + guardBits
+	size := m + n
+	if size == 0 {
+		one := newComplexPrec(prec)
+		one.l.SetInt64(1)
+		return one
+	}
+	s := make([][]Complex, size)
+	for i := range s {
+		s[i] = make([]Complex, size)
+		for j := range s[i] {
+			s[i][j].l.SetPrec(w)
+			s[i][j].r.SetPrec(w)
+		}
+	}
+	// The first n rows hold the shifts of p, and the last m rows the shifts
+	// of q, with the coefficients in decreasing order of degree.
+	for i := 0; i < n; i++ {
+		for k := 0; k <= m; k++ {
+			s[i][i+m-k].round(&p.c[k], w)
+		}
+	}
+	for i := 0; i < m; i++ {
+		for k := 0; k <= n; k++ {
+			s[n+i][i+n-k].round(&q.c[k], w)
+		}
+	}
+	return newComplexPrec(prec).round(complexDet(s, w), prec)
+}
+
+// Discriminant returns the discriminant of p, a pointer to a Complex value.
+// If p has degree n, leading coefficient cₙ and roots aᵢ, then the
+// discriminant is
+// 		cₙ^(2n-2) ∏ (aᵢ - aⱼ)²
+// over i < j, which vanishes exactly when p has a multiple root. It is
+// computed from the resultant of p and its derivative,
+// 		(-1)^(n(n-1)/2) Resultant(p, p') / cₙ
+// and rounded to the largest precision of the coefficients. If p has degree
+// less than one, then Discriminant panics.
+func Discriminant(p *ComplexPoly) *Complex {
+	n := p.Degree()
+	if n < 1 {
+		panic("discriminant of constant polynomial")
+	}
+	r := Resultant(p, new(ComplexPoly).Derivative(p))
+	prec := maxPrec(&r.l, &r.r)
+	w := prec + guardBits
+	d := newComplexPrec(w).Quo(r, &p.c[n])
+	if n*(n-1)/2%2 == 1 {
+		d.Neg(d)
+	}
+	return newComplexPrec(prec).round(d, prec)
+}
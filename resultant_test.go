@@ -0,0 +1,70 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"testing"
+	"testing/quick"
+)
+
+func TestResultantRoots(t *testing.T) {
+	f := func(a, b, c, d *Complex) bool {
+		// t.Logf("a = %v, b = %v, c = %v, d = %v", a, b, c, d)
+		// p = (x - a)(x - b), q = (x - c)(x - d)
+		p := new(ComplexPoly).Mul(NewComplexPoly(new(Complex).Neg(a), newComplex128(1)), NewComplexPoly(new(Complex).Neg(b), newComplex128(1)))
+		q := new(ComplexPoly).Mul(NewComplexPoly(new(Complex).Neg(c), newComplex128(1)), NewComplexPoly(new(Complex).Neg(d), newComplex128(1)))
+		want := newComplex128(1)
+		for _, u := range []*Complex{a, b} {
+			for _, v := range []*Complex{c, d} {
+				want.Mul(want, new(Complex).Sub(u, v))
+			}
+		}
+		return closeToComplex(Resultant(p, q), want, 40) && closeToComplex(Resultant(q, p), want, 40)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestResultantSign(t *testing.T) {
+	f := func(p, q *ComplexPoly) bool {
+		// t.Logf("p = %v, q = %v", p, q)
+		l := Resultant(p, q)
+		r := Resultant(q, p)
+		if p.Degree()*q.Degree()%2 == 1 {
+			r.Neg(r)
+		}
+		return closeToComplex(l, r, 40)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDiscriminantQuadratic(t *testing.T) {
+	f := func(a, b, c *Complex) bool {
+		// t.Logf("a = %v, b = %v, c = %v", a, b, c)
+		// b² - 4ac
+		want := new(Complex).Mul(b, b)
+		ac := new(Complex).Mul(a, c)
+		ac.Scal(ac, newComplex128(4).Real())
+		want.Sub(want, ac)
+		return closeToComplex(Discriminant(NewComplexPoly(c, b, a)), want, 40)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDiscriminantDoubleRoot(t *testing.T) {
+	// (x - 1)²(x + 2) and (x - 1)(x - i)(x + 2)
+	if d := Discriminant(NewComplexPoly(polyFromRoots(1, 1, -2)...)); d.Quad().Sign() != 0 {
+		t.Errorf("Discriminant = %v, want 0", d)
+	}
+	// ∏ (aᵢ - aⱼ)² = (1 - i)²(3)²(i + 2)²
+	want := newComplex128((1 - 1i) * (1 - 1i) * 9 * (2 + 1i) * (2 + 1i))
+	if d := Discriminant(NewComplexPoly(polyFromRoots(1, 1i, -2)...)); !closeToComplex(d, want, 50) {
+		t.Errorf("Discriminant = %v, want %v", d, want)
+	}
+}
@@ -0,0 +1,320 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math/big"
+
+// ToRotationMatrix returns the 3x3 rotation matrix R of z, which acts on a
+// vector v = xi + yj + zk as
+// 		R v = z v Inv(z)
+// If z = a+bi+cj+dk and s = 2/Quad(z), then
+// 		    [1 - s(c²+d²)    s(bc - ad)      s(bd + ac)  ]
+// 		R = [  s(bc + ad)  1 - s(b²+d²)      s(cd - ab)  ]
+// 		    [  s(bd - ac)    s(cd + ab)    1 - s(b²+c²)  ]
+// so z need not be a unit quaternion. The entries are rounded to the
+// precision of z. If z is zero, then ToRotationMatrix panics.
+func (z *Hamilton) ToRotationMatrix() [3][3]*big.Float {
+	a, b, c, d := z.Cartesian()
+	prec := maxPrec(a, b, c, d)
+	w := prec + guardBits
+	quad := newFloat(w)
+	for _, v := range []*big.Float{a, b, c, d} {
+		quad.Add(quad, newFloat(w).Mul(v, v))
+	}
+	if quad.Sign() == 0 {
+		panic("rotation matrix of zero")
+	}
+	s := newFloat(w).Quo(big.NewFloat(2), quad)
+	p := func(x, y *big.Float) *big.Float {
+		return newFloat(w).Mul(x, y)
+	}
+	// diag returns 1 - s(x² + y²), and off returns s(x ± y).
+	diag := func(x, y *big.Float) *big.Float {
+		t := newFloat(w).Add(p(x, x), p(y, y))
+		t.Mul(t, s)
+		return newFloat(prec).Sub(big.NewFloat(1), t)
+	}
+	off := func(x, y *big.Float, sign int) *big.Float {
+		t := newFloat(w)
+		if sign < 0 {
+			t.Sub(x, y)
+		} else {
+			t.Add(x, y)
+		}
+		return newFloat(prec).Mul(t, s)
+	}
+	return [3][3]*big.Float{
+		{diag(c, d), off(p(b, c), p(a, d), -1), off(p(b, d), p(a, c), 1)},
+		{off(p(b, c), p(a, d), 1), diag(b, d), off(p(c, d), p(a, b), -1)},
+		{off(p(b, d), p(a, c), -1), off(p(c, d), p(a, b), 1), diag(b, c)},
+	}
+}
+
+// FromRotationMatrix sets z equal to the unit quaternion of the rotation
+// matrix m, with a non-negative real part, and returns z. It uses Shepperd's
+// method: the largest of the trace and the three diagonal entries selects
+// which component is recovered from a square root, and the others follow
+// from sums and differences of the off-diagonal entries, which avoids the
+// loss of accuracy when the trace is close to -1. The matrix is assumed to be
+// orthogonal with determinant one. The result is rounded to the largest
+// precision of the entries of m.
+func (z *Hamilton) FromRotationMatrix(m [3][3]*big.Float) *Hamilton {
+	var all []*big.Float
+	for _, row := range m {
+		all = append(all, row[:]...)
+	}
+	prec := maxPrec(all...)
+	w := prec + guardBits
+	add := func(x ...*big.Float) *big.Float {
+		t := newFloat(w)
+		for _, v := range x {
+			t.Add(t, v)
+		}
+		return t
+	}
+	neg := func(x *big.Float) *big.Float {
+		return newFloat(w).Neg(x)
+	}
+	one := big.NewFloat(1)
+	// q[0..3] are the components a, b, c, d.
+	var q [4]*big.Float
+	cand := []*big.Float{
+		add(m[0][0], m[1][1], m[2][2]),
+		m[0][0], m[1][1], m[2][2],
+	}
+	k := 0
+	for i := 1; i < 4; i++ {
+		if cand[i].Cmp(cand[k]) > 0 {
+			k = i
+		}
+	}
+	// t = 4q[k]², from the trace or a diagonal entry.
+	var t *big.Float
+	switch k {
+	case 0:
+		t = add(one, m[0][0], m[1][1], m[2][2])
+	case 1:
+		t = add(one, m[0][0], neg(m[1][1]), neg(m[2][2]))
+	case 2:
+		t = add(one, neg(m[0][0]), m[1][1], neg(m[2][2]))
+	default:
+		t = add(one, neg(m[0][0]), neg(m[1][1]), m[2][2])
+	}
+	r := newFloat(w).Sqrt(t)
+	q[k] = newFloat(w).SetMantExp(r, -1)
+	// The off-diagonal combinations below equal 4q[k]q[i].
+	f := newFloat(w).Quo(big.NewFloat(0.5), r)
+	scaled := func(x *big.Float) *big.Float {
+		return x.Mul(x, f)
+	}
+	switch k {
+	case 0:
+		q[1] = scaled(add(m[2][1], neg(m[1][2])))
+		q[2] = scaled(add(m[0][2], neg(m[2][0])))
+		q[3] = scaled(add(m[1][0], neg(m[0][1])))
+	case 1:
+		q[0] = scaled(add(m[2][1], neg(m[1][2])))
+		q[2] = scaled(add(m[0][1], m[1][0]))
+		q[3] = scaled(add(m[0][2], m[2][0]))
+	case 2:
+		q[0] = scaled(add(m[0][2], neg(m[2][0])))
+		q[1] = scaled(add(m[0][1], m[1][0]))
+		q[3] = scaled(add(m[1][2], m[2][1]))
+	default:
+		q[0] = scaled(add(m[1][0], neg(m[0][1])))
+		q[1] = scaled(add(m[0][2], m[2][0]))
+		q[2] = scaled(add(m[1][2], m[2][1]))
+	}
+	if q[0].Sign() < 0 {
+		for _, v := range q {
+			v.Neg(v)
+		}
+	}
+	z.l.l.SetPrec(prec).Set(q[0])
+	z.l.r.SetPrec(prec).Set(q[1])
+	z.r.l.SetPrec(prec).Set(q[2])
+	z.r.r.SetPrec(prec).Set(q[3])
+	return z
+}
+
+// Rotate returns the vector v rotated by z:
+// 		z v Inv(z)
+// where v is identified with the pure quaternion xi + yj + zk. If z = a+r,
+// where r is the vector part of z, then the rotated vector is
+// 		((a² - r·r)v + 2(r·v)r + 2a(r×v))/Quad(z)
+// which needs no quaternion products, and z need not be a unit quaternion.
+// The components are rounded to the largest precision of z and v. If z is
+// zero, then Rotate panics.
+func (z *Hamilton) Rotate(v *Vec3) *Vec3 {
+	a, b, c, d := z.Cartesian()
+	vx, vy, vz := v.Cartesian()
+	prec := maxPrec(a, b, c, d, vx, vy, vz)
+	w := prec + guardBits
+	r := new(Vec3)
+	r.x.SetPrec(w).Set(b)
+	r.y.SetPrec(w).Set(c)
+	r.z.SetPrec(w).Set(d)
+	u := new(Vec3)
+	u.x.SetPrec(w).Set(vx)
+	u.y.SetPrec(w).Set(vy)
+	u.z.SetPrec(w).Set(vz)
+	rr := r.Dot(r)
+	quad := newFloat(w).Mul(a, a)
+	f := newFloat(w).Sub(quad, rr)
+	quad.Add(quad, rr)
+	if quad.Sign() == 0 {
+		panic("rotation by zero")
+	}
+	g := r.Dot(u)
+	g.SetMantExp(g, 1)
+	h := newFloat(w).SetMantExp(a, 1)
+	cross := new(Vec3).Cross(r, u)
+	// component returns (f v + g r + h (r×v))/Quad(z) for one axis.
+	component := func(v, r, cross *big.Float) *big.Float {
+		t := newFloat(w).Mul(f, v)
+		t.Add(t, newFloat(w).Mul(g, r))
+		t.Add(t, newFloat(w).Mul(h, cross))
+		return t.Quo(t, quad)
+	}
+	return NewVec3(
+		newFloat(prec).Set(component(&u.x, &r.x, &cross.x)),
+		newFloat(prec).Set(component(&u.y, &r.y, &cross.y)),
+		newFloat(prec).Set(component(&u.z, &r.z, &cross.z)),
+	)
+}
+
+// NewHamiltonFromAxisAngle returns a pointer to the unit quaternion of the
+// rotation by angle θ about axis, following the right-hand rule:
+// 		cos(θ/2) + sin(θ/2)(xi + yj + zk)/|axis|
+// The axis need not be a unit vector. The components are rounded to the
+// largest precision of the axis and the angle. If the axis is zero, then
+// NewHamiltonFromAxisAngle panics.
+func NewHamiltonFromAxisAngle(axis [3]*big.Float, angle *big.Float) *Hamilton {
+	prec := maxPrec(axis[0], axis[1], axis[2], angle)
+	w := prec + guardBits
+	norm := bigHypot(w, axis[:]...)
+	if norm.Sign() == 0 {
+		panic("rotation about a zero axis")
+	}
+	half := newFloat(w).SetMantExp(angle, -1)
+	s, c := bigSinCos(half, w)
+	return new(Hamilton).setScaledVector(c, s.Quo(s, norm), axis[0], axis[1], axis[2], prec)
+}
+
+// AxisAngle returns the unit rotation axis and the rotation angle of z, so
+// that z is a positive multiple of NewHamiltonFromAxisAngle(axis, angle). If
+// z = a+v, where v is the vector part of z, then
+// 		axis = v/|v|,    angle = 2 atan2(|v|, a)
+// so the angle lies in [0, 2π]. If v is zero, then the axis is i. The results
+// are rounded to the precision of z. If z is zero, then AxisAngle panics.
+func (z *Hamilton) AxisAngle() ([3]*big.Float, *big.Float) {
+	a, b, c, d := z.Cartesian()
+	prec := maxPrec(a, b, c, d)
+	w := prec + guardBits
+	theta := bigHypot(w, b, c, d)
+	if theta.Sign() == 0 {
+		if a.Sign() == 0 {
+			panic("axis-angle of zero")
+		}
+		angle := newFloat(prec)
+		if a.Sign() < 0 {
+			angle.SetMantExp(bigPi(prec), 1)
+		}
+		return [3]*big.Float{newFloat(prec).SetInt64(1), newFloat(prec), newFloat(prec)}, angle
+	}
+	angle := bigAtan2(theta, a, w)
+	angle.SetMantExp(angle, 1)
+	var axis [3]*big.Float
+	for i, v := range []*big.Float{b, c, d} {
+		axis[i] = newFloat(prec).Quo(v, theta)
+	}
+	return axis, newFloat(prec).Set(angle)
+}
+
+// An EulerOrder selects the sequence of axes of an Euler angle convention.
+// Each order composes three rotations about body axes, so the first angle
+// rotates about the outermost axis of the matrix product.
+type EulerOrder int
+
+const (
+	// ZYX is the yaw-pitch-roll convention, with
+	// 		R = Rz(φ) Ry(θ) Rx(ψ)
+	ZYX EulerOrder = iota
+	// XYZ is the convention with
+	// 		R = Rx(φ) Ry(θ) Rz(ψ)
+	XYZ
+)
+
+// FromEuler sets z equal to the unit quaternion of the rotation with Euler
+// angles φ, θ, and ψ in the given order, and returns z. The result is rounded
+// to the largest precision of the angles. If the order is unknown, then
+// FromEuler panics.
+func (z *Hamilton) FromEuler(phi, theta, psi *big.Float, order EulerOrder) *Hamilton {
+	prec := maxPrec(phi, theta, psi)
+	w := prec + guardBits
+	one := newFloat(w).SetInt64(1)
+	zero := newFloat(w)
+	x := [3]*big.Float{one, zero, zero}
+	y := [3]*big.Float{zero, one, zero}
+	k := [3]*big.Float{zero, zero, one}
+	var axes [3][3]*big.Float
+	switch order {
+	case ZYX:
+		axes = [3][3]*big.Float{k, y, x}
+	case XYZ:
+		axes = [3][3]*big.Float{x, y, k}
+	default:
+		panic("unknown Euler angle order")
+	}
+	q := NewHamiltonFromAxisAngle(axes[0], newFloat(w).Set(phi))
+	q.Mul(q, NewHamiltonFromAxisAngle(axes[1], newFloat(w).Set(theta)))
+	q.Mul(q, NewHamiltonFromAxisAngle(axes[2], newFloat(w).Set(psi)))
+	a, b, c, d := q.Cartesian()
+	return z.setScaledVector(a, one, b, c, d, prec)
+}
+
+// ToEuler returns the Euler angles φ, θ, and ψ of the rotation of z in the
+// given order, with φ and ψ in [-π, π] and θ in [-π/2, π/2]. The quaternion z
+// need not be a unit quaternion.
+//
+// When θ is within 2^(-prec/2) of ±π/2, the first and last axes line up
+// (gimbal lock) and only a combination of φ and ψ is determined. In that case
+// ψ is set to zero, φ carries the whole rotation about the common axis, and
+// the boolean result is true. The angles are rounded to the precision of z.
+// If z is zero or the order is unknown, then ToEuler panics.
+func (z *Hamilton) ToEuler(order EulerOrder) (phi, theta, psi *big.Float, lock bool) {
+	a, b, c, d := z.Cartesian()
+	prec := maxPrec(a, b, c, d)
+	w := prec + guardBits
+	q := new(Hamilton).setScaledVector(newFloat(w).Set(a), newFloat(w).SetInt64(1), b, c, d, w)
+	m := q.ToRotationMatrix()
+	neg := func(x *big.Float) *big.Float {
+		return newFloat(w).Neg(x)
+	}
+	var cos, sin *big.Float
+	switch order {
+	case ZYX:
+		cos, sin = bigHypot(w, m[0][0], m[1][0]), neg(m[2][0])
+	case XYZ:
+		cos, sin = bigHypot(w, m[0][0], m[0][1]), m[0][2]
+	default:
+		panic("unknown Euler angle order")
+	}
+	theta = bigAtan2(sin, cos, w)
+	psi = newFloat(prec)
+	lock = cos.Sign() == 0 || expo(cos) < -int(prec)/2
+	switch {
+	case order == ZYX && lock:
+		phi = bigAtan2(neg(m[0][1]), m[1][1], w)
+	case order == ZYX:
+		phi = bigAtan2(m[1][0], m[0][0], w)
+		psi = bigAtan2(m[2][1], m[2][2], w)
+	case lock:
+		phi = bigAtan2(m[2][1], m[1][1], w)
+	default:
+		phi = bigAtan2(neg(m[1][2]), m[2][2], w)
+		psi = bigAtan2(neg(m[0][1]), m[0][0], w)
+	}
+	return newFloat(prec).Set(phi), newFloat(prec).Set(theta), newFloat(prec).Set(psi), lock
+}
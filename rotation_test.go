@@ -0,0 +1,202 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestHamiltonRotationMatrixConjugation(t *testing.T) {
+	f := func(x, v *Hamilton) bool {
+		// t.Logf("x = %v, v = %v", x, v)
+		y := setPrecHamilton(x, 200)
+		u := setPrecHamilton(v, 200)
+		u.l.l.SetInt64(0)
+		m := y.ToRotationMatrix()
+		// z v Inv(z)
+		r := new(Hamilton).Mul(y, u)
+		r.Mul(r, new(Hamilton).Inv(y))
+		_, b, c, d := u.Cartesian()
+		_, rb, rc, rd := r.Cartesian()
+		for i, want := range []*big.Float{rb, rc, rd} {
+			got := newFloat(200)
+			for j, e := range []*big.Float{b, c, d} {
+				got.Add(got, newFloat(200).Mul(m[i][j], e))
+			}
+			if d := got.Sub(got, want); d.Sign() != 0 && expo(d) > -190 {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonRotationMatrixRoundTrip(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		y := new(Hamilton)
+		y.Versor(setPrecHamilton(x, 200))
+		// Cover every branch of Shepperd's method, including rotations by
+		// nearly π where the trace is close to -1.
+		ok := true
+		for k := 0; k < 4; k++ {
+			z := new(Hamilton).FromRotationMatrix(y.ToRotationMatrix())
+			// z and -z give the same rotation; z has a non-negative real part.
+			d := new(Hamilton).Sub(z, y).Quad()
+			if e := new(Hamilton).Add(z, y).Quad(); e.Cmp(d) < 0 {
+				d = e
+			}
+			ok = ok && z.l.l.Sign() >= 0 && (d.Sign() == 0 || expo(d) < -380)
+			// Multiply by i to move the largest component.
+			i := NewHamilton(new(big.Float), big.NewFloat(1), new(big.Float), new(big.Float))
+			y.Mul(y, i)
+		}
+		return ok
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonAxisAngleQuarterTurn(t *testing.T) {
+	// A quarter turn about the z axis maps the x axis to the y axis.
+	zero := newFloat(100)
+	half := newFloat(100).SetMantExp(bigPi(100), -1)
+	q := NewHamiltonFromAxisAngle([3]*big.Float{zero, zero, big.NewFloat(2)}, half)
+	m := q.ToRotationMatrix()
+	want := [3]float64{0, 1, 0}
+	for i := range want {
+		d := newFloat(100).Sub(m[i][0], big.NewFloat(want[i]))
+		if d.Sign() != 0 && expo(d) > -95 {
+			t.Errorf("R[%d][0] = %v, want %v", i, m[i][0], want[i])
+		}
+	}
+}
+
+func TestHamiltonAxisAngleRoundTrip(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		y := setPrecHamilton(x, 200)
+		axis, angle := y.AxisAngle()
+		z := NewHamiltonFromAxisAngle(axis, angle)
+		// y is a positive multiple of z.
+		u := new(Hamilton)
+		u.Versor(y)
+		d := u.Sub(u, z).Quad()
+		return d.Sign() == 0 || expo(d) < -380
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonEulerRoundTrip(t *testing.T) {
+	f := func(x, y, z int16) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		// φ and ψ in (-π, π), θ in (-π/2, π/2).
+		phi := newFloat(200).SetFloat64(float64(x) / 10500)
+		theta := newFloat(200).SetFloat64(float64(y) / 21000)
+		psi := newFloat(200).SetFloat64(float64(z) / 10500)
+		for _, order := range []EulerOrder{ZYX, XYZ} {
+			q := new(Hamilton).FromEuler(phi, theta, psi, order)
+			a, b, c, lock := q.ToEuler(order)
+			if lock || !closeTo(a, phi, 180) || !closeTo(b, theta, 180) || !closeTo(c, psi, 180) {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonEulerZYXMatrix(t *testing.T) {
+	// Rz(φ) Ry(θ) Rx(ψ) has R[2][0] = -sin(θ) and R[1][0] = sin(φ)cos(θ).
+	phi, theta, psi := big.NewFloat(0.3), big.NewFloat(-0.7), big.NewFloat(1.9)
+	m := new(Hamilton).FromEuler(phi, theta, psi, ZYX).ToRotationMatrix()
+	if got, _ := m[2][0].Float64(); math.Abs(got+math.Sin(-0.7)) > 1e-15 {
+		t.Errorf("R[2][0] = %v, want %v", got, -math.Sin(-0.7))
+	}
+	if got, _ := m[1][0].Float64(); math.Abs(got-math.Sin(0.3)*math.Cos(-0.7)) > 1e-15 {
+		t.Errorf("R[1][0] = %v, want %v", got, math.Sin(0.3)*math.Cos(-0.7))
+	}
+}
+
+func TestHamiltonEulerGimbalLock(t *testing.T) {
+	halfPi := newFloat(200).SetMantExp(bigPi(200), -1)
+	for _, order := range []EulerOrder{ZYX, XYZ} {
+		for _, theta := range []*big.Float{halfPi, newFloat(200).Neg(halfPi)} {
+			q := new(Hamilton).FromEuler(big.NewFloat(0.4), theta, big.NewFloat(-1.1), order)
+			phi, th, psi, lock := q.ToEuler(order)
+			if !lock || psi.Sign() != 0 || !closeTo(th, theta, 90) {
+				t.Errorf("order %d, θ = %v: φ = %v, θ = %v, ψ = %v, lock = %t", order, theta, phi, th, psi, lock)
+				continue
+			}
+			// The recovered angles describe the same rotation.
+			r := new(Hamilton).FromEuler(phi, th, psi, order)
+			d := new(Hamilton).Sub(r, q).Quad()
+			if e := new(Hamilton).Add(r, q).Quad(); e.Cmp(d) < 0 {
+				d = e
+			}
+			if d.Sign() != 0 && expo(d) > -170 {
+				t.Errorf("order %d, θ = %v: rotation differs by %v", order, theta, d)
+			}
+		}
+	}
+}
+
+func TestHamiltonRotateConjugation(t *testing.T) {
+	f := func(x *Hamilton, v *Vec3) bool {
+		// t.Logf("x = %v, v = %v", x, v)
+		y := setPrecHamilton(x, 200)
+		vx, vy, vz := v.Cartesian()
+		u := setPrecHamilton(NewHamilton(new(big.Float), vx, vy, vz), 200)
+		r := new(Hamilton).Mul(y, u)
+		r.Mul(r, new(Hamilton).Inv(y))
+		_, ub, uc, ud := u.Cartesian()
+		_, rb, rc, rd := r.Cartesian()
+		gx, gy, gz := y.Rotate(NewVec3(ub, uc, ud)).Cartesian()
+		for i, got := range []*big.Float{gx, gy, gz} {
+			want := []*big.Float{rb, rc, rd}[i]
+			if d := newFloat(200).Sub(got, want); d.Sign() != 0 && expo(d) > -190 {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonRotatePreservesLength(t *testing.T) {
+	f := func(x *Hamilton, v *Vec3) bool {
+		// t.Logf("x = %v, v = %v", x, v)
+		y := setPrecHamilton(x, 200)
+		vx, vy, vz := v.Cartesian()
+		u := NewVec3(newFloat(200).Set(vx), newFloat(200).Set(vy), newFloat(200).Set(vz))
+		r := y.Rotate(u)
+		return closeTo(r.Dot(r), u.Dot(u), 190)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonRotateQuarterTurn(t *testing.T) {
+	zero := new(big.Float)
+	z := NewHamiltonFromAxisAngle([3]*big.Float{zero, zero, big.NewFloat(1)}, big.NewFloat(math.Pi/2))
+	got := z.Rotate(NewVec3(big.NewFloat(2), zero, zero))
+	x, y, w := got.Cartesian()
+	if !closeTo(y, big.NewFloat(2), 50) || expo(x) > -50 || w.Sign() != 0 {
+		t.Errorf("Rotate = %v", got)
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/big"
+)
+
+// ErrCorruptRotations is returned by DecodeRotations for data that was not
+// produced by a RotationCodec.
+var ErrCorruptRotations = errors.New("bigfloat: corrupt rotation data")
+
+// A RotationCodec compresses a sequence of unit Hamilton quaternions, such as
+// the orientations along a long trajectory, by storing every Interval-th
+// quaternion as a keyframe at Prec bits and the others as the logarithm of
+// the rotation from the previous quaternion, in fixed point with DeltaBits
+// bits. If Interval is not positive, then only the first quaternion is a
+// keyframe.
+//
+// The deltas are taken from the reconstructed previous quaternion, as the
+// reader will see it, rather than from the original one, so their rounding
+// errors do not accumulate between keyframes: every quaternion is
+// reconstructed to within Tolerance. Keyframes bound the work needed to
+// decode from an arbitrary position and guard against a damaged delta.
+type RotationCodec struct {
+	Prec      uint
+	DeltaBits uint
+	Interval  int
+}
+
+// check panics unless the parameters of c are usable.
+func (c RotationCodec) check() {
+	if c.Prec == 0 {
+		panic("zero precision")
+	}
+	if c.DeltaBits < 3 || c.DeltaBits > 62 {
+		panic("delta bits not in [3, 62]")
+	}
+}
+
+// key reports whether the quaternion of index k is a keyframe.
+func (c RotationCodec) key(k int) bool {
+	return k == 0 || c.Interval > 0 && k%c.Interval == 0
+}
+
+// Tolerance returns a bound on the distance |x - y| between a unit quaternion
+// x and its reconstruction y. The logarithm of a rotation has a vector part
+// of length at most π, and each component of a delta is rounded to a multiple
+// of 2^(2-DeltaBits), so the deltas add at most 2^(2-DeltaBits) to the
+// distance, since Exp is a contraction on vectors; the rest allows for the
+// rounding at Prec bits.
+func (c RotationCodec) Tolerance() *big.Float {
+	c.check()
+	t := new(big.Float).SetMantExp(big.NewFloat(1), 2-int(c.DeltaBits))
+	return t.Add(t, new(big.Float).SetMantExp(big.NewFloat(1), 6-int(c.Prec)))
+}
+
+// step sets z equal to the unit quaternion Mul(prev, Exp(δ)), where the vector
+// δ is given by the fixed-point components m, and returns z.
+func (c RotationCodec) step(z, prev *Hamilton, m [3]int64) *Hamilton {
+	d := new(Hamilton)
+	_, b, e, f := d.Cartesian()
+	for k, v := range []*big.Float{b, e, f} {
+		v.SetPrec(c.Prec).SetInt64(m[k])
+		v.SetMantExp(v, 2-int(c.DeltaBits))
+	}
+	d.l.l.SetPrec(c.Prec)
+	d.Exp(d)
+	z.Mul(prev, d)
+	z.Versor(z)
+	return z
+}
+
+// Encode returns the compressed form of the unit quaternions q. Each
+// quaternion should have unit modulus; the reconstruction always does.
+func (c RotationCodec) Encode(q []*Hamilton) []byte {
+	c.check()
+	interval := c.Interval
+	if interval < 0 {
+		interval = 0
+	}
+	var buf []byte
+	buf = binary.AppendUvarint(buf, uint64(c.Prec))
+	buf = binary.AppendUvarint(buf, uint64(c.DeltaBits))
+	buf = binary.AppendUvarint(buf, uint64(interval))
+	buf = binary.AppendUvarint(buf, uint64(len(q)))
+	scale := int(c.DeltaBits) - 2
+	prev := new(Hamilton)
+	for k, x := range q {
+		if c.key(k) {
+			a, b, e, f := prev.Cartesian()
+			xa, xb, xe, xf := x.Cartesian()
+			for i, v := range []*big.Float{a, b, e, f} {
+				v.SetPrec(c.Prec).Set([]*big.Float{xa, xb, xe, xf}[i])
+				data, err := v.GobEncode()
+				if err != nil {
+					panic(err)
+				}
+				buf = binary.AppendUvarint(buf, uint64(len(data)))
+				buf = append(buf, data...)
+			}
+			continue
+		}
+		// The rotation from the reconstructed previous quaternion.
+		d := new(Hamilton).Conj(prev)
+		d.Mul(d, x)
+		d.Log(d)
+		var m [3]int64
+		_, b, e, f := d.Cartesian()
+		for i, v := range []*big.Float{b, e, f} {
+			u := new(big.Float).SetMantExp(v, scale)
+			m[i] = roundInt64(u)
+			buf = binary.AppendVarint(buf, m[i])
+		}
+		c.step(prev, prev, m)
+	}
+	return buf
+}
+
+// roundInt64 returns x rounded to the nearest integer, with ties away from
+// zero.
+func roundInt64(x *big.Float) int64 {
+	h := big.NewFloat(0.5)
+	if x.Sign() < 0 {
+		h.Neg(h)
+	}
+	n, _ := new(big.Float).Add(x, h).Int64()
+	return n
+}
+
+// DecodeRotations returns the unit quaternions compressed by a RotationCodec
+// in data, and the codec. If data is damaged, then it returns
+// ErrCorruptRotations.
+func DecodeRotations(data []byte) ([]*Hamilton, RotationCodec, error) {
+	var c RotationCodec
+	var head [4]uint64
+	for i := range head {
+		v, n := binary.Uvarint(data)
+		if n <= 0 {
+			return nil, c, ErrCorruptRotations
+		}
+		head[i], data = v, data[n:]
+	}
+	c = RotationCodec{uint(head[0]), uint(head[1]), int(head[2])}
+	if c.Prec == 0 || c.Prec > big.MaxPrec || c.DeltaBits < 3 || c.DeltaBits > 62 || head[3] > uint64(len(data)) {
+		return nil, c, ErrCorruptRotations
+	}
+	q := make([]*Hamilton, head[3])
+	for k := range q {
+		z := new(Hamilton)
+		if c.key(k) {
+			a, b, e, f := z.Cartesian()
+			for _, v := range []*big.Float{a, b, e, f} {
+				size, n := binary.Uvarint(data)
+				if n <= 0 || size > uint64(len(data)-n) {
+					return nil, c, ErrCorruptRotations
+				}
+				if err := v.GobDecode(data[n : n+int(size)]); err != nil {
+					return nil, c, ErrCorruptRotations
+				}
+				data = data[n+int(size):]
+			}
+		} else {
+			var m [3]int64
+			for i := range m {
+				v, n := binary.Varint(data)
+				if n <= 0 {
+					return nil, c, ErrCorruptRotations
+				}
+				m[i], data = v, data[n:]
+			}
+			c.step(z, q[k-1], m)
+		}
+		q[k] = z
+	}
+	if len(data) != 0 {
+		return nil, c, ErrCorruptRotations
+	}
+	return q, c, nil
+}
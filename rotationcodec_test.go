@@ -0,0 +1,72 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// randomWalk returns n unit quaternions, each a small random rotation away
+// from the previous one, with prec bits.
+func randomWalk(n int, prec uint, seed int64) []*Hamilton {
+	r := rand.New(rand.NewSource(seed))
+	q := make([]*Hamilton, n)
+	q[0] = NewHamilton(big.NewFloat(1), new(big.Float), new(big.Float), new(big.Float))
+	q[0].l.l.SetPrec(prec)
+	for k := 1; k < n; k++ {
+		d := NewHamilton(
+			newFloat(prec),
+			newFloat(prec).SetFloat64(r.NormFloat64()/20),
+			newFloat(prec).SetFloat64(r.NormFloat64()/20),
+			newFloat(prec).SetFloat64(r.NormFloat64()/20),
+		)
+		d.Exp(d)
+		q[k] = new(Hamilton).Mul(q[k-1], d)
+		q[k].Versor(q[k])
+	}
+	return q
+}
+
+func TestRotationCodecRoundTrip(t *testing.T) {
+	q := randomWalk(500, 128, 1)
+	for _, c := range []RotationCodec{{128, 24, 64}, {128, 12, 0}, {100, 40, 1}} {
+		data := c.Encode(q)
+		got, d, err := DecodeRotations(data)
+		if err != nil {
+			t.Fatalf("%+v: DecodeRotations: %v", c, err)
+		}
+		if d != c {
+			t.Errorf("DecodeRotations codec = %+v, want %+v", d, c)
+		}
+		if len(got) != len(q) {
+			t.Fatalf("%+v: %d quaternions, want %d", c, len(got), len(q))
+		}
+		tol := c.Tolerance()
+		worst := new(big.Float)
+		for k := range q {
+			diff := new(Hamilton).Sub(got[k], q[k]).Abs()
+			if diff.Cmp(worst) > 0 {
+				worst = diff
+			}
+		}
+		if worst.Cmp(tol) > 0 {
+			t.Errorf("%+v: error %v exceeds tolerance %v", c, worst, tol)
+		}
+		// Full-precision storage takes four 128-bit components per quaternion.
+		if c.DeltaBits < 30 && len(data) > len(q)*4*16/4 {
+			t.Errorf("%+v: %d bytes for %d quaternions", c, len(data), len(q))
+		}
+	}
+}
+
+func TestDecodeRotationsCorrupt(t *testing.T) {
+	data := RotationCodec{64, 20, 8}.Encode(randomWalk(20, 64, 2))
+	for _, bad := range [][]byte{nil, data[:3], data[:len(data)-1], append(append([]byte(nil), data...), 0)} {
+		if _, _, err := DecodeRotations(bad); err != ErrCorruptRotations {
+			t.Errorf("DecodeRotations(%d bytes) error = %v", len(bad), err)
+		}
+	}
+}
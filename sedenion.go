@@ -0,0 +1,332 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strings"
+)
+
+var symbSedenion = [16]string{
+	"", "e1", "e2", "e3", "e4", "e5", "e6", "e7",
+	"e8", "e9", "e10", "e11", "e12", "e13", "e14", "e15",
+}
+
+// A Sedenion represents a multi-precision floating-point sedenion, the
+// Cayley-Dickson double of the Cayley octonions.
+type Sedenion struct {
+	l, r Cayley
+}
+
+// Real returns the real part of z.
+func (z *Sedenion) Real() *big.Float {
+	return (&z.l).Real()
+}
+
+// Cartesian returns the sixteen multi-precision floating-point Cartesian
+// components of z.
+func (z *Sedenion) Cartesian() [16]*big.Float {
+	var c [16]*big.Float
+	c[0], c[1], c[2], c[3], c[4], c[5], c[6], c[7] = z.l.Cartesian()
+	c[8], c[9], c[10], c[11], c[12], c[13], c[14], c[15] = z.r.Cartesian()
+	return c
+}
+
+// String returns the string representation of a Sedenion value.
+//
+// If z corresponds to a + b e1 + c e2 + ... + p e15, then the string is
+// "(a+be1+ce2+...+pe15)", similar to complex128 values.
+func (z *Sedenion) String() string {
+	v := z.Cartesian()
+	a := make([]string, 33)
+	a[0] = "("
+	a[1] = fmt.Sprintf("%v", v[0])
+	i := 1
+	for j := 2; j < 32; j = j + 2 {
+		if v[i].Sign() < 0 {
+			a[j] = fmt.Sprintf("%v", v[i])
+		} else {
+			a[j] = fmt.Sprintf("+%v", v[i])
+		}
+		a[j+1] = symbSedenion[i]
+		i++
+	}
+	a[32] = ")"
+	return strings.Join(a, "")
+}
+
+// Format implements fmt.Formatter, with the conventions of the Format method
+// of Complex.
+func (z *Sedenion) Format(s fmt.State, verb rune) {
+	c := z.Cartesian()
+	formatTerms(s, verb, z, symbSedenion[:], c[:])
+}
+
+// Equals returns true if y and z are equal.
+func (z *Sedenion) Equals(y *Sedenion) bool {
+	if !z.l.Equals(&y.l) || !z.r.Equals(&y.r) {
+		return false
+	}
+	return true
+}
+
+// Copy copies y onto z, and returns z.
+func (z *Sedenion) Copy(y *Sedenion) *Sedenion {
+	z.l.Copy(&y.l)
+	z.r.Copy(&y.r)
+	return z
+}
+
+// NewSedenion returns a pointer to the Sedenion value with Cartesian
+// components c, where c[0] is the real part and c[k] is the coefficient of
+// ek.
+func NewSedenion(c [16]*big.Float) *Sedenion {
+	z := new(Sedenion)
+	for k, v := range z.Cartesian() {
+		v.Copy(c[k])
+	}
+	return z
+}
+
+// Scal sets z equal to y scaled by a, and returns z.
+func (z *Sedenion) Scal(y *Sedenion, a *big.Float) *Sedenion {
+	z.l.Scal(&y.l, a)
+	z.r.Scal(&y.r, a)
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *Sedenion) Neg(y *Sedenion) *Sedenion {
+	z.l.Neg(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Conj sets z equal to the conjugate of y, and returns z.
+func (z *Sedenion) Conj(y *Sedenion) *Sedenion {
+	z.l.Conj(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Add sets z equal to x+y, and returns z.
+func (z *Sedenion) Add(x, y *Sedenion) *Sedenion {
+	z.l.Add(&x.l, &y.l)
+	z.r.Add(&x.r, &y.r)
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z.
+func (z *Sedenion) Sub(x, y *Sedenion) *Sedenion {
+	z.l.Sub(&x.l, &y.l)
+	z.r.Sub(&x.r, &y.r)
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z.
+//
+// With x = (a, b) and y = (c, d) as pairs of Cayley values, the product is
+// given by the Cayley-Dickson formula
+// 		(Mul(a, c) - Mul(Conj(d), b), Mul(d, a) + Mul(b, Conj(c)))
+// so that Mul(e8, e8) = -1 and Mul(ek, e8) = e(k+8) for k < 8.
+// This binary operation is noncommutative, nonassociative, and not even
+// alternative, but it is flexible and power-associative. It has zero
+// divisors; see IsZeroDiv.
+// Unless the components of x or y differ widely in scale, the product is
+// computed exactly and then rounded once, so the result is exact whenever it
+// fits the precision of z; otherwise it is computed with rounded arithmetic.
+func (z *Sedenion) Mul(x, y *Sedenion) *Sedenion {
+	c, d := x.Cartesian(), y.Cartesian()
+	prec := maxPrec(append(c[:], d[:]...)...)
+	zc := z.Cartesian()
+	if fitsExact(prec, zc[:], c[:], d[:]) {
+		p := new(Sedenion).mulExact(x, y)
+		z.l.roundCayley(&p.l, prec)
+		z.r.roundCayley(&p.r, prec)
+		return z
+	}
+	mulDoubling(-1, &z.l, &z.r, &x.l, &x.r, &y.l, &y.r)
+	return z
+}
+
+// Commutator sets z equal to the commutator of x and y:
+// 		Mul(x, y) - Mul(y, x)
+// Then it returns z.
+func (z *Sedenion) Commutator(x, y *Sedenion) *Sedenion {
+	return z.Sub(
+		new(Sedenion).Mul(x, y),
+		new(Sedenion).Mul(y, x),
+	)
+}
+
+// Associator sets z equal to the associator of w, x, and y:
+// 		Mul(Mul(w, x), y) - Mul(w, Mul(x, y))
+// Then it returns z.
+func (z *Sedenion) Associator(w, x, y *Sedenion) *Sedenion {
+	t := new(Sedenion).Mul(w, x)
+	t.Mul(t, y)
+	u := new(Sedenion).Mul(x, y)
+	u.Mul(w, u)
+	return z.Sub(t, u)
+}
+
+// Quad returns the quadrance of z, the sum of the squares of its sixteen
+// Cartesian components. This is always non-negative, but unlike for the
+// Cayley octonions it is not multiplicative.
+func (z *Sedenion) Quad() *big.Float {
+	return new(big.Float).Add(
+		z.l.Quad(),
+		z.r.Quad(),
+	)
+}
+
+// Inv sets z equal to the inverse of y, and returns z. The inverse is
+// Conj(y)/Quad(y), which satisfies Mul(y, z) = Mul(z, y) = 1 even when y is a
+// zero divisor, though then it cannot be used to solve Mul(y, w) = x. If y is
+// zero, then Inv panics.
+func (z *Sedenion) Inv(y *Sedenion) *Sedenion {
+	if zero := new(Sedenion); y.Equals(zero) {
+		panic("inverse of zero")
+	}
+	quad := y.Quad()
+	z.Conj(y)
+	z.l.quoQuad(&z.l, quad)
+	z.r.quoQuad(&z.r, quad)
+	return z
+}
+
+// PowInt sets z equal to y raised to the integer power n, and returns z. Mul
+// is power-associative, and the result is computed as for the Cayley
+// octonions, from the real part and the quadrance of the imaginary part of y,
+// so it does not depend on how the product is parenthesized. The result is
+// rounded to the precision of y. If n is negative and y is zero, then PowInt
+// panics.
+func (z *Sedenion) PowInt(y *Sedenion, n int) *Sedenion {
+	c := y.Cartesian()
+	prec := maxPrec(c[:]...)
+	alpha, beta := powQuadratic(c[0], c[1:], n, prec)
+	d := z.Cartesian()
+	for k := 1; k < 16; k++ {
+		d[k].SetPrec(prec).Mul(beta, c[k])
+	}
+	d[0].SetPrec(prec).Set(alpha)
+	return z
+}
+
+// leftMatrix returns the exact rational matrix of left multiplication by z,
+// whose column k holds the components of Mul(z, ek).
+func (z *Sedenion) leftMatrix() [][]*big.Rat {
+	m := make([][]*big.Rat, 16)
+	for i := range m {
+		m[i] = make([]*big.Rat, 16)
+	}
+	for k := 0; k < 16; k++ {
+		e := new(Sedenion)
+		e.Cartesian()[k].SetInt64(1)
+		// The components of Mul(z, ek) are the components of z, up to sign
+		// and order.
+		p := new(Sedenion).mulExact(z, e)
+		for i, v := range p.Cartesian() {
+			m[i][k], _ = v.Rat(nil)
+		}
+	}
+	return m
+}
+
+// ratNullVector returns a non-zero vector v with Mv = 0 for the square
+// rational matrix m, or nil if m is invertible. It uses Gauss–Jordan
+// elimination in exact rational arithmetic, and modifies m.
+func ratNullVector(m [][]*big.Rat) []*big.Rat {
+	n := len(m)
+	pivot := make([]int, 0, n)
+	free := -1
+	row := 0
+	for col := 0; col < n; col++ {
+		p := -1
+		for i := row; i < n; i++ {
+			if m[i][col].Sign() != 0 {
+				p = i
+				break
+			}
+		}
+		if p < 0 {
+			if free < 0 {
+				free = col
+			}
+			continue
+		}
+		m[row], m[p] = m[p], m[row]
+		inv := new(big.Rat).Inv(m[row][col])
+		for j := col; j < n; j++ {
+			m[row][j].Mul(m[row][j], inv)
+		}
+		for i := 0; i < n; i++ {
+			if i == row || m[i][col].Sign() == 0 {
+				continue
+			}
+			f := new(big.Rat).Set(m[i][col])
+			for j := col; j < n; j++ {
+				m[i][j].Sub(m[i][j], new(big.Rat).Mul(f, m[row][j]))
+			}
+		}
+		pivot = append(pivot, col)
+		row++
+	}
+	if free < 0 {
+		return nil
+	}
+	// Set the first free variable to 1, the others to 0, and solve for the
+	// pivot variables.
+	v := make([]*big.Rat, n)
+	for j := range v {
+		v[j] = new(big.Rat)
+	}
+	v[free].SetInt64(1)
+	for i, col := range pivot {
+		v[col].Neg(m[i][free])
+	}
+	return v
+}
+
+// IsZeroDiv returns true if z is a left zero divisor, that is, if there is a
+// non-zero Sedenion value w with Mul(z, w) = 0. Since the components of z are
+// dyadic rationals, this is decided exactly, from the rank of the matrix of
+// left multiplication by z in rational arithmetic. Zero is a zero divisor.
+func (z *Sedenion) IsZeroDiv() bool {
+	return ratNullVector(z.leftMatrix()) != nil
+}
+
+// ZeroDivPartner sets z equal to a non-zero Sedenion value with
+// Mul(y, z) = 0, scaled to unit quadrance and rounded to prec bits, and returns
+// z and true. If y is not a zero divisor, then it leaves z unchanged and
+// returns false. The partner is found exactly, so the only error in the
+// product comes from the final rounding.
+func (z *Sedenion) ZeroDivPartner(y *Sedenion, prec uint) (*Sedenion, bool) {
+	v := ratNullVector(y.leftMatrix())
+	if v == nil {
+		return z, false
+	}
+	w := prec + guardBits
+	c := make([]*big.Float, 16)
+	for k := range c {
+		c[k] = newFloat(w).SetRat(v[k])
+	}
+	abs := bigHypot(w, c...)
+	for k, u := range z.Cartesian() {
+		u.SetPrec(prec).Quo(c[k], abs)
+	}
+	return z, true
+}
+
+// Generate returns a random Sedenion value for quick.Check testing.
+func (z *Sedenion) Generate(rand *rand.Rand, size int) reflect.Value {
+	randomSedenion := new(Sedenion)
+	for _, v := range randomSedenion.Cartesian() {
+		v.SetFloat64(rand.Float64())
+	}
+	return reflect.ValueOf(randomSedenion)
+}
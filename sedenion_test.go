@@ -0,0 +1,154 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+// sedenionBasis returns the basis element ek, with 0 for 1.
+func sedenionBasis(k int) *Sedenion {
+	z := new(Sedenion)
+	z.Cartesian()[k].SetInt64(1)
+	return z
+}
+
+// closeToSedenion returns true if the components of x and y agree to about
+// bits bits, relative to the larger of their modulus and 1.
+func closeToSedenion(x, y *Sedenion, bits int) bool {
+	return closeToCayley(&x.l, &y.l, bits) && closeToCayley(&x.r, &y.r, bits)
+}
+
+func TestSedenionBasis(t *testing.T) {
+	minusOne := new(Sedenion).Neg(sedenionBasis(0))
+	for k := 1; k < 16; k++ {
+		if got := new(Sedenion).Mul(sedenionBasis(k), sedenionBasis(k)); !got.Equals(minusOne) {
+			t.Errorf("Mul(%s, %s) = %v", symbSedenion[k], symbSedenion[k], got)
+		}
+	}
+	for k := 1; k < 8; k++ {
+		if got := new(Sedenion).Mul(sedenionBasis(k), sedenionBasis(8)); !got.Equals(sedenionBasis(k + 8)) {
+			t.Errorf("Mul(%s, e8) = %v", symbSedenion[k], got)
+		}
+	}
+}
+
+func TestSedenionZeroDivisor(t *testing.T) {
+	x := new(Sedenion).Add(sedenionBasis(1), sedenionBasis(10))
+	y := new(Sedenion).Add(sedenionBasis(7), sedenionBasis(12))
+	if p := new(Sedenion).Mul(x, y); !p.Equals(new(Sedenion)) {
+		t.Errorf("Mul(%v, %v) = %v, want 0", x, y, p)
+	}
+	if !x.IsZeroDiv() {
+		t.Errorf("IsZeroDiv(%v) = false", x)
+	}
+	// The product of zero divisors has quadrance 0, not 4.
+	if q := new(Sedenion).Mul(x, y).Quad(); q.Sign() != 0 {
+		t.Errorf("Quad(Mul(%v, %v)) = %v", x, y, q)
+	}
+	z, ok := new(Sedenion).ZeroDivPartner(x, 100)
+	if !ok {
+		t.Fatalf("ZeroDivPartner(%v) failed", x)
+	}
+	if !closeTo(z.Quad(), big.NewFloat(1), 90) {
+		t.Errorf("Quad(%v) = %v, want 1", z, z.Quad())
+	}
+	if p := new(Sedenion).Mul(x, z); !closeToSedenion(p, new(Sedenion), 90) {
+		t.Errorf("Mul(%v, %v) = %v, want 0", x, z, p)
+	}
+	if !new(Sedenion).IsZeroDiv() {
+		t.Error("IsZeroDiv(0) = false")
+	}
+	if x := sedenionBasis(3); x.IsZeroDiv() {
+		t.Errorf("IsZeroDiv(%v) = true", x)
+	}
+}
+
+func TestSedenionNotZeroDivisor(t *testing.T) {
+	f := func(x *Sedenion) bool {
+		// t.Logf("x = %v", x)
+		_, ok := new(Sedenion).ZeroDivPartner(x, 53)
+		return !x.IsZeroDiv() && !ok
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 10}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSedenionMulNonAlternative(t *testing.T) {
+	f := func(x, y *Sedenion) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(Sedenion).Associator(x, x, y)
+		return !closeToSedenion(l, new(Sedenion), 20)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSedenionMulFlexible(t *testing.T) {
+	f := func(x, y *Sedenion) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(Sedenion).Associator(x, y, x)
+		return closeToSedenion(l, new(Sedenion), 45)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSedenionMulConjAntiDistributive(t *testing.T) {
+	f := func(x, y *Sedenion) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(Sedenion).Conj(new(Sedenion).Mul(x, y))
+		r := new(Sedenion).Mul(new(Sedenion).Conj(y), new(Sedenion).Conj(x))
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSedenionInv(t *testing.T) {
+	f := func(x *Sedenion) bool {
+		// t.Logf("x = %v", x)
+		inv := new(Sedenion).Inv(x)
+		l := new(Sedenion).Mul(x, inv)
+		r := new(Sedenion).Mul(inv, x)
+		return closeToSedenion(l, sedenionBasis(0), 45) && closeToSedenion(r, sedenionBasis(0), 45)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSedenionPowInt(t *testing.T) {
+	f := func(x *Sedenion) bool {
+		// t.Logf("x = %v", x)
+		l, r := new(Sedenion).Copy(x), new(Sedenion).Copy(x)
+		for k := 1; k < 4; k++ {
+			l.Mul(l, x)
+			r.Mul(x, r)
+		}
+		p := new(Sedenion).PowInt(x, 4)
+		inv := new(Sedenion).Mul(new(Sedenion).PowInt(x, -2), new(Sedenion).PowInt(x, 2))
+		return closeToSedenion(p, l, 45) && closeToSedenion(p, r, 45) &&
+			closeToSedenion(inv, sedenionBasis(0), 45)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSedenionCommutatorAlias(t *testing.T) {
+	f := func(x, y *Sedenion) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		return commutatorAliased(x, y)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+	"sync"
+)
+
+// A Shadow is a big.Float value paired with a float64 shadow, computed by the
+// same operations in hardware arithmetic.
+type Shadow struct {
+	Big  *big.Float
+	Fast float64
+}
+
+// relErr returns the relative difference between the shadow and the big.Float
+// value of x, or the absolute difference if the value is zero. It is +Inf if
+// the shadow is NaN, or if the shadow or the value is infinite and the other
+// is not the same infinity.
+func (x *Shadow) relErr() float64 {
+	switch {
+	case math.IsNaN(x.Fast):
+		return math.Inf(1)
+	case x.Big.IsInf():
+		if math.IsInf(x.Fast, x.Big.Sign()) {
+			return 0
+		}
+		return math.Inf(1)
+	case math.IsInf(x.Fast, 0):
+		return math.Inf(1)
+	}
+	v, _ := x.Big.Float64()
+	d := math.Abs(x.Fast - v)
+	if v == 0 {
+		return d
+	}
+	return d / math.Abs(v)
+}
+
+// A Divergence records an operation whose float64 shadow differs from its
+// big.Float result by more than the threshold of a ShadowField, while its
+// operands did not.
+type Divergence struct {
+	// Step is the number of operations before this one.
+	Step int
+	// Op is the name of the Field method, such as "Sub".
+	Op string
+	// Label is the most recent label given to Mark, if any.
+	Label string
+	// Big and Fast are the results of the operation.
+	Big  *big.Float
+	Fast float64
+	// RelErr is the relative difference between Fast and Big, or +Inf if
+	// Fast is NaN or an infinity that Big is not.
+	RelErr float64
+}
+
+// A ShadowField is a Field whose scalars carry a float64 shadow along with the
+// big.Float value. Since float64 arithmetic is cheap, running the same
+// computation in both is a quick way to locate the steps of a long pipeline
+// where the precision is critical: each operation whose shadow diverges from
+// the big.Float result by more than Threshold, while its operands agree, is
+// recorded as a Divergence. A ShadowField is safe for concurrent use.
+type ShadowField struct {
+	big       BigFloatField
+	threshold float64
+
+	mu    sync.Mutex
+	step  int
+	label string
+	log   []Divergence
+}
+
+// NewShadowField returns a ShadowField whose big.Float results are rounded to
+// prec bits, or to 64 bits if prec is zero, and which records the operations
+// that raise the relative difference of the shadow above threshold.
+func NewShadowField(prec uint, threshold float64) *ShadowField {
+	return &ShadowField{big: BigFloatField{Prec: prec}, threshold: threshold}
+}
+
+// Mark labels the operations that follow, until the next call to Mark, so that
+// divergences can be traced back to a stage of the computation.
+func (f *ShadowField) Mark(label string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.label = label
+}
+
+// Divergences returns the divergences recorded so far, in the order of the
+// operations.
+func (f *ShadowField) Divergences() []Divergence {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]Divergence(nil), f.log...)
+}
+
+// Reset clears the recorded divergences, the step count, and the label.
+func (f *ShadowField) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.step = 0
+	f.label = ""
+	f.log = nil
+}
+
+// record counts the operation op that produced z from the operands x, and
+// logs it if it is where the shadow first diverges. Then it returns z.
+func (f *ShadowField) record(op string, z *Shadow, x ...*Shadow) *Shadow {
+	e := z.relErr()
+	diverged := e > f.threshold
+	for _, v := range x {
+		if v.relErr() > f.threshold {
+			diverged = false
+		}
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if diverged {
+		f.log = append(f.log, Divergence{f.step, op, f.label, z.Big, z.Fast, e})
+	}
+	f.step++
+	return z
+}
+
+// FromFloat returns x, rounded to the precision of f, with its float64 value
+// as the shadow.
+func (f *ShadowField) FromFloat(x *big.Float) *Shadow {
+	v, _ := x.Float64()
+	return &Shadow{newFloat(f.big.prec()).Set(x), v}
+}
+
+// FromInt64 returns n as a scalar.
+func (f *ShadowField) FromInt64(n int64) *Shadow {
+	return f.record("FromInt64", &Shadow{f.big.FromInt64(n), float64(n)})
+}
+
+// Add returns x+y.
+func (f *ShadowField) Add(x, y *Shadow) *Shadow {
+	return f.record("Add", &Shadow{f.big.Add(x.Big, y.Big), x.Fast + y.Fast}, x, y)
+}
+
+// Sub returns x-y.
+func (f *ShadowField) Sub(x, y *Shadow) *Shadow {
+	return f.record("Sub", &Shadow{f.big.Sub(x.Big, y.Big), x.Fast - y.Fast}, x, y)
+}
+
+// Mul returns x*y.
+func (f *ShadowField) Mul(x, y *Shadow) *Shadow {
+	return f.record("Mul", &Shadow{f.big.Mul(x.Big, y.Big), x.Fast * y.Fast}, x, y)
+}
+
+// Quo returns x/y.
+func (f *ShadowField) Quo(x, y *Shadow) *Shadow {
+	return f.record("Quo", &Shadow{f.big.Quo(x.Big, y.Big), x.Fast / y.Fast}, x, y)
+}
+
+// Neg returns -x.
+func (f *ShadowField) Neg(x *Shadow) *Shadow {
+	return f.record("Neg", &Shadow{f.big.Neg(x.Big), -x.Fast}, x)
+}
+
+// Sign returns the sign of the big.Float value of x.
+func (f *ShadowField) Sign(x *Shadow) int {
+	return x.Big.Sign()
+}
+
+// Float64 returns the float64 value nearest to the big.Float value of x.
+func (f *ShadowField) Float64(x *Shadow) float64 {
+	return f.big.Float64(x.Big)
+}
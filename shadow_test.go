@@ -0,0 +1,92 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestShadowCancellation(t *testing.T) {
+	f := NewShadowField(200, 1e-8)
+	f.Mark("setup")
+	big1 := f.FromFloat(big.NewFloat(1e16))
+	one := f.FromInt64(1)
+	f.Mark("cancel")
+	// 1e16 + 1 is not a float64 value, so the shadow loses the 1, and the
+	// subtraction leaves nothing of it.
+	x := f.Sub(f.Add(big1, one), big1)
+	if got := f.Float64(x); got != 1 {
+		t.Errorf("big.Float result = %v, want 1", got)
+	}
+	if x.Fast != 0 {
+		t.Errorf("shadow = %v, want 0", x.Fast)
+	}
+	d := f.Divergences()
+	if len(d) != 1 {
+		t.Fatalf("Divergences() = %v, want one", d)
+	}
+	if d[0].Step != 2 || d[0].Op != "Sub" || d[0].Label != "cancel" || d[0].RelErr != 1 {
+		t.Errorf("Divergences()[0] = %+v", d[0])
+	}
+	// Operations on the diverged value are not reported again.
+	f.Mul(x, x)
+	if len(f.Divergences()) != 1 {
+		t.Errorf("Divergences() = %v, want one", f.Divergences())
+	}
+	f.Reset()
+	if len(f.Divergences()) != 0 {
+		t.Errorf("Divergences() after Reset = %v", f.Divergences())
+	}
+}
+
+func TestShadowOverflow(t *testing.T) {
+	f := NewShadowField(100, 1e-8)
+	x := f.FromFloat(big.NewFloat(1e200))
+	// The square overflows float64, but not big.Float.
+	y := f.Mul(x, x)
+	d := f.Divergences()
+	if len(d) != 1 || d[0].Op != "Mul" || !math.IsInf(d[0].RelErr, 1) {
+		t.Fatalf("Divergences() = %+v, want an infinite Mul", d)
+	}
+	// Inf - Inf is NaN, but the operands have diverged already.
+	f.Sub(y, y)
+	if len(f.Divergences()) != 1 {
+		t.Errorf("Divergences() = %+v, want one", f.Divergences())
+	}
+	if e := (&Shadow{big.NewFloat(1), math.NaN()}).relErr(); !math.IsInf(e, 1) {
+		t.Errorf("relErr of a NaN shadow = %v, want +Inf", e)
+	}
+	inf := new(big.Float).SetInf(false)
+	if e := (&Shadow{inf, math.Inf(1)}).relErr(); e != 0 {
+		t.Errorf("relErr of matching infinities = %v, want 0", e)
+	}
+	if e := (&Shadow{inf, math.Inf(-1)}).relErr(); !math.IsInf(e, 1) {
+		t.Errorf("relErr of opposite infinities = %v, want +Inf", e)
+	}
+}
+
+func TestShadowCayleyDickson(t *testing.T) {
+	f := NewShadowField(100, 1e-12)
+	alg := NewCayleyDickson[*Shadow](f, -1, -1)
+	x, y := alg.Zero(), alg.Zero()
+	for k := range x {
+		x[k] = f.FromFloat(big.NewFloat(float64(k+1) / 8))
+		y[k] = f.FromFloat(big.NewFloat(float64(3-k) / 4))
+	}
+	a, b, c, d := new(Hamilton).Mul(
+		NewHamilton(x[0].Big, x[1].Big, x[2].Big, x[3].Big),
+		NewHamilton(y[0].Big, y[1].Big, y[2].Big, y[3].Big),
+	).Cartesian()
+	for k, want := range []*big.Float{a, b, c, d} {
+		got := alg.Mul(x, y)[k]
+		if got.Big.Cmp(want) != 0 {
+			t.Errorf("Mul(x, y)[%d] = %v, want %v", k, got.Big, want)
+		}
+	}
+	if d := f.Divergences(); len(d) != 0 {
+		t.Errorf("Divergences() = %v, want none", d)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+// Sin sets z equal to the sine of y, and returns z.
+//
+// If y = a+bi, then the sine is
+// 		sin(a)cosh(b) + cos(a)sinh(b)i
+// The result is rounded to the precision of y.
+func (z *Complex) Sin(y *Complex) *Complex {
+	prec := maxPrec(&y.l, &y.r)
+	s, c := bigSinCos(&y.l, prec)
+	sh, ch := bigSinhCosh(&y.r, prec)
+	z.l.SetPrec(prec)
+	z.r.SetPrec(prec)
+	prod(&z.l, s, ch)
+	prod(&z.r, c, sh)
+	return z
+}
+
+// Cos sets z equal to the cosine of y, and returns z.
+//
+// If y = a+bi, then the cosine is
+// 		cos(a)cosh(b) - sin(a)sinh(b)i
+// The result is rounded to the precision of y.
+func (z *Complex) Cos(y *Complex) *Complex {
+	prec := maxPrec(&y.l, &y.r)
+	s, c := bigSinCos(&y.l, prec)
+	sh, ch := bigSinhCosh(&y.r, prec)
+	z.l.SetPrec(prec)
+	z.r.SetPrec(prec)
+	prod(&z.l, c, ch)
+	prod(&z.r, s, sh)
+	z.r.Neg(&z.r)
+	return z
+}
+
+// Sinh sets z equal to the hyperbolic sine of y, and returns z.
+//
+// If y = a+bi, then the hyperbolic sine is
+// 		sinh(a)cos(b) + cosh(a)sin(b)i
+// The result is rounded to the precision of y.
+func (z *Complex) Sinh(y *Complex) *Complex {
+	prec := maxPrec(&y.l, &y.r)
+	s, c := bigSinCos(&y.r, prec)
+	sh, ch := bigSinhCosh(&y.l, prec)
+	z.l.SetPrec(prec)
+	z.r.SetPrec(prec)
+	prod(&z.l, sh, c)
+	prod(&z.r, ch, s)
+	return z
+}
+
+// Cosh sets z equal to the hyperbolic cosine of y, and returns z.
+//
+// If y = a+bi, then the hyperbolic cosine is
+// 		cosh(a)cos(b) + sinh(a)sin(b)i
+// The result is rounded to the precision of y.
+func (z *Complex) Cosh(y *Complex) *Complex {
+	prec := maxPrec(&y.l, &y.r)
+	s, c := bigSinCos(&y.r, prec)
+	sh, ch := bigSinhCosh(&y.l, prec)
+	z.l.SetPrec(prec)
+	z.r.SetPrec(prec)
+	prod(&z.l, ch, c)
+	prod(&z.r, sh, s)
+	return z
+}
+
+// Sin sets z equal to the sine of y, and returns z. The components follow the
+// recurrence of jetSinCos. The result is rounded to the precision of y.
+func (z *Jet) Sin(y *Jet) *Jet {
+	prec := maxPrec(y.Cartesian()...)
+	sin, _ := jetSinCos(y, prec+guardBits)
+	return z.setJet(sin, prec)
+}
+
+// Cos sets z equal to the cosine of y, and returns z. The components follow
+// the recurrence of jetSinCos. The result is rounded to the precision of y.
+func (z *Jet) Cos(y *Jet) *Jet {
+	prec := maxPrec(y.Cartesian()...)
+	_, cos := jetSinCos(y, prec+guardBits)
+	return z.setJet(cos, prec)
+}
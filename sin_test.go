@@ -0,0 +1,102 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"math/cmplx"
+	"testing"
+	"testing/quick"
+)
+
+// closeToComplex128 returns true if z agrees with c to within a relative error
+// of 2**(-bits).
+func closeToComplex128(z *Complex, c complex128, bits int) bool {
+	d := new(Complex).Sub(z, NewComplex(big.NewFloat(real(c)), big.NewFloat(imag(c))))
+	if d.Quad().Sign() == 0 {
+		return true
+	}
+	m := big.NewFloat(cmplx.Abs(c))
+	if m.Sign() == 0 {
+		m.SetInt64(1)
+	}
+	q := d.Quad()
+	return q.MantExp(nil) <= 2*(m.MantExp(nil)-bits)+2
+}
+
+func TestComplexSinFloat64(t *testing.T) {
+	f := func(x *Complex) bool {
+		a, _ := x.l.Float64()
+		b, _ := x.r.Float64()
+		return closeToComplex128(new(Complex).Sin(x), cmplx.Sin(complex(a, b)), 48)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexCosFloat64(t *testing.T) {
+	f := func(x *Complex) bool {
+		a, _ := x.l.Float64()
+		b, _ := x.r.Float64()
+		return closeToComplex128(new(Complex).Cos(x), cmplx.Cos(complex(a, b)), 48)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexSinCosPythagorean(t *testing.T) {
+	f := func(x *Complex) bool {
+		y := new(Complex)
+		y.l.SetPrec(200).Set(&x.l)
+		y.r.SetPrec(200).Set(&x.r)
+		s := new(Complex).Sin(y)
+		c := new(Complex).Cos(y)
+		s.Mul(s, s)
+		c.Mul(c, c)
+		s.Add(s, c)
+		return closeTo(&s.l, big.NewFloat(1), 190) && (s.r.Sign() == 0 || expo(&s.r) < -190)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexSinhFloat64(t *testing.T) {
+	f := func(x *Complex) bool {
+		a, _ := x.l.Float64()
+		b, _ := x.r.Float64()
+		return closeToComplex128(new(Complex).Sinh(x), cmplx.Sinh(complex(a, b)), 48)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexCoshFloat64(t *testing.T) {
+	f := func(x *Complex) bool {
+		a, _ := x.l.Float64()
+		b, _ := x.r.Float64()
+		return closeToComplex128(new(Complex).Cosh(x), cmplx.Cosh(complex(a, b)), 48)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexCoshSinhHyperbolic(t *testing.T) {
+	f := func(x *Complex) bool {
+		y := setPrecComplex(x, 200)
+		s := new(Complex).Sinh(y)
+		c := new(Complex).Cosh(y)
+		s.Mul(s, s)
+		c.Mul(c, c)
+		c.Sub(c, s)
+		return closeTo(&c.l, big.NewFloat(1), 190) && (c.r.Sign() == 0 || expo(&c.r) < -190)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,312 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"math/rand"
+	"reflect"
+)
+
+// A MöbiusComplex is the coefficient matrix
+// 		[a  b]
+// 		[c  d]
+// of the Möbius transform (a*y + b) * Inv(c*y + d) of Complex values. The
+// transform is unchanged when the matrix is scaled, so the matrix can be
+// normalized to determinant one, that is, to an element of SL(2, C).
+type MöbiusComplex struct {
+	a, b, c, d Complex
+}
+
+// NewMöbiusComplex returns a pointer to the MöbiusComplex value with the
+// coefficients a, b, c, and d.
+func NewMöbiusComplex(a, b, c, d *Complex) *MöbiusComplex {
+	z := new(MöbiusComplex)
+	z.a.Copy(a)
+	z.b.Copy(b)
+	z.c.Copy(c)
+	z.d.Copy(d)
+	return z
+}
+
+// Coefficients returns copies of the coefficients a, b, c, and d of z.
+func (z *MöbiusComplex) Coefficients() (a, b, c, d *Complex) {
+	return new(Complex).Copy(&z.a), new(Complex).Copy(&z.b),
+		new(Complex).Copy(&z.c), new(Complex).Copy(&z.d)
+}
+
+// Copy copies y onto z, and returns z.
+func (z *MöbiusComplex) Copy(y *MöbiusComplex) *MöbiusComplex {
+	z.a.Copy(&y.a)
+	z.b.Copy(&y.b)
+	z.c.Copy(&y.c)
+	z.d.Copy(&y.d)
+	return z
+}
+
+// prec returns the largest precision of the coefficients of z.
+func (z *MöbiusComplex) prec() uint {
+	return maxPrec(&z.a.l, &z.a.r, &z.b.l, &z.b.r, &z.c.l, &z.c.r, &z.d.l, &z.d.r)
+}
+
+// det returns the determinant of z without rounding.
+func (z *MöbiusComplex) det() *Complex {
+	return new(Complex).subExact(
+		new(Complex).mulExact(&z.a, &z.d),
+		new(Complex).mulExact(&z.b, &z.c),
+	)
+}
+
+// Det returns the determinant ad - bc of z, rounded to the precision of z.
+func (z *MöbiusComplex) Det() *Complex {
+	return new(Complex).round(z.det(), z.prec())
+}
+
+// Drift returns |ad - bc - 1|, the distance of z from SL(2, C), rounded to the
+// precision of z. The determinant is computed without rounding, so the drift
+// measures the rounding errors accumulated in the coefficients, and not those
+// of the test itself.
+func (z *MöbiusComplex) Drift() *big.Float {
+	d := z.det()
+	d.l.Copy(exactSub(&d.l, big.NewFloat(1)))
+	return bigHypot(z.prec(), &d.l, &d.r)
+}
+
+// Compose sets z equal to the composition of x after y, which is the matrix
+// product xy, rounded to the larger of the precisions of x and y. Then it
+// returns z.
+func (z *MöbiusComplex) Compose(x, y *MöbiusComplex) *MöbiusComplex {
+	prec := x.prec()
+	if p := y.prec(); p > prec {
+		prec = p
+	}
+	entry := func(p, q, r, s *Complex) *Complex {
+		u := new(Complex).addExact(
+			new(Complex).mulExact(p, q),
+			new(Complex).mulExact(r, s),
+		)
+		return u.round(u, prec)
+	}
+	a := entry(&x.a, &y.a, &x.b, &y.c)
+	b := entry(&x.a, &y.b, &x.b, &y.d)
+	c := entry(&x.c, &y.a, &x.d, &y.c)
+	d := entry(&x.c, &y.b, &x.d, &y.d)
+	z.a, z.b, z.c, z.d = *a, *b, *c, *d
+	return z
+}
+
+// Normalize sets z equal to y divided by the principal square root of its
+// determinant, so that ad - bc = 1 up to one rounding of each coefficient.
+// Then it returns z. The root is taken with guardBits extra bits, and the
+// coefficients are rounded to the precision of y. If y is singular, then
+// Normalize panics.
+func (z *MöbiusComplex) Normalize(y *MöbiusComplex) *MöbiusComplex {
+	det := y.det()
+	if zero := new(Complex); det.Equals(zero) {
+		panic("singular Möbius transform")
+	}
+	prec := y.prec()
+	root := new(Complex).round(det, prec+guardBits)
+	root.Sqrt(new(Complex).Copy(root))
+	inv := new(Complex).Inv(root)
+	scale := func(p *Complex) Complex {
+		u := new(Complex).mulExact(p, inv)
+		return *u.round(u, prec)
+	}
+	z.a, z.b, z.c, z.d = scale(&y.a), scale(&y.b), scale(&y.c), scale(&y.d)
+	return z
+}
+
+// Apply returns the Möbius transform of y by z, a pointer to a Complex
+// value. If the denominator has no inverse, then Apply panics.
+func (z *MöbiusComplex) Apply(y *Complex) *Complex {
+	return new(Complex).Möbius(y, &z.a, &z.b, &z.c, &z.d)
+}
+
+// ComposeMöbiusComplex returns the composition m[0] after m[1] after ... of
+// the transforms m, and the number of times that the running product was
+// normalized. The running product is normalized whenever its Drift exceeds
+// tol, so that long chains stay close to SL(2, C). If m is empty, then
+// ComposeMöbiusComplex panics.
+func ComposeMöbiusComplex(tol *big.Float, m ...*MöbiusComplex) (*MöbiusComplex, int) {
+	if len(m) == 0 {
+		panic("composition of no transforms")
+	}
+	z := new(MöbiusComplex).Copy(m[0])
+	n := 0
+	for _, v := range m[1:] {
+		if z.Compose(z, v); z.Drift().Cmp(tol) > 0 {
+			z.Normalize(z)
+			n++
+		}
+	}
+	return z, n
+}
+
+// Generate returns a random MöbiusComplex value for quick.Check testing.
+func (z *MöbiusComplex) Generate(rand *rand.Rand, size int) reflect.Value {
+	c := func() *Complex {
+		return new(Complex).Generate(rand, size).Interface().(*Complex)
+	}
+	return reflect.ValueOf(NewMöbiusComplex(c(), c(), c(), c()))
+}
+
+// A MöbiusHamilton is the coefficient matrix
+// 		[a  b]
+// 		[c  d]
+// of the right Möbius transform (a*y + b) * Inv(c*y + d) of Hamilton values.
+// Quaternion matrices have no determinant in the usual sense, but the
+// Dieudonné determinant, the non-negative square root of the Study
+// determinant
+// 		|a|²|d|² + |b|²|c|² - 2 Re(a*Conj(c)*d*Conj(b))
+// is multiplicative, and scales by t² when the matrix is scaled by a real t.
+// The matrix can therefore be normalized to Dieudonné determinant one, that
+// is, to an element of SL(2, H).
+type MöbiusHamilton struct {
+	a, b, c, d Hamilton
+}
+
+// NewMöbiusHamilton returns a pointer to the MöbiusHamilton value with the
+// coefficients a, b, c, and d.
+func NewMöbiusHamilton(a, b, c, d *Hamilton) *MöbiusHamilton {
+	z := new(MöbiusHamilton)
+	z.a.Copy(a)
+	z.b.Copy(b)
+	z.c.Copy(c)
+	z.d.Copy(d)
+	return z
+}
+
+// Coefficients returns copies of the coefficients a, b, c, and d of z.
+func (z *MöbiusHamilton) Coefficients() (a, b, c, d *Hamilton) {
+	return new(Hamilton).Copy(&z.a), new(Hamilton).Copy(&z.b),
+		new(Hamilton).Copy(&z.c), new(Hamilton).Copy(&z.d)
+}
+
+// Copy copies y onto z, and returns z.
+func (z *MöbiusHamilton) Copy(y *MöbiusHamilton) *MöbiusHamilton {
+	z.a.Copy(&y.a)
+	z.b.Copy(&y.b)
+	z.c.Copy(&y.c)
+	z.d.Copy(&y.d)
+	return z
+}
+
+// prec returns the largest precision of the coefficients of z.
+func (z *MöbiusHamilton) prec() uint {
+	var x []*big.Float
+	for _, v := range []*Hamilton{&z.a, &z.b, &z.c, &z.d} {
+		a, b, c, d := v.Cartesian()
+		x = append(x, a, b, c, d)
+	}
+	return maxPrec(x...)
+}
+
+// study returns the Study determinant of z without rounding.
+func (z *MöbiusHamilton) study() *big.Float {
+	quad := func(y *Hamilton) *big.Float {
+		return quadExact(y.Cartesian())
+	}
+	p := new(Hamilton).mulExact(&z.a, new(Hamilton).Conj(&z.c))
+	p.mulExact(p, &z.d)
+	p.mulExact(p, new(Hamilton).Conj(&z.b))
+	re := new(big.Float).SetMantExp(&p.l.l, 1)
+	sum := exactAdd(exactMul(quad(&z.a), quad(&z.d)), exactMul(quad(&z.b), quad(&z.c)))
+	return exactSub(sum, re)
+}
+
+// Det returns the Dieudonné determinant of z, rounded to the precision of z.
+func (z *MöbiusHamilton) Det() *big.Float {
+	prec := z.prec()
+	study := newFloat(prec + guardBits).Set(z.study())
+	return newFloat(prec).Sqrt(study)
+}
+
+// Drift returns the distance of the Dieudonné determinant of z from one,
+// rounded to the precision of z.
+func (z *MöbiusHamilton) Drift() *big.Float {
+	prec := z.prec()
+	w := prec + guardBits
+	det := newFloat(w).Sqrt(newFloat(w).Set(z.study()))
+	return newFloat(prec).Abs(det.Sub(det, big.NewFloat(1)))
+}
+
+// Compose sets z equal to the composition of x after y, which is the matrix
+// product xy, rounded to the larger of the precisions of x and y. Then it
+// returns z.
+func (z *MöbiusHamilton) Compose(x, y *MöbiusHamilton) *MöbiusHamilton {
+	prec := x.prec()
+	if p := y.prec(); p > prec {
+		prec = p
+	}
+	entry := func(p, q, r, s *Hamilton) Hamilton {
+		u := new(Hamilton).addExact(
+			new(Hamilton).mulExact(p, q),
+			new(Hamilton).mulExact(r, s),
+		)
+		return *new(Hamilton).setPrec(u, prec)
+	}
+	a := entry(&x.a, &y.a, &x.b, &y.c)
+	b := entry(&x.a, &y.b, &x.b, &y.d)
+	c := entry(&x.c, &y.a, &x.d, &y.c)
+	d := entry(&x.c, &y.b, &x.d, &y.d)
+	z.a, z.b, z.c, z.d = a, b, c, d
+	return z
+}
+
+// Normalize sets z equal to y divided by the square root of its Dieudonné
+// determinant, so that the determinant is one up to one rounding of each
+// coefficient. Then it returns z. The root is taken with guardBits extra bits,
+// and the coefficients are rounded to the precision of y. If y is singular,
+// then Normalize panics.
+func (z *MöbiusHamilton) Normalize(y *MöbiusHamilton) *MöbiusHamilton {
+	study := y.study()
+	if study.Sign() == 0 {
+		panic("singular Möbius transform")
+	}
+	prec := y.prec()
+	w := prec + guardBits
+	root := newFloat(w).Sqrt(newFloat(w).Set(study))
+	inv := newFloat(w).Quo(big.NewFloat(1), root.Sqrt(root))
+	scale := func(p *Hamilton) Hamilton {
+		u := new(Hamilton).setPrec(new(Hamilton), prec)
+		return *u.Scal(p, inv)
+	}
+	z.a, z.b, z.c, z.d = scale(&y.a), scale(&y.b), scale(&y.c), scale(&y.d)
+	return z
+}
+
+// Apply returns the right Möbius transform of y by z, a pointer to a Hamilton
+// value. If the denominator has no inverse, then Apply panics.
+func (z *MöbiusHamilton) Apply(y *Hamilton) *Hamilton {
+	return new(Hamilton).MöbiusR(y, &z.a, &z.b, &z.c, &z.d)
+}
+
+// ComposeMöbiusHamilton returns the composition m[0] after m[1] after ... of
+// the transforms m, and the number of times that the running product was
+// normalized. The running product is normalized whenever its Drift exceeds
+// tol, so that long chains stay close to SL(2, H). If m is empty, then
+// ComposeMöbiusHamilton panics.
+func ComposeMöbiusHamilton(tol *big.Float, m ...*MöbiusHamilton) (*MöbiusHamilton, int) {
+	if len(m) == 0 {
+		panic("composition of no transforms")
+	}
+	z := new(MöbiusHamilton).Copy(m[0])
+	n := 0
+	for _, v := range m[1:] {
+		if z.Compose(z, v); z.Drift().Cmp(tol) > 0 {
+			z.Normalize(z)
+			n++
+		}
+	}
+	return z, n
+}
+
+// Generate returns a random MöbiusHamilton value for quick.Check testing.
+func (z *MöbiusHamilton) Generate(rand *rand.Rand, size int) reflect.Value {
+	h := func() *Hamilton {
+		return new(Hamilton).Generate(rand, size).Interface().(*Hamilton)
+	}
+	return reflect.ValueOf(NewMöbiusHamilton(h(), h(), h(), h()))
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestMöbiusComplexNormalize(t *testing.T) {
+	f := func(a, b, c, d, y *Complex) bool {
+		// t.Logf("a = %v, b = %v, c = %v, d = %v, y = %v", a, b, c, d, y)
+		m := NewMöbiusComplex(a, b, c, d)
+		n := new(MöbiusComplex).Normalize(m)
+		// Rounding the coefficients moves ad - bc by a few ulps of |ad| + |bc|,
+		// which is large when the determinant of m is small.
+		tol := new(big.Float)
+		a, b, c, d = n.Coefficients()
+		for _, v := range []*Complex{a, b, c, d} {
+			tol.Add(tol, v.Quad())
+		}
+		tol.Mul(tol, big.NewFloat(0x1p-50))
+		return n.Drift().Cmp(tol) <= 0 &&
+			closeToComplex(n.Apply(y), m.Apply(y), 40)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMöbiusComplexCompose(t *testing.T) {
+	f := func(x, y *MöbiusComplex, v *Complex) bool {
+		// t.Logf("x = %v, y = %v, v = %v", x, y, v)
+		z := new(MöbiusComplex).Compose(x, y)
+		det := new(Complex).Mul(x.Det(), y.Det())
+		return closeToComplex(z.Apply(v), x.Apply(y.Apply(v)), 40) &&
+			closeToComplex(z.Det(), det, 45)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComposeMöbiusComplex(t *testing.T) {
+	// Rotations of the Riemann sphere keep the coefficients bounded, so the
+	// drift comes only from rounding.
+	m := make([]*MöbiusComplex, 2000)
+	for k := range m {
+		s, c := math.Sincos(float64(k) + 0.5)
+		m[k] = NewMöbiusComplex(
+			newComplex128(complex(c, 0)), newComplex128(complex(0, -s)),
+			newComplex128(complex(0, -s)), newComplex128(complex(c, 0)),
+		)
+	}
+	free, n := ComposeMöbiusComplex(new(big.Float).SetInf(false), m...)
+	if n != 0 {
+		t.Errorf("infinite tolerance normalized %d times", n)
+	}
+	tol := big.NewFloat(0x1p-50)
+	z, n := ComposeMöbiusComplex(tol, m...)
+	if free.Drift().Cmp(tol) <= 0 || n == 0 {
+		t.Fatalf("drift %v without normalization, %d normalizations", free.Drift(), n)
+	}
+	if z.Drift().Cmp(tol) > 0 {
+		t.Errorf("Drift() = %v, want at most %v", z.Drift(), tol)
+	}
+	y := newComplex128(0.25 + 0.5i)
+	if !closeToComplex(z.Apply(y), free.Apply(y), 30) {
+		t.Errorf("Apply = %v, want %v", z.Apply(y), free.Apply(y))
+	}
+}
+
+// dieudonné returns |a| |d - c*Inv(a)*b|, the Dieudonné determinant of m for
+// non-zero a.
+func dieudonné(m *MöbiusHamilton) *big.Float {
+	a, b, c, d := m.Coefficients()
+	s := new(Hamilton).Mul(c, new(Hamilton).Inv(a))
+	s.Sub(d, s.Mul(s, b))
+	return new(big.Float).Mul(a.Abs(), s.Abs())
+}
+
+func TestMöbiusHamiltonDet(t *testing.T) {
+	f := func(x, y *MöbiusHamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		z := new(MöbiusHamilton).Compose(x, y)
+		prod := new(big.Float).Mul(x.Det(), y.Det())
+		return closeTo(x.Det(), dieudonné(x), 45) && closeTo(z.Det(), prod, 45)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMöbiusHamiltonNormalize(t *testing.T) {
+	f := func(x *MöbiusHamilton, y *Hamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		n := new(MöbiusHamilton).Normalize(x)
+		return n.Drift().Cmp(big.NewFloat(0x1p-48)) <= 0 &&
+			closeToHamilton(n.Apply(y), x.Apply(y), 40)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	m := make([]*MöbiusHamilton, 500)
+	for k := range m {
+		s, c := math.Sincos(float64(k) + 0.5)
+		zero := new(big.Float)
+		m[k] = NewMöbiusHamilton(
+			NewHamilton(big.NewFloat(c), zero, zero, zero), NewHamilton(zero, zero, big.NewFloat(-s), zero),
+			NewHamilton(zero, zero, big.NewFloat(-s), zero), NewHamilton(big.NewFloat(c), zero, zero, zero),
+		)
+	}
+	tol := big.NewFloat(0x1p-50)
+	if z, _ := ComposeMöbiusHamilton(tol, m...); z.Drift().Cmp(tol) > 0 {
+		t.Errorf("Drift() = %v, want at most %v", z.Drift(), tol)
+	}
+}
+
+func TestMöbiusSingular(t *testing.T) {
+	zero, one := new(Complex), newComplex128(1)
+	defer func() {
+		if r := recover(); r != "singular Möbius transform" {
+			t.Errorf("recovered %v", r)
+		}
+	}()
+	new(MöbiusComplex).Normalize(NewMöbiusComplex(one, one, zero, zero))
+}
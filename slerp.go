@@ -0,0 +1,139 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math/big"
+
+// rotationPrec returns the precision of the result of an interpolation of
+// rotations of precision prec with the target angular error tol, in radians:
+// prec, or enough bits to resolve tol if that is more. If tol is not positive,
+// then rotationPrec panics.
+func rotationPrec(prec uint, tol *big.Float) uint {
+	if tol.Sign() <= 0 {
+		panic("non-positive angular error target")
+	}
+	if e := expo(tol); !tol.IsInf() && e < 0 {
+		// A unit quaternion rounded to p bits is off by an angle of at most
+		// about 2^(2-p) radians.
+		if need := uint(-e) + 4; need > prec {
+			prec = need
+		}
+	}
+	return prec
+}
+
+// unitAt returns y/|y| at w bits. If y is zero, then unitAt panics.
+func unitAt(y *Hamilton, w uint) *Hamilton {
+	z := new(Hamilton).setPrec(y, w)
+	z.Versor(z)
+	return z
+}
+
+// slerpUnit returns the spherical linear interpolation between the unit
+// quaternions x and y at the fraction s, computed at w bits. If short is true
+// and x and y lie in opposite hemispheres, then -y is used instead of y.
+//
+// The half angle between x and y is taken from their relative rotation
+// Mul(Conj(x), y) = a+v as atan2(|v|, a), which is accurate for every angle,
+// unlike acos of the dot product, which loses half of the digits near 0 and
+// near π. The result is
+// 		Mul(x, cos(sφ) + v sin(sφ)/|v|)
+// If v is zero, then x and y represent the same rotation, and the result is
+// x; for y = -x with short false, the path turns about the i axis.
+func slerpUnit(x, y *Hamilton, s *big.Float, short bool, w uint) *Hamilton {
+	if short && hamiltonDotSign(x, y) < 0 {
+		y = new(Hamilton).Neg(y)
+	}
+	r := new(Hamilton).Mul(new(Hamilton).Conj(x), y)
+	a, b, c, d := r.Cartesian()
+	sin := bigHypot(w, b, c, d)
+	if sin.Sign() == 0 {
+		if a.Sign() >= 0 {
+			return new(Hamilton).Copy(x)
+		}
+		b, sin = newFloat(w).SetInt64(1), newFloat(w).SetInt64(1)
+	}
+	phi := bigAtan2(sin, a, w)
+	sinPhi, cosPhi := bigSinCos(phi.Mul(phi, s), w)
+	p := new(Hamilton).setScaledVector(cosPhi, sinPhi.Quo(sinPhi, sin), b, c, d, w)
+	return p.Mul(x, p)
+}
+
+// Slerp sets z equal to the spherical linear interpolation between the
+// rotations x and y at the fraction s, and returns z. The rotations need not
+// be unit quaternions; they are normalized first. Of y and -y, which represent
+// the same rotation, the one in the hemisphere of x is used, so the path takes
+// the shorter way, and s = 0 gives x/|x| and s = 1 gives ±y/|y|. At an angle
+// of exactly π between the rotations both ways are equally short, and y is
+// used as given.
+//
+// The result is within the angular error tol, in radians, of the exact
+// interpolation of the given x and y, at every angle, including the tiny and
+// nearly antipodal ones. It is rounded to the largest precision of x, y, and
+// s, or to more bits if that is needed to resolve tol. If x or y is zero, or
+// tol is not positive, then Slerp panics.
+func (z *Hamilton) Slerp(x, y *Hamilton, s, tol *big.Float) *Hamilton {
+	a, b, c, d := x.Cartesian()
+	e, f, g, h := y.Cartesian()
+	prec := rotationPrec(maxPrec(a, b, c, d, e, f, g, h, s), tol)
+	w := prec + guardBits
+	p := slerpUnit(unitAt(x, w), unitAt(y, w), newFloat(w).Set(s), true, w)
+	return z.setPrec(p, prec)
+}
+
+// SquadControl returns the inner control point of the spherical cubic spline
+// through the rotations prev, q, and next at q,
+// 		Mul(q, Exp(-(Log(Mul(Inv(q), next)) + Log(Mul(Inv(q), prev)))/4))
+// which makes consecutive segments of Squad meet with a continuous angular
+// velocity. The rotations are normalized first, and they should lie in one
+// hemisphere, as UnflipHamilton arranges. The result is rounded to the
+// largest precision of the rotations, or to more bits if that is needed to
+// resolve tol. If a rotation is zero, or tol is not positive, then
+// SquadControl panics.
+func SquadControl(prev, q, next *Hamilton, tol *big.Float) *Hamilton {
+	var x []*big.Float
+	for _, v := range []*Hamilton{prev, q, next} {
+		a, b, c, d := v.Cartesian()
+		x = append(x, a, b, c, d)
+	}
+	prec := rotationPrec(maxPrec(x...), tol)
+	w := prec + guardBits
+	u := unitAt(q, w)
+	inv := new(Hamilton).Conj(u)
+	l := new(Hamilton).Log(new(Hamilton).Mul(inv, unitAt(next, w)))
+	l.Add(l, new(Hamilton).Log(new(Hamilton).Mul(inv, unitAt(prev, w))))
+	l.Scal(l, newFloat(w).SetFloat64(-0.25))
+	l.Exp(l)
+	return new(Hamilton).setPrec(l.Mul(u, l), prec)
+}
+
+// Squad sets z equal to the spherical cubic interpolation between the
+// rotations q0 and q1 at the fraction s, with the inner control points a0 and
+// a1 of SquadControl, and returns z:
+// 		Slerp(Slerp(q0, q1, s), Slerp(a0, a1, s), 2s(1-s))
+// The inner interpolations do not switch hemispheres, so the rotations and
+// control points should lie in one hemisphere, as UnflipHamilton arranges.
+// As with Slerp, the result is within the angular error tol of the exact
+// interpolation, and is rounded to the largest precision of the rotations,
+// the control points, and s, or to more bits if that is needed to resolve
+// tol. If a rotation or control point is zero, or tol is not positive, then
+// Squad panics.
+func (z *Hamilton) Squad(q0, a0, a1, q1 *Hamilton, s, tol *big.Float) *Hamilton {
+	x := []*big.Float{s}
+	for _, v := range []*Hamilton{q0, a0, a1, q1} {
+		a, b, c, d := v.Cartesian()
+		x = append(x, a, b, c, d)
+	}
+	prec := rotationPrec(maxPrec(x...), tol)
+	w := prec + guardBits
+	sw := newFloat(w).Set(s)
+	// Each of the three interpolations contributes its own rounding errors,
+	// which the guard bits absorb.
+	p := slerpUnit(unitAt(q0, w), unitAt(q1, w), sw, false, w)
+	r := slerpUnit(unitAt(a0, w), unitAt(a1, w), sw, false, w)
+	t := newFloat(w).Sub(big.NewFloat(1), sw)
+	t.Mul(t, sw)
+	t.SetMantExp(t, 1)
+	return z.setPrec(slerpUnit(p, r, t, false, w), prec)
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+)
+
+// rotationAngle returns the angle of the rotation that takes p to q, or zero
+// if they represent the same rotation.
+func rotationAngle(p, q *Hamilton) *big.Float {
+	if j := HamiltonJumps([]*Hamilton{p, q}, new(big.Float)); len(j) > 0 {
+		return j[0].Angle
+	}
+	return new(big.Float)
+}
+
+// axisRotation returns the rotation by angle about the axis (1, 2, 2), at the
+// precision of angle.
+func axisRotation(angle *big.Float) *Hamilton {
+	prec := angle.Prec()
+	return NewHamiltonFromAxisAngle([3]*big.Float{
+		newFloat(prec).SetInt64(1), newFloat(prec).SetInt64(2), newFloat(prec).SetInt64(2),
+	}, angle)
+}
+
+func TestSlerpAngles(t *testing.T) {
+	tol := big.NewFloat(0x1p-100)
+	one := axisRotation(newFloat(200))
+	pi := bigPi(200)
+	for _, theta := range []*big.Float{
+		// Tiny angles, where acos of the dot product loses every digit.
+		new(big.Float).SetPrec(200).SetFloat64(1e-30),
+		new(big.Float).SetPrec(200).SetFloat64(0.7),
+		// Nearly antipodal rotations.
+		new(big.Float).SetPrec(200).Sub(pi, big.NewFloat(1e-25)),
+	} {
+		y := axisRotation(theta)
+		for _, s := range []float64{0, 0.25, 0.5, 1} {
+			got := new(Hamilton).Slerp(one, y, big.NewFloat(s), tol)
+			want := axisRotation(new(big.Float).Mul(theta, new(big.Float).SetPrec(200).SetFloat64(s)))
+			if err := rotationAngle(got, want); err.Cmp(tol) > 0 {
+				t.Errorf("θ = %.3g, s = %v: angular error %v", theta, s, err)
+			}
+		}
+	}
+}
+
+func TestSlerpPrecision(t *testing.T) {
+	// The inputs have 53 bits, so the target forces a larger precision.
+	x := axisRotation(big.NewFloat(0.1))
+	y := axisRotation(big.NewFloat(2))
+	tol := big.NewFloat(0x1p-120)
+	got := new(Hamilton).Slerp(x, y, big.NewFloat(0.5), tol)
+	if got.l.l.Prec() < 120 {
+		t.Errorf("precision %d, want at least 120", got.l.l.Prec())
+	}
+	x300, y300 := new(Hamilton).setPrec(x, 300), new(Hamilton).setPrec(y, 300)
+	want := new(Hamilton).Slerp(x300, y300, big.NewFloat(0.5), big.NewFloat(0x1p-250))
+	if err := rotationAngle(got, want); err.Cmp(tol) > 0 {
+		t.Errorf("angular error %v, want at most %v", err, tol)
+	}
+}
+
+func TestSlerpHemisphere(t *testing.T) {
+	tol := big.NewFloat(0x1p-40)
+	x := axisRotation(big.NewFloat(0.5))
+	y := axisRotation(big.NewFloat(1.5))
+	// -y is the same rotation as y, and the path is the same.
+	got := new(Hamilton).Slerp(x, new(Hamilton).Neg(y), big.NewFloat(0.5), tol)
+	want := axisRotation(big.NewFloat(1))
+	if err := rotationAngle(got, want); err.Cmp(tol) > 0 {
+		t.Errorf("Slerp = %v, want %v", got, want)
+	}
+	// Antipodal quaternions are the same rotation.
+	got.Slerp(x, new(Hamilton).Neg(x), big.NewFloat(0.3), tol)
+	if err := rotationAngle(got, x); err.Cmp(tol) > 0 {
+		t.Errorf("Slerp = %v, want %v", got, x)
+	}
+}
+
+func TestSquad(t *testing.T) {
+	tol := big.NewFloat(0x1p-60)
+	// Rotations at uniform angles about one axis lie on a great circle, where
+	// the spline is the same as Slerp.
+	q := make([]*Hamilton, 4)
+	for k := range q {
+		angle := new(big.Float).SetPrec(100).SetInt64(int64(2 * k))
+		q[k] = axisRotation(angle.Quo(angle, big.NewFloat(5)))
+	}
+	a1 := SquadControl(q[0], q[1], q[2], tol)
+	a2 := SquadControl(q[1], q[2], q[3], tol)
+	for _, s := range []float64{0, 0.3, 1} {
+		got := new(Hamilton).Squad(q[1], a1, a2, q[2], big.NewFloat(s), tol)
+		want := new(Hamilton).Slerp(q[1], q[2], big.NewFloat(s), tol)
+		if err := rotationAngle(got, want); err.Cmp(tol) > 0 {
+			t.Errorf("s = %v: Squad = %v, want %v", s, got, want)
+		}
+	}
+}
+
+func TestSlerpPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "non-positive angular error target" {
+			t.Errorf("recovered %v", r)
+		}
+	}()
+	x := axisRotation(big.NewFloat(1))
+	new(Hamilton).Slerp(x, x, big.NewFloat(0.5), new(big.Float))
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math/big"
+
+// A Snapshot holds the saved state of a value, so that a speculative step can
+// be rolled back with Restore. Saving and restoring copy the components into
+// storage that the Snapshot and the value keep, so an iteration that saves
+// and restores on every step stops allocating once the mantissas have
+// reached their final size. Only Complex, Perplex, Infra, Hamilton, Cockle,
+// InfraComplex, and Supra have Snapshot and Restore methods; the values of
+// the other types can be saved with Flatten and restored with Unflatten.
+type Snapshot struct {
+	f    []big.Float
+	kind string
+}
+
+// save copies the components x of a value of the type kind into s,
+// allocating s if it is nil, and returns s.
+func (s *Snapshot) save(kind string, x ...*big.Float) *Snapshot {
+	if s == nil {
+		s = new(Snapshot)
+	}
+	if len(s.f) != len(x) {
+		s.f = make([]big.Float, len(x))
+	}
+	for i, v := range x {
+		s.f[i].Copy(v)
+	}
+	s.kind = kind
+	return s
+}
+
+// restore copies s onto the components x of a value of the type kind, so
+// that x gets back the saved values, including their precision, rounding
+// mode, and accuracy.
+func (s *Snapshot) restore(kind string, x ...*big.Float) {
+	if s.kind != kind {
+		panic("snapshot of a different type")
+	}
+	for i, v := range x {
+		v.Copy(&s.f[i])
+	}
+}
+
+// Snapshot saves z in s and returns s. If s is nil, then a new Snapshot is
+// allocated.
+func (z *Complex) Snapshot(s *Snapshot) *Snapshot {
+	a, b := z.Cartesian()
+	return s.save("Complex", a, b)
+}
+
+// Restore sets z equal to the value saved in s, and returns z. If s holds a
+// value of another type, then Restore panics.
+func (z *Complex) Restore(s *Snapshot) *Complex {
+	a, b := z.Cartesian()
+	s.restore("Complex", a, b)
+	return z
+}
+
+// Snapshot saves z in s and returns s. If s is nil, then a new Snapshot is
+// allocated.
+func (z *Perplex) Snapshot(s *Snapshot) *Snapshot {
+	a, b := z.Cartesian()
+	return s.save("Perplex", a, b)
+}
+
+// Restore sets z equal to the value saved in s, and returns z. If s holds a
+// value of another type, then Restore panics.
+func (z *Perplex) Restore(s *Snapshot) *Perplex {
+	a, b := z.Cartesian()
+	s.restore("Perplex", a, b)
+	return z
+}
+
+// Snapshot saves z in s and returns s. If s is nil, then a new Snapshot is
+// allocated.
+func (z *Infra) Snapshot(s *Snapshot) *Snapshot {
+	a, b := z.Cartesian()
+	return s.save("Infra", a, b)
+}
+
+// Restore sets z equal to the value saved in s, and returns z. If s holds a
+// value of another type, then Restore panics.
+func (z *Infra) Restore(s *Snapshot) *Infra {
+	a, b := z.Cartesian()
+	s.restore("Infra", a, b)
+	return z
+}
+
+// Snapshot saves z in s and returns s. If s is nil, then a new Snapshot is
+// allocated.
+func (z *Hamilton) Snapshot(s *Snapshot) *Snapshot {
+	a, b, c, d := z.Cartesian()
+	return s.save("Hamilton", a, b, c, d)
+}
+
+// Restore sets z equal to the value saved in s, and returns z. If s holds a
+// value of another type, then Restore panics.
+func (z *Hamilton) Restore(s *Snapshot) *Hamilton {
+	a, b, c, d := z.Cartesian()
+	s.restore("Hamilton", a, b, c, d)
+	return z
+}
+
+// Snapshot saves z in s and returns s. If s is nil, then a new Snapshot is
+// allocated.
+func (z *Cockle) Snapshot(s *Snapshot) *Snapshot {
+	a, b, c, d := z.Cartesian()
+	return s.save("Cockle", a, b, c, d)
+}
+
+// Restore sets z equal to the value saved in s, and returns z. If s holds a
+// value of another type, then Restore panics.
+func (z *Cockle) Restore(s *Snapshot) *Cockle {
+	a, b, c, d := z.Cartesian()
+	s.restore("Cockle", a, b, c, d)
+	return z
+}
+
+// Snapshot saves z in s and returns s. If s is nil, then a new Snapshot is
+// allocated.
+func (z *InfraComplex) Snapshot(s *Snapshot) *Snapshot {
+	a, b, c, d := z.Cartesian()
+	return s.save("InfraComplex", a, b, c, d)
+}
+
+// Restore sets z equal to the value saved in s, and returns z. If s holds a
+// value of another type, then Restore panics.
+func (z *InfraComplex) Restore(s *Snapshot) *InfraComplex {
+	a, b, c, d := z.Cartesian()
+	s.restore("InfraComplex", a, b, c, d)
+	return z
+}
+
+// Snapshot saves z in s and returns s. If s is nil, then a new Snapshot is
+// allocated.
+func (z *Supra) Snapshot(s *Snapshot) *Snapshot {
+	a, b, c, d := z.Cartesian()
+	return s.save("Supra", a, b, c, d)
+}
+
+// Restore sets z equal to the value saved in s, and returns z. If s holds a
+// value of another type, then Restore panics.
+func (z *Supra) Restore(s *Snapshot) *Supra {
+	a, b, c, d := z.Cartesian()
+	s.restore("Supra", a, b, c, d)
+	return z
+}
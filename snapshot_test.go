@@ -0,0 +1,85 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestComplexSnapshotRestore(t *testing.T) {
+	f := func(x, y *Complex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		orig := new(Complex).Copy(x)
+		s := x.Snapshot(nil)
+		x.Mul(x, y)
+		x.l.SetPrec(10)
+		x.Restore(s)
+		return x.Equals(orig) && x.l.Prec() == orig.l.Prec()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonSnapshotRestore(t *testing.T) {
+	f := func(x, y *Hamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		orig := new(Hamilton).Copy(x)
+		s := x.Snapshot(nil)
+		x.Mul(x, y)
+		x.Restore(s)
+		return x.Equals(orig)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSnapshotReuse(t *testing.T) {
+	x := NewHamilton(big.NewFloat(1), big.NewFloat(2), big.NewFloat(3), big.NewFloat(4))
+	y := setPrecHamilton(x, 500)
+	s := y.Snapshot(nil)
+	allocs := testing.AllocsPerRun(100, func() {
+		y.Snapshot(s)
+		y.Restore(s)
+	})
+	if allocs != 0 {
+		t.Errorf("Snapshot and Restore allocate %v times per run", allocs)
+	}
+}
+
+func TestSnapshotWrongType(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Restore from a Complex snapshot into a Hamilton did not panic")
+		}
+	}()
+	s := new(Complex).Snapshot(nil)
+	new(Hamilton).Restore(s)
+}
+
+func TestSnapshotSameSizeType(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Restore from a Complex snapshot into a Perplex did not panic")
+		}
+	}()
+	s := NewComplex(big.NewFloat(1), big.NewFloat(2)).Snapshot(nil)
+	new(Perplex).Restore(s)
+}
+
+func TestSnapshotRestoreTwice(t *testing.T) {
+	x := NewComplex(big.NewFloat(1), big.NewFloat(2))
+	want := new(Complex).Copy(x)
+	s := x.Snapshot(nil)
+	for k := 0; k < 2; k++ {
+		x.Mul(x, x)
+		x.Restore(s)
+		if !x.Equals(want) {
+			t.Errorf("Restore %d gave %v, want %v", k+1, x, want)
+		}
+	}
+}
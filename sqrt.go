@@ -0,0 +1,196 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math/big"
+
+// Sqrt sets z equal to the principal square root of y, and returns z.
+//
+// If y = a+bi, then the principal square root has a non-negative real part,
+// and its imaginary part has the sign of b. The result is rounded to the
+// precision of y.
+func (z *Complex) Sqrt(y *Complex) *Complex {
+	prec := maxPrec(&y.l, &y.r)
+	w := prec + guardBits
+	if y.r.Sign() == 0 {
+		a := newFloat(w).Abs(&y.l)
+		a.Sqrt(a)
+		if y.l.Sign() >= 0 {
+			z.l.SetPrec(prec).Set(a)
+			z.r.SetPrec(prec).Set(&y.r)
+			return z
+		}
+		if y.r.Signbit() {
+			a.Neg(a)
+		}
+		z.l.SetPrec(prec).SetInt64(0)
+		z.r.SetPrec(prec).Set(a)
+		return z
+	}
+	// t = √((|y| + |a|)/2) avoids cancellation; the other component is b/2t.
+	t := bigHypot(w, &y.l, &y.r)
+	t.Add(t, newFloat(w).Abs(&y.l))
+	t.SetMantExp(t, -1)
+	t.Sqrt(t)
+	u := newFloat(w).Quo(&y.r, t)
+	u.SetMantExp(u, -1)
+	if y.l.Sign() >= 0 {
+		z.l.SetPrec(prec).Set(t)
+		z.r.SetPrec(prec).Set(u)
+		return z
+	}
+	if y.r.Sign() < 0 {
+		t.Neg(t)
+	}
+	z.l.SetPrec(prec).Abs(u)
+	z.r.SetPrec(prec).Set(t)
+	return z
+}
+
+// Sqrt sets z equal to the principal square root of y, and returns z.
+//
+// If y = u(1+s)/2 + v(1-s)/2 in the idempotent basis, then the principal
+// square root is
+// 		√u(1+s)/2 + √v(1-s)/2
+// A real square root exists only if u and v are both non-negative, that is,
+// if y is in the RightQuadrant or is a LightLike value with a ≥ 0. Otherwise
+// Sqrt panics. The result is rounded to the precision of y.
+func (z *Perplex) Sqrt(y *Perplex) *Perplex {
+	prec := maxPrec(&y.l, &y.r)
+	w := prec + guardBits
+	u, v := y.Split()
+	if u.Sign() < 0 || v.Sign() < 0 {
+		panic("square root of perplex number outside the right quadrant")
+	}
+	t := new(Perplex).Join(newFloat(w).Sqrt(u), newFloat(w).Sqrt(v))
+	z.l.SetPrec(prec).Set(&t.l)
+	z.r.SetPrec(prec).Set(&t.r)
+	return z
+}
+
+// Sqrt sets z equal to the principal square root of y, and returns z.
+//
+// If y = a+v, where v is the vector part of y, then the principal square root
+// is
+// 		√((|y| + a)/2) + v √((|y| - a)/2)/|v|
+// which has a non-negative real part. If v is zero and a is negative, then the
+// square root is √(-a)i. The result is rounded to the precision of y.
+func (z *Hamilton) Sqrt(y *Hamilton) *Hamilton {
+	a, b, c, d := y.Cartesian()
+	prec := maxPrec(a, b, c, d)
+	w := prec + guardBits
+	theta := bigHypot(w, b, c, d)
+	zero := new(big.Float)
+	if theta.Sign() == 0 {
+		t := newFloat(w).Abs(a)
+		t.Sqrt(t)
+		if a.Sign() < 0 {
+			return z.setScaledVector(zero, t, big.NewFloat(1), zero, zero, prec)
+		}
+		return z.setScaledVector(t, zero, zero, zero, zero, prec)
+	}
+	// t = √((|y| + |a|)/2) avoids cancellation; the other part is |v|/2t.
+	t := bigHypot(w, a, theta)
+	t.Add(t, newFloat(w).Abs(a))
+	t.SetMantExp(t, -1)
+	t.Sqrt(t)
+	if a.Sign() >= 0 {
+		f := newFloat(w).Quo(big.NewFloat(0.5), t)
+		return z.setScaledVector(t, f, b, c, d, prec)
+	}
+	re := newFloat(w).Quo(theta, t)
+	re.SetMantExp(re, -1)
+	return z.setScaledVector(re, t.Quo(t, theta), b, c, d, prec)
+}
+
+// NthRoots returns the n distinct n-th roots of y, starting with the
+// principal root and proceeding counterclockwise. If y = r exp(θi) with θ in
+// [-π, π], then the k-th root is
+// 		r^(1/n) exp((θ + 2πk)i/n)
+// If y is zero, then every root is zero. The roots are rounded to the
+// precision of y. If n is not positive, then NthRoots panics.
+func NthRoots(y *Complex, n int) []*Complex {
+	if n <= 0 {
+		panic("non-positive root index")
+	}
+	prec := maxPrec(&y.l, &y.r)
+	w := prec + guardBits
+	roots := make([]*Complex, n)
+	if y.l.Sign() == 0 && y.r.Sign() == 0 {
+		for k := range roots {
+			roots[k] = newComplexPrec(prec)
+		}
+		return roots
+	}
+	nf := newFloat(w).SetInt64(int64(n))
+	mod := bigLog(bigHypot(w, &y.l, &y.r), w)
+	mod = bigExp(mod.Quo(mod, nf), w)
+	arg := bigAtan2(&y.r, &y.l, w)
+	s, c := bigSinCos(arg.Quo(arg, nf), w)
+	principal := new(Complex)
+	principal.l.Mul(mod, c)
+	principal.r.Mul(mod, s)
+	for k, u := range RootsOfUnity(n, w) {
+		roots[k] = newComplexPrec(prec).round(u.Mul(principal, u), prec)
+	}
+	return roots
+}
+
+// RootsOfUnity returns the n-th roots of unity
+// 		exp(2πki/n)
+// for k = 0, 1, ..., n-1, rounded to prec bits. The roots on the axes are
+// exact, and the others are computed from the nearest quarter turn and an
+// angle of at most an eighth of a turn. If n is not positive, then
+// RootsOfUnity panics. The roots are kept in the cache, and the values
+// returned are copies.
+func RootsOfUnity(n int, prec uint) []*Complex {
+	if n <= 0 {
+		panic("non-positive root index")
+	}
+	roots := cached(cacheKey{cacheRootsOfUnity, n, prec}, func() (interface{}, int64) {
+		return computeRootsOfUnity(n, prec), int64(n) * (8 + 2*floatSize(prec))
+	}).([]*Complex)
+	c := make([]*Complex, n)
+	for k, z := range roots {
+		c[k] = new(Complex).Copy(z)
+	}
+	return c
+}
+
+// computeRootsOfUnity computes the n-th roots of unity for RootsOfUnity.
+func computeRootsOfUnity(n int, prec uint) []*Complex {
+	w := prec + guardBits
+	twoPi := bigPi(w)
+	twoPi.SetMantExp(twoPi, 1)
+	n4 := newFloat(w).SetInt64(int64(4 * n))
+	roots := make([]*Complex, n)
+	for k := range roots {
+		// The angle 2πk/n is q quarter turns plus 2πm/4n, with |m| ≤ n/2.
+		q := (4*k + n/2) / n
+		m := 4*k - q*n
+		neg := m < 0
+		if neg {
+			m = -m
+		}
+		var s, c *big.Float
+		if m == 0 {
+			s, c = newFloat(w), newFloat(w).SetInt64(1)
+		} else {
+			t := newFloat(w).Mul(twoPi, newFloat(w).SetInt64(int64(m)))
+			s, c = bigSinCos(t.Quo(t, n4), w)
+		}
+		if neg {
+			s.Neg(s)
+		}
+		// Rotate by q quarter turns.
+		for ; q > 0; q-- {
+			s, c = c, s.Neg(s)
+		}
+		z := newComplexPrec(prec)
+		z.l.Set(c)
+		z.r.Set(s)
+		roots[k] = z
+	}
+	return roots
+}
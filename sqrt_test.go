@@ -0,0 +1,134 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+	"math/cmplx"
+	"testing"
+	"testing/quick"
+)
+
+func TestPerplexSqrtSquare(t *testing.T) {
+	f := func(x *Perplex) bool {
+		// t.Logf("x = %v", x)
+		y := new(Perplex).Mul(x, x)
+		r := new(Perplex).Sqrt(y)
+		r.Mul(r, r)
+		d := r.Sub(r, y)
+		return (d.l.Sign() == 0 || expo(&d.l) < -50) &&
+			(d.r.Sign() == 0 || expo(&d.r) < -50)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPerplexSqrtLightLike(t *testing.T) {
+	// √(2 + 2s) = 1 + s, since (1 + s)² = 2 + 2s.
+	y := NewPerplex(big.NewFloat(2), big.NewFloat(2))
+	want := NewPerplex(big.NewFloat(1), big.NewFloat(1))
+	if got := new(Perplex).Sqrt(y); !got.Equals(want) {
+		t.Errorf("Sqrt(%v) = %v, want %v", y, got, want)
+	}
+}
+
+func TestPerplexSqrtPanics(t *testing.T) {
+	for _, y := range []*Perplex{
+		NewPerplex(big.NewFloat(-1), big.NewFloat(0)),
+		NewPerplex(big.NewFloat(0), big.NewFloat(1)),
+		NewPerplex(big.NewFloat(-1), big.NewFloat(1)),
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Sqrt(%v) did not panic", y)
+				}
+			}()
+			new(Perplex).Sqrt(y)
+		}()
+	}
+}
+
+func TestHamiltonSqrtSquare(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		y := setPrecHamilton(x, 200)
+		y.l.l.Sub(&y.l.l, big.NewFloat(0.5))
+		r := new(Hamilton).Sqrt(y)
+		if r.l.l.Sign() < 0 {
+			return false
+		}
+		d := r.Mul(r, r).Sub(r, y).Quad()
+		return d.Sign() == 0 || expo(d) < expo(y.Quad())-380
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonSqrtNegativeReal(t *testing.T) {
+	zero := new(big.Float)
+	y := NewHamilton(big.NewFloat(-4), zero, zero, zero)
+	want := NewHamilton(zero, big.NewFloat(2), zero, zero)
+	if got := new(Hamilton).Sqrt(y); !got.Equals(want) {
+		t.Errorf("Sqrt(%v) = %v, want %v", y, got, want)
+	}
+}
+
+func TestNthRootsPower(t *testing.T) {
+	f := func(x *Complex) bool {
+		// t.Logf("x = %v", x)
+		y := setPrecComplex(x, 200)
+		y.l.Sub(&y.l, big.NewFloat(0.5))
+		for _, n := range []int{1, 2, 3, 7} {
+			for _, r := range NthRoots(y, n) {
+				p := new(Complex).Copy(r)
+				for k := 1; k < n; k++ {
+					p.Mul(p, r)
+				}
+				d := new(Complex).Sub(p, y)
+				if d.Quad().Sign() != 0 && complexExpo(d) > complexExpo(y)-190 {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNthRootsPrincipal(t *testing.T) {
+	f := func(x *Complex) bool {
+		// t.Logf("x = %v", x)
+		y := setPrecComplex(x, 200)
+		y.r.Neg(&y.r)
+		r := NthRoots(y, 2)
+		s := new(Complex).Sqrt(y)
+		d := new(Complex).Sub(r[0], s)
+		return d.Quad().Sign() == 0 || complexExpo(d) < complexExpo(s)-190
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRootsOfUnity(t *testing.T) {
+	for _, n := range []int{1, 4, 6, 12, 17} {
+		roots := RootsOfUnity(n, 100)
+		for k, u := range roots {
+			a, _ := u.l.Float64()
+			b, _ := u.r.Float64()
+			if !closeToComplex128(u, cmplx.Rect(1, 2*math.Pi*float64(k)/float64(n)), 50) {
+				t.Errorf("RootsOfUnity(%d)[%d] = %v", n, k, u)
+			}
+			if 4*k%n == 0 && (a != math.Trunc(a) || b != math.Trunc(b)) {
+				t.Errorf("RootsOfUnity(%d)[%d] = %v is not exact", n, k, u)
+			}
+		}
+	}
+}
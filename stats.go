@@ -0,0 +1,334 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math/big"
+
+// welford accumulates the mean and the sum of squared deviations of a stream
+// of vectors with Welford's method, at w bits.
+type welford struct {
+	n    int
+	mean []*big.Float
+	m2   *big.Float
+	w    uint
+}
+
+// newWelford returns an empty accumulator for vectors with dim components at
+// w bits.
+func newWelford(dim int, w uint) welford {
+	mean := make([]*big.Float, dim)
+	for k := range mean {
+		mean[k] = newFloat(w)
+	}
+	return welford{mean: mean, m2: newFloat(w), w: w}
+}
+
+// push adds x to the accumulator. If d = x - mean before the update, then
+// 		mean += d/n
+// 		m2 += d·(x - mean)
+// with the mean after the update in the second line.
+func (a *welford) push(x []*big.Float) {
+	a.n++
+	n := newFloat(a.w).SetInt64(int64(a.n))
+	for k, v := range x {
+		d := newFloat(a.w).Sub(v, a.mean[k])
+		a.mean[k].Add(a.mean[k], newFloat(a.w).Quo(d, n))
+		e := newFloat(a.w).Sub(v, a.mean[k])
+		a.m2.Add(a.m2, e.Mul(d, e))
+	}
+}
+
+// merge adds the values of b to the accumulator. If d is the difference of
+// the means, then
+// 		mean += d nb/n
+// 		m2 += m2b + d·d na nb/n
+// where n = na + nb.
+func (a *welford) merge(b *welford) {
+	if b.n == 0 {
+		return
+	}
+	na := newFloat(a.w).SetInt64(int64(a.n))
+	nb := newFloat(a.w).SetInt64(int64(b.n))
+	a.n += b.n
+	n := newFloat(a.w).SetInt64(int64(a.n))
+	dd := newFloat(a.w)
+	for k := range a.mean {
+		d := newFloat(a.w).Sub(b.mean[k], a.mean[k])
+		dd.Add(dd, newFloat(a.w).Mul(d, d))
+		d.Mul(d, nb)
+		a.mean[k].Add(a.mean[k], d.Quo(d, n))
+	}
+	dd.Mul(dd, na)
+	dd.Mul(dd, nb)
+	a.m2.Add(a.m2, b.m2)
+	a.m2.Add(a.m2, dd.Quo(dd, n))
+}
+
+// variance returns m2/(n-1) rounded to prec bits. If there are fewer than two
+// values, then variance panics.
+func (a *welford) variance(prec uint) *big.Float {
+	if a.n < 2 {
+		panic("variance of fewer than two values")
+	}
+	v := newFloat(a.w).Quo(a.m2, newFloat(a.w).SetInt64(int64(a.n-1)))
+	return newFloat(prec).Set(v)
+}
+
+// checkMean panics if the accumulator is empty.
+func (a *welford) checkMean() {
+	if a.n == 0 {
+		panic("mean of no values")
+	}
+}
+
+// ComplexStats summarizes a stream of Complex values in one pass: it holds
+// their count, mean, and variance, updated with Welford's method at working
+// precision, so the values themselves need not be kept. The zero value is
+// not ready for use; see NewComplexStats.
+type ComplexStats struct {
+	acc  welford
+	prec uint
+}
+
+// NewComplexStats returns a pointer to an empty ComplexStats value whose
+// results are rounded to prec bits.
+func NewComplexStats(prec uint) *ComplexStats {
+	return &ComplexStats{newWelford(2, prec+guardBits), prec}
+}
+
+// Push adds x to s, and returns s.
+func (s *ComplexStats) Push(x *Complex) *ComplexStats {
+	s.acc.push([]*big.Float{&x.l, &x.r})
+	return s
+}
+
+// Merge adds the values summarized by t to s, as if they had been pushed
+// onto s, and returns s. It allows a large stream to be summarized in
+// parts.
+func (s *ComplexStats) Merge(t *ComplexStats) *ComplexStats {
+	s.acc.merge(&t.acc)
+	return s
+}
+
+// Count returns the number of values pushed onto s.
+func (s *ComplexStats) Count() int {
+	return s.acc.n
+}
+
+// Mean returns the mean of the values pushed onto s. If there are none, then
+// Mean panics.
+func (s *ComplexStats) Mean() *Complex {
+	s.acc.checkMean()
+	return new(Complex).round(NewComplex(s.acc.mean[0], s.acc.mean[1]), s.prec)
+}
+
+// Variance returns the sample variance of the values x pushed onto s,
+// 		Σ Quad(x - mean)/(n - 1)
+// which is the sum of the variances of the real and imaginary parts. If there
+// are fewer than two values, then Variance panics.
+func (s *ComplexStats) Variance() *big.Float {
+	return s.acc.variance(s.prec)
+}
+
+// HamiltonStats summarizes a stream of Hamilton values in one pass. It
+// follows the conventions of ComplexStats.
+type HamiltonStats struct {
+	acc  welford
+	prec uint
+}
+
+// NewHamiltonStats returns a pointer to an empty HamiltonStats value whose
+// results are rounded to prec bits.
+func NewHamiltonStats(prec uint) *HamiltonStats {
+	return &HamiltonStats{newWelford(4, prec+guardBits), prec}
+}
+
+// Push adds x to s, and returns s.
+func (s *HamiltonStats) Push(x *Hamilton) *HamiltonStats {
+	a, b, c, d := x.Cartesian()
+	s.acc.push([]*big.Float{a, b, c, d})
+	return s
+}
+
+// Merge adds the values summarized by t to s, and returns s.
+func (s *HamiltonStats) Merge(t *HamiltonStats) *HamiltonStats {
+	s.acc.merge(&t.acc)
+	return s
+}
+
+// Count returns the number of values pushed onto s.
+func (s *HamiltonStats) Count() int {
+	return s.acc.n
+}
+
+// Mean returns the mean of the values pushed onto s. If there are none, then
+// Mean panics.
+func (s *HamiltonStats) Mean() *Hamilton {
+	s.acc.checkMean()
+	m := NewHamilton(s.acc.mean[0], s.acc.mean[1], s.acc.mean[2], s.acc.mean[3])
+	return m.setPrec(m, s.prec)
+}
+
+// Variance returns the sample variance of the values x pushed onto s,
+// 		Σ Quad(x - mean)/(n - 1)
+// If there are fewer than two values, then Variance panics.
+func (s *HamiltonStats) Variance() *big.Float {
+	return s.acc.variance(s.prec)
+}
+
+// quadExact returns the sum of the squares of x without rounding.
+func quadExact(x ...*big.Float) *big.Float {
+	sum := new(big.Float)
+	for _, v := range x {
+		sum = exactAdd(sum, exactMul(v, v))
+	}
+	return sum
+}
+
+// window holds the quadrances of the most recent values of a stream and
+// the sum of the finite ones, which is kept without rounding so that it does
+// not drift as values leave the window. Infinite quadrances are counted
+// instead, since an infinite sum could not be reduced when they leave.
+type window struct {
+	quads []*big.Float
+	next  int
+	full  bool
+	sum   *big.Float
+	infs  int
+}
+
+// newWindow returns an empty window of the given size. If size is not
+// positive, then newWindow panics.
+func newWindow(size int) window {
+	if size <= 0 {
+		panic("non-positive window size")
+	}
+	return window{quads: make([]*big.Float, size), sum: new(big.Float)}
+}
+
+// push adds the quadrance q to the window, dropping the oldest one if the
+// window is full.
+func (w *window) push(q *big.Float) {
+	if w.full {
+		if old := w.quads[w.next]; old.IsInf() {
+			w.infs--
+		} else {
+			w.sum = exactSub(w.sum, old)
+		}
+	}
+	w.quads[w.next] = q
+	if q.IsInf() {
+		w.infs++
+	} else {
+		w.sum = exactAdd(w.sum, q)
+	}
+	w.next++
+	if w.next == len(w.quads) {
+		w.next, w.full = 0, true
+	}
+}
+
+// len returns the number of values in the window.
+func (w *window) len() int {
+	if w.full {
+		return len(w.quads)
+	}
+	return w.next
+}
+
+// norm returns the square root of the sum of the quadrances, divided by the
+// number of values first if rms is true, rounded to prec bits.
+func (w *window) norm(rms bool, prec uint) *big.Float {
+	n := w.len()
+	if n == 0 {
+		return newFloat(prec)
+	}
+	if w.infs > 0 {
+		return newFloat(prec).SetInf(false)
+	}
+	s := newFloat(prec + guardBits).Set(w.sum)
+	if rms {
+		s.Quo(s, newFloat(prec+guardBits).SetInt64(int64(n)))
+	}
+	return newFloat(prec).Sqrt(s)
+}
+
+// ComplexWindow tracks the norm of the most recent values of a stream of
+// Complex values, a sliding window of fixed size. The sum of their
+// quadrances is kept without rounding, so the norm does not drift no matter
+// how many values have passed through the window. The zero value is
+// not ready for use; see NewComplexWindow.
+type ComplexWindow struct {
+	win  window
+	prec uint
+}
+
+// NewComplexWindow returns a pointer to an empty ComplexWindow value holding
+// up to size values, whose results are rounded to prec bits. If size is not
+// positive, then NewComplexWindow panics.
+func NewComplexWindow(size int, prec uint) *ComplexWindow {
+	return &ComplexWindow{newWindow(size), prec}
+}
+
+// Push adds x to w, dropping the oldest value if w is full, and returns w.
+func (w *ComplexWindow) Push(x *Complex) *ComplexWindow {
+	w.win.push(quadExact(&x.l, &x.r))
+	return w
+}
+
+// Len returns the number of values in w.
+func (w *ComplexWindow) Len() int {
+	return w.win.len()
+}
+
+// Norm returns the Euclidean norm of the values x in w,
+// 		√(Σ Quad(x))
+// which is zero if w is empty.
+func (w *ComplexWindow) Norm() *big.Float {
+	return w.win.norm(false, w.prec)
+}
+
+// RMS returns the root mean square of the values x in w,
+// 		√(Σ Quad(x)/n)
+// which is zero if w is empty.
+func (w *ComplexWindow) RMS() *big.Float {
+	return w.win.norm(true, w.prec)
+}
+
+// HamiltonWindow tracks the norm of the most recent values of a stream of
+// Hamilton values. It follows the conventions of ComplexWindow.
+type HamiltonWindow struct {
+	win  window
+	prec uint
+}
+
+// NewHamiltonWindow returns a pointer to an empty HamiltonWindow value
+// holding up to size values, whose results are rounded to prec bits. If size
+// is not positive, then NewHamiltonWindow panics.
+func NewHamiltonWindow(size int, prec uint) *HamiltonWindow {
+	return &HamiltonWindow{newWindow(size), prec}
+}
+
+// Push adds x to w, dropping the oldest value if w is full, and returns w.
+func (w *HamiltonWindow) Push(x *Hamilton) *HamiltonWindow {
+	w.win.push(quadExact(x.Cartesian()))
+	return w
+}
+
+// Len returns the number of values in w.
+func (w *HamiltonWindow) Len() int {
+	return w.win.len()
+}
+
+// Norm returns the Euclidean norm of the values in w, which is zero if w is
+// empty.
+func (w *HamiltonWindow) Norm() *big.Float {
+	return w.win.norm(false, w.prec)
+}
+
+// RMS returns the root mean square of the values in w, which is zero if w is
+// empty.
+func (w *HamiltonWindow) RMS() *big.Float {
+	return w.win.norm(true, w.prec)
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"errors"
+	"math/big"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// twoPassStats returns the mean and the sample variance of x at 300 bits.
+func twoPassStats(x []*Complex) (*Complex, *big.Float) {
+	mean := newComplexPrec(300)
+	for _, v := range x {
+		mean.Add(mean, v)
+	}
+	n := new(big.Float).SetInt64(int64(len(x)))
+	mean.l.Quo(&mean.l, n)
+	mean.r.Quo(&mean.r, n)
+	sum := newFloat(300)
+	for _, v := range x {
+		sum.Add(sum, new(Complex).round(new(Complex).Sub(v, mean), 300).Quad())
+	}
+	return mean, sum.Quo(sum, n.Sub(n, big.NewFloat(1)))
+}
+
+func randomComplexes(r *rand.Rand, n int) []*Complex {
+	x := make([]*Complex, n)
+	for k := range x {
+		// A large offset makes the naive formula lose every digit.
+		x[k] = newComplex128(complex(1e12+r.Float64(), r.Float64()-1e12))
+	}
+	return x
+}
+
+func TestComplexStats(t *testing.T) {
+	x := randomComplexes(rand.New(rand.NewSource(1)), 500)
+	s := NewComplexStats(100)
+	for _, v := range x {
+		s.Push(v)
+	}
+	mean, variance := twoPassStats(x)
+	if s.Count() != len(x) {
+		t.Errorf("Count() = %d, want %d", s.Count(), len(x))
+	}
+	if got := s.Mean(); !closeToComplex(got, mean, 90) {
+		t.Errorf("Mean() = %v, want %v", got, mean)
+	}
+	if got := s.Variance(); !closeTo(got, variance, 80) {
+		t.Errorf("Variance() = %v, want %v", got, variance)
+	}
+	a, b := NewComplexStats(100), NewComplexStats(100)
+	for k, v := range x {
+		if k < 123 {
+			a.Push(v)
+		} else {
+			b.Push(v)
+		}
+	}
+	a.Merge(b)
+	if a.Count() != len(x) || !closeToComplex(a.Mean(), mean, 90) || !closeTo(a.Variance(), variance, 80) {
+		t.Errorf("merged mean %v and variance %v, want %v and %v", a.Mean(), a.Variance(), mean, variance)
+	}
+}
+
+func TestHamiltonStats(t *testing.T) {
+	x := randomComplexes(rand.New(rand.NewSource(2)), 200)
+	s := NewHamiltonStats(100)
+	for k := 0; k+1 < len(x); k += 2 {
+		s.Push(NewHamilton(&x[k].l, &x[k].r, &x[k+1].l, &x[k+1].r))
+	}
+	// The components of the values are split across pairs of Complex values.
+	evens, odds := make([]*Complex, 0, 100), make([]*Complex, 0, 100)
+	for k := range x {
+		if k%2 == 0 {
+			evens = append(evens, x[k])
+		} else {
+			odds = append(odds, x[k])
+		}
+	}
+	m1, v1 := twoPassStats(evens)
+	m2, v2 := twoPassStats(odds)
+	want := NewHamilton(&m1.l, &m1.r, &m2.l, &m2.r)
+	if got := s.Mean(); !closeToHamilton(got, want, 90) {
+		t.Errorf("Mean() = %v, want %v", got, want)
+	}
+	variance := new(big.Float).Add(v1, v2)
+	if got := s.Variance(); !closeTo(got, variance, 80) {
+		t.Errorf("Variance() = %v, want %v", got, variance)
+	}
+}
+
+func TestComplexWindow(t *testing.T) {
+	x := randomComplexes(rand.New(rand.NewSource(3)), 100)
+	w := NewComplexWindow(7, 100)
+	if w.Norm().Sign() != 0 || w.Len() != 0 {
+		t.Fatalf("empty window has norm %v and length %d", w.Norm(), w.Len())
+	}
+	for k, v := range x {
+		w.Push(v)
+		sum := newFloat(300)
+		n := 0
+		for j := k; j >= 0 && j > k-7; j-- {
+			sum.Add(sum, new(Complex).round(x[j], 300).Quad())
+			n++
+		}
+		if w.Len() != n {
+			t.Fatalf("after %d values, Len() = %d, want %d", k+1, w.Len(), n)
+		}
+		norm := new(big.Float).Sqrt(sum)
+		if got := w.Norm(); !closeTo(got, norm, 95) {
+			t.Errorf("after %d values, Norm() = %v, want %v", k+1, got, norm)
+		}
+		rms := new(big.Float).Sqrt(sum.Quo(sum, big.NewFloat(float64(n))))
+		if got := w.RMS(); !closeTo(got, rms, 95) {
+			t.Errorf("after %d values, RMS() = %v, want %v", k+1, got, rms)
+		}
+	}
+}
+
+func TestHamiltonWindow(t *testing.T) {
+	w := NewHamiltonWindow(2, 64)
+	for _, v := range []float64{100, 3, 4} {
+		w.Push(NewHamilton(big.NewFloat(v), new(big.Float), new(big.Float), new(big.Float)))
+	}
+	if got := w.Norm(); got.Cmp(big.NewFloat(5)) != 0 {
+		t.Errorf("Norm() = %v, want 5", got)
+	}
+}
+
+func TestComplexWindowOverflow(t *testing.T) {
+	w := NewComplexWindow(2, 64)
+	inf := new(big.Float).SetInf(false)
+	for k, v := range []*Complex{
+		NewComplex(inf, big.NewFloat(1)),
+		NewComplex(big.NewFloat(3), inf),
+		NewComplex(big.NewFloat(3), big.NewFloat(4)),
+		NewComplex(big.NewFloat(0), big.NewFloat(12)),
+	} {
+		w.Push(v)
+		if got := w.Norm(); k < 3 != got.IsInf() {
+			t.Errorf("after %d values, Norm() = %v", k+1, got)
+		}
+	}
+	if got := w.Norm(); got.Cmp(big.NewFloat(13)) != 0 {
+		t.Errorf("Norm() = %v, want 13", got)
+	}
+}
+
+func TestScanComplex(t *testing.T) {
+	x := randomComplexes(rand.New(rand.NewSource(4)), 50)
+	// The values are written in the form of String.
+	var lines []string
+	for _, v := range x {
+		lines = append(lines, v.String())
+	}
+	stream := "# a stream of values\n\n" + strings.Join(lines, "\n") + "\n"
+	s := NewComplexStats(100)
+	if err := ScanComplex(strings.NewReader(stream), 53, func(z *Complex) error {
+		s.Push(z)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	mean, _ := twoPassStats(x)
+	if s.Count() != len(x) || !closeToComplex(s.Mean(), mean, 90) {
+		t.Errorf("scanned %d values with mean %v, want %d and %v", s.Count(), s.Mean(), len(x), mean)
+	}
+	err := ScanComplex(strings.NewReader("(1+2i)\n(1+2j)\n"), 53, func(*Complex) error { return nil })
+	var pe *ParseError
+	if !errors.As(err, &pe) || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("ScanComplex of a malformed line returned %v", err)
+	}
+	stop := errors.New("stop")
+	n := 0
+	err = ScanComplex(strings.NewReader(stream), 53, func(*Complex) error {
+		if n++; n == 3 {
+			return stop
+		}
+		return nil
+	})
+	if err != stop || n != 3 {
+		t.Errorf("ScanComplex returned %v after %d values, want %v after 3", err, n, stop)
+	}
+}
@@ -54,6 +54,12 @@ func (z *Supra) String() string {
 	return strings.Join(a, "")
 }
 
+// Format implements fmt.Formatter, with the conventions of the Format method
+// of Complex.
+func (z *Supra) Format(s fmt.State, verb rune) {
+	formatTerms(s, verb, z, symbSupra[:], floatSlice(z.Cartesian()))
+}
+
 // Equals returns true if y and z are equal.
 func (z *Supra) Equals(y *Supra) bool {
 	if !z.l.Equals(&y.l) || !z.r.Equals(&y.r) {
@@ -122,17 +128,22 @@ func (z *Supra) Sub(x, y *Supra) *Supra {
 // 		Mul(β, γ) = Mul(γ, β) = 0
 // 		Mul(γ, α) = Mul(α, γ) = 0
 // This binary operation is noncommutative but associative.
+// Unless the components of x or y differ widely in scale, the product is
+// computed exactly and then rounded once, so the result is exact whenever it
+// fits the precision of z; otherwise it is computed with rounded arithmetic.
 func (z *Supra) Mul(x, y *Supra) *Supra {
-	a := new(Infra).Copy(&x.l)
-	b := new(Infra).Copy(&x.r)
-	c := new(Infra).Copy(&y.l)
-	d := new(Infra).Copy(&y.r)
-	temp := new(Infra)
-	z.l.Mul(a, c)
-	z.r.Add(
-		z.r.Mul(d, a),
-		temp.Mul(b, temp.Conj(c)),
+	prec := maxPrec(
+		&x.l.l, &x.l.r, &x.r.l, &x.r.r,
+		&y.l.l, &y.l.r, &y.r.l, &y.r.r,
 	)
+	if fitsExact(prec,
+		[]*big.Float{&z.l.l, &z.l.r, &z.r.l, &z.r.r},
+		[]*big.Float{&x.l.l, &x.l.r, &x.r.l, &x.r.r},
+		[]*big.Float{&y.l.l, &y.l.r, &y.r.l, &y.r.r},
+	) {
+		return z.roundSupra(new(Supra).mulExact(x, y), prec)
+	}
+	mulDoubling(0, &z.l, &z.r, &x.l, &x.r, &y.l, &y.r)
 	return z
 }
 
@@ -141,7 +152,7 @@ func (z *Supra) Mul(x, y *Supra) *Supra {
 // Then it returns z.
 func (z *Supra) Commutator(x, y *Supra) *Supra {
 	return z.Sub(
-		z.Mul(x, y),
+		new(Supra).Mul(x, y),
 		new(Supra).Mul(y, x),
 	)
 }
@@ -207,62 +218,160 @@ func (z *Supra) QuoR(x, y *Supra) *Supra {
 	return z
 }
 
-// CrossFloatioL sets z equal to the left cross-ratio of v, w, x, and y:
+// CrossRatioL sets z equal to the left cross-ratio of v, w, x, and y:
 // 		Inv(w - x) * (v - x) * Inv(v - y) * (w - y)
-// Then it returns z.
+// Then it returns z. If a denominator has no inverse, then CrossRatioL panics
+// and leaves z unchanged.
+func (z *Supra) CrossRatioL(v, w, x, y *Supra) *Supra {
+	if _, err := z.CrossRatioLChecked(v, w, x, y, ReportDegeneracy); err != nil {
+		panic(err.Error())
+	}
+	return z
+}
+
+// CrossFloatioL is the same as CrossRatioL.
+//
+// Deprecated: Use CrossRatioL.
 func (z *Supra) CrossFloatioL(v, w, x, y *Supra) *Supra {
-	temp := new(Supra)
-	z.Sub(w, x)
-	z.Inv(z)
-	temp.Sub(v, x)
-	z.Mul(z, temp)
-	temp.Sub(v, y)
-	temp.Inv(temp)
-	z.Mul(z, temp)
-	temp.Sub(w, y)
-	return z.Mul(z, temp)
+	return z.CrossRatioL(v, w, x, y)
 }
 
-// CrossFloatioR sets z equal to the right cross-ratio of v, w, x, and y:
+// CrossRatioLChecked is like CrossRatioL, but it returns a *DegenerateError
+// instead of panicking when a denominator has no inverse, and leaves z
+// unchanged. The denominators vanish when w = x or v = y. If policy is
+// ProjectiveInfinity, then vanishing denominators with non-zero numerators set
+// z equal to the point at infinity instead.
+func (z *Supra) CrossRatioLChecked(v, w, x, y *Supra, policy DegeneracyPolicy) (*Supra, error) {
+	zero := new(Supra)
+	vx := new(Supra).Sub(v, x)
+	wy := new(Supra).Sub(w, y)
+	wx := new(Supra).Sub(w, x)
+	vy := new(Supra).Sub(v, y)
+	inf, err := checkQuotient(
+		"CrossRatioL",
+		vx.Equals(zero) || wy.Equals(zero),
+		wx.Equals(zero) || vy.Equals(zero),
+		wx.IsZeroDiv() || vy.IsZeroDiv(),
+		policy,
+	)
+	switch {
+	case err != nil:
+		return z, err
+	case inf:
+		setInf(&z.l.l, &z.l.r, &z.r.l, &z.r.r)
+		return z, nil
+	}
+	p := new(Supra).Mul(wx.Inv(wx), vx)
+	p.Mul(p, vy.Inv(vy))
+	return z.Mul(p, wy), nil
+}
+
+// CrossRatioR sets z equal to the right cross-ratio of v, w, x, and y:
 // 		(v - x) * Inv(w - x) * (w - y) * Inv(v - y)
-// Then it returns z.
+// Then it returns z. If a denominator has no inverse, then CrossRatioR panics
+// and leaves z unchanged.
+func (z *Supra) CrossRatioR(v, w, x, y *Supra) *Supra {
+	if _, err := z.CrossRatioRChecked(v, w, x, y, ReportDegeneracy); err != nil {
+		panic(err.Error())
+	}
+	return z
+}
+
+// CrossFloatioR is the same as CrossRatioR.
+//
+// Deprecated: Use CrossRatioR.
 func (z *Supra) CrossFloatioR(v, w, x, y *Supra) *Supra {
-	temp := new(Supra)
-	z.Sub(v, x)
-	temp.Sub(w, x)
-	temp.Inv(temp)
-	z.Mul(z, temp)
-	temp.Sub(w, y)
-	z.Mul(z, temp)
-	temp.Sub(v, y)
-	temp.Inv(temp)
-	return z.Mul(z, temp)
+	return z.CrossRatioR(v, w, x, y)
+}
+
+// CrossRatioRChecked is like CrossRatioR, but it returns a *DegenerateError
+// instead of panicking when a denominator has no inverse, and leaves z
+// unchanged. The denominators vanish when w = x or v = y. If policy is
+// ProjectiveInfinity, then vanishing denominators with non-zero numerators set
+// z equal to the point at infinity instead.
+func (z *Supra) CrossRatioRChecked(v, w, x, y *Supra, policy DegeneracyPolicy) (*Supra, error) {
+	zero := new(Supra)
+	vx := new(Supra).Sub(v, x)
+	wy := new(Supra).Sub(w, y)
+	wx := new(Supra).Sub(w, x)
+	vy := new(Supra).Sub(v, y)
+	inf, err := checkQuotient(
+		"CrossRatioR",
+		vx.Equals(zero) || wy.Equals(zero),
+		wx.Equals(zero) || vy.Equals(zero),
+		wx.IsZeroDiv() || vy.IsZeroDiv(),
+		policy,
+	)
+	switch {
+	case err != nil:
+		return z, err
+	case inf:
+		setInf(&z.l.l, &z.l.r, &z.r.l, &z.r.r)
+		return z, nil
+	}
+	p := new(Supra).Mul(vx, wx.Inv(wx))
+	p.Mul(p, wy)
+	return z.Mul(p, vy.Inv(vy)), nil
 }
 
 // MöbiusL sets z equal to the left Möbius (fractional linear) transform of y:
 // 		Inv(y*c + d) * (y*a + b)
-// Then it returns z.
+// Then it returns z. If the denominator has no inverse, then MöbiusL panics
+// and leaves z unchanged.
 func (z *Supra) MöbiusL(y, a, b, c, d *Supra) *Supra {
-	z.Mul(y, a)
-	z.Add(z, b)
-	temp := new(Supra)
-	temp.Mul(y, c)
-	temp.Add(temp, d)
-	temp.Inv(temp)
-	return z.Mul(temp, z)
+	if _, err := z.MöbiusLChecked(y, a, b, c, d, ReportDegeneracy); err != nil {
+		panic(err.Error())
+	}
+	return z
+}
+
+// MöbiusLChecked is like MöbiusL, but it returns a *DegenerateError instead of
+// panicking when the denominator has no inverse, and leaves z unchanged. If
+// policy is ProjectiveInfinity, then a zero denominator with a non-zero
+// numerator sets z equal to the point at infinity instead.
+func (z *Supra) MöbiusLChecked(y, a, b, c, d *Supra, policy DegeneracyPolicy) (*Supra, error) {
+	zero := new(Supra)
+	num := new(Supra).Add(new(Supra).Mul(y, a), b)
+	den := new(Supra).Add(new(Supra).Mul(y, c), d)
+	inf, err := checkQuotient("MöbiusL", num.Equals(zero), den.Equals(zero), den.IsZeroDiv(), policy)
+	switch {
+	case err != nil:
+		return z, err
+	case inf:
+		setInf(&z.l.l, &z.l.r, &z.r.l, &z.r.r)
+		return z, nil
+	}
+	return z.Mul(new(Supra).Inv(den), num), nil
 }
 
 // MöbiusR sets z equal to the right Möbius (fractional linear) transform of y:
 // 		(a*y + b) * Inv(c*y + d)
-// Then it returns z.
+// Then it returns z. If the denominator has no inverse, then MöbiusR panics
+// and leaves z unchanged.
 func (z *Supra) MöbiusR(y, a, b, c, d *Supra) *Supra {
-	z.Mul(a, y)
-	z.Add(z, b)
-	temp := new(Supra)
-	temp.Mul(c, y)
-	temp.Add(temp, d)
-	temp.Inv(temp)
-	return z.Mul(z, temp)
+	if _, err := z.MöbiusRChecked(y, a, b, c, d, ReportDegeneracy); err != nil {
+		panic(err.Error())
+	}
+	return z
+}
+
+// MöbiusRChecked is like MöbiusR, but it returns a *DegenerateError instead of
+// panicking when the denominator has no inverse, and leaves z unchanged. If
+// policy is ProjectiveInfinity, then a zero denominator with a non-zero
+// numerator sets z equal to the point at infinity instead.
+func (z *Supra) MöbiusRChecked(y, a, b, c, d *Supra, policy DegeneracyPolicy) (*Supra, error) {
+	zero := new(Supra)
+	num := new(Supra).Add(new(Supra).Mul(a, y), b)
+	den := new(Supra).Add(new(Supra).Mul(c, y), d)
+	inf, err := checkQuotient("MöbiusR", num.Equals(zero), den.Equals(zero), den.IsZeroDiv(), policy)
+	switch {
+	case err != nil:
+		return z, err
+	case inf:
+		setInf(&z.l.l, &z.l.r, &z.r.l, &z.r.r)
+		return z, nil
+	}
+	return z.Mul(num, new(Supra).Inv(den)), nil
 }
 
 // Generate returns a random Supra value for quick.Check testing.
@@ -343,3 +343,35 @@ func XTestSupraComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestSupraCrossRatio(t *testing.T) {
+	f := func(v, w, x, y *Supra) bool {
+		// t.Logf("v = %v, w = %v, x = %v, y = %v", v, w, x, y)
+		// The real parts of w - x and v - y are non-zero.
+		w.l.l.Add(&w.l.l, big.NewFloat(2))
+		v.l.l.Sub(&v.l.l, big.NewFloat(2))
+		l, err := new(Supra).CrossRatioLChecked(v, w, x, y, ReportDegeneracy)
+		if err != nil || !l.Equals(new(Supra).CrossRatioL(v, w, x, y)) {
+			return false
+		}
+		r, err := new(Supra).CrossRatioRChecked(v, w, x, y, ReportDegeneracy)
+		if err != nil || !r.Equals(new(Supra).CrossRatioR(v, w, x, y)) {
+			return false
+		}
+		return l.Equals(new(Supra).CrossFloatioL(v, w, x, y)) &&
+			r.Equals(new(Supra).CrossFloatioR(v, w, x, y))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSupraCommutatorAlias(t *testing.T) {
+	f := func(x, y *Supra) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		return commutatorAliased(x, y)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strings"
+)
+
+var symbSupraCockle = [16]string{
+	"", "i", "t", "u", "α", "β", "γ", "δ",
+	"ε", "ζ", "η", "θ", "ι", "κ", "λ", "μ",
+}
+
+// A SupraCockle represents a multi-precision floating-point supra-Cockle
+// quaternion, the Cayley-Dickson double of an infra-Cockle quaternion by a
+// nilpotent unit, as SupraComplex is the double of InfraComplex.
+type SupraCockle struct {
+	l, r InfraCockle
+}
+
+// Real returns the real part of z.
+func (z *SupraCockle) Real() *big.Float {
+	return (&z.l).Real()
+}
+
+// Cartesian returns the sixteen multi-precision floating-point Cartesian
+// components of z.
+func (z *SupraCockle) Cartesian() [16]*big.Float {
+	var c [16]*big.Float
+	c[0], c[1], c[2], c[3], c[4], c[5], c[6], c[7] = z.l.Cartesian()
+	c[8], c[9], c[10], c[11], c[12], c[13], c[14], c[15] = z.r.Cartesian()
+	return c
+}
+
+// String returns the string representation of a SupraCockle value.
+//
+// If z corresponds to a + bi + ct + du + eα + ... + pμ, then the string is
+// "(a+bi+ct+du+eα+...+pμ)", similar to complex128 values.
+func (z *SupraCockle) String() string {
+	v := z.Cartesian()
+	a := make([]string, 33)
+	a[0] = "("
+	a[1] = fmt.Sprintf("%v", v[0])
+	i := 1
+	for j := 2; j < 32; j = j + 2 {
+		if v[i].Sign() < 0 {
+			a[j] = fmt.Sprintf("%v", v[i])
+		} else {
+			a[j] = fmt.Sprintf("+%v", v[i])
+		}
+		a[j+1] = symbSupraCockle[i]
+		i++
+	}
+	a[32] = ")"
+	return strings.Join(a, "")
+}
+
+// Format implements fmt.Formatter, with the conventions of the Format method
+// of Complex.
+func (z *SupraCockle) Format(s fmt.State, verb rune) {
+	c := z.Cartesian()
+	formatTerms(s, verb, z, symbSupraCockle[:], c[:])
+}
+
+// Equals returns true if y and z are equal.
+func (z *SupraCockle) Equals(y *SupraCockle) bool {
+	if !z.l.Equals(&y.l) || !z.r.Equals(&y.r) {
+		return false
+	}
+	return true
+}
+
+// Copy copies y onto z, and returns z.
+func (z *SupraCockle) Copy(y *SupraCockle) *SupraCockle {
+	z.l.Copy(&y.l)
+	z.r.Copy(&y.r)
+	return z
+}
+
+// NewSupraCockle returns a pointer to the SupraCockle value with Cartesian
+// components c, where c[0] is the real part and c[k] is the coefficient of the
+// k-th unit in the order 1, i, t, u, α, β, γ, δ, ε, ζ, η, θ, ι, κ, λ, μ.
+func NewSupraCockle(c [16]*big.Float) *SupraCockle {
+	z := new(SupraCockle)
+	for k, v := range z.Cartesian() {
+		v.Copy(c[k])
+	}
+	return z
+}
+
+// Scal sets z equal to y scaled by a, and returns z.
+func (z *SupraCockle) Scal(y *SupraCockle, a *big.Float) *SupraCockle {
+	z.l.Scal(&y.l, a)
+	z.r.Scal(&y.r, a)
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *SupraCockle) Neg(y *SupraCockle) *SupraCockle {
+	z.l.Neg(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Conj sets z equal to the conjugate of y, and returns z.
+func (z *SupraCockle) Conj(y *SupraCockle) *SupraCockle {
+	z.l.Conj(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Add sets z equal to x+y, and returns z.
+func (z *SupraCockle) Add(x, y *SupraCockle) *SupraCockle {
+	z.l.Add(&x.l, &y.l)
+	z.r.Add(&x.r, &y.r)
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z.
+func (z *SupraCockle) Sub(x, y *SupraCockle) *SupraCockle {
+	z.l.Sub(&x.l, &y.l)
+	z.r.Sub(&x.r, &y.r)
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z.
+//
+// With x = (a, b) and y = (c, d) as pairs of InfraCockle values, the product
+// is given by the Cayley-Dickson formula with a nilpotent unit
+// 		(Mul(a, c), Mul(d, a) + Mul(b, Conj(c)))
+// The units i, t, and u multiply as for Cockle, and α and ε are nilpotent:
+// 		Mul(α, α) = Mul(ε, ε) = 0
+// 		Mul(i, α) = β, Mul(t, α) = γ, Mul(u, α) = δ
+// 		Mul(v, ε) = -Mul(ε, v) = w
+// where v is one of i, t, u, α, β, γ, δ and w is the unit eight places later
+// in the order of NewSupraCockle, so that Mul(i, ε) = ζ, Mul(α, ε) = ι, and
+// Mul(δ, ε) = μ.
+// This binary operation is noncommutative, nonassociative, and not even
+// alternative, but it is flexible. It has zero divisors; see IsZeroDiv.
+// Unless the components of x or y differ widely in scale, the product is
+// computed exactly and then rounded once, so the result is exact whenever it
+// fits the precision of z; otherwise it is computed with rounded arithmetic.
+func (z *SupraCockle) Mul(x, y *SupraCockle) *SupraCockle {
+	c, d := x.Cartesian(), y.Cartesian()
+	prec := maxPrec(append(c[:], d[:]...)...)
+	zc := z.Cartesian()
+	if fitsExact(prec, zc[:], c[:], d[:]) {
+		temp := new(InfraCockle)
+		l := new(InfraCockle).mulExact(&x.l, &y.l)
+		r := new(InfraCockle).addExact(
+			new(InfraCockle).mulExact(&y.r, &x.l),
+			temp.mulExact(&x.r, temp.Conj(&y.l)),
+		)
+		z.l.roundInfraCockle(l, prec)
+		z.r.roundInfraCockle(r, prec)
+		return z
+	}
+	mulDoubling(0, &z.l, &z.r, &x.l, &x.r, &y.l, &y.r)
+	return z
+}
+
+// Commutator sets z equal to the commutator of x and y:
+// 		Mul(x, y) - Mul(y, x)
+// Then it returns z.
+func (z *SupraCockle) Commutator(x, y *SupraCockle) *SupraCockle {
+	return z.Sub(
+		new(SupraCockle).Mul(x, y),
+		new(SupraCockle).Mul(y, x),
+	)
+}
+
+// Associator sets z equal to the associator of w, x, and y:
+// 		Mul(Mul(w, x), y) - Mul(w, Mul(x, y))
+// Then it returns z.
+func (z *SupraCockle) Associator(w, x, y *SupraCockle) *SupraCockle {
+	t := new(SupraCockle).Mul(w, x)
+	t.Mul(t, y)
+	u := new(SupraCockle).Mul(x, y)
+	u.Mul(w, u)
+	return z.Sub(t, u)
+}
+
+// Quad returns the quadrance of z. If z = a+bi+ct+du+eα+...+pμ, then the
+// quadrance is
+// 		Mul(a, a) + Mul(b, b) - Mul(c, c) - Mul(d, d)
+// This can be positive, negative, or zero.
+func (z *SupraCockle) Quad() *big.Float {
+	return z.l.Quad()
+}
+
+// IsZeroDiv returns true if z is a zero divisor. This is equivalent to the
+// Cockle part a+bi+ct+du of z being a zero divisor; otherwise the nilpotent
+// part of z only adds terms of higher order in α and ε to a product, and left
+// multiplication by z is invertible.
+func (z *SupraCockle) IsZeroDiv() bool {
+	return z.l.IsZeroDiv()
+}
+
+// Inv sets z equal to the inverse of y, and returns z. The inverse is
+// Conj(y)/Quad(y), which satisfies Mul(y, z) = Mul(z, y) = 1, though since
+// Mul is not alternative it cannot be used to solve Mul(y, w) = x. If y is a
+// zero divisor, then Inv panics.
+func (z *SupraCockle) Inv(y *SupraCockle) *SupraCockle {
+	if y.IsZeroDiv() {
+		panic("inverse of zero divisor")
+	}
+	quad := y.Quad()
+	z.Conj(y)
+	z.l.quoQuad(&z.l, quad)
+	z.r.quoQuad(&z.r, quad)
+	return z
+}
+
+// Generate returns a random SupraCockle value for quick.Check testing.
+func (z *SupraCockle) Generate(rand *rand.Rand, size int) reflect.Value {
+	randomSupraCockle := new(SupraCockle)
+	for _, v := range randomSupraCockle.Cartesian() {
+		v.SetFloat64(rand.Float64())
+	}
+	return reflect.ValueOf(randomSupraCockle)
+}
@@ -0,0 +1,272 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strings"
+)
+
+var symbSupraComplex = [8]string{"", "i", "β", "γ", "δ", "ε", "ζ", "η"}
+
+// A SupraComplex represents a multi-precision floating-point supra-complex
+// number, the Cayley-Dickson double of an infra-complex number by a nilpotent
+// unit, as Supra is the double of Infra.
+type SupraComplex struct {
+	l, r InfraComplex
+}
+
+// Real returns the real part of z.
+func (z *SupraComplex) Real() *big.Float {
+	return (&z.l).Real()
+}
+
+// Cartesian returns the eight multi-precision floating-point Cartesian
+// components of z.
+func (z *SupraComplex) Cartesian() (*big.Float, *big.Float, *big.Float, *big.Float, *big.Float, *big.Float, *big.Float, *big.Float) {
+	return &z.l.l.l, &z.l.l.r, &z.l.r.l, &z.l.r.r, &z.r.l.l, &z.r.l.r, &z.r.r.l, &z.r.r.r
+}
+
+// String returns the string representation of a SupraComplex value.
+//
+// If z corresponds to a + bi + cβ + dγ + eδ + fε + gζ + hη, then the string is
+// "(a+bi+cβ+dγ+eδ+fε+gζ+hη)", similar to complex128 values.
+func (z *SupraComplex) String() string {
+	v := make([]*big.Float, 8)
+	v[0], v[1], v[2], v[3] = z.l.Cartesian()
+	v[4], v[5], v[6], v[7] = z.r.Cartesian()
+	a := make([]string, 17)
+	a[0] = "("
+	a[1] = fmt.Sprintf("%v", v[0])
+	i := 1
+	for j := 2; j < 16; j = j + 2 {
+		if v[i].Sign() < 0 {
+			a[j] = fmt.Sprintf("%v", v[i])
+		} else {
+			a[j] = fmt.Sprintf("+%v", v[i])
+		}
+		a[j+1] = symbSupraComplex[i]
+		i++
+	}
+	a[16] = ")"
+	return strings.Join(a, "")
+}
+
+// Format implements fmt.Formatter, with the conventions of the Format method
+// of Complex.
+func (z *SupraComplex) Format(s fmt.State, verb rune) {
+	formatTerms(s, verb, z, symbSupraComplex[:], floatSlice(z.Cartesian()))
+}
+
+// Equals returns true if y and z are equal.
+func (z *SupraComplex) Equals(y *SupraComplex) bool {
+	if !z.l.Equals(&y.l) || !z.r.Equals(&y.r) {
+		return false
+	}
+	return true
+}
+
+// Copy copies y onto z, and returns z.
+func (z *SupraComplex) Copy(y *SupraComplex) *SupraComplex {
+	z.l.Copy(&y.l)
+	z.r.Copy(&y.r)
+	return z
+}
+
+// NewSupraComplex returns a pointer to the SupraComplex value
+// a+bi+cβ+dγ+eδ+fε+gζ+hη.
+func NewSupraComplex(a, b, c, d, e, f, g, h *big.Float) *SupraComplex {
+	z := new(SupraComplex)
+	z.l.l.l.Copy(a)
+	z.l.l.r.Copy(b)
+	z.l.r.l.Copy(c)
+	z.l.r.r.Copy(d)
+	z.r.l.l.Copy(e)
+	z.r.l.r.Copy(f)
+	z.r.r.l.Copy(g)
+	z.r.r.r.Copy(h)
+	return z
+}
+
+// Scal sets z equal to y scaled by a, and returns z.
+func (z *SupraComplex) Scal(y *SupraComplex, a *big.Float) *SupraComplex {
+	z.l.Scal(&y.l, a)
+	z.r.Scal(&y.r, a)
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *SupraComplex) Neg(y *SupraComplex) *SupraComplex {
+	z.l.Neg(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Conj sets z equal to the conjugate of y, and returns z.
+func (z *SupraComplex) Conj(y *SupraComplex) *SupraComplex {
+	z.l.Conj(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Add sets z equal to x+y, and returns z.
+func (z *SupraComplex) Add(x, y *SupraComplex) *SupraComplex {
+	z.l.Add(&x.l, &y.l)
+	z.r.Add(&x.r, &y.r)
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z.
+func (z *SupraComplex) Sub(x, y *SupraComplex) *SupraComplex {
+	z.l.Sub(&x.l, &y.l)
+	z.r.Sub(&x.r, &y.r)
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z.
+//
+// With x = (a, b) and y = (c, d) as pairs of InfraComplex values, the product
+// is given by the Cayley-Dickson formula with a nilpotent unit
+// 		(Mul(a, c), Mul(d, a) + Mul(b, Conj(c)))
+// so that Mul(δ, δ) = 0 and Mul(i, δ) = ε, Mul(β, δ) = ζ, Mul(γ, δ) = η.
+// This binary operation is noncommutative and nonassociative, but it is
+// alternative.
+// Unless the components of x or y differ widely in scale, the product is
+// computed exactly and then rounded once, so the result is exact whenever it
+// fits the precision of z; otherwise it is computed with rounded arithmetic.
+func (z *SupraComplex) Mul(x, y *SupraComplex) *SupraComplex {
+	a, b, c, d, e, f, g, h := x.Cartesian()
+	s, t, u, v, w, m, n, p := y.Cartesian()
+	prec := maxPrec(a, b, c, d, e, f, g, h, s, t, u, v, w, m, n, p)
+	z0, z1, z2, z3, z4, z5, z6, z7 := z.Cartesian()
+	if fitsExact(prec,
+		[]*big.Float{z0, z1, z2, z3, z4, z5, z6, z7},
+		[]*big.Float{a, b, c, d, e, f, g, h},
+		[]*big.Float{s, t, u, v, w, m, n, p},
+	) {
+		temp := new(InfraComplex)
+		l := new(InfraComplex).mulExact(&x.l, &y.l)
+		r := new(InfraComplex).addExact(
+			new(InfraComplex).mulExact(&y.r, &x.l),
+			temp.mulExact(&x.r, temp.Conj(&y.l)),
+		)
+		z.l.roundInfraComplex(l, prec)
+		z.r.roundInfraComplex(r, prec)
+		return z
+	}
+	mulDoubling(0, &z.l, &z.r, &x.l, &x.r, &y.l, &y.r)
+	return z
+}
+
+// Commutator sets z equal to the commutator of x and y:
+// 		Mul(x, y) - Mul(y, x)
+// Then it returns z.
+func (z *SupraComplex) Commutator(x, y *SupraComplex) *SupraComplex {
+	return z.Sub(
+		new(SupraComplex).Mul(x, y),
+		new(SupraComplex).Mul(y, x),
+	)
+}
+
+// Associator sets z equal to the associator of w, x, and y:
+// 		Mul(Mul(w, x), y) - Mul(w, Mul(x, y))
+// Then it returns z.
+func (z *SupraComplex) Associator(w, x, y *SupraComplex) *SupraComplex {
+	t := new(SupraComplex).Mul(w, x)
+	t.Mul(t, y)
+	u := new(SupraComplex).Mul(x, y)
+	u.Mul(w, u)
+	return z.Sub(t, u)
+}
+
+// Quad returns the quadrance of z. If z = a+bi+cβ+dγ+eδ+fε+gζ+hη, then the
+// quadrance is
+// 		Mul(a, a) + Mul(b, b)
+// This is always non-negative.
+func (z *SupraComplex) Quad() *big.Float {
+	return z.l.Quad()
+}
+
+// IsZeroDiv returns true if z is a zero divisor. This is equivalent to the
+// complex part a+bi of z being zero.
+func (z *SupraComplex) IsZeroDiv() bool {
+	return z.l.IsZeroDiv()
+}
+
+// Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
+// then Inv panics.
+func (z *SupraComplex) Inv(y *SupraComplex) *SupraComplex {
+	if y.IsZeroDiv() {
+		panic("inverse of zero divisor")
+	}
+	quad := y.Quad()
+	z.Conj(y)
+	return z.quoQuad(z, quad)
+}
+
+// quoQuad sets z equal to y with every component divided by quad, and returns
+// z.
+func (z *SupraComplex) quoQuad(y *SupraComplex, quad *big.Float) *SupraComplex {
+	a, b, c, d, e, f, g, h := y.Cartesian()
+	s, t, u, v, w, m, n, p := z.Cartesian()
+	s.Quo(a, quad)
+	t.Quo(b, quad)
+	u.Quo(c, quad)
+	v.Quo(d, quad)
+	w.Quo(e, quad)
+	m.Quo(f, quad)
+	n.Quo(g, quad)
+	p.Quo(h, quad)
+	return z
+}
+
+// QuoL sets z equal to the left quotient of x and y:
+// 		Mul(Inv(y), x)
+// Then it returns z. Since Mul is alternative, this is the solution of
+// Mul(y, z) = x. If y is a zero divisor, then QuoL panics.
+func (z *SupraComplex) QuoL(x, y *SupraComplex) *SupraComplex {
+	if y.IsZeroDiv() {
+		panic("denominator is zero divisor")
+	}
+	quad := y.Quad()
+	t := new(SupraComplex).Conj(y)
+	t.Mul(t, x)
+	return z.quoQuad(t, quad)
+}
+
+// QuoR sets z equal to the right quotient of x and y:
+// 		Mul(x, Inv(y))
+// Then it returns z. Since Mul is alternative, this is the solution of
+// Mul(z, y) = x. If y is a zero divisor, then QuoR panics.
+func (z *SupraComplex) QuoR(x, y *SupraComplex) *SupraComplex {
+	if y.IsZeroDiv() {
+		panic("denominator is zero divisor")
+	}
+	quad := y.Quad()
+	t := new(SupraComplex).Conj(y)
+	t.Mul(x, t)
+	return z.quoQuad(t, quad)
+}
+
+// Generate returns a random SupraComplex value for quick.Check testing.
+func (z *SupraComplex) Generate(rand *rand.Rand, size int) reflect.Value {
+	randomSupraComplex := &SupraComplex{
+		*NewInfraComplex(
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+		),
+		*NewInfraComplex(
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+		),
+	}
+	return reflect.ValueOf(randomSupraComplex)
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+// supraComplexBasis returns the basis element of index k, with 0 for 1.
+func supraComplexBasis(k int) *SupraComplex {
+	z := new(SupraComplex)
+	a, b, c, d, e, f, g, h := z.Cartesian()
+	[]*big.Float{a, b, c, d, e, f, g, h}[k].SetInt64(1)
+	return z
+}
+
+// closeToSupraComplex returns true if the components of x and y agree to
+// about bits bits, relative to the larger of their modulus and 1.
+func closeToSupraComplex(x, y *SupraComplex, bits int) bool {
+	return closeToComplex(&x.l.l, &y.l.l, bits) && closeToComplex(&x.l.r, &y.l.r, bits) &&
+		closeToComplex(&x.r.l, &y.r.l, bits) && closeToComplex(&x.r.r, &y.r.r, bits)
+}
+
+func TestSupraComplexBasis(t *testing.T) {
+	// i δ = ε, β δ = ζ, γ δ = η
+	rules := [][3]int{{1, 4, 5}, {2, 4, 6}, {3, 4, 7}}
+	for _, r := range rules {
+		x, y, want := supraComplexBasis(r[0]), supraComplexBasis(r[1]), supraComplexBasis(r[2])
+		if got := new(SupraComplex).Mul(x, y); !got.Equals(want) {
+			t.Errorf("Mul(%s, %s) = %v", symbSupraComplex[r[0]], symbSupraComplex[r[1]], got)
+		}
+	}
+	if got := new(SupraComplex).Mul(supraComplexBasis(1), supraComplexBasis(1)); !got.Equals(new(SupraComplex).Neg(supraComplexBasis(0))) {
+		t.Errorf("Mul(i, i) = %v", got)
+	}
+	for k := 2; k < 8; k++ {
+		if got := new(SupraComplex).Mul(supraComplexBasis(k), supraComplexBasis(k)); !got.Equals(new(SupraComplex)) {
+			t.Errorf("Mul(%s, %s) = %v", symbSupraComplex[k], symbSupraComplex[k], got)
+		}
+	}
+}
+
+func TestSupraComplexAddCommutative(t *testing.T) {
+	f := func(x, y *SupraComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(SupraComplex).Add(x, y)
+		r := new(SupraComplex).Add(y, x)
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSupraComplexMulNonCommutative(t *testing.T) {
+	f := func(x, y *SupraComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(SupraComplex).Commutator(x, y)
+		zero := new(SupraComplex)
+		return !l.Equals(zero)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSupraComplexMulNonAssociative(t *testing.T) {
+	f := func(x, y, z *SupraComplex) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		l := new(SupraComplex).Associator(x, y, z)
+		return !closeToSupraComplex(l, new(SupraComplex), 20)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSupraComplexMulAlternative(t *testing.T) {
+	f := func(x, y *SupraComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(SupraComplex).Associator(x, x, y)
+		r := new(SupraComplex).Associator(x, y, y)
+		zero := new(SupraComplex)
+		return closeToSupraComplex(l, zero, 45) && closeToSupraComplex(r, zero, 45)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSupraComplexConjInvolutive(t *testing.T) {
+	f := func(x *SupraComplex) bool {
+		// t.Logf("x = %v", x)
+		l := new(SupraComplex).Conj(new(SupraComplex).Conj(x))
+		return l.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSupraComplexMulConjAntiDistributive(t *testing.T) {
+	f := func(x, y *SupraComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(SupraComplex).Conj(new(SupraComplex).Mul(x, y))
+		r := new(SupraComplex).Mul(new(SupraComplex).Conj(y), new(SupraComplex).Conj(x))
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSupraComplexQuadPositive(t *testing.T) {
+	f := func(x *SupraComplex) bool {
+		// t.Logf("x = %v", x)
+		return x.Quad().Sign() > 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSupraComplexComposition(t *testing.T) {
+	f := func(x, y *SupraComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(SupraComplex).Mul(x, y).Quad()
+		r := new(big.Float).Mul(x.Quad(), y.Quad())
+		return closeTo(l, r, 45)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSupraComplexZeroDivisor(t *testing.T) {
+	x := supraComplexBasis(2)
+	y := supraComplexBasis(4)
+	if !x.IsZeroDiv() || !y.IsZeroDiv() {
+		t.Errorf("IsZeroDiv(β) = %v, IsZeroDiv(δ) = %v", x.IsZeroDiv(), y.IsZeroDiv())
+	}
+	if got := new(SupraComplex).Mul(y, y); !got.Equals(new(SupraComplex)) {
+		t.Errorf("Mul(δ, δ) = %v", got)
+	}
+}
+
+func TestSupraComplexQuo(t *testing.T) {
+	f := func(x, y *SupraComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(SupraComplex).Mul(y, new(SupraComplex).QuoL(x, y))
+		r := new(SupraComplex).Mul(new(SupraComplex).QuoR(x, y), y)
+		return closeToSupraComplex(l, x, 40) && closeToSupraComplex(r, x, 40)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSupraComplexCommutatorAlias(t *testing.T) {
+	f := func(x, y *SupraComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		return commutatorAliased(x, y)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
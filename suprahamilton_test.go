@@ -0,0 +1,143 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+// supraHamiltonBasis returns the basis element of index k, with 0 for 1.
+func supraHamiltonBasis(k int) *SupraHamilton {
+	z := new(SupraHamilton)
+	z.Cartesian()[k].SetInt64(1)
+	return z
+}
+
+// closeToSupraHamilton returns true if the components of x and y agree to
+// about bits bits, relative to the larger of their modulus and 1.
+func closeToSupraHamilton(x, y *SupraHamilton, bits int) bool {
+	c, d := x.Cartesian(), y.Cartesian()
+	zero := new(big.Float)
+	for k := range c {
+		if !closeToComplex(NewComplex(c[k], zero), NewComplex(d[k], zero), bits) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSupraHamiltonBasis(t *testing.T) {
+	// i j = k, i α = β, i ε = ζ, α ε = ι, δ ε = μ, ε i = -ζ
+	rules := [][4]int{{1, 2, 3, 1}, {1, 4, 5, 1}, {1, 8, 9, 1}, {4, 8, 12, 1}, {7, 8, 15, 1}, {8, 1, 9, -1}}
+	for _, r := range rules {
+		x, y, want := supraHamiltonBasis(r[0]), supraHamiltonBasis(r[1]), supraHamiltonBasis(r[2])
+		if r[3] < 0 {
+			want.Neg(want)
+		}
+		if got := new(SupraHamilton).Mul(x, y); !got.Equals(want) {
+			t.Errorf("Mul(%s, %s) = %v", symbSupraHamilton[r[0]], symbSupraHamilton[r[1]], got)
+		}
+	}
+	for k := 1; k < 16; k++ {
+		want := new(SupraHamilton)
+		if k < 4 {
+			want.Neg(supraHamiltonBasis(0))
+		}
+		if got := new(SupraHamilton).Mul(supraHamiltonBasis(k), supraHamiltonBasis(k)); !got.Equals(want) {
+			t.Errorf("Mul(%s, %s) = %v", symbSupraHamilton[k], symbSupraHamilton[k], got)
+		}
+	}
+}
+
+func TestSupraHamiltonMulNonAlternative(t *testing.T) {
+	f := func(x, y *SupraHamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(SupraHamilton).Associator(x, x, y)
+		return !l.Equals(new(SupraHamilton))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSupraHamiltonMulFlexible(t *testing.T) {
+	f := func(x, y *SupraHamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(SupraHamilton).Associator(x, y, x)
+		return closeToSupraHamilton(l, new(SupraHamilton), 48)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSupraHamiltonMulConjAntiDistributive(t *testing.T) {
+	f := func(x, y *SupraHamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l, r := new(SupraHamilton), new(SupraHamilton)
+		l.Conj(l.Mul(x, y))
+		r.Mul(r.Conj(y), new(SupraHamilton).Conj(x))
+		return closeToSupraHamilton(l, r, 48)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSupraHamiltonComposition(t *testing.T) {
+	f := func(x, y *SupraHamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		p := new(SupraHamilton).Mul(x, y)
+		a, b := p.Quad(), new(big.Float).Mul(x.Quad(), y.Quad())
+		return closeTo(a, b, 48)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSupraHamiltonInv(t *testing.T) {
+	f := func(x *SupraHamilton) bool {
+		// t.Logf("x = %v", x)
+		inv := new(SupraHamilton).Inv(x)
+		l := new(SupraHamilton).Mul(x, inv)
+		r := new(SupraHamilton).Mul(inv, x)
+		one := supraHamiltonBasis(0)
+		return closeToSupraHamilton(l, one, 48) && closeToSupraHamilton(r, one, 48)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSupraHamiltonZeroDivisor(t *testing.T) {
+	x := new(SupraHamilton).Add(supraHamiltonBasis(4), supraHamiltonBasis(9))
+	if !x.IsZeroDiv() {
+		t.Errorf("IsZeroDiv(%v) = false", x)
+	}
+	if y := supraHamiltonBasis(12); !new(SupraHamilton).Mul(x, y).Equals(new(SupraHamilton)) {
+		t.Errorf("Mul(%v, ι) = %v", x, new(SupraHamilton).Mul(x, y))
+	}
+	if supraHamiltonBasis(2).IsZeroDiv() {
+		t.Errorf("IsZeroDiv(j) = true")
+	}
+	defer func() {
+		if r := recover(); r != "inverse of zero divisor" {
+			t.Errorf("Inv(%v) recovered %v", x, r)
+		}
+	}()
+	new(SupraHamilton).Inv(x)
+}
+
+func TestSupraHamiltonCommutatorAlias(t *testing.T) {
+	f := func(x, y *SupraHamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		return commutatorAliased(x, y)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+	"runtime"
+	"sync"
+)
+
+// A Checkpoints records named intermediate values of one run of a computation
+// in a precision sweep.
+type Checkpoints struct {
+	mu     sync.Mutex
+	names  []string
+	values map[string]*big.Float
+}
+
+// Record saves a copy of x under name. Recording a name again replaces the
+// value.
+func (c *Checkpoints) Record(name string, x *big.Float) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.values == nil {
+		c.values = make(map[string]*big.Float)
+	}
+	if _, ok := c.values[name]; !ok {
+		c.names = append(c.names, name)
+	}
+	c.values[name] = new(big.Float).Copy(x)
+}
+
+// A SweepCheckpoint holds the values of one checkpoint across a precision
+// sweep.
+type SweepCheckpoint struct {
+	Name string
+	// Values holds the value recorded at each precision of the sweep, in the
+	// order of the precisions, or nil where the run did not record it.
+	Values []*big.Float
+	// Digits holds, for each precision but the last, the number of decimal
+	// digits on which its value agrees with the value at the next precision,
+	// or -1 if either value is missing. When the precisions increase, the
+	// last entry estimates the digits that have converged.
+	Digits []int
+}
+
+// Converged returns the number of decimal digits of the checkpoint that agree
+// between the last two precisions of the sweep, or -1 if there are fewer than
+// two precisions or a value is missing.
+func (s *SweepCheckpoint) Converged() int {
+	if len(s.Digits) == 0 {
+		return -1
+	}
+	return s.Digits[len(s.Digits)-1]
+}
+
+// agreeDigits returns the number of decimal digits on which x and y agree,
+// measured by their difference relative to the larger of the two, or
+// maxDigits if they are equal. An infinite value agrees on no digits with
+// anything but the same infinity.
+func agreeDigits(x, y *big.Float, maxDigits int) int {
+	if x.Cmp(y) == 0 {
+		return maxDigits
+	}
+	if x.IsInf() || y.IsInf() {
+		return 0
+	}
+	diff := exactSub(x, y)
+	scale := new(big.Float).Abs(x)
+	if a := new(big.Float).Abs(y); a.Cmp(scale) > 0 {
+		scale = a
+	}
+	if scale.Sign() == 0 {
+		return maxDigits
+	}
+	rel := newFloat(64).Quo(diff.Abs(diff), scale)
+	// Split off the exponent, since rel may underflow float64.
+	mant := new(big.Float)
+	exp := rel.MantExp(mant)
+	f, _ := mant.Float64()
+	d := -(math.Log10(f) + float64(exp)*math.Log10(2))
+	if d < 0 {
+		return 0
+	}
+	if n := int(math.Floor(d)); n < maxDigits {
+		return n
+	}
+	return maxDigits
+}
+
+// SweepPrecision runs f once at each precision of precs, with at most workers
+// runs at a time, and returns the checkpoints the runs record, in the order
+// in which the run at the last precision first recorded them, followed by any
+// recorded only at other precisions. If workers is not positive, then
+// GOMAXPROCS is used. Every run returns before SweepPrecision does; if a run
+// panics, then SweepPrecision panics with the same value once the others have
+// finished.
+//
+// Evaluating the same computation at several precisions is the usual way to
+// see how many digits of each intermediate result can be trusted, and the
+// runs are independent, so they are spread across cores.
+func SweepPrecision(precs []uint, workers int, f func(prec uint, c *Checkpoints)) []SweepCheckpoint {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	n := len(precs)
+	runs := make([]Checkpoints, n)
+	panics := make([]interface{}, n)
+	parallelFor(n, workers, func(i int) {
+		defer func() {
+			panics[i] = recover()
+		}()
+		f(precs[i], &runs[i])
+	})
+	for _, p := range panics {
+		if p != nil {
+			panic(p)
+		}
+	}
+	var names []string
+	seen := make(map[string]bool)
+	for i := n - 1; i >= 0; i-- {
+		for _, name := range runs[i].names {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	result := make([]SweepCheckpoint, len(names))
+	for k, name := range names {
+		s := SweepCheckpoint{Name: name, Values: make([]*big.Float, n)}
+		for i := range runs {
+			s.Values[i] = runs[i].values[name]
+		}
+		if n > 1 {
+			s.Digits = make([]int, n-1)
+		}
+		for i := 0; i+1 < n; i++ {
+			x, y := s.Values[i], s.Values[i+1]
+			if x == nil || y == nil {
+				s.Digits[i] = -1
+				continue
+			}
+			limit := x.Prec()
+			if y.Prec() < limit {
+				limit = y.Prec()
+			}
+			s.Digits[i] = agreeDigits(x, y, int(float64(limit)*math.Log10(2)))
+		}
+		result[k] = s
+	}
+	return result
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestSweepPrecision(t *testing.T) {
+	precs := []uint{64, 128, 256, 512}
+	sweep := SweepPrecision(precs, 0, func(prec uint, c *Checkpoints) {
+		pi := bigPi(prec)
+		c.Record("pi", pi)
+		// Cancellation: (π + 2^40) - 2^40 loses 40 bits at every precision.
+		shift := newFloat(prec).SetMantExp(big.NewFloat(1), 40)
+		x := newFloat(prec).Add(pi, shift)
+		x.Sub(x, shift)
+		c.Record("cancel", x)
+		if prec > 64 {
+			c.Record("extra", pi)
+		}
+	})
+	if len(sweep) != 3 {
+		t.Fatalf("len(SweepPrecision) = %d, want 3", len(sweep))
+	}
+	names := []string{"pi", "cancel", "extra"}
+	for k, s := range sweep {
+		if s.Name != names[k] {
+			t.Errorf("sweep[%d].Name = %q, want %q", k, s.Name, names[k])
+		}
+		if len(s.Values) != len(precs) || len(s.Digits) != len(precs)-1 {
+			t.Fatalf("%s: %d values and %d digits", s.Name, len(s.Values), len(s.Digits))
+		}
+	}
+	pi, cancel, extra := sweep[0], sweep[1], sweep[2]
+	// 64 bits is about 19 digits.
+	if d := pi.Digits[0]; d < 18 || d > 20 {
+		t.Errorf("pi.Digits[0] = %d, want about 19", d)
+	}
+	if d := cancel.Digits[0]; d < 5 || d > 8 {
+		t.Errorf("cancel.Digits[0] = %d, want about 7", d)
+	}
+	for i := 1; i < len(precs)-1; i++ {
+		if pi.Digits[i] <= pi.Digits[i-1] || cancel.Digits[i] >= pi.Digits[i] {
+			t.Errorf("pi.Digits = %v, cancel.Digits = %v", pi.Digits, cancel.Digits)
+		}
+	}
+	if extra.Values[0] != nil || extra.Digits[0] != -1 || extra.Converged() < 70 {
+		t.Errorf("extra = %+v", extra)
+	}
+}
+
+func TestSweepPrecisionPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf("recover() = %v, want boom", r)
+		}
+	}()
+	SweepPrecision([]uint{64, 128}, 2, func(prec uint, c *Checkpoints) {
+		if prec == 128 {
+			panic("boom")
+		}
+	})
+}
+
+func TestSweepPrecisionInf(t *testing.T) {
+	// The computation overflows at the lower precisions only.
+	sweep := SweepPrecision([]uint{64, 128, 256}, 0, func(prec uint, c *Checkpoints) {
+		x := big.NewFloat(1)
+		if prec < 256 {
+			x.SetInf(false)
+		}
+		c.Record("x", x)
+	})
+	if d := sweep[0].Digits; d[0] != 15 || d[1] != 0 {
+		t.Errorf("Digits = %v, want [15 0]", d)
+	}
+	inf, one := new(big.Float).SetInf(false), big.NewFloat(1)
+	for _, c := range []struct {
+		x, y *big.Float
+		want int
+	}{
+		{inf, one, 0},
+		{one, inf, 0},
+		{inf, new(big.Float).SetInf(true), 0},
+		{inf, inf, 50},
+	} {
+		if d := agreeDigits(c.x, c.y, 50); d != c.want {
+			t.Errorf("agreeDigits(%v, %v) = %d, want %d", c.x, c.y, d, c.want)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math/big"
+
+// The compact symplectic group Sp(n) is the group of n x n HamiltonMatrix
+// values m with
+// 		Mul(ConjTranspose(m), m) = 1
+// so Sp(1) is the group of unit Hamilton values, and Sp(2) acts on pairs of
+// Hamilton values by matrix multiplication, preserving
+// 		Quad(p) + Quad(q)
+// The group product is Mul, and the inverse is SymplecticInv.
+
+// NewSymplecticIdentity returns a pointer to the n x n identity matrix, the
+// identity of Sp(n). If n is negative, then NewSymplecticIdentity panics.
+func NewSymplecticIdentity(n int) *HamiltonMatrix {
+	m := NewHamiltonMatrix(n, n)
+	for i := 0; i < n; i++ {
+		m.At(i, i).l.l.SetInt64(1)
+	}
+	return m
+}
+
+// NewSymplecticFromPair returns a pointer to a 2x2 HamiltonMatrix value whose
+// first column is the pair (p, q). The second column is
+// 		(-Mul(p, Conj(q), p)/Quad(p), p)	if Quad(p) >= Quad(q)
+// 		(Conj(q), -Mul(q, Conj(p), Conj(q))/Quad(q))	otherwise
+// which is orthogonal to the first and has the same quadrance, so that if
+// Quad(p) + Quad(q) = 1, then the result is an element of Sp(2). The choice
+// depends on the pair, since Sp(2) has no continuous section over the unit
+// pairs. If p and q are both zero, then NewSymplecticFromPair panics.
+func NewSymplecticFromPair(p, q *Hamilton) *HamiltonMatrix {
+	m := NewHamiltonMatrix(2, 2)
+	m.At(0, 0).Copy(p)
+	m.At(1, 0).Copy(q)
+	qp, qq := p.Quad(), q.Quad()
+	if qp.Sign() == 0 && qq.Sign() == 0 {
+		panic("zero pair")
+	}
+	if qp.Cmp(qq) >= 0 {
+		a := m.At(0, 1)
+		a.Mul(p, new(Hamilton).Conj(q))
+		a.Mul(a, p)
+		a.Scal(a, new(big.Float).Quo(big.NewFloat(-1), qp))
+		m.At(1, 1).Copy(p)
+		return m
+	}
+	m.At(0, 1).Conj(q)
+	b := m.At(1, 1)
+	b.Mul(q, new(Hamilton).Conj(p))
+	b.Mul(b, new(Hamilton).Conj(q))
+	b.Scal(b, new(big.Float).Quo(big.NewFloat(-1), qq))
+	return m
+}
+
+// SymplecticPair returns the first column of the 2x2 matrix m, as a pair of
+// pointers to new Hamilton values. It undoes NewSymplecticFromPair. If m is
+// not 2x2, then SymplecticPair panics.
+func (m *HamiltonMatrix) SymplecticPair() (*Hamilton, *Hamilton) {
+	if m.rows != 2 || m.cols != 2 {
+		panic("not a 2x2 matrix")
+	}
+	return new(Hamilton).Copy(m.At(0, 0)), new(Hamilton).Copy(m.At(1, 0))
+}
+
+// SymplecticAct returns the pair obtained by multiplying the column (p, q) on
+// the left by the 2x2 matrix m, as pointers to new Hamilton values. If m is
+// not 2x2, then SymplecticAct panics.
+func (m *HamiltonMatrix) SymplecticAct(p, q *Hamilton) (*Hamilton, *Hamilton) {
+	if m.rows != 2 || m.cols != 2 {
+		panic("not a 2x2 matrix")
+	}
+	v := NewHamiltonMatrix(2, 1)
+	v.At(0, 0).Copy(p)
+	v.At(1, 0).Copy(q)
+	v.Mul(m, v)
+	return v.At(0, 0), v.At(1, 0)
+}
+
+// SymplecticInv sets m equal to the inverse of the element y of Sp(n), which
+// is ConjTranspose(y), and returns m. The result is the inverse of y only to
+// the extent that y is symplectic; see IsSymplectic.
+func (m *HamiltonMatrix) SymplecticInv(y *HamiltonMatrix) *HamiltonMatrix {
+	return m.ConjTranspose(y)
+}
+
+// IsSymplectic returns true if m is square and every component of
+// 		Mul(ConjTranspose(m), m) - 1
+// has absolute value at most tol.
+func (m *HamiltonMatrix) IsSymplectic(tol *big.Float) bool {
+	if m.rows != m.cols {
+		return false
+	}
+	d := new(HamiltonMatrix).ConjTranspose(m)
+	d.Mul(d, m)
+	d.Sub(d, NewSymplecticIdentity(m.rows))
+	for k := range d.e {
+		a, b, c, e := d.e[k].Cartesian()
+		for _, x := range []*big.Float{a, b, c, e} {
+			if new(big.Float).Abs(x).Cmp(tol) > 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
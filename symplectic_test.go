@@ -0,0 +1,68 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestSymplecticFromPair(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	tol := new(big.Float).SetMantExp(big.NewFloat(1), -190)
+	for n := 0; n < 20; n++ {
+		v := RandomUnitHamiltonVector(rng, 2, 200)
+		p, q := v[0], v[1]
+		if n%2 == 1 {
+			// Exercise both choices of the second column.
+			p, q = q, p
+		}
+		m := NewSymplecticFromPair(p, q)
+		if !m.IsSymplectic(tol) {
+			t.Fatalf("NewSymplecticFromPair(%v, %v) = %v, not symplectic", p, q, m)
+		}
+		if l, r := m.SymplecticPair(); !l.Equals(p) || !r.Equals(q) {
+			t.Errorf("SymplecticPair(%v) = (%v, %v), want (%v, %v)", m, l, r, p, q)
+		}
+	}
+}
+
+func TestSymplecticGroup(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	tol := new(big.Float).SetMantExp(big.NewFloat(1), -185)
+	for n := 0; n < 10; n++ {
+		v := RandomUnitHamiltonVector(rng, 2, 200)
+		w := RandomUnitHamiltonVector(rng, 2, 200)
+		x := NewSymplecticFromPair(v[0], v[1])
+		y := NewSymplecticFromPair(w[0], w[1])
+		xy := new(HamiltonMatrix).Mul(x, y)
+		if !xy.IsSymplectic(tol) {
+			t.Fatalf("Mul(%v, %v) = %v, not symplectic", x, y, xy)
+		}
+		one := new(HamiltonMatrix).Mul(new(HamiltonMatrix).SymplecticInv(xy), xy)
+		if !closeToHamiltonMatrix(one, NewSymplecticIdentity(2), 185) {
+			t.Errorf("Mul(SymplecticInv(%v), %v) = %v, want 1", xy, xy, one)
+		}
+		// The action of x sends the base pair (1, 0) to the pair of x, and it
+		// preserves the norm of any pair.
+		p, q := x.SymplecticAct(NewHamilton(big.NewFloat(1), new(big.Float), new(big.Float), new(big.Float)), new(Hamilton))
+		if !p.Equals(v[0]) || !q.Equals(v[1]) {
+			t.Errorf("SymplecticAct(%v, 1, 0) = (%v, %v), want (%v, %v)", x, p, q, v[0], v[1])
+		}
+		p, q = x.SymplecticAct(w[0], w[1])
+		if quad := new(big.Float).Add(p.Quad(), q.Quad()); !closeTo(quad, big.NewFloat(1), 185) {
+			t.Errorf("norm of SymplecticAct(%v, %v, %v) = %v, want 1", x, w[0], w[1], quad)
+		}
+	}
+}
+
+func TestSymplecticFromZeroPair(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("NewSymplecticFromPair(0, 0) did not panic")
+		}
+	}()
+	NewSymplecticFromPair(new(Hamilton), new(Hamilton))
+}
@@ -0,0 +1,216 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "sync"
+
+// A SyncComplex is a Complex value guarded by a mutex, which can be shared
+// between goroutines. The zero value holds zero and is ready to use.
+type SyncComplex struct {
+	mu sync.RWMutex
+	v  Complex
+}
+
+// Load sets z equal to the value held by s, and returns z.
+func (s *SyncComplex) Load(z *Complex) *Complex {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return z.Copy(&s.v)
+}
+
+// Store sets the value held by s equal to y.
+func (s *SyncComplex) Store(y *Complex) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.v.Copy(y)
+}
+
+// Update calls f with the value held by s while holding the lock, so that f
+// can modify the value in place without other goroutines observing a partial
+// update. The pointer passed to f must not be retained after f returns.
+func (s *SyncComplex) Update(f func(z *Complex)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f(&s.v)
+}
+
+// A SyncPerplex is a Perplex value guarded by a mutex, which can be shared
+// between goroutines. The zero value holds zero and is ready to use.
+type SyncPerplex struct {
+	mu sync.RWMutex
+	v  Perplex
+}
+
+// Load sets z equal to the value held by s, and returns z.
+func (s *SyncPerplex) Load(z *Perplex) *Perplex {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return z.Copy(&s.v)
+}
+
+// Store sets the value held by s equal to y.
+func (s *SyncPerplex) Store(y *Perplex) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.v.Copy(y)
+}
+
+// Update calls f with the value held by s while holding the lock, so that f
+// can modify the value in place without other goroutines observing a partial
+// update. The pointer passed to f must not be retained after f returns.
+func (s *SyncPerplex) Update(f func(z *Perplex)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f(&s.v)
+}
+
+// A SyncInfra is an Infra value guarded by a mutex, which can be shared between
+// goroutines. The zero value holds zero and is ready to use.
+type SyncInfra struct {
+	mu sync.RWMutex
+	v  Infra
+}
+
+// Load sets z equal to the value held by s, and returns z.
+func (s *SyncInfra) Load(z *Infra) *Infra {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return z.Copy(&s.v)
+}
+
+// Store sets the value held by s equal to y.
+func (s *SyncInfra) Store(y *Infra) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.v.Copy(y)
+}
+
+// Update calls f with the value held by s while holding the lock, so that f
+// can modify the value in place without other goroutines observing a partial
+// update. The pointer passed to f must not be retained after f returns.
+func (s *SyncInfra) Update(f func(z *Infra)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f(&s.v)
+}
+
+// A SyncHamilton is a Hamilton value guarded by a mutex, which can be shared
+// between goroutines. The zero value holds zero and is ready to use.
+type SyncHamilton struct {
+	mu sync.RWMutex
+	v  Hamilton
+}
+
+// Load sets z equal to the value held by s, and returns z.
+func (s *SyncHamilton) Load(z *Hamilton) *Hamilton {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return z.Copy(&s.v)
+}
+
+// Store sets the value held by s equal to y.
+func (s *SyncHamilton) Store(y *Hamilton) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.v.Copy(y)
+}
+
+// Update calls f with the value held by s while holding the lock, so that f
+// can modify the value in place without other goroutines observing a partial
+// update. The pointer passed to f must not be retained after f returns.
+func (s *SyncHamilton) Update(f func(z *Hamilton)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f(&s.v)
+}
+
+// A SyncCockle is a Cockle value guarded by a mutex, which can be shared
+// between goroutines. The zero value holds zero and is ready to use.
+type SyncCockle struct {
+	mu sync.RWMutex
+	v  Cockle
+}
+
+// Load sets z equal to the value held by s, and returns z.
+func (s *SyncCockle) Load(z *Cockle) *Cockle {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return z.Copy(&s.v)
+}
+
+// Store sets the value held by s equal to y.
+func (s *SyncCockle) Store(y *Cockle) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.v.Copy(y)
+}
+
+// Update calls f with the value held by s while holding the lock, so that f
+// can modify the value in place without other goroutines observing a partial
+// update. The pointer passed to f must not be retained after f returns.
+func (s *SyncCockle) Update(f func(z *Cockle)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f(&s.v)
+}
+
+// A SyncInfraComplex is an InfraComplex value guarded by a mutex, which can be
+// shared between goroutines. The zero value holds zero and is ready to use.
+type SyncInfraComplex struct {
+	mu sync.RWMutex
+	v  InfraComplex
+}
+
+// Load sets z equal to the value held by s, and returns z.
+func (s *SyncInfraComplex) Load(z *InfraComplex) *InfraComplex {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return z.Copy(&s.v)
+}
+
+// Store sets the value held by s equal to y.
+func (s *SyncInfraComplex) Store(y *InfraComplex) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.v.Copy(y)
+}
+
+// Update calls f with the value held by s while holding the lock, so that f
+// can modify the value in place without other goroutines observing a partial
+// update. The pointer passed to f must not be retained after f returns.
+func (s *SyncInfraComplex) Update(f func(z *InfraComplex)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f(&s.v)
+}
+
+// A SyncSupra is a Supra value guarded by a mutex, which can be shared between
+// goroutines. The zero value holds zero and is ready to use.
+type SyncSupra struct {
+	mu sync.RWMutex
+	v  Supra
+}
+
+// Load sets z equal to the value held by s, and returns z.
+func (s *SyncSupra) Load(z *Supra) *Supra {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return z.Copy(&s.v)
+}
+
+// Store sets the value held by s equal to y.
+func (s *SyncSupra) Store(y *Supra) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.v.Copy(y)
+}
+
+// Update calls f with the value held by s while holding the lock, so that f
+// can modify the value in place without other goroutines observing a partial
+// update. The pointer passed to f must not be retained after f returns.
+func (s *SyncSupra) Update(f func(z *Supra)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f(&s.v)
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+)
+
+func TestSyncHamiltonConcurrentUpdate(t *testing.T) {
+	var s SyncHamilton
+	one := NewHamilton(big.NewFloat(1), big.NewFloat(1), new(big.Float), new(big.Float))
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				s.Update(func(z *Hamilton) {
+					z.Add(z, one)
+				})
+				s.Load(new(Hamilton))
+			}
+		}()
+	}
+	wg.Wait()
+	want := NewHamilton(big.NewFloat(800), big.NewFloat(800), new(big.Float), new(big.Float))
+	if got := s.Load(new(Hamilton)); !got.Equals(want) {
+		t.Errorf("after concurrent updates, got %v, want %v", got, want)
+	}
+}
+
+func TestSyncComplexStoreLoad(t *testing.T) {
+	var s SyncComplex
+	x := NewComplex(big.NewFloat(1.5), big.NewFloat(-2))
+	s.Store(x)
+	x.l.SetInt64(7)
+	if got := s.Load(new(Complex)); !got.Equals(NewComplex(big.NewFloat(1.5), big.NewFloat(-2))) {
+		t.Errorf("Load = %v, want a copy of the stored value", got)
+	}
+}
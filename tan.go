@@ -0,0 +1,62 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+// Tan sets z equal to the tangent of y, and returns z.
+//
+// If y = a+bi, then the tangent is
+// 		(sin(a)cos(a) + sinh(b)cosh(b)i) / (cos²(a) + sinh²(b))
+// When |b| is so large that exp(-2|b|) is below the precision of y, the
+// hyperbolic terms would overflow; the tangent is then evaluated as
+// 		2sin(2a)exp(-2|b|) ± i
+// which agrees with the full formula to the precision of y. The result is
+// rounded to the precision of y. If cos²(a) + sinh²(b) vanishes, then Tan
+// panics.
+func (z *Complex) Tan(y *Complex) *Complex {
+	prec := maxPrec(&y.l, &y.r)
+	w := prec + guardBits
+	a := newFloat(w).Set(&y.l)
+	b := newFloat(w).Set(&y.r)
+	// exp(-2|b|) < 2**(-w) once |b| > w*log(2)/2.
+	limit := newFloat(w).SetFloat64(0.35)
+	limit.Mul(limit, newFloat(w).SetInt64(int64(w)))
+	if newFloat(w).Abs(b).Cmp(limit) > 0 {
+		s, _ := bigSinCos(a.SetMantExp(a, 1), w)
+		e := newFloat(w).Abs(b)
+		e = bigExp(e.Neg(e.SetMantExp(e, 1)), w)
+		z.l.SetPrec(prec)
+		z.r.SetPrec(prec)
+		z.l.Mul(s, e.SetMantExp(e, 1))
+		z.r.SetInt64(int64(b.Sign()))
+		return z
+	}
+	s, c := bigSinCos(a, w)
+	sh, ch := bigSinhCosh(b, w)
+	den := newFloat(w).Mul(c, c)
+	den.Add(den, newFloat(w).Mul(sh, sh))
+	if den.Sign() == 0 {
+		panic("tangent at a pole")
+	}
+	z.l.SetPrec(prec)
+	z.r.SetPrec(prec)
+	z.l.Quo(s.Mul(s, c), den)
+	z.r.Quo(sh.Mul(sh, ch), den)
+	return z
+}
+
+// Tanh sets z equal to the hyperbolic tangent of y, and returns z.
+//
+// The hyperbolic tangent is computed from the identity
+// 		tanh(y) = -i tan(iy)
+// so large real parts are handled as in Tan. The result is rounded to the
+// precision of y.
+func (z *Complex) Tanh(y *Complex) *Complex {
+	t := new(Complex)
+	t.l.Neg(&y.r)
+	t.r.Set(&y.l)
+	t.Tan(t)
+	z.l.SetPrec(t.r.Prec()).Set(&t.r)
+	z.r.SetPrec(t.l.Prec()).Neg(&t.l)
+	return z
+}
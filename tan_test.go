@@ -0,0 +1,46 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"math/cmplx"
+	"testing"
+	"testing/quick"
+)
+
+func TestComplexTanFloat64(t *testing.T) {
+	f := func(x *Complex) bool {
+		a, _ := x.l.Float64()
+		b, _ := x.r.Float64()
+		return closeToComplex128(new(Complex).Tan(x), cmplx.Tan(complex(a, b)), 48)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexTanLargeImaginary(t *testing.T) {
+	y := NewComplex(big.NewFloat(0.5), big.NewFloat(-1e12))
+	z := new(Complex).Tan(y)
+	if z.l.Sign() != 0 || z.r.Cmp(big.NewFloat(-1)) != 0 {
+		t.Errorf("Tan(%v) = %v", y, z)
+	}
+	y = NewComplex(big.NewFloat(0.5), big.NewFloat(30))
+	z.Tan(y)
+	if !closeToComplex128(z, cmplx.Tan(complex(0.5, 30)), 48) {
+		t.Errorf("Tan(%v) = %v", y, z)
+	}
+}
+
+func TestComplexTanhFloat64(t *testing.T) {
+	f := func(x *Complex) bool {
+		a, _ := x.l.Float64()
+		b, _ := x.r.Float64()
+		return closeToComplex128(new(Complex).Tanh(x), cmplx.Tanh(complex(a, b)), 48)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math"
+
+// A TaylorJet returns the first n Taylor coefficients
+// 		f(z), f'(z), f''(z)/2!, ..., f⁽ⁿ⁻¹⁾(z)/(n-1)!
+// of a function about z, at prec bits, given the value f of the function at
+// z on the current branch. For functions defined by a differential equation
+// the coefficients follow from the value by a recurrence, and for functions
+// with a single-valued derivative they can ignore the value beyond the first
+// coefficient.
+type TaylorJet func(z, f *Complex, n int, prec uint) []*Complex
+
+// taylorRadius returns an estimate of the radius of convergence of the series
+// with coefficients c, from the decay of its upper half, or +Inf if the upper
+// half is zero.
+func taylorRadius(c []*Complex) float64 {
+	lo, hi := -1, -1
+	for k := len(c) / 2; k < len(c); k++ {
+		if c[k].l.Sign() != 0 || c[k].r.Sign() != 0 {
+			if lo < 0 {
+				lo = k
+			}
+			hi = k
+		}
+	}
+	switch {
+	case lo < 0:
+		return math.Inf(1)
+	case lo == hi || lo == 0:
+		return math.Exp2(-float64(complexExpo(c[hi])-complexExpo(c[0])) / float64(hi))
+	}
+	return math.Exp2(float64(complexExpo(c[lo])-complexExpo(c[hi])) / float64(hi-lo))
+}
+
+// TaylorContinue continues the analytic function with value f0 at z0 along
+// the polygonal path through the points of path, and returns its value at the
+// last point, rounded to prec bits. At each step jet supplies the Taylor
+// coefficients about the current point on the current branch, and the value
+// is carried to the next point by summing the series, with the step at most
+// a quarter of the estimated radius of convergence and shortened until the
+// last terms are negligible. So the continuation goes around singularities
+// and across the branch cuts of principal values, and the result depends on
+// the homotopy class of the path, as analytic continuation does.
+func TaylorContinue(jet TaylorJet, z0, f0 *Complex, path []*Complex, prec uint) *Complex {
+	w := prec + guardBits
+	n := int(prec)/2 + 16
+	z := newComplexPrec(w).round(z0, w)
+	f := newComplexPrec(w).round(f0, w)
+	for _, target := range path {
+		for !z.Equals(target) {
+			c := jet(z, f, n, w)
+			h := newComplexPrec(w).Sub(target, z)
+			last := true
+			if r, m := taylorRadius(c)/4, complexAbs(h); m > r {
+				h.Scal(h, newFloat(w).SetFloat64(r/m))
+				last = false
+			}
+			for {
+				sum, ok := taylorSum(c, h, w)
+				if ok {
+					f = sum
+					break
+				}
+				h.Scal(h, newFloat(w).SetFloat64(0.5))
+				last = false
+			}
+			if last {
+				z.round(target, w)
+			} else {
+				z.Add(z, h)
+			}
+		}
+	}
+	return newComplexPrec(prec).round(f, prec)
+}
+
+// taylorSum returns the sum of the series with coefficients c at h, by
+// Horner's rule at prec bits, and true if its last term is negligible.
+func taylorSum(c []*Complex, h *Complex, prec uint) (*Complex, bool) {
+	sum := newComplexPrec(prec)
+	for k := len(c) - 1; k >= 0; k-- {
+		sum.Add(sum.Mul(sum, h), c[k])
+	}
+	t := newComplexPrec(prec).Copy(c[len(c)-1])
+	for k := 1; k < len(c); k++ {
+		t.Mul(t, h)
+	}
+	if t.l.Sign() == 0 && t.r.Sign() == 0 {
+		return sum, true
+	}
+	return sum, complexExpo(t) < complexExpo(sum)-int(prec)
+}
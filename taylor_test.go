@@ -0,0 +1,80 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+)
+
+// logJet returns the Taylor coefficients of log about z, given its value f.
+func logJet(z, f *Complex, n int, prec uint) []*Complex {
+	c := make([]*Complex, n)
+	c[0] = newComplexPrec(prec).Copy(f)
+	// (-1)^(k+1) / (k z^k)
+	inv := newComplexPrec(prec).Inv(z)
+	p := newComplexPrec(prec).Copy(inv)
+	for k := 1; k < n; k++ {
+		c[k] = newComplexPrec(prec)
+		c[k].l.Quo(&p.l, newFloat(prec).SetInt64(int64(k)))
+		c[k].r.Quo(&p.r, newFloat(prec).SetInt64(int64(k)))
+		p.Mul(p, inv)
+		p.Neg(p)
+	}
+	return c
+}
+
+// sqrtJet returns the Taylor coefficients of √z about z, given its value f,
+// from the binomial series f (1 + u/z)^(1/2).
+func sqrtJet(z, f *Complex, n int, prec uint) []*Complex {
+	c := make([]*Complex, n)
+	c[0] = newComplexPrec(prec).Copy(f)
+	inv := newComplexPrec(prec).Inv(z)
+	for k := 1; k < n; k++ {
+		// c[k] = c[k-1] (1/2 - (k-1))/k / z
+		r := newFloat(prec).SetInt64(int64(3 - 2*k))
+		r.Quo(r, newFloat(prec).SetInt64(int64(2*k)))
+		c[k] = newComplexPrec(prec).Mul(c[k-1], inv)
+		c[k].Scal(c[k], r)
+	}
+	return c
+}
+
+func TestTaylorContinueLog(t *testing.T) {
+	// Once around the origin, log gains 2πi.
+	path := []*Complex{
+		newComplex128(1i), newComplex128(-1), newComplex128(-1i), newComplex128(1),
+	}
+	got := TaylorContinue(logJet, newComplex128(1), new(Complex), path, 100)
+	want := NewComplex(new(big.Float), new(big.Float).SetMantExp(bigPi(100), 1))
+	if !closeToComplex(got, want, 90) {
+		t.Errorf("log after one turn = %v, want %v", got, want)
+	}
+}
+
+func TestTaylorContinueCut(t *testing.T) {
+	// Across the cut of the principal logarithm from above.
+	path := []*Complex{newComplex128(1i), newComplex128(-1 + 0.5i), newComplex128(-1 - 0.5i)}
+	got := TaylorContinue(logJet, newComplex128(1), new(Complex), path, 100)
+	want := new(Complex).Log(setPrecComplex(newComplex128(-1-0.5i), 100))
+	want.r.Add(&want.r, new(big.Float).SetMantExp(bigPi(120), 1))
+	if !closeToComplex(got, want, 90) {
+		t.Errorf("log = %v, want %v", got, want)
+	}
+}
+
+func TestTaylorContinueSqrt(t *testing.T) {
+	// Once around the origin, √z changes sign; twice, it comes back.
+	loop := []*Complex{
+		newComplex128(4i), newComplex128(-4), newComplex128(-4i), newComplex128(4),
+	}
+	one := TaylorContinue(sqrtJet, newComplex128(4), newComplex128(2), loop, 100)
+	if !closeToComplex(one, newComplex128(-2), 90) {
+		t.Errorf("√4 after one turn = %v, want -2", one)
+	}
+	two := TaylorContinue(sqrtJet, newComplex128(4), newComplex128(2), append(loop, loop...), 100)
+	if !closeToComplex(two, newComplex128(2), 90) {
+		t.Errorf("√4 after two turns = %v, want 2", two)
+	}
+}
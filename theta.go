@@ -0,0 +1,225 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+)
+
+// negligibleComplex returns true if term is zero, or if it is below sum by
+// more than prec bits.
+func negligibleComplex(term, sum *Complex, prec uint) bool {
+	if term.l.Sign() == 0 && term.r.Sign() == 0 {
+		return true
+	}
+	if sum.l.Sign() == 0 && sum.r.Sign() == 0 {
+		return false
+	}
+	return complexExpo(term) < complexExpo(sum)-int(prec)
+}
+
+// mulIPi returns iπy at precision prec.
+func mulIPi(y *Complex, prec uint) *Complex {
+	t := new(Complex).Scal(newComplexPrec(prec).round(y, prec), bigPi(prec))
+	return t.mulI(t, 1)
+}
+
+// jacobiTheta returns θn(u|τ) rounded to prec bits, from the series
+// 		θ1(u|τ) = 2 Σ (-1)^k q^((k+1/2)²) sin((2k+1)u)
+// 		θ2(u|τ) = 2 Σ q^((k+1/2)²) cos((2k+1)u)
+// 		θ3(u|τ) = 1 + 2 Σ q^(k²) cos(2ku)
+// 		θ4(u|τ) = 1 + 2 Σ (-1)^k q^(k²) cos(2ku)
+// where q = exp(iπτ).
+func jacobiTheta(n int, u, tau *Complex, prec uint) *Complex {
+	if tau.r.Sign() <= 0 {
+		panic("theta function outside the upper half-plane")
+	}
+	imTau, _ := tau.r.Float64()
+	imU, _ := u.r.Float64()
+	imU = math.Abs(imU)
+	// The largest term is about exp(|Im u|²/(π Im τ)), which is lost to
+	// cancellation when the sum is small.
+	w := prec + guardBits + uint(imU*imU/(math.Pi*imTau)*math.Log2E)
+	x := newComplexPrec(w).round(u, w)
+	ipt := mulIPi(tau, w)
+	sum := newComplexPrec(w)
+	if n == 3 || n == 4 {
+		sum.l.SetInt64(1)
+	}
+	peak := int(imU/(math.Pi*imTau)) + 1
+	start := 0
+	if n == 3 || n == 4 {
+		start = 1
+	}
+	for k := start; ; k++ {
+		var e, m *big.Float
+		if n == 1 || n == 2 {
+			// (k+1/2)² and 2k+1
+			e = newFloat(w).SetFloat64(float64(k) + 0.5)
+			m = newFloat(w).SetInt64(int64(2*k + 1))
+		} else {
+			e = newFloat(w).SetInt64(int64(k))
+			m = newFloat(w).SetInt64(int64(2 * k))
+		}
+		e.Mul(e, e)
+		term := new(Complex).Scal(ipt, e)
+		term.Exp(term)
+		arg := new(Complex).Scal(x, m)
+		if n == 1 {
+			term.Mul(term, arg.Sin(arg))
+		} else {
+			term.Mul(term, arg.Cos(arg))
+		}
+		term.Scal(term, big.NewFloat(2))
+		if (n == 1 || n == 4) && k%2 == 1 {
+			term.Neg(term)
+		}
+		sum.Add(sum, term)
+		if k > peak && negligibleComplex(term, sum, w) {
+			break
+		}
+	}
+	return newComplexPrec(prec).round(sum, prec)
+}
+
+// JacobiTheta sets z equal to the Jacobi theta function θn(u|τ), for n equal
+// to 1, 2, 3, or 4, and returns z. The nome is q = exp(iπτ), so
+// 		θ3(u|τ) = Σ q^(k²) exp(2iku)
+// over all integers k, and the other three functions are its half-period
+// shifts. The series converge for τ in the upper half-plane, and take more
+// terms as Im(τ) approaches zero. The result is rounded to the larger
+// precision of u and τ. If n is not between 1 and 4, or if Im(τ) ≤ 0, then
+// JacobiTheta panics.
+func (z *Complex) JacobiTheta(n int, u, tau *Complex) *Complex {
+	if n < 1 || n > 4 {
+		panic("theta function index out of range")
+	}
+	prec := maxPrec(&u.l, &u.r, &tau.l, &tau.r)
+	return z.Copy(jacobiTheta(n, u, tau, prec))
+}
+
+// ModularReduce sets z equal to the point of the standard fundamental domain
+// of SL(2, Z),
+// 		|Re(z)| ≤ 1/2,    |z| ≥ 1
+// that is equivalent to τ, and returns the integer matrix with
+// 		z = (aτ + b)/(cτ + d)
+// so that z can be recomputed with Möbius. If Im(τ) ≤ 0, then ModularReduce
+// panics.
+func (z *Complex) ModularReduce(tau *Complex) (a, b, c, d *big.Int) {
+	t, m, _ := modularReduce(tau, maxPrec(&tau.l, &tau.r))
+	z.Copy(t)
+	return m[0], m[1], m[2], m[3]
+}
+
+// modularReduce reduces τ to the fundamental domain at precision prec, and
+// returns the reduced point, the matrix of the reduction, and the factor f
+// with η(τ) = f η(z). The generators act on η as
+// 		η(τ + 1) = exp(iπ/12) η(τ)
+// 		η(-1/τ) = √(-iτ) η(τ)
+func modularReduce(tau *Complex, prec uint) (*Complex, [4]*big.Int, *Complex) {
+	if tau.r.Sign() <= 0 {
+		panic("modular reduction outside the upper half-plane")
+	}
+	w := prec + guardBits
+	t := newComplexPrec(w).round(tau, w)
+	m := [4]*big.Int{big.NewInt(1), big.NewInt(0), big.NewInt(0), big.NewInt(1)}
+	f := newComplexPrec(w)
+	f.l.SetInt64(1)
+	phase := new(big.Int)
+	one := big.NewFloat(1)
+	for i := 0; i < 4*int(w); i++ {
+		// τ → τ - n, with n the integer nearest to Re(τ).
+		n := big.NewInt(int64(nearestTurn(&t.l, one)))
+		if n.Sign() != 0 {
+			t.l.Sub(&t.l, newFloat(w).SetInt(n))
+			m[0].Sub(m[0], new(big.Int).Mul(n, m[2]))
+			m[1].Sub(m[1], new(big.Int).Mul(n, m[3]))
+			phase.Add(phase, n)
+		}
+		if t.Quad().Cmp(one) >= 0 {
+			break
+		}
+		// τ → -1/τ
+		t = new(Complex).Inv(t)
+		t.Neg(t)
+		m[0], m[1], m[2], m[3] = new(big.Int).Neg(m[2]), new(big.Int).Neg(m[3]), m[0], m[1]
+		s := new(Complex).mulI(t, -1)
+		f.Mul(f, s.Sqrt(s))
+	}
+	// exp(iπ phase/12), with the phase reduced modulo 24.
+	phase.Mod(phase, big.NewInt(24))
+	e := newComplexPrec(w)
+	e.r.Quo(newFloat(w).Mul(bigPi(w), newFloat(w).SetInt(phase)), big.NewFloat(12))
+	f.Mul(f, e.Exp(e))
+	return newComplexPrec(prec).round(t, prec), m, f
+}
+
+// dedekindEta returns η(τ) for τ in the fundamental domain, from the
+// pentagonal number series
+// 		η(τ) = exp(iπτ/12) Σ (-1)^n exp(iπτ n(3n-1))
+// over all integers n.
+func dedekindEta(tau *Complex, prec uint) *Complex {
+	ipt := mulIPi(tau, prec)
+	sum := newComplexPrec(prec)
+	sum.l.SetInt64(1)
+	for n := 1; ; n++ {
+		t := newComplexPrec(prec)
+		for _, k := range []int{n * (3*n - 1), n * (3*n + 1)} {
+			e := new(Complex).Scal(ipt, newFloat(prec).SetInt64(int64(k)))
+			t.Add(t, e.Exp(e))
+		}
+		if n%2 == 1 {
+			t.Neg(t)
+		}
+		sum.Add(sum, t)
+		if negligibleComplex(t, sum, prec) {
+			break
+		}
+	}
+	e := new(Complex).Scal(ipt, newFloat(prec).Quo(big.NewFloat(1), big.NewFloat(12)))
+	return sum.Mul(sum, e.Exp(e))
+}
+
+// DedekindEta sets z equal to the Dedekind eta function
+// 		η(τ) = q^(1/24) Π (1 - q^n),    q = exp(2πiτ)
+// and returns z. The argument is first moved into the fundamental domain with
+// ModularReduce, where the pentagonal number series converges quickly. The
+// result is rounded to the precision of τ. If Im(τ) ≤ 0, then DedekindEta
+// panics.
+func (z *Complex) DedekindEta(tau *Complex) *Complex {
+	prec := maxPrec(&tau.l, &tau.r)
+	w := prec + guardBits
+	t, _, f := modularReduce(tau, w)
+	eta := dedekindEta(t, w)
+	return z.Copy(newComplexPrec(prec).round(eta.Mul(eta, f), prec))
+}
+
+// JInvariant sets z equal to Klein's modular j-invariant of τ, and returns
+// z. The argument is first moved into the fundamental domain, where j is
+// computed from the theta constants
+// 		j(τ) = 32 (θ2⁸ + θ3⁸ + θ4⁸)³ / (θ2 θ3 θ4)⁸
+// so that j(i) = 1728. The result is rounded to the precision of τ. If
+// Im(τ) ≤ 0, then JInvariant panics.
+func (z *Complex) JInvariant(tau *Complex) *Complex {
+	prec := maxPrec(&tau.l, &tau.r)
+	w := prec + guardBits
+	t, _, _ := modularReduce(tau, w)
+	zero := newComplexPrec(w)
+	pow8 := func(x *Complex) *Complex {
+		x.Mul(x, x)
+		x.Mul(x, x)
+		return x.Mul(x, x)
+	}
+	t2 := pow8(jacobiTheta(2, zero, t, w))
+	t3 := pow8(jacobiTheta(3, zero, t, w))
+	t4 := pow8(jacobiTheta(4, zero, t, w))
+	num := new(Complex).Add(t2, t3)
+	num.Add(num, t4)
+	num.Mul(num, new(Complex).Mul(num, num))
+	num.Scal(num, big.NewFloat(32))
+	den := new(Complex).Mul(t2, t3)
+	den.Mul(den, t4)
+	return z.Copy(newComplexPrec(prec).round(new(Complex).Quo(num, den), prec))
+}
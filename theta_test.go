@@ -0,0 +1,136 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestThetaValues(t *testing.T) {
+	i := newComplex128(1i)
+	zero := new(Complex)
+	// θ3(0|i) = π^(1/4)/Γ(3/4)
+	if got := new(Complex).JacobiTheta(3, zero, i); !closeToComplex128(got, 1.0864348112133078, 50) {
+		t.Errorf("θ3(0|i) = %v", got)
+	}
+	if got := new(Complex).JacobiTheta(1, zero, i); got.l.Sign() != 0 || got.r.Sign() != 0 {
+		t.Errorf("θ1(0|i) = %v, want 0", got)
+	}
+}
+
+func TestThetaJacobiIdentity(t *testing.T) {
+	// θ3(0|τ)⁴ = θ2(0|τ)⁴ + θ4(0|τ)⁴
+	for _, p := range [][2]float64{{0, 1}, {0.3, 0.5}, {-2.1, 0.2}, {0.5, 3}} {
+		tau := complexPrec(p[0], p[1], 200)
+		zero := newComplexPrec(200)
+		pow4 := func(x *Complex) *Complex {
+			x.Mul(x, x)
+			return x.Mul(x, x)
+		}
+		t2 := pow4(new(Complex).JacobiTheta(2, zero, tau))
+		t3 := pow4(new(Complex).JacobiTheta(3, zero, tau))
+		t4 := pow4(new(Complex).JacobiTheta(4, zero, tau))
+		if d := t3.Sub(t3, t2.Add(t2, t4)); complexExpo(d) > complexExpo(t2)-185 {
+			t.Errorf("Jacobi identity at τ = %v: residual %v", p, d)
+		}
+	}
+}
+
+func TestThetaQuasiPeriodic(t *testing.T) {
+	// θ1(u+π|τ) = -θ1(u|τ) and θ3(u+πτ|τ) = exp(-iπτ - 2iu) θ3(u|τ)
+	u := complexPrec(0.4, 0.3, 150)
+	tau := complexPrec(0.2, 0.9, 150)
+	a := new(Complex).JacobiTheta(1, u, tau)
+	v := new(Complex).Copy(u)
+	v.l.Add(&v.l, bigPi(150))
+	b := new(Complex).JacobiTheta(1, v, tau)
+	if d := b.Add(b, a); complexExpo(d) > complexExpo(a)-140 {
+		t.Errorf("θ1(u+π) + θ1(u) = %v", d)
+	}
+	v.Add(u, new(Complex).Scal(tau, bigPi(150)))
+	b = new(Complex).JacobiTheta(3, v, tau)
+	e := new(Complex).Add(mulIPi(tau, 150), new(Complex).Scal(new(Complex).mulI(u, 1), big.NewFloat(2)))
+	e.Neg(e)
+	a = new(Complex).JacobiTheta(3, u, tau)
+	a.Mul(a, e.Exp(e))
+	if d := b.Sub(b, a); complexExpo(d) > complexExpo(a)-140 {
+		t.Errorf("θ3(u+πτ) mismatch: %v", d)
+	}
+}
+
+func TestDedekindEtaValues(t *testing.T) {
+	// η(i) = Γ(1/4)/(2π^(3/4))
+	if got := new(Complex).DedekindEta(newComplex128(1i)); !closeToComplex128(got, 0.7682254223260567, 50) {
+		t.Errorf("η(i) = %v", got)
+	}
+}
+
+func TestDedekindEtaInversion(t *testing.T) {
+	// η(-1/τ) = √(-iτ) η(τ), checked near the real axis where the reduction
+	// takes several steps.
+	for _, p := range [][2]float64{{0.3, 0.01}, {-1.37, 0.05}, {2.5, 1.5}} {
+		tau := complexPrec(p[0], p[1], 150)
+		inv := new(Complex).Inv(tau)
+		a := new(Complex).DedekindEta(inv.Neg(inv))
+		s := new(Complex).mulI(tau, -1)
+		b := new(Complex).DedekindEta(tau)
+		b.Mul(b, s.Sqrt(s))
+		if d := new(Complex).Sub(a, b); complexExpo(d) > complexExpo(b)-135 {
+			t.Errorf("η(-1/τ) at τ = %v: %v and %v", p, a, b)
+		}
+	}
+}
+
+func TestJInvariantValues(t *testing.T) {
+	cases := []struct {
+		tau complex128
+		j   complex128
+	}{
+		{1i, 1728},
+		{2i, 287496},
+		{complex(0.5, math.Sqrt(7)/2), -3375},
+		{complex(-0.5, math.Sqrt(3)/2), 0},
+	}
+	for _, c := range cases {
+		got := new(Complex).JInvariant(newComplex128(c.tau))
+		if c.j == 0 {
+			if complexExpo(got) > -40 {
+				t.Errorf("j(%v) = %v, want 0", c.tau, got)
+			}
+			continue
+		}
+		if !closeToComplex128(got, c.j, 44) {
+			t.Errorf("j(%v) = %v, want %v", c.tau, got, c.j)
+		}
+	}
+}
+
+func TestModularReduce(t *testing.T) {
+	for _, p := range [][2]float64{{0.3, 0.01}, {-7.2, 0.4}, {0.1, 0.9}, {3, 2}} {
+		tau := complexPrec(p[0], p[1], 150)
+		z := new(Complex)
+		a, b, c, d := z.ModularReduce(tau)
+		if new(big.Int).Sub(new(big.Int).Mul(a, d), new(big.Int).Mul(b, c)).Cmp(big.NewInt(1)) != 0 {
+			t.Errorf("ModularReduce(%v) matrix %v %v %v %v not in SL(2, Z)", p, a, b, c, d)
+		}
+		if x, _ := z.l.Float64(); math.Abs(x) > 0.5 || z.Quad().Cmp(big.NewFloat(1-1e-30)) < 0 {
+			t.Errorf("ModularReduce(%v) = %v outside the fundamental domain", p, z)
+		}
+		toC := func(n *big.Int) *Complex {
+			return NewComplex(newFloat(150).SetInt(n), newFloat(150))
+		}
+		m := new(Complex).Möbius(tau, toC(a), toC(b), toC(c), toC(d))
+		if e := new(Complex).Sub(m, z); complexExpo(e) > -120 {
+			t.Errorf("Möbius(%v) = %v, want %v", p, m, z)
+		}
+		// j is invariant under the modular group.
+		j0 := new(Complex).JInvariant(tau)
+		j1 := new(Complex).JInvariant(z)
+		if e := new(Complex).Sub(j0, j1); complexExpo(e) > complexExpo(j1)-120 {
+			t.Errorf("j(%v) = %v, j(%v) = %v", p, j0, z, j1)
+		}
+	}
+}
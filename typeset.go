@@ -0,0 +1,754 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// greekNames are the names of the Greek letters of the symbols of the units.
+var greekNames = map[rune]string{
+	'α': "Alpha", 'β': "Beta", 'γ': "Gamma", 'δ': "Delta", 'ε': "Epsilon",
+	'ζ': "Zeta", 'η': "Eta", 'θ': "Theta", 'ι': "Iota", 'κ': "Kappa",
+	'λ': "Lambda", 'μ': "Mu", 'τ': "Tau", 'υ': "Upsilon",
+}
+
+// A unitFactor is a letter of the symbol of a unit, with its subscript and
+// its power, if any.
+type unitFactor struct {
+	letter   rune
+	sub, pow string
+}
+
+// unitFactors splits the symbol of a unit, such as "e1e2" or "α^2", into its
+// factors. The digits after an e are a subscript, and those after a ^ are a
+// power.
+func unitFactors(u string) []unitFactor {
+	r := []rune(u)
+	var f []unitFactor
+	for k := 0; k < len(r); {
+		digits := func() string {
+			j := k
+			for k < len(r) && unicode.IsDigit(r[k]) {
+				k++
+			}
+			return string(r[j:k])
+		}
+		x := unitFactor{letter: r[k]}
+		k++
+		if x.letter == 'e' {
+			x.sub = digits()
+		}
+		if k < len(r) && r[k] == '^' {
+			k++
+			x.pow = digits()
+		}
+		f = append(f, x)
+	}
+	return f
+}
+
+// texUnits returns the LaTeX forms of the symbols of the units, with the Latin
+// letters in bold, such as \mathbf{e}_{1}\mathbf{e}_{2} for "e1e2" and
+// \alpha^{2} for "α^2".
+func texUnits(units []string) []string {
+	t := make([]string, len(units))
+	for k, u := range units {
+		var b strings.Builder
+		for _, f := range unitFactors(u) {
+			switch name, ok := greekNames[f.letter]; {
+			case f.letter == 'ε':
+				b.WriteString(`\varepsilon`)
+			case ok:
+				b.WriteString(`\` + strings.ToLower(name))
+			default:
+				b.WriteString(`\mathbf{` + string(f.letter) + `}`)
+			}
+			if f.sub != "" {
+				b.WriteString("_{" + f.sub + "}")
+			}
+			if f.pow != "" {
+				b.WriteString("^{" + f.pow + "}")
+			}
+		}
+		t[k] = b.String()
+	}
+	return t
+}
+
+// mmaUnits returns the Mathematica forms of the symbols of the units, with
+// the factors joined by **, since the units need not commute, such as
+// Subscript[e, 1]**Subscript[e, 2] for "e1e2" and \[Alpha]^2 for "α^2".
+func mmaUnits(units []string) []string {
+	t := make([]string, len(units))
+	for k, u := range units {
+		var a []string
+		for _, f := range unitFactors(u) {
+			s := string(f.letter)
+			if name, ok := greekNames[f.letter]; ok {
+				s = `\[` + name + `]`
+			}
+			if f.sub != "" {
+				s = "Subscript[" + s + ", " + f.sub + "]"
+			}
+			if f.pow != "" {
+				s += "^" + f.pow
+			}
+			a = append(a, s)
+		}
+		t[k] = strings.Join(a, "**")
+	}
+	return t
+}
+
+// decimalParts returns the mantissa and the decimal exponent, which is empty
+// if there is none, of x in the 'g' format with digits significant digits,
+// or with the shortest decimal that gives back x at its precision if digits is
+// not positive. The digits are correctly rounded from the exact binary value
+// of x.
+func decimalParts(x *big.Float, digits int) (string, string) {
+	if digits <= 0 {
+		digits = -1
+	}
+	s := x.Text('g', digits)
+	k := strings.IndexByte(s, 'e')
+	if k < 0 {
+		return s, ""
+	}
+	e, _ := strconv.Atoi(s[k+1:])
+	return s[:k], strconv.Itoa(e)
+}
+
+// texFloat returns x in LaTeX, in the format of decimalParts, such as
+// 1.5 \times 10^{-20}.
+func texFloat(x *big.Float, digits int) string {
+	if x.IsInf() {
+		if x.Signbit() {
+			return `-\infty`
+		}
+		return `\infty`
+	}
+	m, e := decimalParts(x, digits)
+	if e == "" {
+		return m
+	}
+	return m + ` \times 10^{` + e + `}`
+}
+
+// mmaFloat returns x in Mathematica, in the format of decimalParts, with the
+// precision mark of digits, or of the precision of x if digits is not
+// positive, such as 1.5`20*^-20. Zero is written as the exact 0.
+func mmaFloat(x *big.Float, digits int) string {
+	switch {
+	case x.IsInf() && x.Signbit():
+		return "-Infinity"
+	case x.IsInf():
+		return "Infinity"
+	case x.Sign() == 0:
+		return "0"
+	}
+	m, e := decimalParts(x, digits)
+	p := strconv.Itoa(digits)
+	if digits <= 0 {
+		p = strconv.FormatFloat(float64(x.Prec())*math.Log10(2), 'f', 3, 64)
+	}
+	if e == "" {
+		return m + "`" + p
+	}
+	return m + "`" + p + "*^" + e
+}
+
+// joinTerms returns the sum of the terms, each already formatted, with " + "
+// or " - " between them, following the sign of each term after the first.
+func joinTerms(terms []string) string {
+	var b strings.Builder
+	for k, t := range terms {
+		if k > 0 {
+			if strings.HasPrefix(t, "-") {
+				b.WriteString(" - ")
+				t = t[1:]
+			} else {
+				b.WriteString(" + ")
+			}
+		}
+		b.WriteString(t)
+	}
+	return b.String()
+}
+
+// texTerms returns the sum of the components x times the units in LaTeX.
+func texTerms(x []*big.Float, units []string, digits int) string {
+	units = texUnits(units)
+	terms := make([]string, len(x))
+	for k, v := range x {
+		terms[k] = texFloat(v, digits) + units[k]
+	}
+	return joinTerms(terms)
+}
+
+// mmaTerms returns the sum of the components x times the units in
+// Mathematica. The units are already in Mathematica form.
+func mmaTerms(x []*big.Float, units []string, digits int) string {
+	terms := make([]string, len(x))
+	for k, v := range x {
+		terms[k] = mmaFloat(v, digits)
+		if units[k] != "" {
+			terms[k] += "*" + units[k]
+		}
+	}
+	return joinTerms(terms)
+}
+
+// texGroups returns the sum of the groups, each already in LaTeX and put in
+// parentheses, times the units, which are already in LaTeX form.
+func texGroups(groups []string, units []string) string {
+	terms := make([]string, len(groups))
+	for k, g := range groups {
+		terms[k] = `\left(` + g + `\right)` + units[k]
+	}
+	return strings.Join(terms, " + ")
+}
+
+// mmaGroups returns the sum of the groups, each already in Mathematica and
+// put in parentheses, times the units, which are already in Mathematica form.
+func mmaGroups(groups []string, units []string) string {
+	terms := make([]string, len(groups))
+	for k, g := range groups {
+		terms[k] = "(" + g + ")"
+		if units[k] != "" {
+			terms[k] += "*" + units[k]
+		}
+	}
+	return strings.Join(terms, " + ")
+}
+
+// texMatrix returns the entries, each already in LaTeX, in rows of cols
+// entries as a pmatrix.
+func texMatrix(entries []string, cols int) string {
+	var rows []string
+	for k := 0; k < len(entries); k += cols {
+		rows = append(rows, strings.Join(entries[k:k+cols], " & "))
+	}
+	return `\begin{pmatrix} ` + strings.Join(rows, ` \\ `) + ` \end{pmatrix}`
+}
+
+// mmaList returns the items, each already in Mathematica, as a list.
+func mmaList(items []string) string {
+	return "{" + strings.Join(items, ", ") + "}"
+}
+
+// mmaMatrix returns the entries, each already in Mathematica, in rows of cols
+// entries as a list of lists.
+func mmaMatrix(entries []string, cols int) string {
+	var rows []string
+	for k := 0; k < len(entries); k += cols {
+		rows = append(rows, mmaList(entries[k:k+cols]))
+	}
+	return mmaList(rows)
+}
+
+// ToLaTeX returns z in LaTeX, for inclusion in a document in math mode, such
+// as 1.5 - 2\mathbf{i} for 1.5 - 2i. Each component is written with digits
+// significant digits, correctly rounded, or with the shortest decimal that
+// gives back its value at its precision if digits is not positive, and with
+// a power of ten if its exponent is large or small, as in the 'g' format, such
+// as 1.5 \times 10^{-20}. The Latin letters of the units are in bold, the
+// Greek letters are not, and the numbers in symbols such as e1 are
+// subscripts.
+func (z *Complex) ToLaTeX(digits int) string {
+	return texTerms(floatSlice(z.Cartesian()), []string{"", "i"}, digits)
+}
+
+// ToMathematica returns z as a Mathematica expression, for use in a notebook,
+// such as 1.5`20 - 2`20*I for 1.5 - 2i with 20 digits. Each component is
+// written with the digits of the ToLaTeX method, and the precision mark of
+// digits, or of its precision if digits is not positive, so that Mathematica
+// keeps it as an arbitrary-precision number, with *^ for a power of ten; zero
+// is written as the exact 0. The units other than I are symbols, such as i,
+// \[Alpha], and Subscript[e, 1], multiplied with ** since they need not
+// commute.
+func (z *Complex) ToMathematica(digits int) string {
+	return mmaTerms(floatSlice(z.Cartesian()), []string{"", "I"}, digits)
+}
+
+// ToLaTeX returns z in LaTeX, with the conventions of the ToLaTeX method of
+// Complex.
+func (z *Perplex) ToLaTeX(digits int) string {
+	return texTerms(floatSlice(z.Cartesian()), []string{"", "s"}, digits)
+}
+
+// ToMathematica returns z as a Mathematica expression, with the conventions of
+// the ToMathematica method of Complex.
+func (z *Perplex) ToMathematica(digits int) string {
+	return mmaTerms(floatSlice(z.Cartesian()), mmaUnits([]string{"", "s"}), digits)
+}
+
+// ToLaTeX returns z in LaTeX, with the conventions of the ToLaTeX method of
+// Complex.
+func (z *Infra) ToLaTeX(digits int) string {
+	return texTerms(floatSlice(z.Cartesian()), []string{"", "α"}, digits)
+}
+
+// ToMathematica returns z as a Mathematica expression, with the conventions of
+// the ToMathematica method of Complex.
+func (z *Infra) ToMathematica(digits int) string {
+	return mmaTerms(floatSlice(z.Cartesian()), mmaUnits([]string{"", "α"}), digits)
+}
+
+// ToLaTeX returns z in LaTeX, with the conventions of the ToLaTeX method of
+// Complex.
+func (z *Hamilton) ToLaTeX(digits int) string {
+	return texTerms(floatSlice(z.Cartesian()), symbHamilton[:], digits)
+}
+
+// ToMathematica returns z as a Mathematica expression, with the conventions of
+// the ToMathematica method of Complex.
+func (z *Hamilton) ToMathematica(digits int) string {
+	return mmaTerms(floatSlice(z.Cartesian()), mmaUnits(symbHamilton[:]), digits)
+}
+
+// ToLaTeX returns z in LaTeX, with the conventions of the ToLaTeX method of
+// Complex.
+func (z *Cockle) ToLaTeX(digits int) string {
+	return texTerms(floatSlice(z.Cartesian()), symbCockle[:], digits)
+}
+
+// ToMathematica returns z as a Mathematica expression, with the conventions of
+// the ToMathematica method of Complex.
+func (z *Cockle) ToMathematica(digits int) string {
+	return mmaTerms(floatSlice(z.Cartesian()), mmaUnits(symbCockle[:]), digits)
+}
+
+// ToLaTeX returns z in LaTeX, with the conventions of the ToLaTeX method of
+// Complex.
+func (z *DualComplex) ToLaTeX(digits int) string {
+	return texTerms(floatSlice(z.Cartesian()), symbDualComplex[:], digits)
+}
+
+// ToMathematica returns z as a Mathematica expression, with the conventions of
+// the ToMathematica method of Complex.
+func (z *DualComplex) ToMathematica(digits int) string {
+	return mmaTerms(floatSlice(z.Cartesian()), mmaUnits(symbDualComplex[:]), digits)
+}
+
+// ToLaTeX returns z in LaTeX, with the conventions of the ToLaTeX method of
+// Complex.
+func (z *InfraComplex) ToLaTeX(digits int) string {
+	return texTerms(floatSlice(z.Cartesian()), symbInfraComplex[:], digits)
+}
+
+// ToMathematica returns z as a Mathematica expression, with the conventions of
+// the ToMathematica method of Complex.
+func (z *InfraComplex) ToMathematica(digits int) string {
+	return mmaTerms(floatSlice(z.Cartesian()), mmaUnits(symbInfraComplex[:]), digits)
+}
+
+// ToLaTeX returns z in LaTeX, with the conventions of the ToLaTeX method of
+// Complex.
+func (z *InfraPerplex) ToLaTeX(digits int) string {
+	return texTerms(floatSlice(z.Cartesian()), symbInfraPerplex[:], digits)
+}
+
+// ToMathematica returns z as a Mathematica expression, with the conventions of
+// the ToMathematica method of Complex.
+func (z *InfraPerplex) ToMathematica(digits int) string {
+	return mmaTerms(floatSlice(z.Cartesian()), mmaUnits(symbInfraPerplex[:]), digits)
+}
+
+// ToLaTeX returns z in LaTeX, with the conventions of the ToLaTeX method of
+// Complex.
+func (z *Supra) ToLaTeX(digits int) string {
+	return texTerms(floatSlice(z.Cartesian()), symbSupra[:], digits)
+}
+
+// ToMathematica returns z as a Mathematica expression, with the conventions of
+// the ToMathematica method of Complex.
+func (z *Supra) ToMathematica(digits int) string {
+	return mmaTerms(floatSlice(z.Cartesian()), mmaUnits(symbSupra[:]), digits)
+}
+
+// ToLaTeX returns z in LaTeX, with the conventions of the ToLaTeX method of
+// Complex.
+func (z *Macfarlane) ToLaTeX(digits int) string {
+	return texTerms(floatSlice(z.Cartesian()), symbMacfarlane[:], digits)
+}
+
+// ToMathematica returns z as a Mathematica expression, with the conventions of
+// the ToMathematica method of Complex.
+func (z *Macfarlane) ToMathematica(digits int) string {
+	return mmaTerms(floatSlice(z.Cartesian()), mmaUnits(symbMacfarlane[:]), digits)
+}
+
+// ToLaTeX returns z in LaTeX, with the conventions of the ToLaTeX method of
+// Complex.
+func (z *Cayley) ToLaTeX(digits int) string {
+	return texTerms(floatSlice(z.Cartesian()), symbCayley[:], digits)
+}
+
+// ToMathematica returns z as a Mathematica expression, with the conventions of
+// the ToMathematica method of Complex.
+func (z *Cayley) ToMathematica(digits int) string {
+	return mmaTerms(floatSlice(z.Cartesian()), mmaUnits(symbCayley[:]), digits)
+}
+
+// ToLaTeX returns z in LaTeX, with the conventions of the ToLaTeX method of
+// Complex.
+func (z *DualHamilton) ToLaTeX(digits int) string {
+	return texTerms(floatSlice(z.Cartesian()), symbDualHamilton[:], digits)
+}
+
+// ToMathematica returns z as a Mathematica expression, with the conventions of
+// the ToMathematica method of Complex.
+func (z *DualHamilton) ToMathematica(digits int) string {
+	return mmaTerms(floatSlice(z.Cartesian()), mmaUnits(symbDualHamilton[:]), digits)
+}
+
+// ToLaTeX returns z in LaTeX, with the conventions of the ToLaTeX method of
+// Complex.
+func (z *InfraCockle) ToLaTeX(digits int) string {
+	return texTerms(floatSlice(z.Cartesian()), symbInfraCockle[:], digits)
+}
+
+// ToMathematica returns z as a Mathematica expression, with the conventions of
+// the ToMathematica method of Complex.
+func (z *InfraCockle) ToMathematica(digits int) string {
+	return mmaTerms(floatSlice(z.Cartesian()), mmaUnits(symbInfraCockle[:]), digits)
+}
+
+// ToLaTeX returns z in LaTeX, with the conventions of the ToLaTeX method of
+// Complex.
+func (z *InfraHamilton) ToLaTeX(digits int) string {
+	return texTerms(floatSlice(z.Cartesian()), symbInfraHamilton[:], digits)
+}
+
+// ToMathematica returns z as a Mathematica expression, with the conventions of
+// the ToMathematica method of Complex.
+func (z *InfraHamilton) ToMathematica(digits int) string {
+	return mmaTerms(floatSlice(z.Cartesian()), mmaUnits(symbInfraHamilton[:]), digits)
+}
+
+// ToLaTeX returns z in LaTeX, with the conventions of the ToLaTeX method of
+// Complex.
+func (z *SupraComplex) ToLaTeX(digits int) string {
+	return texTerms(floatSlice(z.Cartesian()), symbSupraComplex[:], digits)
+}
+
+// ToMathematica returns z as a Mathematica expression, with the conventions of
+// the ToMathematica method of Complex.
+func (z *SupraComplex) ToMathematica(digits int) string {
+	return mmaTerms(floatSlice(z.Cartesian()), mmaUnits(symbSupraComplex[:]), digits)
+}
+
+// ToLaTeX returns z in LaTeX, with the conventions of the ToLaTeX method of
+// Complex.
+func (z *Ultra) ToLaTeX(digits int) string {
+	return texTerms(floatSlice(z.Cartesian()), symbUltra[:], digits)
+}
+
+// ToMathematica returns z as a Mathematica expression, with the conventions of
+// the ToMathematica method of Complex.
+func (z *Ultra) ToMathematica(digits int) string {
+	return mmaTerms(floatSlice(z.Cartesian()), mmaUnits(symbUltra[:]), digits)
+}
+
+// ToLaTeX returns z in LaTeX, with the conventions of the ToLaTeX method of
+// Complex.
+func (z *Sedenion) ToLaTeX(digits int) string {
+	c := z.Cartesian()
+	return texTerms(c[:], symbSedenion[:], digits)
+}
+
+// ToMathematica returns z as a Mathematica expression, with the conventions of
+// the ToMathematica method of Complex.
+func (z *Sedenion) ToMathematica(digits int) string {
+	c := z.Cartesian()
+	return mmaTerms(c[:], mmaUnits(symbSedenion[:]), digits)
+}
+
+// ToLaTeX returns z in LaTeX, with the conventions of the ToLaTeX method of
+// Complex.
+func (z *SupraCockle) ToLaTeX(digits int) string {
+	c := z.Cartesian()
+	return texTerms(c[:], symbSupraCockle[:], digits)
+}
+
+// ToMathematica returns z as a Mathematica expression, with the conventions of
+// the ToMathematica method of Complex.
+func (z *SupraCockle) ToMathematica(digits int) string {
+	c := z.Cartesian()
+	return mmaTerms(c[:], mmaUnits(symbSupraCockle[:]), digits)
+}
+
+// ToLaTeX returns z in LaTeX, with the conventions of the ToLaTeX method of
+// Complex.
+func (z *SupraHamilton) ToLaTeX(digits int) string {
+	c := z.Cartesian()
+	return texTerms(c[:], symbSupraHamilton[:], digits)
+}
+
+// ToMathematica returns z as a Mathematica expression, with the conventions of
+// the ToMathematica method of Complex.
+func (z *SupraHamilton) ToMathematica(digits int) string {
+	c := z.Cartesian()
+	return mmaTerms(c[:], mmaUnits(symbSupraHamilton[:]), digits)
+}
+
+// ToLaTeX returns v in LaTeX, such as \left(1, 2, 3\right), with the
+// conventions of the ToLaTeX method of Complex for each component.
+func (v *Vec3) ToLaTeX(digits int) string {
+	x := floatSlice(v.Cartesian())
+	a := make([]string, len(x))
+	for k := range x {
+		a[k] = texFloat(x[k], digits)
+	}
+	return `\left(` + strings.Join(a, ", ") + `\right)`
+}
+
+// ToMathematica returns v as a Mathematica list, such as {1`20, 2`20, 3`20},
+// with the conventions of the ToMathematica method of Complex for each
+// component.
+func (v *Vec3) ToMathematica(digits int) string {
+	x := floatSlice(v.Cartesian())
+	a := make([]string, len(x))
+	for k := range x {
+		a[k] = mmaFloat(x[k], digits)
+	}
+	return mmaList(a)
+}
+
+// ToLaTeX returns z in LaTeX, with the units \alpha, \alpha^{2}, and so on, and
+// otherwise with the conventions of the ToLaTeX method of Complex.
+func (z *Jet) ToLaTeX(digits int) string {
+	return texTerms(z.Cartesian(), jetUnits(z.Order()), digits)
+}
+
+// ToMathematica returns z as a Mathematica expression, with the units \[Alpha],
+// \[Alpha]^2, and so on, and otherwise with the conventions of the
+// ToMathematica method of Complex.
+func (z *Jet) ToMathematica(digits int) string {
+	return mmaTerms(z.Cartesian(), mmaUnits(jetUnits(z.Order())), digits)
+}
+
+// ToLaTeX returns z in LaTeX, with the blades \mathbf{e}_{1},
+// \mathbf{e}_{1}\mathbf{e}_{2}, and so on, and otherwise with the conventions
+// of the ToLaTeX method of Complex. The signature is not written.
+func (z *Clifford) ToLaTeX(digits int) string {
+	return texTerms(z.Cartesian(), bladeUnits(z.p+z.q), digits)
+}
+
+// ToMathematica returns z as a Mathematica expression, with the blades
+// Subscript[e, 1], Subscript[e, 1]**Subscript[e, 2], and so on, and otherwise
+// with the conventions of the ToMathematica method of Complex. The signature is
+// not written.
+func (z *Clifford) ToMathematica(digits int) string {
+	return mmaTerms(z.Cartesian(), mmaUnits(bladeUnits(z.p+z.q)), digits)
+}
+
+// ToLaTeX returns z in LaTeX, with the conventions of the ToLaTeX method of
+// Clifford.
+func (z *Grassmann) ToLaTeX(digits int) string {
+	return texTerms(z.Cartesian(), bladeUnits(z.n), digits)
+}
+
+// ToMathematica returns z as a Mathematica expression, with the conventions of
+// the ToMathematica method of Clifford.
+func (z *Grassmann) ToMathematica(digits int) string {
+	return mmaTerms(z.Cartesian(), mmaUnits(bladeUnits(z.n)), digits)
+}
+
+// ToLaTeX returns z in LaTeX, with each component in the format of the ToLaTeX
+// method of Complex, in parentheses, such as \left(1 + 2\mathbf{i}\right) +
+// \left(3 + 4\mathbf{i}\right)\mathbf{i} + ....
+func (z *Biquaternion) ToLaTeX(digits int) string {
+	v := []*Complex{&z.w, &z.x, &z.y, &z.z}
+	g := make([]string, len(v))
+	for k := range v {
+		g[k] = v[k].ToLaTeX(digits)
+	}
+	return texGroups(g, texUnits(symbBiquaternion[:]))
+}
+
+// ToMathematica returns z as a Mathematica expression, with each component in
+// the format of the ToMathematica method of Complex, in parentheses, such as
+// (1`20 + 2`20*I) + (3`20 + 4`20*I)*i + ....
+func (z *Biquaternion) ToMathematica(digits int) string {
+	v := []*Complex{&z.w, &z.x, &z.y, &z.z}
+	g := make([]string, len(v))
+	for k := range v {
+		g[k] = v[k].ToMathematica(digits)
+	}
+	return mmaGroups(g, mmaUnits(symbBiquaternion[:]))
+}
+
+// ToLaTeX returns z in LaTeX as a pmatrix with the diagonal a, b and the
+// vectors u, v in the format of the ToLaTeX method of Vec3, in the layout of
+// String.
+func (z *Zorn) ToLaTeX(digits int) string {
+	a := []string{texFloat(&z.a, digits), z.u.ToLaTeX(digits), z.v.ToLaTeX(digits), texFloat(&z.b, digits)}
+	return texMatrix(a, 2)
+}
+
+// ToMathematica returns z as a Mathematica matrix {{a, u}, {v, b}}, with the
+// vectors u and v in the format of the ToMathematica method of Vec3.
+func (z *Zorn) ToMathematica(digits int) string {
+	a := []string{mmaFloat(&z.a, digits), z.u.ToMathematica(digits), z.v.ToMathematica(digits), mmaFloat(&z.b, digits)}
+	return mmaMatrix(a, 2)
+}
+
+// ToLaTeX returns p in LaTeX as a polynomial in x, in increasing order of
+// degree, with each coefficient in the format of the ToLaTeX method of Complex,
+// in parentheses, such as \left(1 + 2\mathbf{i}\right) + \left(3 -
+// \mathbf{i}\right)x^{2}. The zero polynomial is 0.
+func (p *ComplexPoly) ToLaTeX(digits int) string {
+	if len(p.c) == 0 {
+		return "0"
+	}
+	g := make([]string, len(p.c))
+	x := make([]string, len(p.c))
+	for k := range p.c {
+		g[k] = p.c[k].ToLaTeX(digits)
+		switch k {
+		case 0:
+		case 1:
+			x[k] = "x"
+		default:
+			x[k] = fmt.Sprintf("x^{%d}", k)
+		}
+	}
+	return texGroups(g, x)
+}
+
+// ToMathematica returns p as a Mathematica polynomial in x, in increasing order
+// of degree, with each coefficient in the format of the ToMathematica method of
+// Complex, in parentheses. The zero polynomial is 0.
+func (p *ComplexPoly) ToMathematica(digits int) string {
+	if len(p.c) == 0 {
+		return "0"
+	}
+	g := make([]string, len(p.c))
+	x := make([]string, len(p.c))
+	for k := range p.c {
+		g[k] = p.c[k].ToMathematica(digits)
+		switch k {
+		case 0:
+		case 1:
+			x[k] = "x"
+		default:
+			x[k] = fmt.Sprintf("x^%d", k)
+		}
+	}
+	return mmaGroups(g, x)
+}
+
+// ToLaTeX returns m in LaTeX as a pmatrix, with each entry in the format of the
+// ToLaTeX method of Hamilton.
+func (m *HamiltonMatrix) ToLaTeX(digits int) string {
+	a := make([]string, len(m.e))
+	for k := range m.e {
+		a[k] = m.e[k].ToLaTeX(digits)
+	}
+	return texMatrix(a, m.cols)
+}
+
+// ToMathematica returns m as a Mathematica matrix, a list of rows, with each
+// entry in the format of the ToMathematica method of Hamilton.
+func (m *HamiltonMatrix) ToMathematica(digits int) string {
+	a := make([]string, len(m.e))
+	for k := range m.e {
+		a[k] = m.e[k].ToMathematica(digits)
+	}
+	return mmaMatrix(a, m.cols)
+}
+
+// ToLaTeX returns z in LaTeX as \left[x : y\right], with the coordinates in the
+// format of the ToLaTeX method of Complex.
+func (z *ProjectiveComplex) ToLaTeX(digits int) string {
+	return `\left[` + z.x.ToLaTeX(digits) + ` : ` + z.y.ToLaTeX(digits) + `\right]`
+}
+
+// ToMathematica returns z as the Mathematica list {x, y} of its homogeneous
+// coordinates, in the format of the ToMathematica method of Complex.
+func (z *ProjectiveComplex) ToMathematica(digits int) string {
+	return mmaList([]string{z.x.ToMathematica(digits), z.y.ToMathematica(digits)})
+}
+
+// ToLaTeX returns z in LaTeX as \left[x : y\right], with the coordinates in the
+// format of the ToLaTeX method of Hamilton.
+func (z *ProjectiveHamilton) ToLaTeX(digits int) string {
+	return `\left[` + z.x.ToLaTeX(digits) + ` : ` + z.y.ToLaTeX(digits) + `\right]`
+}
+
+// ToMathematica returns z as the Mathematica list {x, y} of its homogeneous
+// coordinates, in the format of the ToMathematica method of Hamilton.
+func (z *ProjectiveHamilton) ToMathematica(digits int) string {
+	return mmaList([]string{z.x.ToMathematica(digits), z.y.ToMathematica(digits)})
+}
+
+// ToLaTeX returns z in LaTeX as \left[x : y\right], with the coordinates in the
+// format of the ToLaTeX method of Cockle.
+func (z *ProjectiveCockle) ToLaTeX(digits int) string {
+	return `\left[` + z.x.ToLaTeX(digits) + ` : ` + z.y.ToLaTeX(digits) + `\right]`
+}
+
+// ToMathematica returns z as the Mathematica list {x, y} of its homogeneous
+// coordinates, in the format of the ToMathematica method of Cockle.
+func (z *ProjectiveCockle) ToMathematica(digits int) string {
+	return mmaList([]string{z.x.ToMathematica(digits), z.y.ToMathematica(digits)})
+}
+
+// ToLaTeX returns z in LaTeX as the pmatrix of its coefficients a, b, c, and d,
+// in the format of the ToLaTeX method of Complex.
+func (z *MöbiusComplex) ToLaTeX(digits int) string {
+	a := []string{z.a.ToLaTeX(digits), z.b.ToLaTeX(digits), z.c.ToLaTeX(digits), z.d.ToLaTeX(digits)}
+	return texMatrix(a, 2)
+}
+
+// ToMathematica returns z as the Mathematica matrix {{a, b}, {c, d}} of its
+// coefficients, in the format of the ToMathematica method of Complex.
+func (z *MöbiusComplex) ToMathematica(digits int) string {
+	a := []string{z.a.ToMathematica(digits), z.b.ToMathematica(digits), z.c.ToMathematica(digits), z.d.ToMathematica(digits)}
+	return mmaMatrix(a, 2)
+}
+
+// ToLaTeX returns z in LaTeX as the pmatrix of its coefficients a, b, c, and d,
+// in the format of the ToLaTeX method of Hamilton.
+func (z *MöbiusHamilton) ToLaTeX(digits int) string {
+	a := []string{z.a.ToLaTeX(digits), z.b.ToLaTeX(digits), z.c.ToLaTeX(digits), z.d.ToLaTeX(digits)}
+	return texMatrix(a, 2)
+}
+
+// ToMathematica returns z as the Mathematica matrix {{a, b}, {c, d}} of its
+// coefficients, in the format of the ToMathematica method of Hamilton.
+func (z *MöbiusHamilton) ToMathematica(digits int) string {
+	a := []string{z.a.ToMathematica(digits), z.b.ToMathematica(digits), z.c.ToMathematica(digits), z.d.ToMathematica(digits)}
+	return mmaMatrix(a, 2)
+}
+
+// jetUnits returns the symbols of the units of a Jet value of order k.
+func jetUnits(k int) []string {
+	units := make([]string, k+1)
+	for n := 1; n <= k; n++ {
+		if n == 1 {
+			units[n] = "α"
+		} else {
+			units[n] = fmt.Sprintf("α^%d", n)
+		}
+	}
+	return units
+}
+
+// bladeUnits returns the symbols of the blades of a multivector with 2^n
+// components.
+func bladeUnits(n int) []string {
+	units := make([]string, 1<<uint(n))
+	for k := range units {
+		units[k] = bladeName(k)
+	}
+	return units
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestToLaTeX(t *testing.T) {
+	one, two := big.NewFloat(1), big.NewFloat(2)
+	tiny := new(big.Float).SetPrec(100).SetMantExp(big.NewFloat(1.5), -70)
+	c := NewClifford(2, 0)
+	c.c[3].SetInt64(-3)
+	cases := []struct {
+		got, want string
+	}{
+		{NewComplex(big.NewFloat(1.5), big.NewFloat(-2)).ToLaTeX(0), `1.5 - 2\mathbf{i}`},
+		{NewComplex(tiny, one).ToLaTeX(5), `1.2705 \times 10^{-21} + 1\mathbf{i}`},
+		{NewInfra(one, new(big.Float).SetInf(true)).ToLaTeX(0), `1 - \infty\alpha`},
+		{NewDualComplex(NewComplex(one, two), NewComplex(one, two)).ToLaTeX(0), `1 + 2\mathbf{i} + 1\varepsilon + 2\mathbf{i}\varepsilon`},
+		{NewJetVariable(two, 2).ToLaTeX(0), `2 + 1\alpha + 0\alpha^{2}`},
+		{c.ToLaTeX(0), `0 + 0\mathbf{e}_{1} + 0\mathbf{e}_{2} - 3\mathbf{e}_{1}\mathbf{e}_{2}`},
+		{NewVec3(one, two, one).ToLaTeX(0), `\left(1, 2, 1\right)`},
+		{NewComplexPoly(NewComplex(one, two), new(Complex), NewComplex(two, one)).ToLaTeX(0), `\left(1 + 2\mathbf{i}\right) + \left(0 + 0\mathbf{i}\right)x + \left(2 + 1\mathbf{i}\right)x^{2}`},
+		{new(ComplexPoly).ToLaTeX(0), `0`},
+		{NewProjectiveComplex(NewComplex(one, two), new(Complex)).ToLaTeX(0), `\left[1 + 2\mathbf{i} : 0 + 0\mathbf{i}\right]`},
+		{NewZorn(one, two, NewVec3(one, two, one), new(Vec3)).ToLaTeX(0), `\begin{pmatrix} 1 & \left(1, 2, 1\right) \\ \left(0, 0, 0\right) & 2 \end{pmatrix}`},
+	}
+	for _, c := range cases {
+		if c.got != c.want {
+			t.Errorf("ToLaTeX = %s, want %s", c.got, c.want)
+		}
+	}
+}
+
+func TestToMathematica(t *testing.T) {
+	one, two := big.NewFloat(1), big.NewFloat(2)
+	third := new(big.Float).SetPrec(100).Quo(big.NewFloat(1), big.NewFloat(3))
+	huge := new(big.Float).SetMantExp(big.NewFloat(-1.5), 100)
+	g := NewGrassmann(2)
+	g.c[3].SetInt64(5)
+	m := NewHamiltonMatrix(1, 2)
+	m.At(0, 1).Copy(NewHamilton(one, two, one, two))
+	cases := []struct {
+		got, want string
+	}{
+		{NewComplex(third, huge).ToMathematica(20), "0.33333333333333333333`20 - 1.9014759003423441022`20*^30*I"},
+		{NewComplex(big.NewFloat(1.5), new(big.Float)).ToMathematica(0), "1.5`15.955 + 0*I"},
+		{NewHamilton(one, two, new(big.Float), huge).ToMathematica(5), "1`5 + 2`5*i + 0*j - 1.9015`5*^30*k"},
+		{NewInfraPerplex(one, one, one, two).ToMathematica(3), "1`3 + 1`3*s + 1`3*\\[Tau] + 2`3*\\[Upsilon]"},
+		{NewJetVariable(two, 2).ToMathematica(3), "2`3 + 1`3*\\[Alpha] + 0*\\[Alpha]^2"},
+		{g.ToMathematica(3), "0 + 0*Subscript[e, 1] + 0*Subscript[e, 2] + 5`3*Subscript[e, 1]**Subscript[e, 2]"},
+		{m.ToMathematica(3), "{{0 + 0*i + 0*j + 0*k, 1`3 + 2`3*i + 1`3*j + 2`3*k}}"},
+		{NewBiquaternion(NewComplex(one, two), new(Complex), new(Complex), NewComplex(two, one)).ToMathematica(3), "(1`3 + 2`3*I) + (0 + 0*I)*i + (0 + 0*I)*j + (2`3 + 1`3*I)*k"},
+		{NewProjectiveComplex(NewComplex(one, two), new(Complex)).ToMathematica(3), "{1`3 + 2`3*I, 0 + 0*I}"},
+		{NewMöbiusComplex(NewComplex(one, one), new(Complex), new(Complex), NewComplex(two, one)).ToMathematica(3), "{{1`3 + 1`3*I, 0 + 0*I}, {0 + 0*I, 2`3 + 1`3*I}}"},
+	}
+	for _, c := range cases {
+		if c.got != c.want {
+			t.Errorf("ToMathematica = %s, want %s", c.got, c.want)
+		}
+	}
+}
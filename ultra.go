@@ -0,0 +1,270 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strings"
+)
+
+var symbUltra = [8]string{"", "α", "β", "γ", "δ", "ε", "ζ", "η"}
+
+// An Ultra represents a multi-precision floating-point ultra number, the
+// Cayley-Dickson double of a supra number by a nilpotent unit, as Supra is the
+// double of Infra.
+type Ultra struct {
+	l, r Supra
+}
+
+// Real returns the real part of z.
+func (z *Ultra) Real() *big.Float {
+	return (&z.l).Real()
+}
+
+// Cartesian returns the eight multi-precision floating-point Cartesian
+// components of z.
+func (z *Ultra) Cartesian() (*big.Float, *big.Float, *big.Float, *big.Float, *big.Float, *big.Float, *big.Float, *big.Float) {
+	a, b, c, d := z.l.Cartesian()
+	e, f, g, h := z.r.Cartesian()
+	return a, b, c, d, e, f, g, h
+}
+
+// String returns the string representation of an Ultra value.
+//
+// If z corresponds to a + bα + cβ + dγ + eδ + fε + gζ + hη, then the string is
+// "(a+bα+cβ+dγ+eδ+fε+gζ+hη)", similar to complex128 values.
+func (z *Ultra) String() string {
+	v := make([]*big.Float, 8)
+	v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7] = z.Cartesian()
+	a := make([]string, 17)
+	a[0] = "("
+	a[1] = fmt.Sprintf("%v", v[0])
+	i := 1
+	for j := 2; j < 16; j = j + 2 {
+		if v[i].Sign() < 0 {
+			a[j] = fmt.Sprintf("%v", v[i])
+		} else {
+			a[j] = fmt.Sprintf("+%v", v[i])
+		}
+		a[j+1] = symbUltra[i]
+		i++
+	}
+	a[16] = ")"
+	return strings.Join(a, "")
+}
+
+// Format implements fmt.Formatter, with the conventions of the Format method
+// of Complex.
+func (z *Ultra) Format(s fmt.State, verb rune) {
+	formatTerms(s, verb, z, symbUltra[:], floatSlice(z.Cartesian()))
+}
+
+// Equals returns true if y and z are equal.
+func (z *Ultra) Equals(y *Ultra) bool {
+	if !z.l.Equals(&y.l) || !z.r.Equals(&y.r) {
+		return false
+	}
+	return true
+}
+
+// Copy copies y onto z, and returns z.
+func (z *Ultra) Copy(y *Ultra) *Ultra {
+	z.l.Copy(&y.l)
+	z.r.Copy(&y.r)
+	return z
+}
+
+// NewUltra returns a pointer to the Ultra value a+bα+cβ+dγ+eδ+fε+gζ+hη.
+func NewUltra(a, b, c, d, e, f, g, h *big.Float) *Ultra {
+	z := new(Ultra)
+	z.l.Copy(NewSupra(a, b, c, d))
+	z.r.Copy(NewSupra(e, f, g, h))
+	return z
+}
+
+// Scal sets z equal to y scaled by a, and returns z.
+func (z *Ultra) Scal(y *Ultra, a *big.Float) *Ultra {
+	z.l.Scal(&y.l, a)
+	z.r.Scal(&y.r, a)
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *Ultra) Neg(y *Ultra) *Ultra {
+	z.l.Neg(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Conj sets z equal to the conjugate of y, and returns z.
+func (z *Ultra) Conj(y *Ultra) *Ultra {
+	z.l.Conj(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Add sets z equal to x+y, and returns z.
+func (z *Ultra) Add(x, y *Ultra) *Ultra {
+	z.l.Add(&x.l, &y.l)
+	z.r.Add(&x.r, &y.r)
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z.
+func (z *Ultra) Sub(x, y *Ultra) *Ultra {
+	z.l.Sub(&x.l, &y.l)
+	z.r.Sub(&x.r, &y.r)
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z.
+//
+// With x = (a, b) and y = (c, d) as pairs of Supra values, the product is
+// given by the Cayley-Dickson formula with a nilpotent unit
+// 		(Mul(a, c), Mul(d, a) + Mul(b, Conj(c)))
+// The multiplication rules are:
+// 		Mul(u, u) = 0 for every unit u other than 1
+// 		Mul(α, β) = -Mul(β, α) = γ
+// 		Mul(α, δ) = -Mul(δ, α) = ε
+// 		Mul(β, δ) = -Mul(δ, β) = ζ
+// 		Mul(γ, δ) = -Mul(δ, γ) = η
+// 		Mul(α, ζ) = -Mul(ζ, α) = -η
+// 		Mul(β, ε) = -Mul(ε, β) = η
+// and all other products of two units other than 1 vanish. So the algebra is
+// generated by the three nilpotent units α, β, and δ, and a product of more
+// than three of them vanishes. This binary operation is noncommutative and
+// nonassociative; for example
+// 		Mul(Mul(α, β), δ) = η = -Mul(α, Mul(β, δ))
+// Unless the components of x or y differ widely in scale, the product is
+// computed exactly and then rounded once, so the result is exact whenever it
+// fits the precision of z; otherwise it is computed with rounded arithmetic.
+func (z *Ultra) Mul(x, y *Ultra) *Ultra {
+	a, b, c, d, e, f, g, h := x.Cartesian()
+	s, t, u, v, w, m, n, p := y.Cartesian()
+	prec := maxPrec(a, b, c, d, e, f, g, h, s, t, u, v, w, m, n, p)
+	z0, z1, z2, z3, z4, z5, z6, z7 := z.Cartesian()
+	if fitsExact(prec,
+		[]*big.Float{z0, z1, z2, z3, z4, z5, z6, z7},
+		[]*big.Float{a, b, c, d, e, f, g, h},
+		[]*big.Float{s, t, u, v, w, m, n, p},
+	) {
+		l := new(Supra).mulExact(&x.l, &y.l)
+		r := new(Supra).addExact(
+			new(Supra).mulExact(&y.r, &x.l),
+			new(Supra).mulExact(&x.r, new(Supra).Conj(&y.l)),
+		)
+		z.l.roundSupra(l, prec)
+		z.r.roundSupra(r, prec)
+		return z
+	}
+	mulDoubling(0, &z.l, &z.r, &x.l, &x.r, &y.l, &y.r)
+	return z
+}
+
+// Commutator sets z equal to the commutator of x and y:
+// 		Mul(x, y) - Mul(y, x)
+// Then it returns z.
+func (z *Ultra) Commutator(x, y *Ultra) *Ultra {
+	return z.Sub(
+		new(Ultra).Mul(x, y),
+		new(Ultra).Mul(y, x),
+	)
+}
+
+// Associator sets z equal to the associator of w, x, and y:
+// 		Mul(Mul(w, x), y) - Mul(w, Mul(x, y))
+// Then it returns z.
+func (z *Ultra) Associator(w, x, y *Ultra) *Ultra {
+	t := new(Ultra).Mul(w, x)
+	t.Mul(t, y)
+	u := new(Ultra).Mul(x, y)
+	u.Mul(w, u)
+	return z.Sub(t, u)
+}
+
+// Quad returns the quadrance of z. If z = a+bα+cβ+dγ+eδ+fε+gζ+hη, then the
+// quadrance is
+// 		Mul(a, a)
+// This is always non-negative.
+func (z *Ultra) Quad() *big.Float {
+	return z.l.Quad()
+}
+
+// IsZeroDiv returns true if z is a zero divisor. This is equivalent to the
+// real part of z being zero.
+func (z *Ultra) IsZeroDiv() bool {
+	return z.l.IsZeroDiv()
+}
+
+// IsNilpotent returns true if z raised to the n-th power vanishes. The powers
+// are taken as Mul(Mul(z, z), ...), though every power of an Ultra value is
+// the same however it is parenthesized. A value with zero real part squares
+// to zero, while any other value is invertible, so for n of at least 2,
+// IsNilpotent is equivalent to IsZeroDiv.
+func (z *Ultra) IsNilpotent(n int) bool {
+	zero := new(Ultra)
+	zeroFloat := new(big.Float)
+	if z.Equals(zero) {
+		return true
+	}
+	p := NewUltra(big.NewFloat(1), zeroFloat, zeroFloat, zeroFloat,
+		zeroFloat, zeroFloat, zeroFloat, zeroFloat)
+	for i := 0; i < n; i++ {
+		p.Mul(p, z)
+		if p.Equals(zero) {
+			return true
+		}
+	}
+	return false
+}
+
+// Inv sets z equal to the inverse of y, and returns z. The inverse is
+// Conj(y)/Quad(y), which satisfies Mul(y, z) = Mul(z, y) = 1. If y is a zero
+// divisor, then Inv panics.
+func (z *Ultra) Inv(y *Ultra) *Ultra {
+	if y.IsZeroDiv() {
+		panic("inverse of zero divisor")
+	}
+	quad := y.Quad()
+	z.Conj(y)
+	return z.quoQuad(z, quad)
+}
+
+// quoQuad sets z equal to y with every component divided by quad, and returns
+// z.
+func (z *Ultra) quoQuad(y *Ultra, quad *big.Float) *Ultra {
+	a, b, c, d, e, f, g, h := y.Cartesian()
+	s, t, u, v, w, m, n, p := z.Cartesian()
+	s.Quo(a, quad)
+	t.Quo(b, quad)
+	u.Quo(c, quad)
+	v.Quo(d, quad)
+	w.Quo(e, quad)
+	m.Quo(f, quad)
+	n.Quo(g, quad)
+	p.Quo(h, quad)
+	return z
+}
+
+// Generate returns a random Ultra value for quick.Check testing.
+func (z *Ultra) Generate(rand *rand.Rand, size int) reflect.Value {
+	randomUltra := &Ultra{
+		*NewSupra(
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+		),
+		*NewSupra(
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+			big.NewFloat(rand.Float64()),
+		),
+	}
+	return reflect.ValueOf(randomUltra)
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+// closeToUltra returns true if the components of x and y agree to about bits
+// bits, relative to the larger of their modulus and 1.
+func closeToUltra(x, y *Ultra, bits int) bool {
+	return closeToComplex(NewComplex(&x.l.l.l, &x.l.l.r), NewComplex(&y.l.l.l, &y.l.l.r), bits) &&
+		closeToComplex(NewComplex(&x.l.r.l, &x.l.r.r), NewComplex(&y.l.r.l, &y.l.r.r), bits) &&
+		closeToComplex(NewComplex(&x.r.l.l, &x.r.l.r), NewComplex(&y.r.l.l, &y.r.l.r), bits) &&
+		closeToComplex(NewComplex(&x.r.r.l, &x.r.r.r), NewComplex(&y.r.r.l, &y.r.r.r), bits)
+}
+
+// ultraBasis returns the basis element of index k, with 0 for 1.
+func ultraBasis(k int) *Ultra {
+	z := new(Ultra)
+	a, b, c, d, e, f, g, h := z.Cartesian()
+	[]*big.Float{a, b, c, d, e, f, g, h}[k].SetInt64(1)
+	return z
+}
+
+func TestUltraBasis(t *testing.T) {
+	// The non-zero products of two units other than 1, as {u, v, w, sign}
+	// for Mul(u, v) = sign w.
+	rules := [][4]int{
+		{1, 2, 3, 1}, {2, 1, 3, -1},
+		{1, 4, 5, 1}, {4, 1, 5, -1},
+		{2, 4, 6, 1}, {4, 2, 6, -1},
+		{3, 4, 7, 1}, {4, 3, 7, -1},
+		{1, 6, 7, -1}, {6, 1, 7, 1},
+		{2, 5, 7, 1}, {5, 2, 7, -1},
+	}
+	for u := 1; u < 8; u++ {
+		for v := 1; v < 8; v++ {
+			want := new(Ultra)
+			for _, r := range rules {
+				if r[0] == u && r[1] == v {
+					want.Scal(ultraBasis(r[2]), big.NewFloat(float64(r[3])))
+				}
+			}
+			if got := new(Ultra).Mul(ultraBasis(u), ultraBasis(v)); !got.Equals(want) {
+				t.Errorf("Mul(%s, %s) = %v, want %v", symbUltra[u], symbUltra[v], got, want)
+			}
+		}
+	}
+}
+
+func TestUltraMulNonAssociative(t *testing.T) {
+	l := new(Ultra).Associator(ultraBasis(1), ultraBasis(2), ultraBasis(4))
+	if want := new(Ultra).Scal(ultraBasis(7), big.NewFloat(2)); !l.Equals(want) {
+		t.Errorf("Associator(α, β, δ) = %v, want %v", l, want)
+	}
+}
+
+func TestUltraMulAlternative(t *testing.T) {
+	f := func(x, y *Ultra) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(Ultra).Associator(x, x, y)
+		r := new(Ultra).Associator(y, x, x)
+		zero := new(Ultra)
+		return closeToUltra(l, zero, 48) && closeToUltra(r, zero, 48)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUltraMulConjAntiDistributive(t *testing.T) {
+	f := func(x, y *Ultra) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l, r := new(Ultra), new(Ultra)
+		l.Conj(l.Mul(x, y))
+		r.Mul(r.Conj(y), new(Ultra).Conj(x))
+		return closeToUltra(l, r, 48)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUltraComposition(t *testing.T) {
+	f := func(x, y *Ultra) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(Ultra).Mul(x, y).Quad()
+		r := new(big.Float).Mul(x.Quad(), y.Quad())
+		return closeTo(l, r, 48)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUltraInv(t *testing.T) {
+	f := func(x *Ultra) bool {
+		// t.Logf("x = %v", x)
+		// Keep the real part away from zero, where Inv is ill-conditioned.
+		x.Real().Add(x.Real(), big.NewFloat(1))
+		inv := new(Ultra).Inv(x)
+		one := ultraBasis(0)
+		return closeToUltra(new(Ultra).Mul(x, inv), one, 40) &&
+			closeToUltra(new(Ultra).Mul(inv, x), one, 40)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUltraIsNilpotent(t *testing.T) {
+	f := func(x *Ultra) bool {
+		// t.Logf("x = %v", x)
+		y := new(Ultra).Copy(x)
+		y.Real().SetInt64(0)
+		return !x.IsNilpotent(5) && y.IsNilpotent(2) && !y.IsNilpotent(1) && y.IsZeroDiv()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUltraCommutatorAlias(t *testing.T) {
+	f := func(x, y *Ultra) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		return commutatorAliased(x, y)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
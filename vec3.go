@@ -0,0 +1,113 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strings"
+)
+
+// A Vec3 represents a multi-precision floating-point vector in
+// three-dimensional space.
+type Vec3 struct {
+	x, y, z big.Float
+}
+
+// Cartesian returns the three Cartesian components of v.
+func (v *Vec3) Cartesian() (*big.Float, *big.Float, *big.Float) {
+	return &v.x, &v.y, &v.z
+}
+
+// String returns the string version of a Vec3 value.
+//
+// If v corresponds to (x, y, z), then the string is "(x, y, z)".
+func (v *Vec3) String() string {
+	a := make([]string, 3)
+	a[0] = fmt.Sprintf("%v", &v.x)
+	a[1] = fmt.Sprintf("%v", &v.y)
+	a[2] = fmt.Sprintf("%v", &v.z)
+	return "(" + strings.Join(a, ", ") + ")"
+}
+
+// Format implements fmt.Formatter, with the conventions of the Format method
+// of Complex, except that no component is given a sign.
+func (v *Vec3) Format(s fmt.State, verb rune) {
+	if formatBad(s, verb, v) {
+		return
+	}
+	io.WriteString(s, "(")
+	for k, x := range floatSlice(v.Cartesian()) {
+		if k > 0 {
+			io.WriteString(s, ", ")
+		}
+		formatFloat(s, verb, x, false)
+	}
+	io.WriteString(s, ")")
+}
+
+// Equals returns true if u and v are equal.
+func (v *Vec3) Equals(u *Vec3) bool {
+	if v.x.Cmp(&u.x) != 0 || v.y.Cmp(&u.y) != 0 || v.z.Cmp(&u.z) != 0 {
+		return false
+	}
+	return true
+}
+
+// Copy copies u onto v, and returns v.
+func (v *Vec3) Copy(u *Vec3) *Vec3 {
+	v.x.Copy(&u.x)
+	v.y.Copy(&u.y)
+	v.z.Copy(&u.z)
+	return v
+}
+
+// NewVec3 returns a pointer to the Vec3 value (x, y, z).
+func NewVec3(x, y, z *big.Float) *Vec3 {
+	v := new(Vec3)
+	v.x.Copy(x)
+	v.y.Copy(y)
+	v.z.Copy(z)
+	return v
+}
+
+// Dot returns the dot product of v and u, a pointer to a big.Float value.
+func (v *Vec3) Dot(u *Vec3) *big.Float {
+	dot := new(big.Float).Mul(&v.x, &u.x)
+	dot.Add(dot, new(big.Float).Mul(&v.y, &u.y))
+	return dot.Add(dot, new(big.Float).Mul(&v.z, &u.z))
+}
+
+// Cross sets v equal to the cross product of s and t, and returns v.
+func (v *Vec3) Cross(s, t *Vec3) *Vec3 {
+	x := new(big.Float).Sub(
+		new(big.Float).Mul(&s.y, &t.z),
+		new(big.Float).Mul(&s.z, &t.y),
+	)
+	y := new(big.Float).Sub(
+		new(big.Float).Mul(&s.z, &t.x),
+		new(big.Float).Mul(&s.x, &t.z),
+	)
+	z := new(big.Float).Sub(
+		new(big.Float).Mul(&s.x, &t.y),
+		new(big.Float).Mul(&s.y, &t.x),
+	)
+	v.x.Set(x)
+	v.y.Set(y)
+	v.z.Set(z)
+	return v
+}
+
+// Generate returns a random Vec3 value for quick.Check testing.
+func (v *Vec3) Generate(rand *rand.Rand, size int) reflect.Value {
+	randomVec3 := &Vec3{
+		*big.NewFloat(rand.Float64()),
+		*big.NewFloat(rand.Float64()),
+		*big.NewFloat(rand.Float64()),
+	}
+	return reflect.ValueOf(randomVec3)
+}
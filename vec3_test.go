@@ -0,0 +1,36 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"testing"
+	"testing/quick"
+)
+
+func TestVec3CrossAntiCommutative(t *testing.T) {
+	f := func(x, y *Vec3) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(Vec3).Cross(x, y)
+		r := new(Vec3).Cross(y, x)
+		r.x.Neg(&r.x)
+		r.y.Neg(&r.y)
+		r.z.Neg(&r.z)
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestVec3CrossOrthogonal(t *testing.T) {
+	f := func(x, y *Vec3) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		c := new(Vec3).Cross(x, y)
+		d := c.Dot(x)
+		return d.Sign() == 0 || expo(d) < -45
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,285 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import "math/big"
+
+// isOne returns true if z is equal to one.
+func isOne(z *Complex) bool {
+	return z.r.Sign() == 0 && z.l.Cmp(big.NewFloat(1)) == 0
+}
+
+// zetaNegativeInteger returns ζ(-m) = (-1)^m B[m+1]/(m+1) rounded to prec
+// bits. It vanishes for even m > 0, which are the trivial zeros.
+func zetaNegativeInteger(m int, prec uint) *Complex {
+	r := new(big.Rat).Quo(bernoulli(m+1), big.NewRat(int64(m+1), 1))
+	if m%2 == 1 {
+		r.Neg(r)
+	}
+	z := newComplexPrec(prec)
+	z.l.SetRat(r)
+	return z
+}
+
+// complexPowNeg returns n^(-s) = exp(-s log(n)) at precision prec.
+func complexPowNeg(n int, s *Complex, prec uint) *Complex {
+	l := bigLog(newFloat(prec).SetInt64(int64(n)), prec)
+	e := new(Complex).Scal(s, l.Neg(l))
+	return e.Exp(e)
+}
+
+// zetaComplex returns the Riemann zeta function of s rounded to prec bits. If
+// s is one, then zetaComplex panics.
+//
+// For Re(s) ≥ 0 it uses the Euler–Maclaurin formula
+// 		ζ(s) = Σ n^(-s) + N^(1-s)/(s-1) + N^(-s)/2
+// 		     + Σ B[2k]/(2k)! s(s+1)...(s+2k-2) N^(-s-2k+1)
+// where the first sum runs over 1 ≤ n < N. The cut-off N grows with |s| and
+// the precision, so that the second sum converges geometrically. For
+// Re(s) < 0 it uses the functional equation
+// 		ζ(s) = 2^s π^(s-1) sin(πs/2) Γ(1-s) ζ(1-s)
+func zetaComplex(s *Complex, prec uint) *Complex {
+	if isOne(s) {
+		panic("zeta function at its pole")
+	}
+	if isNonPositiveInteger(s) {
+		m, _ := s.l.Int64()
+		return zetaNegativeInteger(int(-m), prec)
+	}
+	w := prec + guardBits
+	x := newComplexPrec(w).round(s, w)
+	one := newComplexPrec(w)
+	one.l.SetInt64(1)
+	if x.l.Sign() < 0 {
+		y := new(Complex).Sub(one, x)
+		z := zetaComplex(y, w)
+		z.Mul(z, gammaComplex(y, w))
+		pi := bigPi(w)
+		sn := new(Complex).Scal(x, newFloat(w).SetMantExp(pi, -1))
+		z.Mul(z, sn.Sin(sn))
+		// 2^s π^(s-1) = exp(s log(2π))/π
+		f := new(Complex).Scal(x, bigLog(newFloat(w).SetMantExp(pi, 1), w))
+		f.Exp(f)
+		z.Mul(z, f)
+		z.Scal(z, newFloat(w).Quo(big.NewFloat(1), pi))
+		return newComplexPrec(prec).round(z, prec)
+	}
+	n := int(complexAbs(x)) + int(w)/2 + 1
+	w += uint(bitLen(n))
+	x = newComplexPrec(w).round(s, w)
+	one = newComplexPrec(w)
+	one.l.SetInt64(1)
+	sum := newComplexPrec(w)
+	for k := 1; k < n; k++ {
+		sum.Add(sum, complexPowNeg(k, x, w))
+	}
+	nf := newFloat(w).SetInt64(int64(n))
+	ns := complexPowNeg(n, x, w)
+	// N^(1-s)/(s-1)
+	t := new(Complex).Scal(ns, nf)
+	sum.Add(sum, new(Complex).Quo(t, new(Complex).Sub(x, one)))
+	sum.Add(sum, new(Complex).Scal(ns, big.NewFloat(0.5)))
+	// Euler–Maclaurin tail.
+	invN2 := newFloat(w).Mul(nf, nf)
+	invN2.Quo(big.NewFloat(1), invN2)
+	pw := new(Complex).Scal(ns, newFloat(w).Quo(big.NewFloat(1), nf))
+	poch := new(Complex).Copy(x)
+	fact := big.NewInt(2)
+	for k := 1; k <= int(w); k++ {
+		c := new(big.Rat).SetFrac(big.NewInt(1), fact)
+		c.Mul(c, bernoulli(2*k))
+		term := new(Complex).Mul(poch, pw)
+		term.Scal(term, newFloat(w).SetRat(c))
+		sum.Add(sum, term)
+		if complexExpo(term) < complexExpo(sum)-int(w) {
+			break
+		}
+		a := new(Complex).Copy(x)
+		a.l.Add(&a.l, newFloat(w).SetInt64(int64(2*k-1)))
+		poch.Mul(poch, a)
+		a.l.Add(&a.l, big.NewFloat(1))
+		poch.Mul(poch, a)
+		pw.Scal(pw, invN2)
+		fact.Mul(fact, big.NewInt(int64((2*k+1)*(2*k+2))))
+	}
+	return newComplexPrec(prec).round(sum, prec)
+}
+
+// Zeta sets z equal to the Riemann zeta function of s, and returns z.
+//
+// The function is evaluated with the Euler–Maclaurin formula in the right
+// half-plane, including the critical strip, and with the functional equation
+// in the left half-plane. The result is rounded to the precision of s. If s
+// is one, then Zeta panics.
+func (z *Complex) Zeta(s *Complex) *Complex {
+	return z.Copy(zetaComplex(s, maxPrec(&s.l, &s.r)))
+}
+
+// polyLogSeries returns Σ y^k/k^n over k ≥ 1, for |y| ≤ 1/2.
+func polyLogSeries(n int, y *Complex, prec uint) *Complex {
+	sum := newComplexPrec(prec)
+	pow := new(Complex).Copy(y)
+	for k := 1; k <= 2*int(prec); k++ {
+		d := new(big.Int).Exp(big.NewInt(int64(k)), big.NewInt(int64(n)), nil)
+		term := new(Complex).Scal(pow, newFloat(prec).Quo(big.NewFloat(1), newFloat(prec).SetInt(d)))
+		sum.Add(sum, term)
+		if complexExpo(term) < complexExpo(sum)-int(prec) {
+			break
+		}
+		pow.Mul(pow, y)
+	}
+	return sum
+}
+
+// polyLogLog returns Li[n](y) from the expansion in μ = log(y)
+// 		Li[n](y) = Σ ζ(n-k) μ^k/k! + (H[n-1] - log(-μ)) μ^(n-1)/(n-1)!
+// where the sum skips k = n-1 and H[n-1] is a harmonic number. It converges
+// for |μ| < 2π, and is used for 1/2 < |y| < 2.
+func polyLogLog(n int, y *Complex, prec uint) *Complex {
+	mu := new(Complex).Log(y)
+	sum := newComplexPrec(prec)
+	pow := newComplexPrec(prec)
+	pow.l.SetInt64(1)
+	fact := big.NewInt(1)
+	for k := 0; k <= n+4*int(prec); k++ {
+		var c *Complex
+		switch {
+		case k == n-1:
+			h := new(big.Rat)
+			for j := 1; j < n; j++ {
+				h.Add(h, big.NewRat(1, int64(j)))
+			}
+			c = new(Complex).Log(new(Complex).Neg(mu))
+			c.Neg(c)
+			c.l.Add(&c.l, newFloat(prec).SetRat(h))
+		case k < n-1:
+			c = zetaComplex(NewComplex(big.NewFloat(float64(n-k)), new(big.Float)), prec)
+		default:
+			c = zetaNegativeInteger(k-n, prec)
+		}
+		term := new(Complex).Mul(c, pow)
+		term.Scal(term, newFloat(prec).Quo(big.NewFloat(1), newFloat(prec).SetInt(fact)))
+		sum.Add(sum, term)
+		if k > n && c.l.Sign() != 0 && complexExpo(term) < complexExpo(sum)-int(prec) {
+			break
+		}
+		pow.Mul(pow, mu)
+		fact.Mul(fact, big.NewInt(int64(k+1)))
+	}
+	return sum
+}
+
+// polyLogInverse returns Li[n](y) for |y| ≥ 2 from the inversion formula
+// 		Li[n](y) + (-1)^n Li[n](1/y) = -(2πi)^n/n! B[n](1/2 + log(-y)/(2πi))
+// where B[n] is a Bernoulli polynomial.
+func polyLogInverse(n int, y *Complex, prec uint) *Complex {
+	twoPi := bigPi(prec)
+	twoPi.SetMantExp(twoPi, 1)
+	v := new(Complex).Log(new(Complex).Neg(y))
+	v.mulI(v, -1)
+	v.Scal(v, newFloat(prec).Quo(big.NewFloat(1), twoPi))
+	v.l.Add(&v.l, big.NewFloat(0.5))
+	// B[n](v) = Σ C(n, k) B[k] v^(n-k), by Horner's rule.
+	b := newComplexPrec(prec)
+	c := big.NewInt(1)
+	for k := 0; k <= n; k++ {
+		b.Mul(b, v)
+		r := new(big.Rat).Mul(new(big.Rat).SetInt(c), bernoulli(k))
+		b.l.Add(&b.l, newFloat(prec).SetRat(r))
+		c.Mul(c, big.NewInt(int64(n-k)))
+		c.Quo(c, big.NewInt(int64(k+1)))
+	}
+	f := new(big.Int).MulRange(1, int64(n))
+	g := newFloat(prec).SetInt64(1)
+	for k := 0; k < n; k++ {
+		g.Mul(g, twoPi)
+	}
+	g.Quo(g, newFloat(prec).SetInt(f))
+	b.Scal(b, g.Neg(g))
+	b.mulI(b, n)
+	inv := polyLogSeries(n, new(Complex).Inv(y), prec)
+	if n%2 == 0 {
+		return b.Sub(b, inv)
+	}
+	return b.Add(b, inv)
+}
+
+// polyLogNonPositive returns Li[-m](y) from the rational form
+// 		Li[-m](y) = Σ k! S(m+1, k+1) (y/(1-y))^(k+1),    0 ≤ k ≤ m
+// where S are the Stirling numbers of the second kind.
+func polyLogNonPositive(m int, y *Complex, prec uint) *Complex {
+	one := newComplexPrec(prec)
+	one.l.SetInt64(1)
+	u := new(Complex).Quo(y, new(Complex).Sub(one, y))
+	// s[k] = S(m+1, k), built row by row.
+	s := []*big.Int{big.NewInt(1)}
+	for r := 1; r <= m+1; r++ {
+		t := make([]*big.Int, r+1)
+		t[0] = new(big.Int)
+		for k := 1; k <= r; k++ {
+			t[k] = new(big.Int)
+			if k < len(s) {
+				t[k].Mul(big.NewInt(int64(k)), s[k])
+			}
+			t[k].Add(t[k], s[k-1])
+		}
+		s = t
+	}
+	sum := newComplexPrec(prec)
+	pow := new(Complex).Copy(u)
+	f := big.NewInt(1)
+	for k := 0; k <= m; k++ {
+		c := new(big.Int).Mul(f, s[k+1])
+		sum.Add(sum, new(Complex).Scal(pow, newFloat(prec).SetInt(c)))
+		pow.Mul(pow, u)
+		f.Mul(f, big.NewInt(int64(k+1)))
+	}
+	return sum
+}
+
+// PolyLog sets z equal to the polylogarithm
+// 		Li[n](y) = Σ y^k/k^n,    k ≥ 1
+// continued analytically to the plane cut along [1, ∞), and returns z. On the
+// cut, the sign of the imaginary part of y selects the side, as for Log.
+//
+// For n ≤ 0 the polylogarithm is a rational function of y, and Li[1](y) is
+// -log(1-y). For n ≥ 2 the defining series is used for |y| ≤ 1/2, the
+// inversion formula for |y| ≥ 2, and the expansion in log(y) in between. The
+// result is rounded to the precision of y. If n ≤ 1 and y is one, then
+// PolyLog panics.
+func (z *Complex) PolyLog(n int, y *Complex) *Complex {
+	prec := maxPrec(&y.l, &y.r)
+	w := prec + guardBits
+	if n > 1 {
+		w += uint(bitLen(n))
+	}
+	x := newComplexPrec(w).round(y, w)
+	one := newComplexPrec(w)
+	one.l.SetInt64(1)
+	var r *Complex
+	switch {
+	case x.l.Sign() == 0 && x.r.Sign() == 0:
+		r = newComplexPrec(w)
+	case n <= 1 && isOne(x):
+		panic("polylogarithm at its pole")
+	case n <= 0:
+		r = polyLogNonPositive(-n, x, w)
+	case n == 1:
+		r = new(Complex).Log(one.Sub(one, x))
+		r.Neg(r)
+	case isOne(x):
+		r = zetaComplex(x.Scal(x, newFloat(w).SetInt64(int64(n))), w)
+	default:
+		q := x.Quad()
+		switch {
+		case q.Cmp(big.NewFloat(0.25)) <= 0:
+			r = polyLogSeries(n, x, w)
+		case q.Cmp(big.NewFloat(4)) >= 0:
+			r = polyLogInverse(n, x, w)
+		default:
+			r = polyLogLog(n, x, w)
+		}
+	}
+	return z.Copy(newComplexPrec(prec).round(r, prec))
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math"
+	"math/big"
+	"math/cmplx"
+	"testing"
+)
+
+func TestZetaValues(t *testing.T) {
+	cases := []struct {
+		s    complex128
+		zeta complex128
+	}{
+		{2, math.Pi * math.Pi / 6},
+		{3, 1.2020569031595942854},
+		{4, math.Pi * math.Pi * math.Pi * math.Pi / 90},
+		{0.5, -1.4603545088095868129},
+		{0, -0.5},
+		{-1, -1.0 / 12},
+		{-2, 0},
+		{-2.5, 0.0085169287778503305423},
+		{complex(2, 1), complex(1.1503557032549019, -0.43753086591960794)},
+	}
+	for _, c := range cases {
+		s := NewComplex(big.NewFloat(real(c.s)), big.NewFloat(imag(c.s)))
+		if got := new(Complex).Zeta(s); !closeToComplex128(got, c.zeta, 48) {
+			t.Errorf("Zeta(%v) = %v, want %v", c.s, got, c.zeta)
+		}
+	}
+}
+
+func TestZetaFirstZero(t *testing.T) {
+	// The first nontrivial zero is at 1/2 + 14.134725141734693790457251983562...i.
+	s := newComplexPrec(200)
+	s.l.SetFloat64(0.5)
+	s.r.SetPrec(200).SetString("14.134725141734693790457251983562470270784257115699243175685567460149")
+	if z := new(Complex).Zeta(s); complexExpo(z) > -185 {
+		t.Errorf("Zeta(%v) = %v, want 0", s, z)
+	}
+}
+
+func TestZetaFunctionalEquationSeam(t *testing.T) {
+	// Values just on either side of Re(s) = 0 use different methods.
+	for _, im := range []float64{0, 3, -20} {
+		a := new(Complex).Zeta(complexPrec(-1e-30, im, 150))
+		b := new(Complex).Zeta(complexPrec(1e-30, im, 150))
+		if d := new(Complex).Sub(a, b); complexExpo(d) > complexExpo(a)-90 {
+			t.Errorf("Zeta across Re(s) = 0 at Im(s) = %v: %v and %v", im, a, b)
+		}
+	}
+}
+
+func TestPolyLogValues(t *testing.T) {
+	const ln2 = math.Ln2
+	cases := []struct {
+		n    int
+		y    complex128
+		want complex128
+	}{
+		{2, 1, math.Pi * math.Pi / 6},
+		{2, -1, -math.Pi * math.Pi / 12},
+		{2, 0.5, math.Pi*math.Pi/12 - ln2*ln2/2},
+		{3, 0.5, 0.53721319360804020094},
+		{2, 2, complex(math.Pi*math.Pi/4, math.Pi*ln2)},
+		{2, complex(2, math.Copysign(0, -1)), complex(math.Pi*math.Pi/4, -math.Pi*ln2)},
+		{1, complex(0.3, 0.4), -cmplx.Log(complex(0.7, -0.4))},
+		{0, complex(3, 1), complex(3, 1) / (1 - complex(3, 1))},
+		{-1, 0.5, 2},
+		{-2, complex(0.2, -0.7), complex(0.2, -0.7) * (1 + complex(0.2, -0.7)) / cmplx.Pow(1-complex(0.2, -0.7), 3)},
+	}
+	for _, c := range cases {
+		y := NewComplex(big.NewFloat(real(c.y)), big.NewFloat(imag(c.y)))
+		if got := new(Complex).PolyLog(c.n, y); !closeToComplex128(got, c.want, 46) {
+			t.Errorf("PolyLog(%d, %v) = %v, want %v", c.n, c.y, got, c.want)
+		}
+	}
+}
+
+func TestPolyLogDuplication(t *testing.T) {
+	// Li[n](y) + Li[n](-y) = 2^(1-n) Li[n](y²), across all three regions.
+	points := [][2]float64{{0.2, 0.1}, {0.6, -0.5}, {-1.1, 0.9}, {1.5, 2}, {-4, -3}, {0.1, 3}}
+	for _, n := range []int{2, 3, 5} {
+		for _, p := range points {
+			y := complexPrec(p[0], p[1], 150)
+			a := new(Complex).PolyLog(n, y)
+			a.Add(a, new(Complex).PolyLog(n, new(Complex).Neg(y)))
+			b := new(Complex).PolyLog(n, new(Complex).Mul(y, y))
+			b.l.SetMantExp(&b.l, 1-n)
+			b.r.SetMantExp(&b.r, 1-n)
+			if d := new(Complex).Sub(a, b); complexExpo(d) > complexExpo(b)-135 {
+				t.Errorf("duplication for Li[%d] at %v: %v and %v", n, p, a, b)
+			}
+		}
+	}
+}
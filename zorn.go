@@ -0,0 +1,263 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"math/rand"
+	"reflect"
+)
+
+// A Zorn represents a multi-precision floating-point split-octonion, as a
+// Zorn vector-matrix
+// 		[a  u]
+// 		[v  b]
+// with real numbers a and b on the diagonal and vectors u and v in
+// three-dimensional space off the diagonal.
+type Zorn struct {
+	a, b big.Float
+	u, v Vec3
+}
+
+// components returns the eight components of z, in the order a, u, v, b.
+func (z *Zorn) components() []*big.Float {
+	return []*big.Float{&z.a, &z.u.x, &z.u.y, &z.u.z, &z.v.x, &z.v.y, &z.v.z, &z.b}
+}
+
+// VectorMatrix returns the diagonal entries a and b and the off-diagonal
+// vectors u and v of z.
+func (z *Zorn) VectorMatrix() (*big.Float, *big.Float, *Vec3, *Vec3) {
+	return &z.a, &z.b, &z.u, &z.v
+}
+
+// String returns the string representation of a Zorn value.
+//
+// If z corresponds to the vector-matrix with diagonal a, b and off-diagonal
+// u, v, then the string is "[[a, u], [v, b]]", with the vectors in the format
+// of a Vec3 value.
+func (z *Zorn) String() string {
+	return fmt.Sprintf("[[%v, %v], [%v, %v]]", &z.a, &z.u, &z.v, &z.b)
+}
+
+// Format implements fmt.Formatter, with the conventions of the Format method
+// of Vec3.
+func (z *Zorn) Format(s fmt.State, verb rune) {
+	if formatBad(s, verb, z) {
+		return
+	}
+	io.WriteString(s, "[[")
+	formatFloat(s, verb, &z.a, false)
+	io.WriteString(s, ", ")
+	z.u.Format(s, verb)
+	io.WriteString(s, "], [")
+	z.v.Format(s, verb)
+	io.WriteString(s, ", ")
+	formatFloat(s, verb, &z.b, false)
+	io.WriteString(s, "]]")
+}
+
+// Equals returns true if y and z are equal.
+func (z *Zorn) Equals(y *Zorn) bool {
+	if z.a.Cmp(&y.a) != 0 || z.b.Cmp(&y.b) != 0 || !z.u.Equals(&y.u) || !z.v.Equals(&y.v) {
+		return false
+	}
+	return true
+}
+
+// Copy copies y onto z, and returns z.
+func (z *Zorn) Copy(y *Zorn) *Zorn {
+	z.a.Copy(&y.a)
+	z.b.Copy(&y.b)
+	z.u.Copy(&y.u)
+	z.v.Copy(&y.v)
+	return z
+}
+
+// NewZorn returns a pointer to the Zorn value with diagonal entries a and b
+// and off-diagonal vectors u and v.
+func NewZorn(a, b *big.Float, u, v *Vec3) *Zorn {
+	z := new(Zorn)
+	z.a.Copy(a)
+	z.b.Copy(b)
+	z.u.Copy(u)
+	z.v.Copy(v)
+	return z
+}
+
+// Scal sets z equal to y scaled by a, and returns z.
+func (z *Zorn) Scal(y *Zorn, a *big.Float) *Zorn {
+	s, t := z.components(), y.components()
+	for k := range s {
+		s[k].Mul(t[k], a)
+	}
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *Zorn) Neg(y *Zorn) *Zorn {
+	s, t := z.components(), y.components()
+	for k := range s {
+		s[k].Neg(t[k])
+	}
+	return z
+}
+
+// Conj sets z equal to the conjugate of y, and returns z. The conjugate of
+// the vector-matrix with diagonal a, b and off-diagonal u, v has diagonal b,
+// a and off-diagonal -u, -v.
+func (z *Zorn) Conj(y *Zorn) *Zorn {
+	a := new(big.Float).Copy(&y.a)
+	z.a.Copy(&y.b)
+	z.b.Copy(a)
+	s, t := z.components(), y.components()
+	for k := 1; k < 7; k++ {
+		s[k].Neg(t[k])
+	}
+	return z
+}
+
+// Add sets z equal to x+y, and returns z.
+func (z *Zorn) Add(x, y *Zorn) *Zorn {
+	s, t, u := z.components(), x.components(), y.components()
+	for k := range s {
+		s[k].Add(t[k], u[k])
+	}
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z.
+func (z *Zorn) Sub(x, y *Zorn) *Zorn {
+	s, t, u := z.components(), x.components(), y.components()
+	for k := range s {
+		s[k].Sub(t[k], u[k])
+	}
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z.
+//
+// The multiplication rule is the vector-matrix product
+// 		[a  u][c  s]   [ac + u·t        as + du - v×t]
+// 		[v  b][t  d] = [cv + bt + u×s        bd + v·s]
+// This binary operation is noncommutative and nonassociative, but it is
+// alternative.
+// Unless the components of x or y differ widely in scale, the product is
+// computed exactly and then rounded once, so the result is exact whenever it
+// fits the precision of z; otherwise it is computed with rounded arithmetic.
+func (z *Zorn) Mul(x, y *Zorn) *Zorn {
+	prec := maxPrec(append(x.components(), y.components()...)...)
+	o := productOps(prec, z.components(), x.components(), y.components())
+	a := o.add(o.mul(&x.a, &y.a), o.dot(&x.u, &y.v))
+	b := o.add(o.mul(&x.b, &y.b), o.dot(&x.v, &y.u))
+	u := o.comb(new(Vec3), &x.a, &y.u, &y.b, &x.u)
+	o.subVec(u, u, o.cross(new(Vec3), &x.v, &y.v))
+	v := o.comb(new(Vec3), &y.a, &x.v, &x.b, &y.v)
+	o.addVec(v, v, o.cross(new(Vec3), &x.u, &y.u))
+	roundFloat(&z.a, a, prec)
+	roundFloat(&z.b, b, prec)
+	s, t := z.components(), []*big.Float{&u.x, &u.y, &u.z, &v.x, &v.y, &v.z}
+	for k := range t {
+		roundFloat(s[1+k], t[k], prec)
+	}
+	o.settle(z.components()...)
+	return z
+}
+
+// Commutator sets z equal to the commutator of x and y:
+// 		Mul(x, y) - Mul(y, x)
+// Then it returns z.
+func (z *Zorn) Commutator(x, y *Zorn) *Zorn {
+	return z.Sub(
+		new(Zorn).Mul(x, y),
+		new(Zorn).Mul(y, x),
+	)
+}
+
+// Associator sets z equal to the associator of w, x, and y:
+// 		Mul(Mul(w, x), y) - Mul(w, Mul(x, y))
+// Then it returns z.
+func (z *Zorn) Associator(w, x, y *Zorn) *Zorn {
+	t := new(Zorn).Mul(w, x)
+	t.Mul(t, y)
+	u := new(Zorn).Mul(x, y)
+	u.Mul(w, u)
+	return z.Sub(t, u)
+}
+
+// Quad returns the quadrance of z, the determinant
+// 		ab - u·v
+// of its vector-matrix. It can be negative, and it is zero exactly when z is
+// a zero divisor. The components are prescaled by a power of two, so the
+// result overflows or underflows only if the quadrance is out of the exponent
+// range of big.Float, even when the products of the components are not.
+func (z *Zorn) Quad() *big.Float {
+	y, e := prescaled(z.components()...)
+	quad := new(big.Float).Mul(y[0], y[7])
+	dot := NewVec3(y[1], y[2], y[3]).Dot(NewVec3(y[4], y[5], y[6]))
+	return rescaleQuad(quad.Sub(quad, dot), e)
+}
+
+// IsZeroDiv returns true if z is a zero divisor.
+func (z *Zorn) IsZeroDiv() bool {
+	y, _ := prescaled(z.components()...)
+	return exactOps.dot(NewVec3(y[1], y[2], y[3]), NewVec3(y[4], y[5], y[6])).Cmp(exactMul(y[0], y[7])) == 0
+}
+
+// Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
+// then Inv panics.
+func (z *Zorn) Inv(y *Zorn) *Zorn {
+	if y.IsZeroDiv() {
+		panic("inverse of zero divisor")
+	}
+	quad := y.Quad()
+	z.Conj(y)
+	return z.quoQuad(z, quad)
+}
+
+// quoQuad sets z equal to y with every component divided by quad, and returns
+// z.
+func (z *Zorn) quoQuad(y *Zorn, quad *big.Float) *Zorn {
+	s, t := z.components(), y.components()
+	for k := range s {
+		s[k].Quo(t[k], quad)
+	}
+	return z
+}
+
+// QuoL sets z equal to the left quotient of x and y:
+// 		Mul(Inv(y), x)
+// Then it returns z. If y is a zero divisor, then QuoL panics.
+func (z *Zorn) QuoL(x, y *Zorn) *Zorn {
+	if y.IsZeroDiv() {
+		panic("left denominator is zero divisor")
+	}
+	quad := y.Quad()
+	t := new(Zorn).Conj(y)
+	t.Mul(t, x)
+	return z.quoQuad(t, quad)
+}
+
+// QuoR sets z equal to the right quotient of x and y:
+// 		Mul(x, Inv(y))
+// Then it returns z. If y is a zero divisor, then QuoR panics.
+func (z *Zorn) QuoR(x, y *Zorn) *Zorn {
+	if y.IsZeroDiv() {
+		panic("right denominator is zero divisor")
+	}
+	quad := y.Quad()
+	t := new(Zorn).Conj(y)
+	t.Mul(x, t)
+	return z.quoQuad(t, quad)
+}
+
+// Generate returns a random Zorn value for quick.Check testing.
+func (z *Zorn) Generate(rand *rand.Rand, size int) reflect.Value {
+	randomZorn := new(Zorn)
+	for _, v := range randomZorn.components() {
+		v.SetFloat64(rand.Float64())
+	}
+	return reflect.ValueOf(randomZorn)
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+// closeToZorn returns true if the components of x and y agree to about bits
+// bits, relative to the larger of their modulus and 1.
+func closeToZorn(x, y *Zorn, bits int) bool {
+	s, t := x.components(), y.components()
+	for k := range s {
+		if !closeToComplex(NewComplex(s[k], new(big.Float)), NewComplex(t[k], new(big.Float)), bits) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestZornZeroDivisor(t *testing.T) {
+	zero := new(Vec3)
+	x := NewZorn(big.NewFloat(1), new(big.Float), zero, zero)
+	y := NewZorn(new(big.Float), big.NewFloat(1), zero, zero)
+	if !x.IsZeroDiv() || !y.IsZeroDiv() {
+		t.Errorf("IsZeroDiv(%v) = %v, IsZeroDiv(%v) = %v", x, x.IsZeroDiv(), y, y.IsZeroDiv())
+	}
+	if got := new(Zorn).Mul(x, y); !got.Equals(new(Zorn)) {
+		t.Errorf("Mul(%v, %v) = %v", x, y, got)
+	}
+	u := new(Vec3)
+	u.x.SetInt64(1)
+	w := NewZorn(big.NewFloat(1), big.NewFloat(1), u, u)
+	if !w.IsZeroDiv() || w.Quad().Sign() != 0 {
+		t.Errorf("IsZeroDiv(%v) = %v", w, w.IsZeroDiv())
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Inv(%v) did not panic", w)
+		}
+	}()
+	new(Zorn).Inv(w)
+}
+
+func TestZornQuadIndefinite(t *testing.T) {
+	zero := new(Vec3)
+	x := NewZorn(big.NewFloat(1), big.NewFloat(-1), zero, zero)
+	if got := x.Quad(); got.Cmp(big.NewFloat(-1)) != 0 {
+		t.Errorf("Quad(%v) = %v, want -1", x, got)
+	}
+}
+
+func TestZornAddCommutative(t *testing.T) {
+	f := func(x, y *Zorn) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(Zorn).Add(x, y)
+		r := new(Zorn).Add(y, x)
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestZornMulNonCommutative(t *testing.T) {
+	f := func(x, y *Zorn) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(Zorn).Commutator(x, y)
+		zero := new(Zorn)
+		return !l.Equals(zero)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestZornMulNonAssociative(t *testing.T) {
+	f := func(x, y, z *Zorn) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		l := new(Zorn).Associator(x, y, z)
+		return !closeToZorn(l, new(Zorn), 20)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestZornMulAlternative(t *testing.T) {
+	f := func(x, y *Zorn) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(Zorn).Associator(x, x, y)
+		r := new(Zorn).Associator(x, y, y)
+		zero := new(Zorn)
+		return closeToZorn(l, zero, 45) && closeToZorn(r, zero, 45)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestZornConjInvolutive(t *testing.T) {
+	f := func(x *Zorn) bool {
+		// t.Logf("x = %v", x)
+		l := new(Zorn).Conj(new(Zorn).Conj(x))
+		return l.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestZornMulConjAntiDistributive(t *testing.T) {
+	f := func(x, y *Zorn) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(Zorn).Conj(new(Zorn).Mul(x, y))
+		r := new(Zorn).Mul(new(Zorn).Conj(y), new(Zorn).Conj(x))
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestZornComposition(t *testing.T) {
+	f := func(x, y *Zorn) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(Zorn).Mul(x, y).Quad()
+		r := new(big.Float).Mul(x.Quad(), y.Quad())
+		zero := new(big.Float)
+		return closeToComplex(NewComplex(l, zero), NewComplex(r, zero), 45)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestZornQuo(t *testing.T) {
+	f := func(x, y *Zorn) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		if y.IsZeroDiv() {
+			return true
+		}
+		l := new(Zorn).Mul(y, new(Zorn).QuoL(x, y))
+		r := new(Zorn).Mul(new(Zorn).QuoR(x, y), y)
+		return closeToZorn(l, x, 30) && closeToZorn(r, x, 30)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestZornCommutatorAlias(t *testing.T) {
+	f := func(x, y *Zorn) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		return commutatorAliased(x, y)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}